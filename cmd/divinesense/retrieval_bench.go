@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	"github.com/hrygo/divinesense/internal/version"
+	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/plugin/ai/vector"
+	"github.com/hrygo/divinesense/server/retrieval/bench"
+	"github.com/hrygo/divinesense/store"
+	"github.com/hrygo/divinesense/store/db"
+)
+
+var retrievalBenchCmd = &cobra.Command{
+	Use:   "retrieval-bench",
+	Short: "Benchmark retrieval strategies against a labeled query dataset",
+	Long: `Loads a labeled dataset into a temporary store, runs every query with every
+retrieval strategy and reports recall@k, MRR, nDCG@k and latency.
+
+The dataset is a JSON file of memos and queries labeled with their relevant memos:
+
+  {
+    "memos": [{"id": "okr", "content": "Q3 OKR: ...", "created_at": "2026-03-01"}],
+    "queries": [{"query": "third quarter goals", "relevant": ["okr"]}]
+  }
+
+Without --dsn the dataset is loaded into a temporary SQLite database, where only
+keyword search is available. The DSN is only taken from the command line, never
+from the environment, so the bench does not write to a configured server database.
+With --driver postgres and --dsn the memos are created under a temporary user,
+which is deleted afterwards; use a scratch database. Semantic search and reranking use the AI settings from the environment.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		datasetPath, _ := cmd.Flags().GetString("dataset")
+		k, _ := cmd.Flags().GetInt("k")
+		strategies, _ := cmd.Flags().GetStringSlice("strategies")
+		useReranker, _ := cmd.Flags().GetBool("reranker")
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		dataset, err := bench.LoadDataset(datasetPath)
+		if err != nil {
+			return err
+		}
+
+		instanceProfile := &profile.Profile{
+			Mode:    "prod",
+			Version: version.GetCurrentVersion("prod"),
+		}
+		instanceProfile.FromEnv()
+		// Flags are read directly: viper would fall back to DIVINESENSE_DSN and DIVINESENSE_DRIVER
+		if cmd.Flags().Changed("dsn") {
+			instanceProfile.Driver, _ = cmd.Flags().GetString("driver")
+			instanceProfile.DSN, _ = cmd.Flags().GetString("dsn")
+		}
+		if instanceProfile.DSN == "" {
+			dir, err := os.MkdirTemp("", "divinesense-bench-")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(dir)
+			instanceProfile.Driver = "sqlite"
+			instanceProfile.Data = dir
+			instanceProfile.DSN = filepath.Join(dir, "bench.db")
+		}
+
+		ctx := cmd.Context()
+		dbDriver, err := db.NewDBDriver(instanceProfile)
+		if err != nil {
+			return fmt.Errorf("failed to create db driver: %w", err)
+		}
+		storeInstance := store.New(dbDriver, instanceProfile)
+		defer storeInstance.Close()
+		if err := storeInstance.Migrate(ctx); err != nil {
+			return fmt.Errorf("failed to migrate: %w", err)
+		}
+
+		var embeddingService ai.EmbeddingService
+		var rerankerService ai.RerankerService
+		model := ""
+		if instanceProfile.IsAIEnabled() {
+			aiConfig := ai.NewConfigFromProfile(instanceProfile)
+			if err := aiConfig.Validate(); err != nil {
+				slog.Warn("AI config validation failed, semantic search is disabled", "error", err)
+			} else {
+				if embeddingService, err = ai.NewEmbeddingService(&aiConfig.Embedding); err != nil {
+					return fmt.Errorf("failed to create embedding service: %w", err)
+				}
+				model = aiConfig.Embedding.Model
+				if useReranker {
+					rerankerService = ai.NewRerankerService(&aiConfig.Reranker)
+				}
+			}
+		}
+
+		b := bench.New(storeInstance, vector.NewStoreVectorService(storeInstance, embeddingService, model), embeddingService, rerankerService)
+		corpus, err := b.Load(ctx, dataset)
+		defer func() {
+			// Clean up even if the run was interrupted
+			if err := b.Cleanup(context.WithoutCancel(ctx), corpus); err != nil {
+				slog.Warn("failed to clean up bench data", "error", err)
+			}
+		}()
+		if err != nil {
+			return err
+		}
+
+		report, err := b.Run(ctx, corpus, dataset, bench.Options{K: k, Strategies: strategies})
+		if err != nil {
+			return err
+		}
+		if jsonOutput {
+			return report.WriteJSON(os.Stdout)
+		}
+		fmt.Printf("%d memos, %d queries, database %s, semantic search %s, reranker %s\n\n",
+			len(dataset.Memos), len(dataset.Queries), instanceProfile.Driver,
+			enabledString(embeddingService != nil), enabledString(rerankerService != nil && rerankerService.IsEnabled()))
+		return report.WriteText(os.Stdout)
+	},
+}
+
+func init() {
+	retrievalBenchCmd.Flags().String("dataset", "", "path to the labeled JSON dataset")
+	retrievalBenchCmd.Flags().Int("k", 10, "number of results evaluated per query")
+	retrievalBenchCmd.Flags().StringSlice("strategies", nil, "strategies to run (default: "+strings.Join(bench.Strategies, ",")+")")
+	retrievalBenchCmd.Flags().Bool("reranker", true, "use the configured reranker")
+	retrievalBenchCmd.Flags().Bool("json", false, "print the report as JSON")
+	if err := retrievalBenchCmd.MarkFlagRequired("dataset"); err != nil {
+		panic(err)
+	}
+	rootCmd.AddCommand(retrievalBenchCmd)
+}
+
+func enabledString(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -46,6 +47,15 @@ type Profile struct {
 	AIEmbeddingModel     string // MEMOS_AI_EMBEDDING_MODEL (default: BAAI/bge-m3)
 	AIRerankModel        string // MEMOS_AI_RERANK_MODEL (default: BAAI/bge-reranker-v2-m3)
 	AILLMModel           string // MEMOS_AI_LLM_MODEL (default: deepseek-chat)
+	// AISemanticCacheEnabled reuses cached LLM responses of idempotent tasks (tag suggestions,
+	// capture summaries) for semantically similar prompts.
+	AISemanticCacheEnabled bool // DIVINESENSE_AI_SEMANTIC_CACHE_ENABLED (default: false)
+	// AIMMREnabled collapses near-duplicate memos in retrieval results and reorders
+	// the rest by maximal marginal relevance for diversity.
+	AIMMREnabled bool // DIVINESENSE_AI_MMR_ENABLED (default: false)
+	// AITokenizerVocabDir holds tiktoken vocabularies (e.g. cl100k_base.tiktoken.gz) for exact
+	// token counting; startup fails if a vocabulary is missing. Empty uses the embedded ones or a heuristic.
+	AITokenizerVocabDir string // DIVINESENSE_AI_TOKENIZER_VOCAB_DIR (default: "")
 
 	// Attachment Processing Configuration
 	OCREnabled          bool   // MEMOS_OCR_ENABLED (default: false)
@@ -54,6 +64,16 @@ type Profile struct {
 	TessdataPath        string // MEMOS_OCR_TESSDATA_PATH (default: "")
 	OCRLanguages        string // MEMOS_OCR_LANGUAGES (default: chi_sim+eng)
 	TikaServerURL       string // MEMOS_TEXTEXTRACT_TIKA_URL (default: http://localhost:9998)
+
+	// Outgoing Email Configuration (used for AI digest delivery)
+	SMTPHost      string // DIVINESENSE_SMTP_HOST
+	SMTPPort      int    // DIVINESENSE_SMTP_PORT (default: 587)
+	SMTPUsername  string // DIVINESENSE_SMTP_USERNAME
+	SMTPPassword  string // DIVINESENSE_SMTP_PASSWORD
+	SMTPFromEmail string // DIVINESENSE_SMTP_FROM_EMAIL
+	SMTPFromName  string // DIVINESENSE_SMTP_FROM_NAME (default: DivineSense)
+	SMTPUseTLS    bool   // DIVINESENSE_SMTP_USE_TLS (default: true)
+	SMTPUseSSL    bool   // DIVINESENSE_SMTP_USE_SSL (default: false)
 }
 
 func (p *Profile) IsDev() bool {
@@ -65,6 +85,11 @@ func (p *Profile) IsAIEnabled() bool {
 	return p.AIEnabled && (p.AISiliconFlowAPIKey != "" || p.AIOpenAIAPIKey != "" || p.AIOllamaBaseURL != "" || p.AIDeepSeekAPIKey != "")
 }
 
+// IsEmailEnabled returns true if an SMTP server and sender address are configured.
+func (p *Profile) IsEmailEnabled() bool {
+	return p.SMTPHost != "" && p.SMTPFromEmail != ""
+}
+
 // getEnvOrDefault returns the environment variable value or the default value.
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -114,6 +139,9 @@ func (p *Profile) FromEnv() {
 	p.AIEmbeddingModel = getEnvWithDefault("DIVINESENSE_AI_EMBEDDING_MODEL", "MEMOS_AI_EMBEDDING_MODEL", "BAAI/bge-m3")
 	p.AIRerankModel = getEnvWithDefault("DIVINESENSE_AI_RERANK_MODEL", "MEMOS_AI_RERANK_MODEL", "BAAI/bge-reranker-v2-m3")
 	p.AILLMModel = getEnvWithDefault("DIVINESENSE_AI_LLM_MODEL", "MEMOS_AI_LLM_MODEL", "deepseek-chat")
+	p.AISemanticCacheEnabled = os.Getenv("DIVINESENSE_AI_SEMANTIC_CACHE_ENABLED") == "true"
+	p.AIMMREnabled = os.Getenv("DIVINESENSE_AI_MMR_ENABLED") == "true"
+	p.AITokenizerVocabDir = os.Getenv("DIVINESENSE_AI_TOKENIZER_VOCAB_DIR")
 
 	// Attachment processing configuration
 	p.OCREnabled = getBoolEnvWithFallback("DIVINESENSE_OCR_ENABLED", "MEMOS_OCR_ENABLED")
@@ -122,6 +150,19 @@ func (p *Profile) FromEnv() {
 	p.TessdataPath = getEnvWithFallback("DIVINESENSE_OCR_TESSDATA_PATH", os.Getenv("MEMOS_OCR_TESSDATA_PATH"))
 	p.OCRLanguages = getEnvWithFallback("DIVINESENSE_OCR_LANGUAGES", getEnvOrDefault("MEMOS_OCR_LANGUAGES", "chi_sim+eng"))
 	p.TikaServerURL = getEnvWithFallback("DIVINESENSE_TEXTEXTRACT_TIKA_URL", getEnvOrDefault("MEMOS_TEXTEXTRACT_TIKA_URL", "http://localhost:9998"))
+
+	// Outgoing email configuration
+	p.SMTPHost = os.Getenv("DIVINESENSE_SMTP_HOST")
+	p.SMTPPort = 587
+	if port, err := strconv.Atoi(os.Getenv("DIVINESENSE_SMTP_PORT")); err == nil {
+		p.SMTPPort = port
+	}
+	p.SMTPUsername = os.Getenv("DIVINESENSE_SMTP_USERNAME")
+	p.SMTPPassword = os.Getenv("DIVINESENSE_SMTP_PASSWORD")
+	p.SMTPFromEmail = os.Getenv("DIVINESENSE_SMTP_FROM_EMAIL")
+	p.SMTPFromName = getEnvOrDefault("DIVINESENSE_SMTP_FROM_NAME", "DivineSense")
+	p.SMTPUseTLS = getEnvOrDefault("DIVINESENSE_SMTP_USE_TLS", "true") == "true"
+	p.SMTPUseSSL = os.Getenv("DIVINESENSE_SMTP_USE_SSL") == "true"
 }
 
 func checkDataDir(dataDir string) (string, error) {
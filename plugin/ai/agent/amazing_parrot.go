@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -12,7 +14,9 @@ import (
 
 	"github.com/hrygo/divinesense/plugin/ai"
 	"github.com/hrygo/divinesense/plugin/ai/agent/tools"
+	"github.com/hrygo/divinesense/plugin/ai/habit"
 	"github.com/hrygo/divinesense/plugin/ai/timeout"
+	"github.com/hrygo/divinesense/plugin/ai/tokenizer"
 	"github.com/hrygo/divinesense/server/retrieval"
 	"github.com/hrygo/divinesense/server/service/schedule"
 )
@@ -29,6 +33,8 @@ type AmazingParrot struct {
 	scheduleAddTool    *tools.ScheduleAddTool
 	findFreeTimeTool   *tools.FindFreeTimeTool
 	scheduleUpdateTool *tools.ScheduleUpdateTool
+	webCaptureTool     *tools.WebCaptureTool     // optional, nil disables web capture
+	attachmentReadTool *tools.AttachmentReadTool // optional, nil disables attachment reading
 }
 
 // retrievalPlan represents the plan for concurrent retrieval.
@@ -42,9 +48,19 @@ type retrievalPlan struct {
 	needsFreeTime       bool
 	freeTimeDate        string
 	needsScheduleUpdate bool
+	needsWebCapture     bool
+	webCaptureURL       string
+	webCaptureSave      bool // Save the captured page as a memo
+	needsAttachmentRead bool
+	attachmentFilename  string
+	attachmentQuery     string
 	needsDirectAnswer   bool // If true, skip retrieval and answer directly
 }
 
+// urlPattern matches http(s) links in user input.
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"'，。；！？、）)\]]+`)
+
+
 // NewAmazingParrot creates a new amazing parrot agent.
 // NewAmazingParrot 创建一个新的综合助手鹦鹉。
 func NewAmazingParrot(
@@ -90,6 +106,38 @@ func NewAmazingParrot(
 	}, nil
 }
 
+// SetWebCaptureTool enables the web_capture tool for links in user input.
+// SetWebCaptureTool 启用网页抓取工具，用于处理用户输入中的链接。
+func (p *AmazingParrot) SetWebCaptureTool(tool *tools.WebCaptureTool) {
+	p.webCaptureTool = tool
+}
+
+// SetAttachmentReadTool enables reading the extracted text of attachments.
+// SetAttachmentReadTool 启用附件提取文本的阅读能力。
+func (p *AmazingParrot) SetAttachmentReadTool(tool *tools.AttachmentReadTool) {
+	p.attachmentReadTool = tool
+}
+
+// SetHabitApplier lets schedule_add fill in the user's usual time and duration.
+// SetHabitApplier 让日程创建在用户未指定时间或时长时使用习惯默认值。
+func (p *AmazingParrot) SetHabitApplier(applier *habit.HabitApplier) {
+	p.scheduleAddTool.SetHabitApplier(applier)
+}
+
+// SetTokenizer sets the tokenizer used to fit memo search results into the prompt.
+// SetTokenizer 设置用于将笔记搜索结果裁剪到提示词预算内的分词器。
+func (p *AmazingParrot) SetTokenizer(tok tokenizer.Tokenizer) {
+	p.memoSearchTool.SetTokenizer(tok)
+}
+
+// SetTimezone sets the user's timezone for schedule creation, free time lookup and memo search dates.
+// SetTimezone 设置用户时区，用于创建日程、查找空闲时间和解析笔记搜索中的日期。
+func (p *AmazingParrot) SetTimezone(timezone string) {
+	p.memoSearchTool.SetTimezone(timezone)
+	p.scheduleAddTool.SetTimezone(timezone)
+	p.findFreeTimeTool.SetTimezone(timezone)
+}
+
 // Name returns the name of the parrot.
 // Name 返回鹦鹉名称。
 func (p *AmazingParrot) Name() string {
@@ -295,6 +343,11 @@ func (p *AmazingParrot) executeConcurrentRetrieval(ctx context.Context, plan *re
 				return
 			}
 
+			// Show the detected time constraint, so the user can tell why results are limited to it
+			if structuredResult.TimeRange != "" && callback != nil {
+				callback(EventTypeThinking, fmt.Sprintf("按时间范围检索笔记：%s", structuredResult.TimeRange))
+			}
+
 			// Convert to JSON for LLM synthesis
 			jsonBytes, marshalErr := json.Marshal(structuredResult)
 			if marshalErr != nil {
@@ -435,6 +488,64 @@ func (p *AmazingParrot) executeConcurrentRetrieval(ctx context.Context, plan *re
 		}()
 	}
 
+	// Execute web capture
+	if plan.needsWebCapture {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			safeCallback(EventTypeToolUse, "正在抓取网页...")
+
+			input, _ := json.Marshal(tools.WebCaptureInput{URL: plan.webCaptureURL, Save: plan.webCaptureSave})
+			result, err := p.webCaptureTool.Run(ctx, string(input))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				results["web_capture_error"] = err.Error()
+				atomic.AddInt32(&errorCount, 1)
+				if callback != nil {
+					callback(EventTypeError, fmt.Sprintf("网页抓取失败: %v", err))
+				}
+				return
+			}
+			results["web_capture"] = result
+			if callback != nil {
+				callback(EventTypeToolResult, result)
+			}
+		}()
+	}
+
+	// Execute attachment read
+	if plan.needsAttachmentRead {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			safeCallback(EventTypeToolUse, "正在阅读附件...")
+
+			input, _ := json.Marshal(tools.AttachmentReadInput{Filename: plan.attachmentFilename, Query: plan.attachmentQuery})
+			result, err := p.attachmentReadTool.Run(ctx, string(input))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				results["attachment_read_error"] = err.Error()
+				atomic.AddInt32(&errorCount, 1)
+				if callback != nil {
+					callback(EventTypeError, fmt.Sprintf("附件阅读失败: %v", err))
+				}
+				return
+			}
+			results["attachment_read"] = result
+			if callback != nil {
+				callback(EventTypeToolResult, result)
+			}
+		}()
+	}
+
 	// Execute find free time
 	if plan.needsFreeTime {
 		wg.Add(1)
@@ -477,6 +588,12 @@ func (p *AmazingParrot) executeConcurrentRetrieval(ctx context.Context, plan *re
 		if plan.needsFreeTime {
 			expectedResults++
 		}
+		if plan.needsWebCapture {
+			expectedResults++
+		}
+		if plan.needsAttachmentRead {
+			expectedResults++
+		}
 
 		// If all retrievals failed, return error
 		if int(actualErrorCount) >= expectedResults {
@@ -574,6 +691,25 @@ func (p *AmazingParrot) parseRetrievalPlan(response string, userInput string, no
 			}
 		}
 
+		// Parse attachment read: "attachment_read: <filename> | <query>"
+		if p.attachmentReadTool != nil && (strings.HasPrefix(line, "attachment_read:") || strings.HasPrefix(line, "ATTACHMENT_READ:")) {
+			parts := strings.SplitN(line, ":", 2)
+			filename, query, found := strings.Cut(parts[1], "|")
+			if !found {
+				// Without a separator the whole argument is the query
+				filename, query = "", filename
+			}
+			plan.needsAttachmentRead = true
+			plan.attachmentFilename = strings.TrimSpace(filename)
+			plan.attachmentQuery = strings.TrimSpace(query)
+		}
+
+		// Parse web capture
+		if strings.HasPrefix(line, "web_capture:") || strings.HasPrefix(line, "WEB_CAPTURE:") {
+			parts := strings.SplitN(line, ":", 2)
+			p.planWebCapture(plan, parts[1], userInput)
+		}
+
 		// Parse memo search
 		if strings.HasPrefix(line, "memo_search:") || strings.HasPrefix(line, "MEMO_SEARCH:") {
 			parts := strings.SplitN(line, ":", 2)
@@ -606,7 +742,7 @@ func (p *AmazingParrot) parseRetrievalPlan(response string, userInput string, no
 	}
 
 	// Default: if no specific plan detected, check if this is casual chat before trying memo search
-	if !plan.needsMemoSearch && !plan.needsScheduleQuery && !plan.needsFreeTime {
+	if !plan.needsMemoSearch && !plan.needsScheduleQuery && !plan.needsFreeTime && !plan.needsWebCapture && !plan.needsAttachmentRead {
 		// Check if the user input looks like casual chat (short, no search keywords)
 		if p.isCasualChatInput(userInput) {
 			// This is casual chat, answer directly without retrieval
@@ -625,6 +761,24 @@ func (p *AmazingParrot) parseRetrievalPlan(response string, userInput string, no
 	return plan
 }
 
+// planWebCapture adds a web capture step for a "web_capture: URL [save]" plan line.
+// Only links the user sent are fetched, and the page is saved only when the
+// planner adds "save" after the URL.
+func (p *AmazingParrot) planWebCapture(plan *retrievalPlan, arg string, userInput string) {
+	if p.webCaptureTool == nil || plan.needsWebCapture {
+		return
+	}
+	link := urlPattern.FindString(arg)
+	if link == "" || !strings.Contains(userInput, link) {
+		return
+	}
+	plan.needsWebCapture = true
+	plan.webCaptureURL = link
+
+	_, rest, _ := strings.Cut(arg, link)
+	plan.webCaptureSave = slices.Contains(strings.Fields(strings.ToLower(rest)), "save")
+}
+
 // isCasualChatInput detects if the input looks like casual chat that doesn't need retrieval.
 // This helps avoid unnecessary memo searches for conversational inputs.
 func (p *AmazingParrot) isCasualChatInput(input string) bool {
@@ -682,6 +836,22 @@ func (p *AmazingParrot) buildSynthesisPrompt(results map[string]string) string {
 		contextBuilder.WriteString(freeTimeResult)
 	}
 
+	if webResult, ok := results["web_capture"]; ok {
+		if contextBuilder.Len() > 0 {
+			contextBuilder.WriteString("\n")
+		}
+		contextBuilder.WriteString("[网页内容]\n")
+		contextBuilder.WriteString(webResult)
+	}
+
+	if attachmentResult, ok := results["attachment_read"]; ok {
+		if contextBuilder.Len() > 0 {
+			contextBuilder.WriteString("\n")
+		}
+		contextBuilder.WriteString("[附件内容] 引用时注明文件名和页码，如（contract.pdf 第3页）\n")
+		contextBuilder.WriteString(attachmentResult)
+	}
+
 	return GetAmazingSynthesisPrompt(contextBuilder.String())
 }
 
@@ -747,6 +917,8 @@ func (p *AmazingParrot) SelfDescribe() *ParrotSelfCognition {
 			"综合多源信息回答",
 			"智能规划检索策略",
 			"一站式信息助手",
+			"抓取网页正文并保存为笔记",
+			"阅读附件（PDF/图片）并按页引用",
 		},
 		Limitations: []string{
 			"不擅长纯创意任务",
@@ -755,7 +927,7 @@ func (p *AmazingParrot) SelfDescribe() *ParrotSelfCognition {
 		},
 		WorkingStyle: "两阶段并发检索 - 意图分析 → 并发执行工具 → 综合回答",
 		FavoriteTools: []string{
-			"memo_search", "schedule_query", "find_free_time",
+			"memo_search", "schedule_query", "find_free_time", "web_capture", "attachment_read",
 			"综合规划引擎",
 		},
 		SelfIntroduction: "我是惊奇，你的全能助手。我能同时调用笔记搜索和日程查询，并发执行，快速给你完整的答案。",
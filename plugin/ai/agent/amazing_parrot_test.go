@@ -0,0 +1,33 @@
+package agent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hrygo/divinesense/plugin/ai/agent/tools"
+)
+
+func TestParseRetrievalPlanWebCapture(t *testing.T) {
+	p := &AmazingParrot{webCaptureTool: &tools.WebCaptureTool{}}
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	// A link alone is not captured without a planner decision
+	plan := p.parseRetrievalPlan("PLAN: direct_answer", "这个链接 https://go.dev/blog/loopvar 我之前收藏过吗", now)
+	assert.False(t, plan.needsWebCapture)
+	assert.True(t, plan.needsDirectAnswer)
+
+	plan = p.parseRetrievalPlan("web_capture: https://go.dev/blog/loopvar", "总结一下 https://go.dev/blog/loopvar，不用保存", now)
+	assert.True(t, plan.needsWebCapture)
+	assert.Equal(t, "https://go.dev/blog/loopvar", plan.webCaptureURL)
+	assert.False(t, plan.webCaptureSave, "save keywords in the input do not save the page")
+
+	plan = p.parseRetrievalPlan("web_capture: https://go.dev/blog/loopvar save", "帮我收藏 https://go.dev/blog/loopvar", now)
+	assert.True(t, plan.needsWebCapture)
+	assert.True(t, plan.webCaptureSave)
+
+	// Links the user did not send are never fetched
+	plan = p.parseRetrievalPlan("web_capture: https://example.com/other", "帮我收藏 https://go.dev/blog/loopvar", now)
+	assert.False(t, plan.needsWebCapture)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/sashabaranov/go-openai"
@@ -31,6 +32,7 @@ type ChatRouteResult struct {
 	Route      ChatRouteType `json:"route"`
 	Confidence float64       `json:"confidence"`
 	Method     string        `json:"method"` // "rule" or "llm"
+	Intent     router.Intent `json:"intent,omitempty"`
 }
 
 // ChatRouterConfig holds configuration for the chat router.
@@ -47,6 +49,17 @@ type ChatRouter struct {
 	routerService *router.Service // Optional: three-layer router service
 	client        *openai.Client // Reserved for LLM classification
 	model         string
+
+	// lastRoutes remembers the latest routing per user, so that a correction
+	// can be labeled with the intent that was actually predicted.
+	lastRoutesMu sync.Mutex
+	lastRoutes   map[int32]lastRoute
+}
+
+// lastRoute is the latest routing decision of a user.
+type lastRoute struct {
+	input  string
+	result ChatRouteResult
 }
 
 // NewChatRouter creates a new chat router with hybrid rule+LLM classification.
@@ -70,7 +83,50 @@ func NewChatRouter(cfg ChatRouterConfig, routerSvc *router.Service) *ChatRouter
 		routerService: routerSvc,
 		client:        openai.NewClientWithConfig(clientConfig),
 		model:         model,
+		lastRoutes:    make(map[int32]lastRoute),
+	}
+}
+
+// RouteForUser routes the input like Route and remembers the decision for corrections.
+func (r *ChatRouter) RouteForUser(ctx context.Context, userID int32, input string) (*ChatRouteResult, error) {
+	result, err := r.Route(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	r.lastRoutesMu.Lock()
+	r.lastRoutes[userID] = lastRoute{input: input, result: *result}
+	r.lastRoutesMu.Unlock()
+	return result, nil
+}
+
+// LastRoute returns the latest routing of the same input for a user, if any.
+func (r *ChatRouter) LastRoute(userID int32, input string) (*ChatRouteResult, bool) {
+	r.lastRoutesMu.Lock()
+	defer r.lastRoutesMu.Unlock()
+	last, ok := r.lastRoutes[userID]
+	if !ok || last.input != input {
+		return nil, false
 	}
+	result := last.result
+	return &result, true
+}
+
+// RecordCorrection stores a user correction of a routing decision.
+// corrected is the route the user picked, or empty if the user only flagged the route as wrong.
+// Corrections need the three-layer router service; without it this is a no-op.
+func (r *ChatRouter) RecordCorrection(ctx context.Context, userID int32, input string, routed *ChatRouteResult, corrected ChatRouteType) error {
+	if r.routerService == nil || routed == nil {
+		return nil
+	}
+	predicted := routed.Intent
+	if predicted == "" {
+		predicted = routeTypeToIntent(routed.Route)
+	}
+	correctedAgent := router.AgentTypeUnknown
+	if corrected != "" {
+		correctedAgent = router.AgentType(corrected)
+	}
+	return r.routerService.RecordCorrection(ctx, userID, input, predicted, correctedAgent)
 }
 
 // Route determines the appropriate Parrot agent for the user input.
@@ -93,6 +149,7 @@ func (r *ChatRouter) Route(ctx context.Context, input string) (*ChatRouteResult,
 			Route:      mapIntentToRouteType(intent),
 			Confidence: float64(confidence),
 			Method:     "router",
+			Intent:     intent,
 		}, nil
 	}
 
@@ -315,6 +372,11 @@ var chatRouterJSONSchema = &jsonSchema{
 	AdditionalProperties: false,
 }
 
+// routeTypeToIntent converts ChatRouteType to the default router.Intent of the agent.
+func routeTypeToIntent(route ChatRouteType) router.Intent {
+	return router.AgentTypeToIntent(router.AgentType(route))
+}
+
 // mapIntentToRouteType converts router.Intent to ChatRouteType.
 // Uses the canonical IntentToAgentType mapping from router package.
 func mapIntentToRouteType(intent router.Intent) ChatRouteType {
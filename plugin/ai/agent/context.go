@@ -4,6 +4,7 @@
 package agent
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"log/slog"
 
 	"github.com/hrygo/divinesense/plugin/ai/schedule"
+	"github.com/hrygo/divinesense/plugin/ai/session"
 	"github.com/hrygo/divinesense/store"
 )
 
@@ -394,6 +396,35 @@ func (c *ConversationContext) ToHistoryPrompt() string {
 	return result
 }
 
+// PendingDraftPrompt describes a proposed schedule that was not created yet
+// (e.g. waiting for a conflict to be resolved). It returns "" if there is none.
+func (c *ConversationContext) PendingDraftPrompt() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.WorkingState == nil || c.WorkingState.ProposedSchedule == nil {
+		return ""
+	}
+	if c.WorkingState.CurrentStep == StepCompleted || c.WorkingState.CurrentStep == StepIdle {
+		return ""
+	}
+
+	draft := c.WorkingState.ProposedSchedule
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Pending Schedule Draft (%s): %s", c.WorkingState.CurrentStep, draft.Title))
+	if draft.StartTime != nil {
+		start := *draft.StartTime
+		if loc, err := time.LoadLocation(draft.Timezone); err == nil {
+			start = start.In(loc)
+		}
+		sb.WriteString(", start " + start.Format(time.RFC3339))
+	}
+	if draft.Location != "" {
+		sb.WriteString(", location " + draft.Location)
+	}
+	return sb.String()
+}
+
 // ContextSummary provides a quick overview of the context state.
 type ContextSummary struct {
 	SessionID           string
@@ -408,18 +439,34 @@ type ContextSummary struct {
 }
 
 // ContextStore manages conversation contexts for multiple sessions.
+// A store created by NewPersistentContextStore keeps contexts in the database
+// (via session.SessionService), so multi-turn drafts survive restarts and are
+// shared between server instances; a plain store only keeps them in memory.
 type ContextStore struct {
 	mu       sync.RWMutex
 	contexts map[string]*ConversationContext
+
+	sessions  session.SessionService
+	agentType string
 }
 
-// NewContextStore creates a new context store.
+// NewContextStore creates a new in-memory context store.
 func NewContextStore() *ContextStore {
 	return &ContextStore{
 		contexts: make(map[string]*ConversationContext),
 	}
 }
 
+// NewPersistentContextStore creates a context store backed by the session service.
+// agentType is recorded with every saved context (e.g. "schedule").
+func NewPersistentContextStore(sessions session.SessionService, agentType string) *ContextStore {
+	return &ContextStore{
+		contexts:  make(map[string]*ConversationContext),
+		sessions:  sessions,
+		agentType: agentType,
+	}
+}
+
 // GetOrCreate retrieves or creates a conversation context.
 func (s *ContextStore) GetOrCreate(sessionID string, userID int32, timezone string) *ConversationContext {
 	s.mu.Lock()
@@ -434,6 +481,42 @@ func (s *ContextStore) GetOrCreate(sessionID string, userID int32, timezone stri
 	return ctx
 }
 
+// Load retrieves or creates a conversation context, reading the persisted state
+// when the store is backed by a session service. The database is always read,
+// since another instance may have advanced the session. If it is unavailable
+// the in-memory context is used instead.
+func (s *ContextStore) Load(ctx context.Context, sessionID string, userID int32, timezone string) *ConversationContext {
+	if s.sessions == nil {
+		return s.GetOrCreate(sessionID, userID, timezone)
+	}
+
+	saved, err := s.sessions.LoadContext(ctx, sessionID)
+	if err != nil {
+		slog.Warn("context: failed to load persisted context, using memory",
+			"session_id", sessionID,
+			"error", err)
+		return s.GetOrCreate(sessionID, userID, timezone)
+	}
+	if saved == nil || saved.UserID != userID {
+		return NewConversationContext(sessionID, userID, timezone)
+	}
+
+	return conversationContextFromSession(saved, timezone)
+}
+
+// Save persists the conversation context. It is a no-op for in-memory stores.
+func (s *ContextStore) Save(ctx context.Context, c *ConversationContext) error {
+	if s.sessions == nil || c == nil {
+		return nil
+	}
+
+	saved, err := c.toSession(s.agentType)
+	if err != nil {
+		return err
+	}
+	return s.sessions.SaveContext(ctx, c.SessionID, saved)
+}
+
 // Get retrieves a conversation context if it exists.
 func (s *ContextStore) Get(sessionID string) *ConversationContext {
 	s.mu.RLock()
@@ -468,6 +551,92 @@ func (s *ContextStore) CleanupOld(maxAge time.Duration) int {
 	return deleted
 }
 
+// Metadata keys of a persisted conversation context.
+const (
+	metadataTimezone     = "timezone"
+	metadataWorkingState = "working_state"
+)
+
+// toSession converts the context to its persisted form. Turns become
+// user/assistant message pairs; tool call records are not persisted.
+func (c *ConversationContext) toSession(agentType string) (*session.ConversationContext, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	messages := make([]session.Message, 0, len(c.Turns)*2)
+	for _, turn := range c.Turns {
+		messages = append(messages,
+			session.Message{Role: "user", Content: turn.UserInput},
+			session.Message{Role: "assistant", Content: turn.AgentOutput})
+	}
+
+	metadata := map[string]any{metadataTimezone: c.Timezone}
+	if c.WorkingState != nil {
+		// Round-trip through JSON so the metadata holds plain values
+		data, err := json.Marshal(c.WorkingState)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal working state: %w", err)
+		}
+		var state map[string]any
+		if err := json.Unmarshal(data, &state); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal working state: %w", err)
+		}
+		metadata[metadataWorkingState] = state
+	}
+
+	return &session.ConversationContext{
+		SessionID: c.SessionID,
+		UserID:    c.UserID,
+		AgentType: agentType,
+		Messages:  messages,
+		Metadata:  metadata,
+		CreatedAt: c.CreatedAt.Unix(),
+	}, nil
+}
+
+// conversationContextFromSession restores a context saved by toSession.
+// timezone is used when the saved context has none.
+func conversationContextFromSession(saved *session.ConversationContext, timezone string) *ConversationContext {
+	if tz, ok := saved.Metadata[metadataTimezone].(string); ok && tz != "" {
+		timezone = tz
+	}
+	c := NewConversationContext(saved.SessionID, saved.UserID, timezone)
+	c.CreatedAt = time.Unix(saved.CreatedAt, 0)
+	c.UpdatedAt = time.Unix(saved.UpdatedAt, 0)
+
+	updatedAt := c.UpdatedAt
+	for i := 0; i < len(saved.Messages); i++ {
+		turn := ConversationTurn{Timestamp: updatedAt}
+		if saved.Messages[i].Role == "user" {
+			turn.UserInput = saved.Messages[i].Content
+			if i+1 < len(saved.Messages) && saved.Messages[i+1].Role == "assistant" {
+				i++
+				turn.AgentOutput = saved.Messages[i].Content
+			}
+		} else {
+			turn.AgentOutput = saved.Messages[i].Content
+		}
+		c.Turns = append(c.Turns, turn)
+	}
+
+	if raw, ok := saved.Metadata[metadataWorkingState]; ok {
+		state := &WorkingState{}
+		data, err := json.Marshal(raw)
+		if err == nil {
+			err = json.Unmarshal(data, state)
+		}
+		if err != nil {
+			slog.Warn("context: failed to restore working state",
+				"session_id", saved.SessionID,
+				"error", err)
+		} else {
+			c.WorkingState = state
+		}
+	}
+
+	return c
+}
+
 // Helper functions
 
 // lower converts a string to lowercase using the standard library for proper Unicode support.
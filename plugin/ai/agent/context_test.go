@@ -1,10 +1,15 @@
 package agent
 
 import (
+	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/plugin/ai/session"
 )
 
 func TestToHistoryPrompt(t *testing.T) {
@@ -31,3 +36,35 @@ func TestToHistoryPrompt(t *testing.T) {
 	// Ensure chronological order (append)
 	assert.True(t, strings.Index(prompt, "Hello") < strings.Index(prompt, "Find schedule"))
 }
+
+func TestPersistentContextStore(t *testing.T) {
+	ctx := context.Background()
+	sessions := session.NewMockSessionService()
+
+	// First instance: one turn that left a draft waiting for conflict resolution
+	store := NewPersistentContextStore(sessions, "schedule")
+	convCtx := store.Load(ctx, "schedule-session", 1, "Asia/Shanghai")
+	start := time.Date(2026, 3, 2, 7, 0, 0, 0, time.UTC)
+	convCtx.AddTurn("明天下午3点开会", "该时段有冲突，要换个时间吗？", nil)
+	convCtx.UpdateWorkingState(&WorkingState{
+		ProposedSchedule: &ScheduleDraft{Title: "开会", StartTime: &start, Timezone: "Asia/Shanghai"},
+		LastToolUsed:     "schedule_add",
+		CurrentStep:      StepConflictResolve,
+	})
+	require.NoError(t, store.Save(ctx, convCtx))
+
+	// Second instance (or after a restart) continues the same session
+	restored := NewPersistentContextStore(sessions, "schedule").Load(ctx, "schedule-session", 1, "UTC")
+	assert.Equal(t, "Asia/Shanghai", restored.Timezone)
+	assert.Contains(t, restored.ToHistoryPrompt(), "User: 明天下午3点开会")
+
+	state := restored.GetWorkingState()
+	require.NotNil(t, state.ProposedSchedule)
+	assert.Equal(t, "开会", state.ProposedSchedule.Title)
+	assert.True(t, start.Equal(*state.ProposedSchedule.StartTime))
+	assert.Equal(t, StepConflictResolve, state.CurrentStep)
+	assert.Contains(t, restored.PendingDraftPrompt(), "2026-03-02T15:00:00+08:00")
+
+	// Contexts of other users are not handed out
+	assert.Empty(t, NewPersistentContextStore(sessions, "schedule").Load(ctx, "schedule-session", 2, "UTC").Turns)
+}
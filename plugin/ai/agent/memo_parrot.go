@@ -11,6 +11,7 @@ import (
 	"github.com/hrygo/divinesense/plugin/ai"
 	"github.com/hrygo/divinesense/plugin/ai/agent/tools"
 	"github.com/hrygo/divinesense/plugin/ai/timeout"
+	"github.com/hrygo/divinesense/plugin/ai/tokenizer"
 	"github.com/hrygo/divinesense/server/retrieval"
 )
 
@@ -65,6 +66,18 @@ func NewMemoParrot(
 	}, nil
 }
 
+// SetTokenizer sets the tokenizer used to fit memo search results into the prompt.
+// SetTokenizer 设置用于将笔记搜索结果裁剪到提示词预算内的分词器。
+func (p *MemoParrot) SetTokenizer(tok tokenizer.Tokenizer) {
+	p.memoSearchTool.SetTokenizer(tok)
+}
+
+// SetTimezone sets the user's timezone for relative dates in memo searches.
+// SetTimezone 设置用户时区，用于解析笔记搜索中的相对日期。
+func (p *MemoParrot) SetTimezone(timezone string) {
+	p.memoSearchTool.SetTimezone(timezone)
+}
+
 // Name returns the name of the parrot.
 // Name 返回鹦鹉名称。
 func (p *MemoParrot) Name() string {
@@ -252,8 +265,16 @@ func (p *MemoParrot) ExecuteWithCallback(
 				return NewParrotError(p.Name(), "memo_search", runErr)
 			}
 
+			// Show the detected time constraint, so the user can tell why results are limited to it
+			if structuredResult.TimeRange != "" && callback != nil {
+				callback(EventTypeThinking, fmt.Sprintf("按时间范围检索：%s", structuredResult.TimeRange))
+			}
+
 			// Format tool result for LLM (text format for ReAct loop)
 			var resultBuilder strings.Builder
+			if structuredResult.TimeRange != "" {
+				fmt.Fprintf(&resultBuilder, "时间范围：%s\n", structuredResult.TimeRange)
+			}
 			if structuredResult.Count > 0 {
 				fmt.Fprintf(&resultBuilder, "找到 %d 条相关笔记：\n\n", structuredResult.Count)
 				for i, m := range structuredResult.Memos {
@@ -250,10 +250,14 @@ schedule_query → 直接返回结果
 - memo_search: 关键词
 - schedule_query: today/tomorrow
 - find_free_time: YYYY-MM-DD
+- web_capture: URL [save] (用户要求阅读/总结消息中的链接时抓取网页；仅当用户明确要求保存/收藏时加 save)
+- attachment_read: 文件名关键词 | 内容关键词 (询问附件/PDF/图片内容时)
 - direct_answer (无需检索)
 
 ## 示例
 "找Python笔记，看今天有空吗" → memo_search: Python + schedule_query: today
+"帮我收藏 https://go.dev/blog/loopvar" → web_capture: https://go.dev/blog/loopvar save
+"合同PDF里关于终止的条款" → attachment_read: 合同 | 终止
 "明天安排" → schedule_query: tomorrow
 "你好" → direct_answer
 
@@ -10,6 +10,10 @@ import (
 // It wraps SchedulerAgentV2 with zero code rewriting.
 type ScheduleParrotV2 struct {
 	agent *SchedulerAgentV2
+
+	// Optional persistent conversation context (see SetContextStore)
+	contexts  *ContextStore
+	sessionID string
 }
 
 // NewScheduleParrotV2 creates a new schedule parrot agent with the V2 framework.
@@ -28,6 +32,14 @@ func (p *ScheduleParrotV2) Name() string {
 	return "schedule"
 }
 
+// SetContextStore makes the parrot load and save the conversation context of the
+// session, instead of rebuilding it from the request history. This keeps the
+// working state (e.g. a schedule draft waiting for conflict resolution) across turns.
+func (p *ScheduleParrotV2) SetContextStore(contexts *ContextStore, sessionID string) {
+	p.contexts = contexts
+	p.sessionID = sessionID
+}
+
 // ExecuteWithCallback executes the schedule parrot by forwarding to SchedulerAgentV2.
 func (p *ScheduleParrotV2) ExecuteWithCallback(
 	ctx context.Context,
@@ -50,9 +62,11 @@ func (p *ScheduleParrotV2) ExecuteWithCallback(
 		}
 	}
 
-	// Create conversation context from history if provided
+	// Load the session context, or create it from history if provided
 	var conversationCtx *ConversationContext
-	if len(history) > 0 {
+	if p.contexts != nil && p.sessionID != "" {
+		conversationCtx = p.contexts.Load(ctx, p.sessionID, p.agent.userID, p.agent.timezone)
+	} else if len(history) > 0 {
 		// Use agent's internal fields (same package access)
 		// We use a temporary session ID as this context is reconstructed from history
 		conversationCtx = NewConversationContext("restored-session", p.agent.userID, p.agent.timezone)
@@ -68,11 +82,20 @@ func (p *ScheduleParrotV2) ExecuteWithCallback(
 	}
 
 	// Directly forward to the SchedulerAgentV2
-	_, err := p.agent.ExecuteWithCallback(ctx, userInput, conversationCtx, adaptedCallback)
+	response, err := p.agent.ExecuteWithCallback(ctx, userInput, conversationCtx, adaptedCallback)
 	if err != nil {
 		return NewParrotError(p.Name(), "ExecuteWithCallback", err)
 	}
 
+	if p.contexts != nil && p.sessionID != "" {
+		conversationCtx.AddTurn(userInput, response, nil)
+		if err := p.contexts.Save(ctx, conversationCtx); err != nil {
+			slog.Warn("failed to save conversation context",
+				"session_id", p.sessionID,
+				"error", err)
+		}
+	}
+
 	return nil
 }
 
@@ -2,12 +2,15 @@ package agent
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/plugin/ai/habit"
+	"github.com/hrygo/divinesense/plugin/ai/memory"
 	"github.com/hrygo/divinesense/server/service/schedule"
 	"github.com/hrygo/divinesense/store"
 )
@@ -145,6 +148,46 @@ func TestSchedulerAgentV2_Execute(t *testing.T) {
 	mockSvc.AssertExpectations(t)
 }
 
+// preferencesMemory serves fixed preferences to a habit applier.
+type preferencesMemory struct {
+	memory.MemoryService
+	prefs *memory.UserPreferences
+}
+
+func (m *preferencesMemory) GetPreferences(ctx context.Context, userID int32) (*memory.UserPreferences, error) {
+	return m.prefs, nil
+}
+
+// TestSchedulerAgentV2_FastCreateHint verifies the fast-create parse with habit defaults reaches the LLM.
+func TestSchedulerAgentV2_FastCreateHint(t *testing.T) {
+	mockLLM := new(MockLLM)
+	mockSvc := new(MockScheduleService)
+
+	agentSvc, err := NewSchedulerAgentV2(mockLLM, mockSvc, 1, "Asia/Shanghai")
+	assert.NoError(t, err)
+	agentSvc.SetHabitApplier(habit.NewHabitApplier(&preferencesMemory{prefs: &memory.UserPreferences{
+		DefaultDuration: 45,
+		PreferredTimes:  []string{"15:30"},
+	}}))
+
+	loc, _ := time.LoadLocation("Asia/Shanghai")
+	now := time.Now().In(loc)
+	start := time.Date(now.Year(), now.Month(), now.Day()+1, 15, 30, 0, 0, loc)
+
+	mockLLM.On("ChatWithTools", mock.Anything, mock.MatchedBy(func(messages []ai.Message) bool {
+		last := messages[len(messages)-1].Content
+		return strings.Contains(last, "[快速解析: 标题「会议」, 开始 "+start.Format(time.RFC3339)) &&
+			strings.Contains(last, "时长 45 分钟")
+	}), mock.Anything).
+		Return(mockFinalAnswer("OK"), nil).
+		Once()
+
+	_, err = agentSvc.ExecuteWithCallback(context.Background(), "明天下午开会", nil, nil)
+	assert.NoError(t, err)
+
+	mockLLM.AssertExpectations(t)
+}
+
 // TestSchedulerAgentV2_StateInjection verifies state handling.
 func TestSchedulerAgentV2_StateInjection(t *testing.T) {
 	mockLLM := new(MockLLM)
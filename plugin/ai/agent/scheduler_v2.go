@@ -10,6 +10,9 @@ import (
 
 	"github.com/hrygo/divinesense/plugin/ai"
 	localtools "github.com/hrygo/divinesense/plugin/ai/agent/tools"
+	"github.com/hrygo/divinesense/plugin/ai/aitime"
+	"github.com/hrygo/divinesense/plugin/ai/habit"
+	aischedule "github.com/hrygo/divinesense/plugin/ai/schedule"
 	"github.com/hrygo/divinesense/server/service/schedule"
 )
 
@@ -24,6 +27,8 @@ type SchedulerAgentV2 struct {
 	timezoneLoc      *time.Location
 	intentClassifier *LLMIntentClassifier // LLM-based intent classification
 	queryTool        interface{}          // Stored for structured result access
+	addTool          *localtools.ScheduleAddTool
+	fastCreate       *aischedule.FastCreateParser // Rule-based pre-parsing with habit defaults
 }
 
 // NewSchedulerAgentV2 creates a new framework-less schedule agent.
@@ -63,6 +68,7 @@ func NewSchedulerAgentV2(llm ai.LLMService, scheduleSvc schedule.Service, userID
 	updateTool := localtools.NewScheduleUpdateTool(scheduleSvc, userIDGetter)
 	findFreeTimeTool := localtools.NewFindFreeTimeTool(scheduleSvc, userIDGetter)
 	findFreeTimeTool.SetTimezone(userTimezone)
+	addTool.SetTimezone(userTimezone)
 
 	// Convert to ToolWithSchema using adapter
 	tools := []ToolWithSchema{
@@ -90,9 +96,22 @@ func NewSchedulerAgentV2(llm ai.LLMService, scheduleSvc schedule.Service, userID
 		timezone:    userTimezone,
 		timezoneLoc: timezoneLoc,
 		queryTool:   queryTool,
+		addTool:     addTool,
 	}, nil
 }
 
+// SetHabitApplier lets schedule_add fill in the user's usual time and duration
+// when they are omitted.
+func (a *SchedulerAgentV2) SetHabitApplier(applier *habit.HabitApplier) {
+	a.addTool.SetHabitApplier(applier)
+	// Refresh the tool schema so the LLM knows the fields may be omitted
+	if nt, ok := a.agent.toolMap["schedule_add"].(*NativeTool); ok {
+		nt.description = a.addTool.Description()
+		nt.params = a.addTool.InputType()
+	}
+	a.fastCreate = aischedule.NewFastCreateParser(aitime.NewService(a.timezone), applier)
+}
+
 // SetIntentClassifier configures the LLM-based intent classifier.
 // When set, the agent will classify user input before execution to optimize
 // routing and provide better responses.
@@ -216,6 +235,20 @@ func (a *SchedulerAgentV2) ExecuteWithCallback(ctx context.Context, userInput st
 		}
 	}
 
+	// Remind the agent of a draft left unfinished in an earlier turn
+	if conversationCtx != nil {
+		if draftPrompt := conversationCtx.PendingDraftPrompt(); draftPrompt != "" {
+			fullInput = draftPrompt + "\n" + fullInput
+		}
+	}
+
+	// Hand a confident rule-based parse (with the user's habit defaults) to the agent
+	if intent == IntentSimpleCreate && a.fastCreate != nil && (conversationCtx == nil || conversationCtx.PendingDraftPrompt() == "") {
+		if hint := a.fastCreateHint(ctx, userInput); hint != "" {
+			fullInput = hint + "\n" + fullInput
+		}
+	}
+
 	// Add intent hint to help the agent
 	if intent != IntentSimpleCreate {
 		fullInput = fmt.Sprintf("[意图: %s]\n%s", a.intentToHint(intent), fullInput)
@@ -223,6 +256,9 @@ func (a *SchedulerAgentV2) ExecuteWithCallback(ctx context.Context, userInput st
 
 	// Wrap the callback to inject UI events
 	uiCallback := a.wrapUICallback(ctx, callback)
+	if conversationCtx != nil {
+		uiCallback = a.trackWorkingState(conversationCtx, intent, uiCallback)
+	}
 
 	// Run the agent
 	// TODO: For IntentBatchCreate, use Plan-Execute mode instead of ReAct
@@ -234,6 +270,22 @@ func (a *SchedulerAgentV2) ExecuteWithCallback(ctx context.Context, userInput st
 	return result, err
 }
 
+// fastCreateHint returns the fast-create parse of the input as a hint for the LLM,
+// or "" if the input can't be fast created.
+func (a *SchedulerAgentV2) fastCreateHint(ctx context.Context, userInput string) string {
+	result, err := a.fastCreate.Parse(ctx, a.userID, userInput)
+	if err != nil || !result.CanFastCreate {
+		return ""
+	}
+	s := result.Schedule
+	hint := fmt.Sprintf("[快速解析: 标题「%s」, 开始 %s, 结束 %s（时长 %d 分钟）",
+		s.Title, s.StartTime.Format(time.RFC3339), s.EndTime.Format(time.RFC3339), s.Duration)
+	if s.Location != "" {
+		hint += fmt.Sprintf(", 地点 %s", s.Location)
+	}
+	return hint + "]"
+}
+
 // intentToHint converts intent to a hint string for the LLM.
 func (a *SchedulerAgentV2) intentToHint(intent TaskIntent) string {
 	switch intent {
@@ -297,6 +349,60 @@ func (a *SchedulerAgentV2) wrapUICallback(ctx context.Context, originalCallback
 	}
 }
 
+// trackWorkingState wraps the callback to record the schedule draft and workflow step
+// in the conversation context, so a follow-up turn (or another server instance after
+// the context is persisted) can continue from the proposed schedule.
+func (a *SchedulerAgentV2) trackWorkingState(conversationCtx *ConversationContext, intent TaskIntent, next func(event string, data string)) func(event string, data string) {
+	return func(event string, data string) {
+		next(event, data)
+
+		switch event {
+		case "tool_use":
+			state := conversationCtx.GetWorkingState()
+			if state == nil {
+				state = &WorkingState{}
+			}
+			state.LastIntent = string(intent)
+			state.LastToolUsed, _, _ = strings.Cut(data, ":")
+			if scheduleData := a.parseScheduleAddInput(data); scheduleData != nil {
+				state.ProposedSchedule = a.draftFromSuggestion(scheduleData, data)
+				state.Conflicts = nil
+				state.CurrentStep = StepConflictCheck
+			}
+			conversationCtx.UpdateWorkingState(state)
+
+		case "tool_result":
+			state := conversationCtx.GetWorkingState()
+			if state == nil || state.CurrentStep != StepConflictCheck {
+				return
+			}
+			if a.isConflictResult(data) {
+				state.CurrentStep = StepConflictResolve
+			} else {
+				state.ProposedSchedule = nil
+				state.CurrentStep = StepCompleted
+			}
+			conversationCtx.UpdateWorkingState(state)
+		}
+	}
+}
+
+// draftFromSuggestion converts a parsed schedule_add input to a schedule draft.
+func (a *SchedulerAgentV2) draftFromSuggestion(data *UIScheduleSuggestionData, toolData string) *ScheduleDraft {
+	start := time.Unix(data.StartTs, 0)
+	end := time.Unix(data.EndTs, 0)
+	return &ScheduleDraft{
+		Title:         data.Title,
+		Description:   data.Description,
+		Location:      data.Location,
+		StartTime:     &start,
+		EndTime:       &end,
+		AllDay:        data.AllDay,
+		Timezone:      a.timezone,
+		OriginalInput: toolData,
+	}
+}
+
 // parseScheduleAddInput parses the schedule_add tool input to extract schedule data.
 func (a *SchedulerAgentV2) parseScheduleAddInput(toolData string) *UIScheduleSuggestionData {
 	// Format: "schedule_add:{JSON}"
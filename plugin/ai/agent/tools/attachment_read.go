@@ -0,0 +1,497 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hrygo/divinesense/plugin/ai/timeout"
+	"github.com/hrygo/divinesense/store"
+)
+
+const (
+	// maxAttachmentReadRunes bounds the extracted text returned in one call,
+	// keeping the tool result within the synthesis token budget.
+	maxAttachmentReadRunes = 6000
+
+	// attachmentSectionRunes is the size of a virtual section when the
+	// extracted text carries no page breaks.
+	attachmentSectionRunes = 2000
+
+	// maxAttachmentCandidates limits how many attachments are searched per call.
+	maxAttachmentCandidates = 20
+
+	// maxMatchedPages limits how many matching pages are returned for a query.
+	maxMatchedPages = 5
+
+	// minMatchWindowRunes is the smallest passage worth returning for a match.
+	minMatchWindowRunes = 200
+)
+
+// AttachmentReadTool lists a user's attachments and reads their extracted text
+// (Tika/OCR) by page range or by query.
+// AttachmentReadTool 列出用户附件，并按页码范围或查询读取其提取文本（Tika/OCR）。
+type AttachmentReadTool struct {
+	store        *store.Store
+	userIDGetter func(ctx context.Context) int32
+}
+
+// NewAttachmentReadTool creates a new attachment read tool.
+// NewAttachmentReadTool 创建一个新的附件阅读工具。
+func NewAttachmentReadTool(st *store.Store, userIDGetter func(ctx context.Context) int32) (*AttachmentReadTool, error) {
+	if st == nil {
+		return nil, fmt.Errorf("store cannot be nil")
+	}
+	if userIDGetter == nil {
+		return nil, fmt.Errorf("userIDGetter cannot be nil")
+	}
+
+	return &AttachmentReadTool{
+		store:        st,
+		userIDGetter: userIDGetter,
+	}, nil
+}
+
+// Name returns the name of the tool.
+// Name 返回工具名称。
+func (t *AttachmentReadTool) Name() string {
+	return "attachment_read"
+}
+
+// Description returns a description of what the tool does.
+// Description 返回工具描述。
+func (t *AttachmentReadTool) Description() string {
+	return `Lists memo attachments and reads the text extracted from them (PDF, Office, images via OCR).
+
+INPUT FORMAT:
+{"memo_uid": "abc", "attachment_uid": "def", "filename": "contract", "query": "termination", "page_start": 1, "page_end": 3, "page_part": 2}
+- memo_uid (optional): list/read attachments of this memo
+- attachment_uid (optional): read this attachment
+- filename (optional): match attachments whose filename contains this text
+- query (optional): return only the pages that mention these keywords
+- page_start, page_end (optional): 1-based page range to read
+- page_part (optional): 1-based part of page_start to continue from; pages longer than the output limit are read in parts
+
+Without query or page range and with several matches, the attachments are listed.
+
+OUTPUT FORMAT (text):
+[contract.pdf] page 3/12 (attachment: def, memo: memos/abc)
+extracted text...
+
+Parts of long pages are shown as "page 3/12 part 2/4". Cite answers as (contract.pdf, page 3).`
+}
+
+// AttachmentReadInput represents the input for attachment read.
+// AttachmentReadInput 表示附件阅读的输入。
+type AttachmentReadInput struct {
+	MemoUID       string `json:"memo_uid,omitempty"`
+	AttachmentUID string `json:"attachment_uid,omitempty"`
+	Filename      string `json:"filename,omitempty"`
+	Query         string `json:"query,omitempty"`
+	PageStart     int    `json:"page_start,omitempty"`
+	PageEnd       int    `json:"page_end,omitempty"`
+	PagePart      int    `json:"page_part,omitempty"`
+}
+
+// AttachmentPages is the extracted text of an attachment split into pages.
+// Unit is "page" when the text carries form-feed page breaks, otherwise "section".
+type AttachmentPages struct {
+	Pages []string
+	Unit  string
+}
+
+// SplitAttachmentPages splits extracted text into pages on form feeds.
+// Text without page breaks is cut into fixed-size sections at paragraph boundaries.
+// SplitAttachmentPages 按换页符拆分提取文本；无分页时按段落切分为固定大小的章节。
+func SplitAttachmentPages(text string) *AttachmentPages {
+	if strings.Contains(text, "\f") {
+		var pages []string
+		for _, page := range strings.Split(text, "\f") {
+			pages = append(pages, strings.TrimSpace(page))
+		}
+		// Trailing form feed produces an empty last page
+		for len(pages) > 0 && pages[len(pages)-1] == "" {
+			pages = pages[:len(pages)-1]
+		}
+		return &AttachmentPages{Pages: pages, Unit: "page"}
+	}
+	return &AttachmentPages{Pages: splitSections(text), Unit: "section"}
+}
+
+// splitSections cuts text into fixed-size sections at paragraph boundaries.
+func splitSections(text string) []string {
+	var sections []string
+	var current strings.Builder
+	currentRunes := 0
+	flush := func() {
+		if s := strings.TrimSpace(current.String()); s != "" {
+			sections = append(sections, s)
+		}
+		current.Reset()
+		currentRunes = 0
+	}
+	for _, paragraph := range strings.SplitAfter(text, "\n\n") {
+		n := utf8.RuneCountInString(paragraph)
+		if currentRunes > 0 && currentRunes+n > attachmentSectionRunes {
+			flush()
+		}
+		// Oversized paragraphs are hard-split
+		for n > attachmentSectionRunes {
+			runes := []rune(paragraph)
+			current.WriteString(string(runes[:attachmentSectionRunes]))
+			flush()
+			paragraph = string(runes[attachmentSectionRunes:])
+			n = len(runes) - attachmentSectionRunes
+		}
+		current.WriteString(paragraph)
+		currentRunes += n
+	}
+	flush()
+	return sections
+}
+
+// pageParts splits a page longer than the output limit into sections, so every
+// part of it can be read. Other pages have a single part.
+func pageParts(page string) []string {
+	if utf8.RuneCountInString(page) <= maxAttachmentReadRunes {
+		return []string{page}
+	}
+	return splitSections(page)
+}
+
+// Run executes the attachment read tool.
+// Run 执行附件阅读工具。
+func (t *AttachmentReadTool) Run(ctx context.Context, input string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout.ToolExecutionTimeout)
+	defer cancel()
+
+	var readInput AttachmentReadInput
+	if err := json.Unmarshal([]byte(input), &readInput); err != nil {
+		return "", fmt.Errorf("invalid JSON input: %w", err)
+	}
+	readInput.Query = strings.TrimSpace(readInput.Query)
+	readInput.Filename = strings.TrimSpace(readInput.Filename)
+
+	attachments, err := t.findAttachments(ctx, t.userIDGetter(ctx), &readInput)
+	if err != nil {
+		return "", err
+	}
+	if len(attachments) == 0 {
+		return "No attachments found.", nil
+	}
+
+	hasRange := readInput.PageStart > 0 || readInput.PageEnd > 0
+	if readInput.Query == "" && !hasRange && len(attachments) > 1 {
+		return formatAttachmentList(attachments), nil
+	}
+
+	if readInput.Query != "" && !hasRange {
+		return readMatchingPages(attachments, readInput.Query), nil
+	}
+	return readPageRange(attachments[0], readInput.PageStart, readInput.PageEnd, readInput.PagePart), nil
+}
+
+// findAttachments resolves the attachments the input refers to.
+// Only the user's own attachments are returned.
+func (t *AttachmentReadTool) findAttachments(ctx context.Context, userID int32, input *AttachmentReadInput) ([]*store.Attachment, error) {
+	limit := maxAttachmentCandidates
+	find := &store.FindAttachment{
+		CreatorID: &userID,
+		Limit:     &limit,
+	}
+
+	switch {
+	case input.AttachmentUID != "":
+		find.UID = &input.AttachmentUID
+	case input.MemoUID != "":
+		memo, err := t.store.GetMemo(ctx, &store.FindMemo{UID: &input.MemoUID, CreatorID: &userID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get memo: %w", err)
+		}
+		if memo == nil {
+			return nil, fmt.Errorf("memo not found: %s", input.MemoUID)
+		}
+		find.MemoID = &memo.ID
+	case input.Filename != "":
+		find.FilenameSearch = &input.Filename
+	}
+
+	attachments, err := t.store.ListAttachments(ctx, find)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+
+	// A filename that matches nothing falls back to searching every attachment by query
+	if len(attachments) == 0 && find.FilenameSearch != nil && input.Query != "" {
+		find.FilenameSearch = nil
+		attachments, err = t.store.ListAttachments(ctx, find)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list attachments: %w", err)
+		}
+	}
+	return attachments, nil
+}
+
+// attachmentText returns the extracted document text, or the OCR text for images.
+func attachmentText(attachment *store.Attachment) string {
+	if strings.TrimSpace(attachment.ExtractedText) != "" {
+		return attachment.ExtractedText
+	}
+	return attachment.OCRText
+}
+
+// attachmentHeader renders the citation header for a page, or a part of it when the page has several parts.
+func attachmentHeader(attachment *store.Attachment, unit string, page, total, part, parts int) string {
+	header := fmt.Sprintf("[%s] %s %d/%d", attachment.Filename, unit, page, total)
+	if parts > 1 {
+		header += fmt.Sprintf(" part %d/%d", part, parts)
+	}
+	header += " (attachment: " + attachment.UID
+	if attachment.MemoUID != nil {
+		header += ", memo: memos/" + *attachment.MemoUID
+	}
+	return header + ")"
+}
+
+// formatAttachmentList lists attachments with their page counts.
+func formatAttachmentList(attachments []*store.Attachment) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Found %d attachment(s):\n\n", len(attachments))
+	for i, attachment := range attachments {
+		fmt.Fprintf(&b, "%d. %s (%s)\n   UID: %s\n", i+1, attachment.Filename, attachment.Type, attachment.UID)
+		if attachment.MemoUID != nil {
+			fmt.Fprintf(&b, "   Memo: memos/%s\n", *attachment.MemoUID)
+		}
+		if text := attachmentText(attachment); text != "" {
+			pages := SplitAttachmentPages(text)
+			fmt.Fprintf(&b, "   Text: %d %s(s)\n", len(pages.Pages), pages.Unit)
+		} else {
+			b.WriteString("   Text: not extracted\n")
+		}
+	}
+	return b.String()
+}
+
+// readPageRange reads a 1-based page range of one attachment, starting at the
+// given part of the first page, within the output budget.
+func readPageRange(attachment *store.Attachment, start, end, part int) string {
+	text := attachmentText(attachment)
+	if text == "" {
+		return fmt.Sprintf("No text has been extracted from %s.", attachment.Filename)
+	}
+	pages := SplitAttachmentPages(text)
+	total := len(pages.Pages)
+
+	if start <= 0 {
+		start = 1
+	}
+	if end <= 0 || end > total {
+		end = total
+	}
+	if start > total || start > end {
+		return fmt.Sprintf("%s has %d %s(s); %s %d is out of range.", attachment.Filename, total, pages.Unit, pages.Unit, start)
+	}
+
+	if part <= 0 {
+		part = 1
+	}
+	if parts := len(pageParts(pages.Pages[start-1])); part > parts {
+		return fmt.Sprintf("%s %d of %s has %d part(s); part %d is out of range.", pages.Unit, start, attachment.Filename, parts, part)
+	}
+
+	var b strings.Builder
+	budget := maxAttachmentReadRunes
+	for page := start; page <= end; page++ {
+		parts := pageParts(pages.Pages[page-1])
+		for ; part <= len(parts); part++ {
+			if !writeBudgeted(&b, attachmentHeader(attachment, pages.Unit, page, total, part, len(parts)), parts[part-1], &budget) {
+				// Continue from the truncated part so the rest of it is not skipped
+				if part > 1 {
+					fmt.Fprintf(&b, "\n(truncated; continue with page_start=%d, page_part=%d)\n", page, part)
+				} else {
+					fmt.Fprintf(&b, "\n(truncated; continue with page_start=%d)\n", page)
+				}
+				return b.String()
+			}
+		}
+		part = 1
+	}
+	return b.String()
+}
+
+// matchedPage is a page, or a part of an oversized page, that mentions the query.
+type matchedPage struct {
+	attachment *store.Attachment
+	unit       string
+	page       int
+	total      int
+	part       int
+	parts      int
+	content    string
+	score      int
+}
+
+// readMatchingPages returns the pages across attachments that best match the query.
+func readMatchingPages(attachments []*store.Attachment, query string) string {
+	terms := queryTerms(query)
+
+	var matches []matchedPage
+	for _, attachment := range attachments {
+		text := attachmentText(attachment)
+		if text == "" {
+			continue
+		}
+		pages := SplitAttachmentPages(text)
+		for i, page := range pages.Pages {
+			parts := pageParts(page)
+			for j, content := range parts {
+				if score := scorePage(content, terms); score > 0 {
+					matches = append(matches, matchedPage{
+						attachment: attachment,
+						unit:       pages.Unit,
+						page:       i + 1,
+						total:      len(pages.Pages),
+						part:       j + 1,
+						parts:      len(parts),
+						content:    content,
+						score:      score,
+					})
+				}
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return fmt.Sprintf("No attachment text matches query: %s\n\n%s", query, formatAttachmentList(attachments))
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+	if len(matches) > maxMatchedPages {
+		matches = matches[:maxMatchedPages]
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Found %d matching page(s) for query: %s\n\n", len(matches), query)
+	budget := maxAttachmentReadRunes
+	for _, m := range matches {
+		if budget < minMatchWindowRunes {
+			break
+		}
+		// Pages that do not fit are cut around the match rather than at the end
+		passage := matchWindow(m.content, terms, budget)
+		writeBudgeted(&b, attachmentHeader(m.attachment, m.unit, m.page, m.total, m.part, m.parts), passage, &budget)
+	}
+	return b.String()
+}
+
+// matchWindow returns the passage of at most size runes centred on the part of
+// content with the most query term occurrences. Cut ends are marked with "…".
+func matchWindow(content string, terms []string, size int) string {
+	runes := []rune(content)
+	if len(runes) <= size {
+		return content
+	}
+	size -= 2 // room for the "…" marks
+
+	// Lowercase rune by rune so positions line up with runes
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	type hit struct{ center, weight int }
+	var hits []hit
+	for _, term := range terms {
+		termRunes := []rune(term)
+		weight := 1
+		if len(termRunes) > 2 {
+			weight = 3
+		}
+		for i := 0; i+len(termRunes) <= len(lower); i++ {
+			if string(lower[i:i+len(termRunes)]) == term {
+				hits = append(hits, hit{center: i + len(termRunes)/2, weight: weight})
+			}
+		}
+	}
+
+	center := 0
+	best := -1
+	for _, candidate := range hits {
+		score := 0
+		for _, h := range hits {
+			if h.center >= candidate.center-size/2 && h.center < candidate.center+size/2 {
+				score += h.weight
+			}
+		}
+		if score > best {
+			best = score
+			center = candidate.center
+		}
+	}
+
+	start := max(0, min(center-size/2, len(runes)-size))
+	passage := string(runes[start : start+size])
+	if start > 0 {
+		passage = "…" + passage
+	}
+	if start+size < len(runes) {
+		passage += "…"
+	}
+	return passage
+}
+
+// writeBudgeted writes a page, truncating it to the remaining budget.
+// It reports false once the budget is exhausted.
+func writeBudgeted(b *strings.Builder, header, content string, budget *int) bool {
+	if *budget <= 0 {
+		return false
+	}
+	runes := []rune(content)
+	truncated := len(runes) > *budget
+	if truncated {
+		runes = runes[:*budget]
+	}
+	*budget -= len(runes)
+	fmt.Fprintf(b, "%s\n%s", header, string(runes))
+	if truncated {
+		b.WriteString("…")
+	}
+	b.WriteString("\n\n")
+	return !truncated
+}
+
+// queryTerms splits a query into lowercase search terms.
+// CJK terms are also split into bigrams so partial phrases still match.
+func queryTerms(query string) []string {
+	var terms []string
+	for _, field := range strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) {
+		terms = append(terms, field)
+		runes := []rune(field)
+		if len(runes) > 2 && unicode.Is(unicode.Han, runes[0]) {
+			for i := 0; i+1 < len(runes); i++ {
+				terms = append(terms, string(runes[i:i+2]))
+			}
+		}
+	}
+	return terms
+}
+
+// scorePage counts query term occurrences in a page; whole terms weigh more than bigrams.
+func scorePage(content string, terms []string) int {
+	lower := strings.ToLower(content)
+	score := 0
+	for _, term := range terms {
+		weight := 1
+		if utf8.RuneCountInString(term) > 2 {
+			weight = 3
+		}
+		score += strings.Count(lower, term) * weight
+	}
+	return score
+}
@@ -0,0 +1,106 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+func TestSplitAttachmentPages(t *testing.T) {
+	pages := SplitAttachmentPages("Page one\fPage two\n\fPage three\f")
+	assert.Equal(t, "page", pages.Unit)
+	assert.Equal(t, []string{"Page one", "Page two", "Page three"}, pages.Pages)
+
+	long := strings.Repeat("a", 1500) + "\n\n" + strings.Repeat("b", 1500) + "\n\n" + strings.Repeat("c", 4500)
+	sections := SplitAttachmentPages(long)
+	assert.Equal(t, "section", sections.Unit)
+	require.Len(t, sections.Pages, 5)
+	assert.Equal(t, strings.Repeat("a", 1500), sections.Pages[0])
+	assert.Equal(t, strings.Repeat("b", 1500), sections.Pages[1])
+	assert.Equal(t, strings.Repeat("c", 500), sections.Pages[4])
+}
+
+func TestReadMatchingPages(t *testing.T) {
+	memoUID := "memo1"
+	contract := &store.Attachment{
+		UID:           "att1",
+		Filename:      "contract.pdf",
+		MemoUID:       &memoUID,
+		ExtractedText: "Parties and scope.\fPayment terms.\fTermination: either party may terminate with 30 days notice.",
+	}
+	photo := &store.Attachment{
+		UID:      "att2",
+		Filename: "合同扫描.png",
+		OCRText:  "本合同的终止条件：提前三十日书面通知。",
+	}
+
+	result := readMatchingPages([]*store.Attachment{contract, photo}, "termination")
+	assert.Contains(t, result, "[contract.pdf] page 3/3 (attachment: att1, memo: memos/memo1)")
+	assert.Contains(t, result, "30 days notice")
+	assert.NotContains(t, result, "Payment terms")
+
+	result = readMatchingPages([]*store.Attachment{contract, photo}, "终止条件")
+	assert.Contains(t, result, "[合同扫描.png] section 1/1 (attachment: att2)")
+
+	result = readMatchingPages([]*store.Attachment{contract}, "warranty")
+	assert.True(t, strings.HasPrefix(result, "No attachment text matches query: warranty"))
+
+	// A match deep inside an oversized page is found in its part
+	manual := &store.Attachment{
+		UID:           "att3",
+		Filename:      "manual.pdf",
+		ExtractedText: "Contents\f" + strings.Repeat("filler text. ", 1000) + "Warranty: two years.",
+	}
+	result = readMatchingPages([]*store.Attachment{manual}, "warranty")
+	assert.Contains(t, result, "[manual.pdf] page 2/2 part 7/7 (attachment: att3)")
+	assert.Contains(t, result, "Warranty: two years.")
+}
+
+func TestMatchWindow(t *testing.T) {
+	content := strings.Repeat("a ", 500) + "the termination clause" + strings.Repeat(" b", 500)
+	window := matchWindow(content, queryTerms("termination"), 100)
+	assert.Contains(t, window, "the termination clause")
+	assert.True(t, strings.HasPrefix(window, "…"))
+	assert.True(t, strings.HasSuffix(window, "…"))
+	assert.Equal(t, 100, utf8.RuneCountInString(window))
+
+	// The densest cluster of matches wins over a single early match
+	content = "termination " + strings.Repeat("x", 1000) + strings.Repeat(" termination notice", 5) + strings.Repeat("y", 1000)
+	window = matchWindow(content, queryTerms("termination"), 200)
+	assert.Equal(t, 5, strings.Count(window, "termination"))
+
+	assert.Equal(t, "short", matchWindow("short", queryTerms("x"), 100))
+}
+
+func TestReadPageRange(t *testing.T) {
+	attachment := &store.Attachment{
+		UID:           "att1",
+		Filename:      "report.pdf",
+		ExtractedText: "one\ftwo\fthree",
+	}
+
+	result := readPageRange(attachment, 2, 3, 0)
+	assert.Equal(t, "[report.pdf] page 2/3 (attachment: att1)\ntwo\n\n[report.pdf] page 3/3 (attachment: att1)\nthree\n\n", result)
+
+	assert.Contains(t, readPageRange(attachment, 5, 0, 0), "out of range")
+
+	// A page longer than the output limit is read in parts, continuing from the truncated part
+	long := strings.Repeat("x", maxAttachmentReadRunes+10)
+	attachment.ExtractedText = "one\f" + long + "\fthree"
+	result = readPageRange(attachment, 1, 3, 0)
+	assert.Contains(t, result, "[report.pdf] page 2/3 part 1/4 (attachment: att1)")
+	assert.Contains(t, result, "(truncated; continue with page_start=2, page_part=3)")
+
+	result = readPageRange(attachment, 2, 3, 3)
+	assert.Contains(t, result, "[report.pdf] page 2/3 part 4/4 (attachment: att1)")
+	assert.Contains(t, result, "[report.pdf] page 3/3 (attachment: att1)\nthree")
+	assert.NotContains(t, result, "truncated")
+
+	assert.Contains(t, readPageRange(attachment, 2, 3, 5), "part 5 is out of range")
+	assert.Contains(t, readPageRange(&store.Attachment{Filename: "x.bin"}, 0, 0, 0), "No text has been extracted")
+}
@@ -4,10 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/hrygo/divinesense/plugin/ai/timeout"
+	"github.com/hrygo/divinesense/plugin/ai/tokenizer"
+	"github.com/hrygo/divinesense/plugin/search"
+	"github.com/hrygo/divinesense/server/queryengine"
 	"github.com/hrygo/divinesense/server/retrieval"
+	"github.com/hrygo/divinesense/store"
 )
 
 const (
@@ -19,6 +26,15 @@ const (
 
 	// Default minimum relevance score for search results.
 	defaultMinScore = 0.5
+
+	// Maximum tokens of a single memo in the result.
+	maxMemoResultTokens = 400
+
+	// Maximum tokens of the whole result, so that tool output leaves room in the context window.
+	maxSearchResultTokens = 4000
+
+	// Maximum tags and months listed in the overview of all matches.
+	maxOverviewValues = 5
 )
 
 // JSON field name mappings for camelCase to snake_case compatibility.
@@ -54,7 +70,10 @@ func normalizeMemoJSONFields(inputJSON string) string {
 // MemoSearchTool 使用语义和关键词搜索来查找笔记。
 type MemoSearchTool struct {
 	retriever    *retrieval.AdaptiveRetriever
+	queryRouter  *queryengine.QueryRouter // Detects time constraints such as "last week"
 	userIDGetter func(ctx context.Context) int32
+	tokenizer    tokenizer.Tokenizer
+	timezone     string // Resolves relative dates such as "yesterday"
 }
 
 // NewMemoSearchTool creates a new memo search tool.
@@ -72,10 +91,27 @@ func NewMemoSearchTool(
 
 	return &MemoSearchTool{
 		retriever:    retriever,
+		queryRouter:  queryengine.NewQueryRouter(),
 		userIDGetter: userIDGetter,
+		tokenizer:    tokenizer.Default(),
+		timezone:     DefaultTimezone,
 	}, nil
 }
 
+// SetTokenizer sets the tokenizer used to truncate results, typically tokenizer.ForModel.
+// SetTokenizer 设置用于截断结果的分词器。
+func (t *MemoSearchTool) SetTokenizer(tok tokenizer.Tokenizer) {
+	t.tokenizer = tok
+}
+
+// SetTimezone sets the user's timezone for relative dates such as "last week".
+// SetTimezone 设置用户时区，用于解析“上周”等相对日期。
+func (t *MemoSearchTool) SetTimezone(timezone string) {
+	if timezone != "" {
+		t.timezone = timezone
+	}
+}
+
 // Name returns the name of the tool.
 // Name 返回工具名称。
 func (t *MemoSearchTool) Name() string {
@@ -89,12 +125,22 @@ func (t *MemoSearchTool) Description() string {
 
 INPUT FORMAT:
 {"query": "搜索词", "limit": 10, "min_score": 0.5}
-- query (required): search keywords
+- query (required): search keywords, optionally with operators:
+  tag:work, -tag:draft (exclude), before:2026-01-01, after:"last month",
+  has:attachment, has:task, has:link, has:code, is:pinned,
+  visibility:private|protected|public, "exact phrase", a OR b
+  Time phrases such as "last week", "in March" or "上周" restrict results to
+  memos of that period; if none match, memos closest to it rank first
+  Keyword strategies also match the user's synonyms, related tags and
+  frequent keywords, ranked below direct matches
 - limit (optional): max results, default 10
 - min_score (optional): min relevance 0-1, default 0.5
 
 OUTPUT FORMAT (text):
 Found N memo(s) matching query: xxx
+Time range: last week (2026-03-02 - 2026-03-08)
+Expanded with: kubernetes (synonym of k8s), helm (tag related to #k8s)
+Overview of M keyword matches: tags #a (20), #b (5); months 2026-03 (15), ...; 3 with attachments, 2 with tasks
 
 1. [Score: 0.85] memo content...
    UID: xxxxx
@@ -135,6 +181,13 @@ func (t *MemoSearchTool) Run(ctx context.Context, input string) (string, error)
 		return "", fmt.Errorf("query cannot be empty")
 	}
 
+	// Split operators such as tag:work from the search text
+	now := time.Now().In(getTimezoneLocation(t.timezone))
+	query, err := search.ParseQuery(ctx, searchInput.Query, now)
+	if err != nil {
+		return "", fmt.Errorf("invalid query: %w", err)
+	}
+
 	// Set defaults
 	if searchInput.Limit <= 0 {
 		searchInput.Limit = defaultSearchLimit
@@ -157,12 +210,17 @@ func (t *MemoSearchTool) Run(ctx context.Context, input string) (string, error)
 
 	// Execute search
 	opts := &retrieval.RetrievalOptions{
-		Query:    searchInput.Query,
+		Query:    query.Text,
+		Filter:   query.Filter,
 		UserID:   userID,
 		Strategy: strategy,
 		Limit:    searchInput.Limit,
 		MinScore: searchInput.MinScore,
+		Location: now.Location(),
 	}
+	started := time.Now()
+	t.applyTimeRange(opts, now.Location())
+	t.applyExpansions(ctx, opts)
 
 	results, err := t.retriever.Retrieve(ctx, opts)
 	if err != nil {
@@ -176,25 +234,56 @@ func (t *MemoSearchTool) Run(ctx context.Context, input string) (string, error)
 			memoResults = append(memoResults, result)
 		}
 	}
+	t.recordSearch(ctx, searchInput.Query, opts, len(memoResults), started)
 
 	// Format results
 	if len(memoResults) == 0 {
+		if opts.TimeRange != nil {
+			return fmt.Sprintf("No memos found matching query: %s (time range: %s)", searchInput.Query, formatTimeRange(opts.TimeRange)), nil
+		}
 		return fmt.Sprintf("No memos found matching query: %s", searchInput.Query), nil
 	}
 
 	// Build response
 	var response strings.Builder
-	fmt.Fprintf(&response, "Found %d memo(s) matching query: %s\n\n", len(memoResults), searchInput.Query)
+	fmt.Fprintf(&response, "Found %d memo(s) matching query: %s\n", len(memoResults), searchInput.Query)
+	if opts.TimeRange != nil {
+		fmt.Fprintf(&response, "Time range: %s\n", formatTimeRange(opts.TimeRange))
+	}
+	if len(opts.Expansions) > 0 {
+		fmt.Fprintf(&response, "Expanded with: %s\n", strings.Join(formatExpansions(opts.Expansions), ", "))
+	}
+	if facets, err := t.retriever.Facets(ctx, opts); err != nil {
+		slog.Debug("failed to get memo facets", "error", err)
+	} else if overview := formatFacets(facets); overview != "" {
+		response.WriteString(overview + "\n")
+	}
+	response.WriteString("\n")
 
+	usedTokens := t.tokenizer.CountTokens(response.String())
 	for i, result := range memoResults {
-		fmt.Fprintf(&response, "%d. [Score: %.2f] %s\n", i+1, result.Score, result.Content)
+		var entry strings.Builder
+		fmt.Fprintf(&entry, "%d. [Score: %.2f] %s\n", i+1, result.Score, truncateTokens(t.tokenizer, result.Content, maxMemoResultTokens))
 
 		// Add memo UID if available
 		if result.Memo != nil && result.Memo.UID != "" {
-			fmt.Fprintf(&response, "   UID: %s\n", result.Memo.UID)
+			fmt.Fprintf(&entry, "   UID: %s\n", result.Memo.UID)
+		}
+		// Near-duplicates were collapsed into this result to save context
+		if len(result.Similar) > 0 {
+			fmt.Fprintf(&entry, "   (%d similar memo(s) omitted)\n", len(result.Similar))
 		}
 
-		fmt.Fprintf(&response, "\n")
+		entry.WriteString("\n")
+
+		// Drop lower-ranked results that do not fit the output budget
+		entryTokens := t.tokenizer.CountTokens(entry.String())
+		if usedTokens+entryTokens > maxSearchResultTokens {
+			fmt.Fprintf(&response, "(%d more result(s) omitted to fit the context window)\n", len(memoResults)-i)
+			break
+		}
+		response.WriteString(entry.String())
+		usedTokens += entryTokens
 	}
 
 	return response.String(), nil
@@ -205,14 +294,17 @@ type MemoSummary struct {
 	UID     string  `json:"uid"`
 	Content string  `json:"content"`
 	Score   float32 `json:"score"`
+	Similar int     `json:"similar,omitempty"` // Number of collapsed near-duplicates
 }
 
 // MemoSearchToolResult represents the structured result of memo search.
 // MemoSearchToolResult 表示笔记搜索的结构化结果。
 type MemoSearchToolResult struct {
-	Query string         `json:"query"`
-	Memos []MemoSummary  `json:"memos"`
-	Count int            `json:"count"`
+	Query      string        `json:"query"`
+	TimeRange  string        `json:"time_range,omitempty"` // Detected time constraint, e.g. "last week (2026-03-02 - 2026-03-08)"
+	Expansions []string      `json:"expansions,omitempty"` // Terms the query was expanded with, e.g. "kubernetes (synonym of k8s)"
+	Memos      []MemoSummary `json:"memos"`
+	Count      int           `json:"count"`
 }
 
 // RunWithStructuredResult executes the tool and returns a structured result.
@@ -236,6 +328,13 @@ func (t *MemoSearchTool) RunWithStructuredResult(ctx context.Context, input stri
 		return nil, fmt.Errorf("query cannot be empty")
 	}
 
+	// Split operators such as tag:work from the search text
+	now := time.Now().In(getTimezoneLocation(t.timezone))
+	query, err := search.ParseQuery(ctx, searchInput.Query, now)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query: %w", err)
+	}
+
 	// Set defaults using defined constants
 	if searchInput.Limit <= 0 {
 		searchInput.Limit = defaultSearchLimit
@@ -258,12 +357,17 @@ func (t *MemoSearchTool) RunWithStructuredResult(ctx context.Context, input stri
 
 	// Execute search
 	opts := &retrieval.RetrievalOptions{
-		Query:    searchInput.Query,
+		Query:    query.Text,
+		Filter:   query.Filter,
 		UserID:   userID,
 		Strategy: strategy,
 		Limit:    searchInput.Limit,
 		MinScore: searchInput.MinScore,
+		Location: now.Location(),
 	}
+	started := time.Now()
+	t.applyTimeRange(opts, now.Location())
+	t.applyExpansions(ctx, opts)
 
 	results, err := t.retriever.Retrieve(ctx, opts)
 	if err != nil {
@@ -278,13 +382,116 @@ func (t *MemoSearchTool) RunWithStructuredResult(ctx context.Context, input stri
 				UID:     result.Memo.UID,
 				Content: result.Content,
 				Score:   result.Score,
+				Similar: len(result.Similar),
 			})
 		}
 	}
+	t.recordSearch(ctx, searchInput.Query, opts, len(memos), started)
 
-	return &MemoSearchToolResult{
+	result := &MemoSearchToolResult{
 		Query: searchInput.Query,
 		Memos: memos,
 		Count: len(memos),
-	}, nil
+	}
+	if opts.TimeRange != nil {
+		result.TimeRange = formatTimeRange(opts.TimeRange)
+	}
+	if len(opts.Expansions) > 0 {
+		result.Expansions = formatExpansions(opts.Expansions)
+	}
+	return result, nil
+}
+
+// applyTimeRange moves a time phrase such as "last week" or "in March" from
+// the search text into the time range of the retrieval options, resolved in loc.
+func (t *MemoSearchTool) applyTimeRange(opts *retrieval.RetrievalOptions, loc *time.Location) {
+	timeRange, content := t.queryRouter.DetectMemoTimeRange(opts.Query, loc)
+	if timeRange == nil {
+		return
+	}
+	opts.TimeRange = timeRange
+	opts.Query = content
+}
+
+// applyExpansions expands the search text with the user's synonyms, related
+// tags and frequent keywords, for strategies with keyword search.
+func (t *MemoSearchTool) applyExpansions(ctx context.Context, opts *retrieval.RetrievalOptions) {
+	if !retrieval.UsesExpansions(opts.Strategy) {
+		return
+	}
+	opts.Expansions = t.retriever.ExpandQuery(ctx, opts.UserID, opts.Query)
+}
+
+// recordSearch logs the search for search insights, unless the user disabled search logging.
+func (t *MemoSearchTool) recordSearch(ctx context.Context, query string, opts *retrieval.RetrievalOptions, resultCount int, started time.Time) {
+	t.retriever.RecordSearch(ctx, &store.SearchLog{
+		UserID:      opts.UserID,
+		Query:       query,
+		Source:      store.SearchLogSourceAgent,
+		Strategy:    opts.Strategy,
+		ResultCount: int32(resultCount),
+		LatencyMs:   int32(time.Since(started).Milliseconds()),
+	})
+}
+
+// formatExpansions describes expansion terms with their origin, e.g. "kubernetes (synonym of k8s)".
+func formatExpansions(expansions []retrieval.ExpandedTerm) []string {
+	formatted := make([]string, 0, len(expansions))
+	for _, e := range expansions {
+		switch e.Source {
+		case retrieval.ExpansionSourceSynonym:
+			formatted = append(formatted, fmt.Sprintf("%s (synonym of %s)", e.Term, e.Original))
+		case retrieval.ExpansionSourceTag:
+			formatted = append(formatted, fmt.Sprintf("%s (tag related to #%s)", e.Term, e.Original))
+		default:
+			formatted = append(formatted, fmt.Sprintf("%s (frequent keyword)", e.Term))
+		}
+	}
+	return formatted
+}
+
+// formatTimeRange describes a time range with its inclusive dates, e.g. "last week (2026-03-02 - 2026-03-08)".
+func formatTimeRange(tr *queryengine.TimeRange) string {
+	start := tr.Start.Format(time.DateOnly)
+	end := tr.End.Add(-time.Second).Format(time.DateOnly)
+	if start == end {
+		return fmt.Sprintf("%s (%s)", tr.Label, start)
+	}
+	return fmt.Sprintf("%s (%s - %s)", tr.Label, start, end)
+}
+
+// truncateTokens shortens content to maxTokens, marking the cut with an ellipsis.
+func truncateTokens(tok tokenizer.Tokenizer, content string, maxTokens int) string {
+	if tok.CountTokens(content) <= maxTokens {
+		return content
+	}
+	return tok.Truncate(content, maxTokens-1) + "..."
+}
+
+// formatFacets summarizes the facets of all keyword matches, so the agent can
+// describe the result set and narrow the query with tag: or after: operators.
+func formatFacets(facets *store.MemoFacets) string {
+	if facets == nil || facets.Total == 0 {
+		return ""
+	}
+	parts := []string{}
+	if len(facets.Tags) > 0 {
+		tags := make([]string, 0, maxOverviewValues)
+		for _, tag := range facets.Tags[:min(len(facets.Tags), maxOverviewValues)] {
+			tags = append(tags, fmt.Sprintf("#%s (%d)", tag.Value, tag.Count))
+		}
+		parts = append(parts, "tags "+strings.Join(tags, ", "))
+	}
+	if len(facets.Months) > 0 {
+		// Busiest months first
+		months := slices.Clone(facets.Months)
+		slices.SortStableFunc(months, func(a, b *store.FacetCount) int { return b.Count - a.Count })
+		values := make([]string, 0, maxOverviewValues)
+		for _, month := range months[:min(len(months), maxOverviewValues)] {
+			values = append(values, fmt.Sprintf("%s (%d)", month.Value, month.Count))
+		}
+		parts = append(parts, "months "+strings.Join(values, ", "))
+	}
+	parts = append(parts, fmt.Sprintf("%d with attachments, %d with tasks", facets.HasAttachment, facets.HasTaskList))
+	return fmt.Sprintf("Overview of %d keyword matches: %s", facets.Total, strings.Join(parts, "; "))
 }
@@ -0,0 +1,30 @@
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/server/queryengine"
+	"github.com/hrygo/divinesense/server/retrieval"
+)
+
+func TestMemoSearchTool_ApplyTimeRangeInUserTimezone(t *testing.T) {
+	tool := &MemoSearchTool{queryRouter: queryengine.NewQueryRouter(), timezone: DefaultTimezone}
+	tool.SetTimezone("America/Los_Angeles")
+	tool.SetTimezone("")
+	assert.Equal(t, "America/Los_Angeles", tool.timezone)
+
+	loc := getTimezoneLocation(tool.timezone)
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	opts := &retrieval.RetrievalOptions{Query: "yesterday meeting notes"}
+	tool.applyTimeRange(opts, loc)
+	require.NotNil(t, opts.TimeRange)
+	assert.True(t, today.AddDate(0, 0, -1).Equal(opts.TimeRange.Start), opts.TimeRange.Start)
+	assert.True(t, today.Equal(opts.TimeRange.End), opts.TimeRange.End)
+	assert.NotContains(t, opts.Query, "yesterday")
+}
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hrygo/divinesense/plugin/ai/habit"
 	"github.com/hrygo/divinesense/server/service/schedule"
 )
 
@@ -404,6 +405,8 @@ type ScheduleAddTool struct {
 	service          schedule.Service
 	userIDGetter     func(ctx context.Context) int32
 	conflictResolver *schedule.ConflictResolver
+	habitApplier     *habit.HabitApplier
+	timezone         string
 }
 
 // NewScheduleAddTool creates a new schedule add tool.
@@ -412,9 +415,23 @@ func NewScheduleAddTool(service schedule.Service, userIDGetter func(ctx context.
 		service:          service,
 		userIDGetter:     userIDGetter,
 		conflictResolver: schedule.NewConflictResolver(service),
+		timezone:         DefaultTimezone,
 	}
 }
 
+// SetTimezone sets the user's timezone for learned times, night-hour checks and created schedules.
+func (t *ScheduleAddTool) SetTimezone(timezone string) {
+	if timezone != "" {
+		t.timezone = timezone
+	}
+}
+
+// SetHabitApplier enables learned defaults: the user's usual time when
+// start_time is omitted and usual duration when end_time is omitted.
+func (t *ScheduleAddTool) SetHabitApplier(applier *habit.HabitApplier) {
+	t.habitApplier = applier
+}
+
 // Name returns the tool name.
 func (t *ScheduleAddTool) Name() string {
 	return "schedule_add"
@@ -422,7 +439,19 @@ func (t *ScheduleAddTool) Name() string {
 
 // Description returns the tool description for the LLM.
 func (t *ScheduleAddTool) Description() string {
-	return `Create a schedule event.
+	if t.habitApplier != nil {
+		return scheduleAddDescription + `
+
+Note: end_time can be omitted to use the user's usual duration (learned from habits).
+start_time can be omitted when the user gives no time at all; the user's usual time is used.`
+	}
+	return scheduleAddDescription + `
+
+Note: end_time can be omitted for 1-hour default duration.`
+}
+
+// scheduleAddDescription is the common part of the schedule_add tool description.
+const scheduleAddDescription = `Create a schedule event.
 
 AUTO-HANDLED BY THIS TOOL (you don't need to handle these manually):
 - Past times: Automatically adjusted to tomorrow same time
@@ -436,10 +465,7 @@ USAGE:
 - Pre-checking with schedule_query is optional but helps avoid confusion
 
 Input: {"title": "event name", "start_time": "ISO8601", "end_time": "ISO8601"}
-Example: {"title": "Team Meeting", "start_time": "2026-01-25T15:00:00+08:00", "end_time": "2026-01-25T16:00:00+08:00"}
-
-Note: end_time can be omitted for 1-hour default duration.`
-}
+Example: {"title": "Team Meeting", "start_time": "2026-01-25T15:00:00+08:00", "end_time": "2026-01-25T16:00:00+08:00"}`
 
 // InputType returns the expected input type schema.
 func (t *ScheduleAddTool) InputType() map[string]interface{} {
@@ -471,10 +497,18 @@ func (t *ScheduleAddTool) InputType() map[string]interface{} {
 				"description": "Whether this is an all-day event (default: false)",
 			},
 		},
-		"required": []string{"title", "start_time"},
+		"required": t.requiredFields(),
 	}
 }
 
+// requiredFields returns the required input fields; start_time is optional with learned habits.
+func (t *ScheduleAddTool) requiredFields() []string {
+	if t.habitApplier != nil {
+		return []string{"title"}
+	}
+	return []string{"title", "start_time"}
+}
+
 // Run executes the tool.
 func (t *ScheduleAddTool) Run(ctx context.Context, inputJSON string) (string, error) {
 	// Validate input first
@@ -507,14 +541,29 @@ func (t *ScheduleAddTool) Run(ctx context.Context, inputJSON string) (string, er
 	if input.Title == "" {
 		return "", fmt.Errorf("title cannot be empty")
 	}
-	if input.StartTime == "" {
+	if input.StartTime == "" && t.habitApplier == nil {
 		return "", fmt.Errorf("start_time cannot be empty")
 	}
 
-	// Parse start time
-	startTime, err := time.Parse(time.RFC3339, input.StartTime)
-	if err != nil {
-		return "", fmt.Errorf("invalid start_time format: %w. Please use ISO8601 format", err)
+	// Get user ID from context
+	userID := t.userIDGetter(ctx)
+	if userID == 0 {
+		return "", fmt.Errorf("unauthorized: no user ID in context")
+	}
+
+	// Parse start time, falling back to the user's usual time
+	var startTime time.Time
+	if input.StartTime == "" {
+		startTime = t.habitApplier.NextPreferredTime(ctx, userID, time.Now().In(getTimezoneLocation(t.timezone)))
+		if startTime.IsZero() {
+			return "", fmt.Errorf("start_time cannot be empty")
+		}
+	} else {
+		var err error
+		startTime, err = time.Parse(time.RFC3339, input.StartTime)
+		if err != nil {
+			return "", fmt.Errorf("invalid start_time format: %w. Please use ISO8601 format", err)
+		}
 	}
 
 	// Store original start time for adjustment detection
@@ -523,6 +572,9 @@ func (t *ScheduleAddTool) Run(ctx context.Context, inputJSON string) (string, er
 	// Parse end time BEFORE adjusting start_time to preserve duration
 	// Calculate original duration if end_time provided
 	var originalDuration int64 = 3600 // Default 1 hour
+	if input.EndTime == "" && t.habitApplier != nil {
+		originalDuration = int64(t.habitApplier.GetSuggestedDuration(ctx, userID)) * 60
+	}
 	if input.EndTime != "" {
 		originalEndTime, err := time.Parse(time.RFC3339, input.EndTime)
 		if err != nil {
@@ -558,7 +610,7 @@ func (t *ScheduleAddTool) Run(ctx context.Context, inputJSON string) (string, er
 
 	// PRINCIPLE 3: Avoid 22:00-06:00 for non-explicit requests
 	// If auto-adjusted time falls in night hours (22:00-06:00), move to next day 9:00
-	loc := getTimezoneLocation(DefaultTimezone)
+	loc := getTimezoneLocation(t.timezone)
 	localTime := startTime.In(loc)
 	hour := localTime.Hour()
 
@@ -583,12 +635,6 @@ func (t *ScheduleAddTool) Run(ctx context.Context, inputJSON string) (string, er
 	adjustedEndTs := startTime.Unix() + originalDuration
 	endTime = &adjustedEndTs
 
-	// Get user ID from context
-	userID := t.userIDGetter(ctx)
-	if userID == 0 {
-		return "", fmt.Errorf("unauthorized: no user ID in context")
-	}
-
 	// Create schedule request
 	createReq := &schedule.CreateScheduleRequest{
 		Title:       input.Title,
@@ -597,7 +643,7 @@ func (t *ScheduleAddTool) Run(ctx context.Context, inputJSON string) (string, er
 		StartTs:     startTime.Unix(),
 		EndTs:       endTime,
 		AllDay:      input.AllDay,
-		Timezone:    DefaultTimezone,
+		Timezone:    t.timezone,
 	}
 
 	// Create schedule
@@ -751,6 +797,9 @@ func (t *ScheduleAddTool) Validate(ctx context.Context, inputJSON string) error
 		return fmt.Errorf("title is required and cannot be empty")
 	}
 	if input.StartTime == "" {
+		if t.habitApplier != nil {
+			return nil
+		}
 		return fmt.Errorf("start_time is required and cannot be empty")
 	}
 
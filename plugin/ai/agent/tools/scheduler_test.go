@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/hrygo/divinesense/plugin/ai/habit"
+	"github.com/hrygo/divinesense/plugin/ai/memory"
 	"github.com/hrygo/divinesense/server/service/schedule"
 	"github.com/hrygo/divinesense/store"
 )
@@ -17,6 +19,7 @@ type MockScheduleService struct {
 	findSchedulesError   error
 	createScheduleResult *store.Schedule
 	createScheduleError  error
+	lastCreate           *schedule.CreateScheduleRequest
 }
 
 func (m *MockScheduleService) FindSchedules(ctx context.Context, userID int32, start, end time.Time) ([]*schedule.ScheduleInstance, error) {
@@ -27,6 +30,7 @@ func (m *MockScheduleService) FindSchedules(ctx context.Context, userID int32, s
 }
 
 func (m *MockScheduleService) CreateSchedule(ctx context.Context, userID int32, create *schedule.CreateScheduleRequest) (*store.Schedule, error) {
+	m.lastCreate = create
 	if m.createScheduleError != nil {
 		return nil, m.createScheduleError
 	}
@@ -217,6 +221,30 @@ func TestScheduleAddTool_Run(t *testing.T) {
 	})
 }
 
+func TestScheduleAddTool_Run_PreferredTimeInUserTimezone(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.NewMockMemoryService()
+	prefs := memory.DefaultPreferences()
+	prefs.PreferredTimes = []string{"10:00"}
+	require.NoError(t, mem.UpdatePreferences(ctx, 1, prefs))
+
+	mockService := &MockScheduleService{}
+	tool := NewScheduleAddTool(mockService, func(context.Context) int32 { return 1 })
+	tool.SetHabitApplier(habit.NewHabitApplier(mem))
+	tool.SetTimezone("America/New_York")
+
+	_, err := tool.Run(ctx, `{"title": "Standup"}`)
+	require.NoError(t, err)
+	require.NotNil(t, mockService.lastCreate)
+	assert.Equal(t, "America/New_York", mockService.lastCreate.Timezone)
+
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	start := time.Unix(mockService.lastCreate.StartTs, 0).In(loc)
+	assert.Equal(t, 10, start.Hour(), "the usual time is taken in the user's timezone")
+	assert.Equal(t, 0, start.Minute())
+}
+
 // TestScheduleQueryTool_Validate tests the validation logic.
 func TestScheduleQueryTool_Validate(t *testing.T) {
 	ctx := context.Background()
@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hrygo/divinesense/plugin/ai/timeout"
+	"github.com/hrygo/divinesense/server/service/capture"
+)
+
+// maxCapturedContentRunes limits how much article text is returned to the agent.
+const maxCapturedContentRunes = 3000
+
+// WebCaptureTool fetches a web page and extracts its readable content,
+// optionally saving it as a memo.
+// WebCaptureTool 抓取网页正文，可选择保存为笔记。
+type WebCaptureTool struct {
+	service      capture.Service
+	userIDGetter func(ctx context.Context) int32
+}
+
+// NewWebCaptureTool creates a new web capture tool.
+// NewWebCaptureTool 创建一个新的网页抓取工具。
+func NewWebCaptureTool(service capture.Service, userIDGetter func(ctx context.Context) int32) (*WebCaptureTool, error) {
+	if service == nil {
+		return nil, fmt.Errorf("capture service cannot be nil")
+	}
+	if userIDGetter == nil {
+		return nil, fmt.Errorf("userIDGetter cannot be nil")
+	}
+
+	return &WebCaptureTool{
+		service:      service,
+		userIDGetter: userIDGetter,
+	}, nil
+}
+
+// Name returns the name of the tool.
+// Name 返回工具名称。
+func (t *WebCaptureTool) Name() string {
+	return "web_capture"
+}
+
+// Description returns a description of what the tool does.
+// Description 返回工具描述。
+func (t *WebCaptureTool) Description() string {
+	return `Fetches a web page, extracts the article content and summarizes it. Can save the page as a memo.
+
+INPUT FORMAT:
+{"url": "https://example.com/post", "save": false}
+- url (required): absolute http(s) URL
+- save (optional): save the page as a memo, default false
+
+OUTPUT FORMAT (text):
+Title: xxx
+URL: https://...
+Summary: ...
+Tags: #a #b
+Saved as: memos/xxxxx (only when saved)
+
+Content:
+article markdown (truncated)`
+}
+
+// WebCaptureInput represents the input for web capture.
+// WebCaptureInput 表示网页抓取的输入。
+type WebCaptureInput struct {
+	URL  string `json:"url"`            // Page URL (required)
+	Save bool   `json:"save,omitempty"` // Save the page as a memo
+}
+
+// Run executes the web capture tool.
+// Run 执行网页抓取工具。
+func (t *WebCaptureTool) Run(ctx context.Context, input string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout.ToolExecutionTimeout)
+	defer cancel()
+
+	var captureInput WebCaptureInput
+	if err := json.Unmarshal([]byte(input), &captureInput); err != nil {
+		return "", fmt.Errorf("invalid JSON input: %w", err)
+	}
+	if strings.TrimSpace(captureInput.URL) == "" {
+		return "", fmt.Errorf("url cannot be empty")
+	}
+
+	result, err := t.service.Capture(ctx, t.userIDGetter(ctx), &capture.CaptureRequest{
+		URL:  captureInput.URL,
+		Save: captureInput.Save,
+	})
+	if err != nil {
+		return "", fmt.Errorf("capture failed: %w", err)
+	}
+
+	return FormatCaptureResult(result), nil
+}
+
+// FormatCaptureResult renders a capture result as text for the LLM.
+// FormatCaptureResult 将抓取结果格式化为 LLM 可读文本。
+func FormatCaptureResult(result *capture.CaptureResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Title: %s\n", result.Article.Title)
+	fmt.Fprintf(&b, "URL: %s\n", result.Article.URL)
+	if result.Summary != "" {
+		fmt.Fprintf(&b, "Summary:\n%s\n", result.Summary)
+	}
+	if len(result.Tags) > 0 {
+		fmt.Fprintf(&b, "Tags: #%s\n", strings.Join(result.Tags, " #"))
+	}
+	if result.Memo != nil {
+		fmt.Fprintf(&b, "Saved as: memos/%s\n", result.Memo.UID)
+	}
+
+	content := result.Article.Markdown
+	if runes := []rune(content); len(runes) > maxCapturedContentRunes {
+		content = string(runes[:maxCapturedContentRunes]) + "\n…"
+	}
+	fmt.Fprintf(&b, "\nContent:\n%s\n", content)
+	return b.String()
+}
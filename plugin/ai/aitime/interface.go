@@ -11,7 +11,7 @@ import (
 // Consumers: Team B (Assistant+Schedule)
 type TimeService interface {
 	// Normalize standardizes time expressions.
-	// Supports: "明天3点", "下午三点", "2026-1-28", "15:00"
+	// Supports: "明天3点", "下午三点", "2026-1-28", "15:00", "next Tuesday at 3pm", "in two weeks"
 	// Returns: standardized time.Time
 	Normalize(ctx context.Context, input string, timezone string) (time.Time, error)
 
@@ -6,6 +6,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/hrygo/divinesense/plugin/ai/locale"
 )
 
 // Patterns for time parsing
@@ -94,6 +96,8 @@ var weekdayMap = map[string]int{
 type Parser struct {
 	timezone *time.Location
 	now      func() time.Time
+	// locale is the preferred locale for script-neutral input.
+	locale locale.Locale
 }
 
 // NewParser creates a new time parser with the given timezone.
@@ -112,6 +116,17 @@ func (p *Parser) WithTimezone(tz *time.Location) *Parser {
 	return &Parser{
 		timezone: tz,
 		now:      p.now,
+		locale:   p.locale,
+	}
+}
+
+// WithLocale returns a new parser preferring the given locale.
+// The script of the input still decides when it is unambiguous.
+func (p *Parser) WithLocale(loc locale.Locale) *Parser {
+	return &Parser{
+		timezone: p.timezone,
+		now:      p.now,
+		locale:   loc,
 	}
 }
 
@@ -129,6 +144,11 @@ func (p *Parser) Parse(input string) (time.Time, error) {
 		return t, nil
 	}
 
+	// Parse English expressions (e.g., "next Tuesday at 3pm")
+	if locale.Resolve(p.locale, input) == locale.English {
+		return p.parseEnglishTime(input, now)
+	}
+
 	// Try relative time (e.g., "1小时后")
 	if t, ok := p.tryRelativeTime(input, now); ok {
 		return t, nil
@@ -0,0 +1,352 @@
+package aitime
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// englishHour matches an hour written as digits or a number word.
+const englishHour = `(\d{1,2}|one|two|three|four|five|six|seven|eight|nine|ten|eleven|twelve)`
+
+// englishCount matches a count written as digits, a number word or an article.
+const englishCount = `(\d+|an?|one|two|three|four|five|six|seven|eight|nine|ten|eleven|twelve|fifteen|twenty|thirty|forty|fifty|sixty)`
+
+// Patterns for English time parsing, matched against lowercased input.
+var (
+	enRelativeInPattern  = regexp.MustCompile(`\bin\s+` + englishCount + `\s+(minute|min|hour|hr|day|week|month|year)s?\b`)
+	enRelativeAgoPattern = regexp.MustCompile(`\b` + englishCount + `\s+(minute|min|hour|hr|day|week|month|year)s?\s+(ago|later|from now)\b`)
+	enHalfHourPattern    = regexp.MustCompile(`\bin\s+half\s+an\s+hour\b`)
+
+	enWeekdayPattern = regexp.MustCompile(`\b(?:(next|this|last|coming)\s+)?(monday|tuesday|wednesday|thursday|friday|saturday|sunday)\b`)
+	enEndOfPattern   = regexp.MustCompile(`\b(?:the\s+)?end\s+of\s+(?:the\s+|this\s+)?(week|month|year)\b`)
+	enMonthDayRegex  = regexp.MustCompile(`\b(jan|feb|mar|apr|may|jun|jul|aug|sep|oct|nov|dec)[a-z]*\.?\s+(\d{1,2})(?:st|nd|rd|th)?\b(?:,?\s*(\d{4}))?`)
+	enDayMonthRegex  = regexp.MustCompile(`\b(\d{1,2})(?:st|nd|rd|th)?\s+(?:of\s+)?(jan|feb|mar|apr|may|jun|jul|aug|sep|oct|nov|dec)[a-z]*\b(?:,?\s*(\d{4}))?`)
+
+	enMeridiemPattern = regexp.MustCompile(`\b` + englishHour + `(?::(\d{2}))?\s*(a\.?m\.?|p\.?m\.?)(?:\s|$|[^a-z])`)
+	enClockPattern    = regexp.MustCompile(`\b(\d{1,2}):(\d{2})\b`)
+	enOclockPattern   = regexp.MustCompile(`\b` + englishHour + `\s*o'?clock\b`)
+	enAtHourPattern   = regexp.MustCompile(`\bat\s+` + englishHour + `\b`)
+	enPastPattern     = regexp.MustCompile(`\b(half|quarter)\s+past\s+` + englishHour + `\b`)
+	enToPattern       = regexp.MustCompile(`\bquarter\s+to\s+` + englishHour + `\b`)
+)
+
+// englishNums maps number words to integers.
+var englishNums = map[string]int{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+	"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+	"fifteen": 15, "twenty": 20, "thirty": 30, "forty": 40, "fifty": 50, "sixty": 60,
+}
+
+// englishRelDates maps relative date phrases to day offsets (longest first).
+var englishRelDates = []struct {
+	phrase string
+	offset int
+}{
+	{"day after tomorrow", 2},
+	{"day before yesterday", -2},
+	{"tomorrow", 1},
+	{"yesterday", -1},
+	{"tonight", 0},
+	{"today", 0},
+}
+
+// englishPeriods maps time period words to typical hours (longest first).
+var englishPeriods = []struct {
+	word string
+	hour int
+	pm   bool
+}{
+	{"afternoon", 14, true},
+	{"midnight", 0, false},
+	{"morning", 9, false},
+	{"evening", 19, true},
+	{"tonight", 20, true},
+	{"night", 21, true},
+	{"noon", 12, false},
+}
+
+// englishWeekdays maps weekday names to offsets from Monday.
+var englishWeekdays = map[string]int{
+	"monday": 0, "tuesday": 1, "wednesday": 2, "thursday": 3,
+	"friday": 4, "saturday": 5, "sunday": 6,
+}
+
+// englishMonths maps month abbreviations to months.
+var englishMonths = map[string]time.Month{
+	"jan": time.January, "feb": time.February, "mar": time.March, "apr": time.April,
+	"may": time.May, "jun": time.June, "jul": time.July, "aug": time.August,
+	"sep": time.September, "oct": time.October, "nov": time.November, "dec": time.December,
+}
+
+// parseEnglishNumber parses digits or a number word.
+func parseEnglishNumber(s string) (int, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, true
+	}
+	n, ok := englishNums[s]
+	return n, ok
+}
+
+// parseEnglishTime parses English time expressions such as
+// "next Tuesday at 3pm", "in two weeks" or "end of month".
+func (p *Parser) parseEnglishTime(input string, now time.Time) (time.Time, error) {
+	input = strings.Join(strings.Fields(strings.ToLower(input)), " ")
+
+	hour, minute, timeFound := p.parseEnglishTimePart(input)
+
+	// Relative offsets ("in 2 hours", "3 days ago") are anchored at now
+	if t, unit, ok := p.tryEnglishRelativeTime(input, now); ok {
+		if timeFound && unit != "minute" && unit != "min" && unit != "hour" && unit != "hr" {
+			t = time.Date(t.Year(), t.Month(), t.Day(), hour, minute, 0, 0, p.timezone)
+		}
+		return t, nil
+	}
+
+	date, dateFound := p.parseEnglishDate(input, now)
+	if !dateFound {
+		date = now
+	}
+
+	if timeFound {
+		return time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, p.timezone), nil
+	}
+
+	// If only date was found, default to 9:00
+	if dateFound {
+		return time.Date(date.Year(), date.Month(), date.Day(), 9, 0, 0, 0, p.timezone), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unable to parse time: %s", input)
+}
+
+// tryEnglishRelativeTime parses "in 2 hours", "in two weeks", "3 days ago" and "2 hours later".
+// Returns the unit so callers know whether a clock time still applies.
+func (p *Parser) tryEnglishRelativeTime(input string, now time.Time) (time.Time, string, bool) {
+	if enHalfHourPattern.MatchString(input) {
+		return now.Add(30 * time.Minute), "minute", true
+	}
+
+	sign := 1
+	matches := enRelativeInPattern.FindStringSubmatch(input)
+	if matches == nil {
+		matches = enRelativeAgoPattern.FindStringSubmatch(input)
+		if matches == nil {
+			return time.Time{}, "", false
+		}
+		if matches[3] == "ago" {
+			sign = -1
+		}
+	}
+
+	n, ok := parseEnglishNumber(matches[1])
+	if !ok {
+		return time.Time{}, "", false
+	}
+	n *= sign
+
+	unit := matches[2]
+	switch unit {
+	case "minute", "min":
+		return now.Add(time.Duration(n) * time.Minute), unit, true
+	case "hour", "hr":
+		return now.Add(time.Duration(n) * time.Hour), unit, true
+	case "day":
+		return now.AddDate(0, 0, n), unit, true
+	case "week":
+		return now.AddDate(0, 0, 7*n), unit, true
+	case "month":
+		return now.AddDate(0, n, 0), unit, true
+	case "year":
+		return now.AddDate(n, 0, 0), unit, true
+	}
+	return time.Time{}, "", false
+}
+
+// parseEnglishDate parses the date part of an English expression.
+func (p *Parser) parseEnglishDate(input string, now time.Time) (time.Time, bool) {
+	for _, rd := range englishRelDates {
+		if containsWord(input, rd.phrase) {
+			return now.AddDate(0, 0, rd.offset), true
+		}
+	}
+
+	// Current weekday (Monday = 0)
+	currentWeekday := (int(now.Weekday()) + 6) % 7
+
+	if matches := enEndOfPattern.FindStringSubmatch(input); matches != nil {
+		switch matches[1] {
+		case "week":
+			return now.AddDate(0, 0, 6-currentWeekday), true
+		case "month":
+			firstOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, p.timezone)
+			return firstOfMonth.AddDate(0, 1, -1), true
+		case "year":
+			return time.Date(now.Year(), time.December, 31, 0, 0, 0, 0, p.timezone), true
+		}
+	}
+
+	// Weekdays follow the Chinese rules: "next Tuesday" is Tuesday of next week,
+	// "this Tuesday" of the current week. A bare weekday is the upcoming one.
+	if matches := enWeekdayPattern.FindStringSubmatch(input); matches != nil {
+		target := englishWeekdays[matches[2]]
+		switch matches[1] {
+		case "next":
+			return now.AddDate(0, 0, 7-currentWeekday+target), true
+		case "last":
+			return now.AddDate(0, 0, -currentWeekday-7+target), true
+		case "this":
+			return now.AddDate(0, 0, target-currentWeekday), true
+		default:
+			return now.AddDate(0, 0, (target-currentWeekday+7)%7), true
+		}
+	}
+
+	if containsWord(input, "next week") {
+		return now.AddDate(0, 0, 7-currentWeekday), true
+	}
+	if containsWord(input, "next month") {
+		return time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, p.timezone), true
+	}
+	if containsWord(input, "weekend") {
+		return now.AddDate(0, 0, (5-currentWeekday+7)%7), true
+	}
+
+	if t, ok := p.parseEnglishMonthDay(input, now); ok {
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// parseEnglishMonthDay parses "Jan 15", "January 15th, 2026" and "15 January".
+// Without a year the current year is used.
+func (p *Parser) parseEnglishMonthDay(input string, now time.Time) (time.Time, bool) {
+	var monthText, dayText, yearText string
+	if matches := enMonthDayRegex.FindStringSubmatch(input); matches != nil {
+		monthText, dayText, yearText = matches[1], matches[2], matches[3]
+	} else if matches := enDayMonthRegex.FindStringSubmatch(input); matches != nil {
+		dayText, monthText, yearText = matches[1], matches[2], matches[3]
+	} else {
+		return time.Time{}, false
+	}
+
+	day, _ := strconv.Atoi(dayText)
+	if day < 1 || day > 31 {
+		return time.Time{}, false
+	}
+	year := now.Year()
+	if yearText != "" {
+		year, _ = strconv.Atoi(yearText)
+	}
+	return time.Date(year, englishMonths[monthText], day, 0, 0, 0, 0, p.timezone), true
+}
+
+// parseEnglishTimePart parses the clock time of an English expression.
+func (p *Parser) parseEnglishTimePart(input string) (hour, minute int, found bool) {
+	// Explicit meridiem: "3pm", "10:30 a.m."
+	if matches := enMeridiemPattern.FindStringSubmatch(input); matches != nil {
+		if h, ok := parseEnglishNumber(matches[1]); ok && h >= 1 && h <= 12 {
+			if matches[2] != "" {
+				minute, _ = strconv.Atoi(matches[2])
+			}
+			pm := strings.HasPrefix(matches[3], "p")
+			switch {
+			case pm && h < 12:
+				h += 12
+			case !pm && h == 12:
+				h = 0
+			}
+			return h, minute, true
+		}
+	}
+
+	hour = -1
+	hasPM, hasAM := false, false
+	for _, period := range englishPeriods {
+		if containsWord(input, period.word) {
+			hasPM = period.pm
+			hasAM = !period.pm
+			break
+		}
+	}
+
+	// 24-hour clock: "15:00", "9:30"
+	if matches := enClockPattern.FindStringSubmatch(input); matches != nil {
+		h, _ := strconv.Atoi(matches[1])
+		m, _ := strconv.Atoi(matches[2])
+		if h <= 24 && m < 60 {
+			hour, minute = h, m
+		}
+	}
+
+	// "half past 3", "quarter past 3", "quarter to 4"
+	if hour == -1 {
+		if matches := enPastPattern.FindStringSubmatch(input); matches != nil {
+			if h, ok := parseEnglishNumber(matches[2]); ok && h <= 12 {
+				hour, minute = h, 30
+				if matches[1] == "quarter" {
+					minute = 15
+				}
+			}
+		} else if matches := enToPattern.FindStringSubmatch(input); matches != nil {
+			if h, ok := parseEnglishNumber(matches[1]); ok && h >= 1 && h <= 12 {
+				hour, minute = h-1, 45
+			}
+		}
+	}
+
+	// "3 o'clock", "at 3"
+	if hour == -1 {
+		for _, pattern := range []*regexp.Regexp{enOclockPattern, enAtHourPattern} {
+			if matches := pattern.FindStringSubmatch(input); matches != nil {
+				if h, ok := parseEnglishNumber(matches[1]); ok && h <= 24 {
+					hour = h
+					break
+				}
+			}
+		}
+	}
+
+	if hour == -1 {
+		// Only a period such as "tomorrow morning" or "at noon"
+		for _, period := range englishPeriods {
+			if containsWord(input, period.word) {
+				return period.hour, 0, true
+			}
+		}
+		return 0, 0, false
+	}
+
+	// Same AM/PM defaults as Chinese: ambiguous 1-6 means afternoon
+	if hour <= 12 {
+		if hasPM {
+			if hour < 12 {
+				hour += 12
+			}
+		} else if !hasAM && hour >= 1 && hour <= 6 {
+			hour += 12
+		}
+	}
+	return hour, minute, true
+}
+
+// containsWord reports whether text contains phrase as whole words.
+func containsWord(text, phrase string) bool {
+	for i := 0; ; {
+		idx := strings.Index(text[i:], phrase)
+		if idx < 0 {
+			return false
+		}
+		start, end := i+idx, i+idx+len(phrase)
+		if (start == 0 || !isWordByte(text[start-1])) && (end == len(text) || !isWordByte(text[end])) {
+			return true
+		}
+		i = start + 1
+	}
+}
+
+func isWordByte(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= '0' && b <= '9'
+}
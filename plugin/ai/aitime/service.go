@@ -3,7 +3,10 @@ package aitime
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
+
+	"github.com/hrygo/divinesense/plugin/ai/locale"
 )
 
 // Service implements TimeService with rule-based parsing.
@@ -22,19 +25,26 @@ func NewService(defaultTimezone string) *Service {
 	}
 }
 
+// Location returns the default timezone of the service.
+func (s *Service) Location() *time.Location {
+	return s.defaultTimezone
+}
+
 // Normalize standardizes time expressions.
-func (s *Service) Normalize(_ context.Context, input string, timezone string) (time.Time, error) {
+// The preferred locale is taken from ctx, see locale.WithLocale.
+func (s *Service) Normalize(ctx context.Context, input string, timezone string) (time.Time, error) {
 	loc, err := time.LoadLocation(timezone)
 	if err != nil {
 		loc = s.defaultTimezone
 	}
 
-	parser := NewParser(loc)
+	parser := NewParser(loc).WithLocale(locale.FromContext(ctx))
 	return parser.Parse(input)
 }
 
 // ParseNaturalTime parses natural language time expressions.
-func (s *Service) ParseNaturalTime(_ context.Context, input string, reference time.Time) (TimeRange, error) {
+// The preferred locale is taken from ctx, see locale.WithLocale.
+func (s *Service) ParseNaturalTime(ctx context.Context, input string, reference time.Time) (TimeRange, error) {
 	// First try to parse as a time range keyword
 	tr, err := s.parseRangeKeyword(input, reference)
 	if err == nil {
@@ -46,6 +56,7 @@ func (s *Service) ParseNaturalTime(_ context.Context, input string, reference ti
 	parser := &Parser{
 		timezone: reference.Location(),
 		now:      func() time.Time { return reference },
+		locale:   locale.FromContext(ctx),
 	}
 	t, err := parser.Parse(input)
 	if err != nil {
@@ -120,8 +131,59 @@ func (s *Service) parseRangeKeyword(input string, ref time.Time) (TimeRange, err
 		return TimeRange{Start: start, End: monthStart}, nil
 	}
 
+	if tr, ok := parseEnglishRangeKeyword(input, dayStart, mondayOffset, monthStart); ok {
+		return tr, nil
+	}
+
 	return TimeRange{}, fmt.Errorf("unable to parse time expression: %s", input)
 }
 
+// parseEnglishRangeKeyword parses English range keywords like "today", "this week", "end of month".
+func parseEnglishRangeKeyword(input string, dayStart time.Time, mondayOffset int, monthStart time.Time) (TimeRange, bool) {
+	day := func(offset int) TimeRange {
+		start := dayStart.AddDate(0, 0, offset)
+		return TimeRange{Start: start, End: start.AddDate(0, 0, 1)}
+	}
+	week := func(offset int) TimeRange {
+		monday := dayStart.AddDate(0, 0, mondayOffset+offset)
+		return TimeRange{Start: monday, End: monday.AddDate(0, 0, 7)}
+	}
+	month := func(offset int) TimeRange {
+		start := monthStart.AddDate(0, offset, 0)
+		return TimeRange{Start: start, End: start.AddDate(0, 1, 0)}
+	}
+
+	switch strings.Join(strings.Fields(strings.ToLower(input)), " ") {
+	case "today":
+		return day(0), true
+	case "tomorrow":
+		return day(1), true
+	case "day after tomorrow", "the day after tomorrow":
+		return day(2), true
+	case "yesterday":
+		return day(-1), true
+	case "this week":
+		return week(0), true
+	case "next week":
+		return week(7), true
+	case "last week":
+		return week(-7), true
+	case "this weekend", "weekend":
+		return TimeRange{Start: dayStart.AddDate(0, 0, mondayOffset+5), End: dayStart.AddDate(0, 0, mondayOffset+7)}, true
+	case "end of week", "end of the week":
+		return day(mondayOffset + 6), true
+	case "this month":
+		return month(0), true
+	case "next month":
+		return month(1), true
+	case "last month":
+		return month(-1), true
+	case "end of month", "end of the month":
+		lastDay := monthStart.AddDate(0, 1, -1)
+		return TimeRange{Start: lastDay, End: lastDay.AddDate(0, 0, 1)}, true
+	}
+	return TimeRange{}, false
+}
+
 // Ensure Service implements TimeService
 var _ TimeService = (*Service)(nil)
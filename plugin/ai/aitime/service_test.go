@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/plugin/ai/locale"
 )
 
 func TestParser_StandardFormats(t *testing.T) {
@@ -48,6 +50,11 @@ func TestParser_RelativeDates(t *testing.T) {
 		{"明天", "明天", "2026-01-28"},
 		{"后天", "后天", "2026-01-29"},
 		{"昨天", "昨天", "2026-01-26"},
+		// English
+		{"today", "today", "2026-01-27"},
+		{"tomorrow", "Tomorrow", "2026-01-28"},
+		{"day after tomorrow", "the day after tomorrow", "2026-01-29"},
+		{"yesterday", "yesterday", "2026-01-26"},
 	}
 
 	for _, tt := range tests {
@@ -79,6 +86,18 @@ func TestParser_ChineseTime(t *testing.T) {
 		{"十二点", "十二点", "12:00"},
 		{"9点", "9点", "09:00"},   // 7-11点 stays as AM (common work hours)
 		{"10点", "10点", "10:00"}, // 7-11点 stays as AM
+		// English
+		{"3pm", "3pm", "15:00"},
+		{"10:30 am", "10:30 am", "10:30"},
+		{"7:45 p.m.", "7:45 p.m.", "19:45"},
+		{"12am", "12am", "00:00"},
+		{"at 3", "at 3", "15:00"}, // 1-6 defaults to PM as in Chinese
+		{"9 o'clock", "9 o'clock", "09:00"},
+		{"noon", "at noon", "12:00"},
+		{"half past four", "half past four", "16:30"},
+		{"quarter to five", "quarter to five", "16:45"},
+		{"at 8 tonight", "at 8 tonight", "20:00"},
+		{"eight in the morning", "at eight in the morning", "08:00"},
 	}
 
 	for _, tt := range tests {
@@ -103,6 +122,15 @@ func TestParser_CombinedExpressions(t *testing.T) {
 		{"明天下午3点", "明天下午3点", "2026-01-28 15:00"},
 		{"后天上午9点", "后天上午9点", "2026-01-29 09:00"},
 		{"明天10点30分", "明天10点30分", "2026-01-28 10:30"},
+		// English
+		{"next Tuesday at 3pm", "next Tuesday at 3pm", "2026-02-03 15:00"},
+		{"tomorrow morning", "tomorrow morning", "2026-01-28 09:00"},
+		{"Friday at 10am", "meeting Friday at 10am", "2026-01-30 10:00"},
+		{"end of month", "end of month", "2026-01-31 09:00"},
+		{"end of the week", "by the end of the week", "2026-02-01 09:00"},
+		{"Jan 30 at 2:30pm", "Jan 30 at 2:30pm", "2026-01-30 14:30"},
+		{"15 March 2027", "15th of March 2027", "2027-03-15 09:00"},
+		{"in two weeks at 3pm", "in two weeks at 3pm", "2026-02-10 15:00"},
 	}
 
 	for _, tt := range tests {
@@ -128,6 +156,14 @@ func TestParser_RelativeTime(t *testing.T) {
 		{"2小时后", "2小时后", "2026-01-27 12:00"},
 		{"30分钟后", "30分钟后", "2026-01-27 10:30"},
 		{"1天后", "1天后", "2026-01-28 10:00"},
+		// English
+		{"in 1 hour", "in 1 hour", "2026-01-27 11:00"},
+		{"in two hours", "in two hours", "2026-01-27 12:00"},
+		{"in 30 minutes", "in 30 minutes", "2026-01-27 10:30"},
+		{"in half an hour", "in half an hour", "2026-01-27 10:30"},
+		{"in a day", "in a day", "2026-01-28 10:00"},
+		{"in two weeks", "in two weeks", "2026-02-10 10:00"},
+		{"3 days ago", "3 days ago", "2026-01-24 10:00"},
 	}
 
 	for _, tt := range tests {
@@ -154,6 +190,12 @@ func TestService_Normalize(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 15, got.Hour())
 	})
+
+	t.Run("EnglishExpression", func(t *testing.T) {
+		got, err := svc.Normalize(locale.WithLocale(ctx, locale.English), "at 3pm", "Asia/Shanghai")
+		require.NoError(t, err)
+		assert.Equal(t, 15, got.Hour())
+	})
 }
 
 func TestService_ParseNaturalTime(t *testing.T) {
@@ -183,6 +225,26 @@ func TestService_ParseNaturalTime(t *testing.T) {
 		assert.Equal(t, "2026-01-28 15:00", tr.Start.Format("2006-01-02 15:04"))
 		assert.Equal(t, time.Hour, tr.End.Sub(tr.Start))
 	})
+
+	t.Run("WeekRange_next week", func(t *testing.T) {
+		tr, err := svc.ParseNaturalTime(ctx, "next week", ref)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-02-02", tr.Start.Format("2006-01-02"))
+		assert.Equal(t, "2026-02-09", tr.End.Format("2006-01-02"))
+	})
+
+	t.Run("DayRange_end of month", func(t *testing.T) {
+		tr, err := svc.ParseNaturalTime(ctx, "End of the month", ref)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-01-31", tr.Start.Format("2006-01-02"))
+		assert.Equal(t, "2026-02-01", tr.End.Format("2006-01-02"))
+	})
+
+	t.Run("SpecificTime_English", func(t *testing.T) {
+		tr, err := svc.ParseNaturalTime(ctx, "next Tuesday at 3pm", ref)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-02-03 15:00", tr.Start.Format("2006-01-02 15:04"))
+	})
 }
 
 func TestParser_Weekday(t *testing.T) {
@@ -201,6 +263,13 @@ func TestParser_Weekday(t *testing.T) {
 		{"周日", "周日", "2026-02-01"},   // Sunday of current week
 		{"下周一", "下周一", "2026-02-02"}, // Monday of next week
 		{"下周三", "下周三", "2026-02-04"}, // Wednesday of next week
+		// English
+		{"this Monday", "this Monday", "2026-01-26"},       // Monday of current week
+		{"Wednesday", "Wednesday", "2026-01-28"},           // Upcoming Wednesday
+		{"on Sunday", "on Sunday", "2026-02-01"},           // Upcoming Sunday
+		{"next Monday", "next Monday", "2026-02-02"},       // Monday of next week
+		{"next Wednesday", "next Wednesday", "2026-02-04"}, // Wednesday of next week
+		{"last Friday", "last Friday", "2026-01-23"},       // Friday of last week
 	}
 
 	for _, tt := range tests {
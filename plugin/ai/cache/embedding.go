@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/store"
+	storecache "github.com/hrygo/divinesense/store/cache"
+)
+
+// DefaultEmbeddingCacheTTL is how long cached embeddings are kept in the database.
+const DefaultEmbeddingCacheTTL = 30 * 24 * time.Hour
+
+// statsLogInterval is the number of lookups between hit rate log lines.
+const statsLogInterval = 1000
+
+// HitStats reports the effectiveness of a cache.
+type HitStats struct {
+	Hits    int64   `json:"hits"`
+	Misses  int64   `json:"misses"`
+	HitRate float64 `json:"hit_rate"`
+}
+
+// CachedEmbeddingService is an EmbeddingService decorator that caches vectors
+// by (model, text hash). Vectors are kept in memory (TieredCache, L1/L2) and in
+// the database (L3), so they survive restarts and are shared across replicas.
+type CachedEmbeddingService struct {
+	embedding ai.EmbeddingService
+	store     *store.Store // Optional; without it only the memory tiers are used
+	tiered    *storecache.TieredCache
+	namespace string
+	ttl       time.Duration
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+var _ ai.EmbeddingService = (*CachedEmbeddingService)(nil)
+
+// NewCachedEmbeddingService wraps embedding with a persistent cache for the given model.
+func NewCachedEmbeddingService(st *store.Store, embedding ai.EmbeddingService, model string) *CachedEmbeddingService {
+	tiered, _ := storecache.NewTieredCache(storecache.DefaultTieredConfig())
+	return &CachedEmbeddingService{
+		embedding: embedding,
+		store:     st,
+		tiered:    tiered,
+		namespace: "embedding:" + model,
+		ttl:       DefaultEmbeddingCacheTTL,
+	}
+}
+
+// Embed returns the cached vector of text, embedding it on a miss.
+func (c *CachedEmbeddingService) Embed(ctx context.Context, text string) ([]float32, error) {
+	key := c.key(text)
+	if vector, ok := c.get(ctx, key); ok {
+		c.record(true)
+		return vector, nil
+	}
+	c.record(false)
+
+	vector, err := c.embedding.Embed(ctx, text)
+	if err != nil {
+		return nil, err
+	}
+	c.set(ctx, key, vector)
+	return vector, nil
+}
+
+// EmbedBatch returns the vectors of texts, embedding only the texts not cached yet.
+func (c *CachedEmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	var missing []string
+	var missingIndexes []int
+	for i, text := range texts {
+		if vector, ok := c.get(ctx, c.key(text)); ok {
+			c.record(true)
+			vectors[i] = vector
+			continue
+		}
+		c.record(false)
+		missing = append(missing, text)
+		missingIndexes = append(missingIndexes, i)
+	}
+	if len(missing) == 0 {
+		return vectors, nil
+	}
+
+	embedded, err := c.embedding.EmbedBatch(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	if len(embedded) != len(missing) {
+		return nil, fmt.Errorf("embedding batch returned %d vectors for %d texts", len(embedded), len(missing))
+	}
+	for i, vector := range embedded {
+		vectors[missingIndexes[i]] = vector
+		c.set(ctx, c.key(missing[i]), vector)
+	}
+	return vectors, nil
+}
+
+// Dimensions returns the vector dimension.
+func (c *CachedEmbeddingService) Dimensions() int {
+	return c.embedding.Dimensions()
+}
+
+// Stats returns the hit statistics since the service was created.
+func (c *CachedEmbeddingService) Stats() HitStats {
+	return newHitStats(c.hits.Load(), c.misses.Load())
+}
+
+func (c *CachedEmbeddingService) key(text string) string {
+	return c.namespace + ":" + hashText(text)
+}
+
+func (c *CachedEmbeddingService) get(ctx context.Context, key string) ([]float32, bool) {
+	value, ok := c.tiered.Get(ctx, key, c.fetch)
+	if !ok {
+		return nil, false
+	}
+	vector, ok := value.([]float32)
+	return vector, ok
+}
+
+// fetch loads a vector from the database (L3).
+func (c *CachedEmbeddingService) fetch(ctx context.Context, key string) (any, error) {
+	if c.store == nil {
+		return nil, fmt.Errorf("no persistent cache")
+	}
+	entries, err := c.store.ListAICacheEntries(ctx, &store.FindAICacheEntry{
+		Keys:    []string{key},
+		ValidAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("not cached")
+	}
+	vector, err := store.DecodeVector(entries[0].Value)
+	if err != nil || len(vector) == 0 {
+		return nil, fmt.Errorf("invalid cached vector")
+	}
+	return vector, nil
+}
+
+func (c *CachedEmbeddingService) set(ctx context.Context, key string, vector []float32) {
+	c.tiered.Set(ctx, key, vector)
+	if c.store == nil {
+		return
+	}
+	now := time.Now()
+	if err := c.store.UpsertAICacheEntry(ctx, &store.AICacheEntry{
+		Key:       key,
+		Namespace: c.namespace,
+		Value:     store.EncodeVector(vector),
+		CreatedTs: now.Unix(),
+		ExpiresTs: now.Add(c.ttl).Unix(),
+	}); err != nil {
+		slog.Warn("failed to persist cached embedding", "error", err)
+	}
+}
+
+func (c *CachedEmbeddingService) record(hit bool) {
+	if hit {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+	if total := c.hits.Load() + c.misses.Load(); total%statsLogInterval == 0 {
+		stats := c.Stats()
+		slog.Info("embedding cache stats", "namespace", c.namespace, "hits", stats.Hits, "misses", stats.Misses, "hit_rate", stats.HitRate)
+	}
+}
+
+func newHitStats(hits, misses int64) HitStats {
+	stats := HitStats{Hits: hits, Misses: misses}
+	if total := hits + misses; total > 0 {
+		stats.HitRate = float64(hits) / float64(total)
+	}
+	return stats
+}
+
+// hashText returns the hex SHA-256 of text.
+func hashText(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hrygo/divinesense/plugin/ai"
+)
+
+type countingEmbeddingService struct {
+	calls int
+}
+
+func (s *countingEmbeddingService) Embed(_ context.Context, text string) ([]float32, error) {
+	s.calls++
+	return []float32{float32(len(text)), 1}, nil
+}
+
+func (s *countingEmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i], _ = s.Embed(ctx, text)
+	}
+	return vectors, nil
+}
+
+func (s *countingEmbeddingService) Dimensions() int {
+	return 2
+}
+
+var _ ai.EmbeddingService = (*countingEmbeddingService)(nil)
+
+// TestCachedEmbeddingService tests that repeated texts are served from the cache.
+func TestCachedEmbeddingService(t *testing.T) {
+	ctx := context.Background()
+	inner := &countingEmbeddingService{}
+	svc := NewCachedEmbeddingService(nil, inner, "test-model")
+
+	if _, err := svc.Embed(ctx, "hello"); err != nil {
+		t.Fatalf("Embed failed: %v", err)
+	}
+	vectors, err := svc.EmbedBatch(ctx, []string{"hello", "world"})
+	if err != nil {
+		t.Fatalf("EmbedBatch failed: %v", err)
+	}
+	if len(vectors) != 2 || vectors[0][0] != 5 || vectors[1][0] != 5 {
+		t.Errorf("unexpected vectors: %v", vectors)
+	}
+	if inner.calls != 2 {
+		t.Errorf("expected 2 embedding calls, got %d", inner.calls)
+	}
+
+	stats := svc.Stats()
+	if stats.Hits != 1 || stats.Misses != 2 {
+		t.Errorf("expected 1 hit and 2 misses, got %+v", stats)
+	}
+}
+
+// TestCosineSimilarity tests the similarity used for semantic cache hits.
+func TestCosineSimilarity(t *testing.T) {
+	if got := cosineSimilarity([]float32{1, 0}, []float32{2, 0}); got < 0.999 {
+		t.Errorf("expected parallel vectors to be similar, got %f", got)
+	}
+	if got := cosineSimilarity([]float32{1, 0}, []float32{0, 1}); got != 0 {
+		t.Errorf("expected orthogonal vectors to score 0, got %f", got)
+	}
+	if got := cosineSimilarity([]float32{1}, []float32{1, 0}); got != 0 {
+		t.Errorf("expected mismatched lengths to score 0, got %f", got)
+	}
+}
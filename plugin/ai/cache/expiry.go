@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+// DefaultExpiryInterval is the default interval between expired entry cleanups.
+const DefaultExpiryInterval = 6 * time.Hour
+
+// RunExpiry deletes expired persistent cache entries every interval until ctx is done.
+func RunExpiry(ctx context.Context, st *store.Store, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultExpiryInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now().Unix()
+		if deleted, err := st.DeleteAICacheEntries(ctx, &store.DeleteAICacheEntry{ExpiredBefore: &now}); err != nil {
+			slog.Warn("failed to delete expired AI cache entries", "error", err)
+		} else if deleted > 0 {
+			slog.Info("deleted expired AI cache entries", "deleted", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/store"
+	storecache "github.com/hrygo/divinesense/store/cache"
+)
+
+// SemanticCacheConfig configures SemanticResponseCache.
+type SemanticCacheConfig struct {
+	Threshold     float32       // Minimum prompt similarity for a semantic hit (default: 0.97)
+	TTL           time.Duration // How long responses are reused (default: 7 days)
+	MaxCandidates int           // Recent responses compared per semantic lookup (default: 200)
+}
+
+// DefaultSemanticCacheConfig returns the default semantic cache configuration.
+func DefaultSemanticCacheConfig() SemanticCacheConfig {
+	return SemanticCacheConfig{
+		Threshold:     0.97,
+		TTL:           7 * 24 * time.Hour,
+		MaxCandidates: 200,
+	}
+}
+
+// SemanticCacheStats reports the effectiveness of a SemanticResponseCache.
+type SemanticCacheStats struct {
+	ExactHits    int64   `json:"exact_hits"`
+	SemanticHits int64   `json:"semantic_hits"`
+	Misses       int64   `json:"misses"`
+	HitRate      float64 `json:"hit_rate"`
+}
+
+// SemanticResponseCache caches LLM responses of idempotent tasks such as tag
+// suggestions and summaries. A prompt hits the cache if it was seen before, or
+// if its embedding is similar enough to the prompt of a cached response.
+//
+// Responses are persisted in the database and scoped to the user who sent the
+// prompt, since prompts carry user content such as memo text: a user is never
+// served a response generated from another user's prompt. Only opt in tasks
+// whose output depends on nothing but the prompt.
+type SemanticResponseCache struct {
+	store     *store.Store
+	embedding ai.EmbeddingService // Optional; without it only exact prompts hit
+	config    SemanticCacheConfig
+	tiered    *storecache.TieredCache
+
+	exactHits    atomic.Int64
+	semanticHits atomic.Int64
+	misses       atomic.Int64
+}
+
+// NewSemanticResponseCache creates a new semantic response cache.
+func NewSemanticResponseCache(st *store.Store, embedding ai.EmbeddingService, config SemanticCacheConfig) *SemanticResponseCache {
+	defaults := DefaultSemanticCacheConfig()
+	if config.Threshold <= 0 || config.Threshold > 1 {
+		config.Threshold = defaults.Threshold
+	}
+	if config.TTL <= 0 {
+		config.TTL = defaults.TTL
+	}
+	if config.MaxCandidates <= 0 {
+		config.MaxCandidates = defaults.MaxCandidates
+	}
+	tiered, _ := storecache.NewTieredCache(storecache.DefaultTieredConfig())
+	return &SemanticResponseCache{
+		store:     st,
+		embedding: embedding,
+		config:    config,
+		tiered:    tiered,
+	}
+}
+
+// Wrap returns llm with Chat responses cached under task for a user.
+// Streaming and tool calls are not cached.
+func (c *SemanticResponseCache) Wrap(llm ai.LLMService, task string, userID int32) ai.LLMService {
+	if c == nil || llm == nil {
+		return llm
+	}
+	return &cachedLLMService{LLMService: llm, cache: c, task: task, userID: userID}
+}
+
+// Get returns the cached response of a user's task prompt.
+func (c *SemanticResponseCache) Get(ctx context.Context, task string, userID int32, prompt string) (string, bool) {
+	namespace := responseNamespace(task, userID)
+	if value, ok := c.tiered.Get(ctx, namespace+":"+hashText(prompt), c.fetch); ok {
+		if response, ok := value.(string); ok {
+			c.record(&c.exactHits)
+			return response, true
+		}
+	}
+
+	if response, ok := c.getSimilar(ctx, namespace, prompt); ok {
+		c.record(&c.semanticHits)
+		return response, true
+	}
+	c.record(&c.misses)
+	return "", false
+}
+
+// Set caches the response of a user's task prompt.
+func (c *SemanticResponseCache) Set(ctx context.Context, task string, userID int32, prompt, response string) {
+	namespace := responseNamespace(task, userID)
+	key := namespace + ":" + hashText(prompt)
+	c.tiered.Set(ctx, key, response)
+	if c.store == nil {
+		return
+	}
+
+	var embedding []float32
+	if c.embedding != nil {
+		var err error
+		if embedding, err = c.embedding.Embed(ctx, prompt); err != nil {
+			slog.Debug("failed to embed prompt for semantic cache", "task", task, "error", err)
+		}
+	}
+	now := time.Now()
+	if err := c.store.UpsertAICacheEntry(ctx, &store.AICacheEntry{
+		Key:       key,
+		Namespace: namespace,
+		Value:     []byte(response),
+		Embedding: embedding,
+		CreatedTs: now.Unix(),
+		ExpiresTs: now.Add(c.config.TTL).Unix(),
+	}); err != nil {
+		slog.Warn("failed to persist cached LLM response", "task", task, "error", err)
+	}
+}
+
+// ForgetUser deletes the cached responses of a user's prompts.
+// The in-memory tiers can't be cleared per user, so they are cleared entirely;
+// other users' responses are reloaded from the database.
+func (c *SemanticResponseCache) ForgetUser(ctx context.Context, userID int32) error {
+	c.tiered.Clear(ctx)
+	if c.store == nil {
+		return nil
+	}
+	return DeleteUserResponses(ctx, c.store, userID)
+}
+
+// DeleteUserResponses deletes the persisted responses of a user's prompts.
+// It also works when the cache is disabled, for responses cached while it was enabled.
+func DeleteUserResponses(ctx context.Context, st *store.Store, userID int32) error {
+	prefix := fmt.Sprintf("llm:user:%d:", userID)
+	if _, err := st.DeleteAICacheEntries(ctx, &store.DeleteAICacheEntry{NamespacePrefix: &prefix}); err != nil {
+		return fmt.Errorf("failed to delete cached responses: %w", err)
+	}
+	return nil
+}
+
+// Stats returns the hit statistics since the cache was created.
+func (c *SemanticResponseCache) Stats() SemanticCacheStats {
+	stats := SemanticCacheStats{
+		ExactHits:    c.exactHits.Load(),
+		SemanticHits: c.semanticHits.Load(),
+		Misses:       c.misses.Load(),
+	}
+	if total := stats.ExactHits + stats.SemanticHits + stats.Misses; total > 0 {
+		stats.HitRate = float64(stats.ExactHits+stats.SemanticHits) / float64(total)
+	}
+	return stats
+}
+
+// getSimilar finds the recent response whose prompt is most similar to prompt.
+func (c *SemanticResponseCache) getSimilar(ctx context.Context, namespace, prompt string) (string, bool) {
+	if c.store == nil || c.embedding == nil {
+		return "", false
+	}
+	vector, err := c.embedding.Embed(ctx, prompt)
+	if err != nil {
+		return "", false
+	}
+	entries, err := c.store.ListAICacheEntries(ctx, &store.FindAICacheEntry{
+		Namespace: &namespace,
+		ValidAt:   time.Now().Unix(),
+		Limit:     c.config.MaxCandidates,
+	})
+	if err != nil {
+		slog.Debug("failed to list semantic cache candidates", "namespace", namespace, "error", err)
+		return "", false
+	}
+
+	var best *store.AICacheEntry
+	bestScore := c.config.Threshold
+	for _, entry := range entries {
+		if score := cosineSimilarity(vector, entry.Embedding); score >= bestScore {
+			best, bestScore = entry, score
+		}
+	}
+	if best == nil {
+		return "", false
+	}
+	slog.Debug("semantic cache hit", "namespace", namespace, "score", bestScore)
+	return string(best.Value), true
+}
+
+// fetch loads an exact response from the database (L3).
+func (c *SemanticResponseCache) fetch(ctx context.Context, key string) (any, error) {
+	if c.store == nil {
+		return nil, fmt.Errorf("no persistent cache")
+	}
+	entries, err := c.store.ListAICacheEntries(ctx, &store.FindAICacheEntry{
+		Keys:    []string{key},
+		ValidAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("not cached")
+	}
+	return string(entries[0].Value), nil
+}
+
+func (c *SemanticResponseCache) record(counter *atomic.Int64) {
+	counter.Add(1)
+	stats := c.Stats()
+	if total := stats.ExactHits + stats.SemanticHits + stats.Misses; total%statsLogInterval == 0 {
+		slog.Info("semantic response cache stats",
+			"exact_hits", stats.ExactHits,
+			"semantic_hits", stats.SemanticHits,
+			"misses", stats.Misses,
+			"hit_rate", stats.HitRate)
+	}
+}
+
+// responseNamespace returns the namespace of a user's cached task responses, e.g. "llm:user:1:tags".
+func responseNamespace(task string, userID int32) string {
+	return fmt.Sprintf("llm:user:%d:%s", userID, task) // See DeleteUserResponses
+}
+
+// cachedLLMService caches the Chat responses of an idempotent task for a user.
+type cachedLLMService struct {
+	ai.LLMService
+	cache  *SemanticResponseCache
+	task   string
+	userID int32
+}
+
+func (s *cachedLLMService) Chat(ctx context.Context, messages []ai.Message) (string, error) {
+	prompt := promptText(messages)
+	if response, ok := s.cache.Get(ctx, s.task, s.userID, prompt); ok {
+		return response, nil
+	}
+
+	response, err := s.LLMService.Chat(ctx, messages)
+	if err != nil {
+		return "", err
+	}
+	s.cache.Set(ctx, s.task, s.userID, prompt, response)
+	return response, nil
+}
+
+// promptText flattens chat messages into the text that is hashed and embedded.
+func promptText(messages []ai.Message) string {
+	var sb strings.Builder
+	for _, message := range messages {
+		sb.WriteString(message.Role)
+		sb.WriteString(": ")
+		sb.WriteString(message.Content)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// cosineSimilarity returns the cosine similarity of two vectors, 0 if they differ in length.
+func cosineSimilarity(a, b []float32) float32 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return float32(dot / (math.Sqrt(normA) * math.Sqrt(normB)))
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	"github.com/hrygo/divinesense/store"
+	"github.com/hrygo/divinesense/store/db"
+)
+
+// TestSemanticResponseCache_ScopedToUser tests that responses are only reused for the user who sent the prompt.
+func TestSemanticResponseCache_ScopedToUser(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	p := &profile.Profile{Mode: "prod", Driver: "sqlite", Data: dir, DSN: filepath.Join(dir, "test.db"), Version: "0.26.0"}
+	driver, err := db.NewDBDriver(p)
+	require.NoError(t, err)
+	st := store.New(driver, p)
+	defer st.Close()
+	require.NoError(t, st.Migrate(ctx))
+
+	// The fake embedding only depends on the prompt length, so these prompts are semantically identical
+	c := NewSemanticResponseCache(st, &countingEmbeddingService{}, DefaultSemanticCacheConfig())
+	c.Set(ctx, "tags", 1, "private memo A", "#secret")
+
+	response, ok := c.Get(ctx, "tags", 1, "private memo A")
+	assert.True(t, ok)
+	assert.Equal(t, "#secret", response)
+	response, ok = c.Get(ctx, "tags", 1, "private memo B")
+	assert.True(t, ok, "semantic hit for the same user")
+	assert.Equal(t, "#secret", response)
+
+	_, ok = c.Get(ctx, "tags", 2, "private memo A")
+	assert.False(t, ok, "exact prompt of another user")
+	_, ok = c.Get(ctx, "tags", 2, "private memo B")
+	assert.False(t, ok, "similar prompt of another user")
+
+	// Forgetting a user keeps the responses of the others
+	c.Set(ctx, "tags", 2, "private memo C", "#other")
+	require.NoError(t, c.ForgetUser(ctx, 1))
+	_, ok = c.Get(ctx, "tags", 1, "private memo A")
+	assert.False(t, ok)
+	_, ok = c.Get(ctx, "tags", 1, "private memo B")
+	assert.False(t, ok)
+	response, ok = c.Get(ctx, "tags", 2, "private memo C")
+	assert.True(t, ok)
+	assert.Equal(t, "#other", response)
+}
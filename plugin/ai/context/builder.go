@@ -24,7 +24,8 @@ type ContextRequest struct {
 	CurrentQuery     string
 	AgentType        string           // "memo", "schedule", "amazing"
 	RetrievalResults []*RetrievalItem // RAG retrieval results
-	MaxTokens        int              // Total token budget (default: 4096)
+	MaxTokens        int              // Total token budget (default: model input budget, or 4096)
+	Model            string           // LLM model name, selects the tokenizer and default budget
 }
 
 // RetrievalItem represents a single retrieval result.
@@ -77,6 +78,11 @@ type EpisodicMemory struct {
 	Summary   string
 	AgentType string
 	Outcome   string
+
+	// Importance is the stored importance (0-1)
+	Importance float32
+	// Similarity to the current query (0-1), zero if not semantically recalled
+	Similarity float32
 }
 
 // UserPreferences represents user preferences.
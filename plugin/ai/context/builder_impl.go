@@ -8,6 +8,8 @@ import (
 	"strings"
 	"sync/atomic"
 	"time"
+
+	"github.com/hrygo/divinesense/plugin/ai/tokenizer"
 )
 
 // Service implements ContextBuilder with caching support.
@@ -16,6 +18,7 @@ type Service struct {
 	longTerm  *LongTermExtractor
 	ranker    *PriorityRanker
 	allocator *BudgetAllocator
+	tokenizer tokenizer.Tokenizer
 
 	// Providers (injected)
 	messageProvider  MessageProvider
@@ -77,6 +80,7 @@ func NewService(cfg Config) *Service {
 		longTerm:  NewLongTermExtractor(cfg.MaxEpisodes),
 		ranker:    NewPriorityRanker(),
 		allocator: NewBudgetAllocator(),
+		tokenizer: tokenizer.Default(),
 		stats:     &serviceStats{},
 	}
 }
@@ -99,6 +103,13 @@ func (s *Service) WithPreferenceProvider(p PreferenceProvider) *Service {
 	return s
 }
 
+// WithTokenizer sets the tokenizer used when the request does not name a model.
+func (s *Service) WithTokenizer(tok tokenizer.Tokenizer) *Service {
+	s.tokenizer = tok
+	s.ranker = NewPriorityRankerWithTokenizer(tok)
+	return s
+}
+
 // WithCache sets the cache provider.
 func (s *Service) WithCache(c CacheProvider) *Service {
 	s.cache = c
@@ -110,7 +121,15 @@ func (s *Service) Build(ctx context.Context, req *ContextRequest) (*ContextResul
 	start := time.Now()
 	atomic.AddInt64(&s.stats.totalBuilds, 1)
 
-	// Set defaults
+	// Count tokens with the model's tokenizer and default to its input budget
+	tok, ranker := s.tokenizer, s.ranker
+	if req.Model != "" {
+		tok = tokenizer.ForModel(req.Model)
+		ranker = NewPriorityRankerWithTokenizer(tok)
+		if req.MaxTokens <= 0 {
+			req.MaxTokens = tokenizer.InputBudget(req.Model)
+		}
+	}
 	if req.MaxTokens <= 0 {
 		req.MaxTokens = DefaultMaxTokens
 	}
@@ -127,7 +146,7 @@ func (s *Service) Build(ctx context.Context, req *ContextRequest) (*ContextResul
 	segments = append(segments, &ContextSegment{
 		Content:   systemPrompt,
 		Priority:  PrioritySystem,
-		TokenCost: EstimateTokens(systemPrompt),
+		TokenCost: tok.CountTokens(systemPrompt),
 		Source:    "system",
 	})
 
@@ -146,7 +165,7 @@ func (s *Service) Build(ctx context.Context, req *ContextRequest) (*ContextResul
 				segments = append(segments, &ContextSegment{
 					Content:   recentText,
 					Priority:  PriorityRecentTurns,
-					TokenCost: EstimateTokens(recentText),
+					TokenCost: tok.CountTokens(recentText),
 					Source:    "short_term",
 				})
 			}
@@ -157,7 +176,7 @@ func (s *Service) Build(ctx context.Context, req *ContextRequest) (*ContextResul
 				segments = append(segments, &ContextSegment{
 					Content:   olderText,
 					Priority:  PriorityOlderTurns,
-					TokenCost: EstimateTokens(olderText),
+					TokenCost: tok.CountTokens(olderText),
 					Source:    "short_term",
 				})
 			}
@@ -177,7 +196,7 @@ func (s *Service) Build(ctx context.Context, req *ContextRequest) (*ContextResul
 				segments = append(segments, &ContextSegment{
 					Content:   episodicText,
 					Priority:  PriorityEpisodic,
-					TokenCost: EstimateTokens(episodicText),
+					TokenCost: tok.CountTokens(episodicText),
 					Source:    "long_term",
 				})
 			}
@@ -189,7 +208,7 @@ func (s *Service) Build(ctx context.Context, req *ContextRequest) (*ContextResul
 					segments = append(segments, &ContextSegment{
 						Content:   prefsText,
 						Priority:  PriorityPreferences,
-						TokenCost: EstimateTokens(prefsText),
+						TokenCost: tok.CountTokens(prefsText),
 						Source:    "prefs",
 					})
 				}
@@ -203,14 +222,14 @@ func (s *Service) Build(ctx context.Context, req *ContextRequest) (*ContextResul
 		segments = append(segments, &ContextSegment{
 			Content:   retrievalText,
 			Priority:  PriorityRetrieval,
-			TokenCost: EstimateTokens(retrievalText),
+			TokenCost: tok.CountTokens(retrievalText),
 			Source:    "retrieval",
 		})
 	}
 
 	// Prioritize and truncate to fit total budget
 	// Note: System prompt is included in segments and will be prioritized highest
-	finalSegments := s.ranker.RankAndTruncate(segments, budget.Total)
+	finalSegments := ranker.RankAndTruncate(segments, budget.Total)
 
 	// Assemble result
 	result := s.assembleResult(finalSegments, budget)
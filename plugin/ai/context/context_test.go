@@ -124,6 +124,19 @@ func TestFormatEpisodes(t *testing.T) {
 	assert.Contains(t, result, "Searched for Go documentation")
 }
 
+func TestRankEpisodes(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local)
+	similarOld := &EpisodicMemory{Summary: "similar but old", Timestamp: now.AddDate(0, -2, 0), Similarity: 0.9, Importance: 0.5}
+	recentUnrelated := &EpisodicMemory{Summary: "recent but unrelated", Timestamp: now.Add(-time.Hour), Similarity: 0.1, Importance: 0.5}
+	similarRecent := &EpisodicMemory{Summary: "similar and recent", Timestamp: now.AddDate(0, 0, -1), Similarity: 0.85, Importance: 0.5}
+	important := &EpisodicMemory{Summary: "explicitly remembered", Timestamp: now.AddDate(0, 0, -1), Similarity: 0.85, Importance: 1.0}
+
+	ranked := RankEpisodes([]*EpisodicMemory{similarOld, recentUnrelated, similarRecent, important}, now, 3)
+
+	assert.Equal(t, []*EpisodicMemory{important, similarRecent, similarOld}, ranked)
+	assert.Empty(t, RankEpisodes(nil, now, 3))
+}
+
 func TestFormatPreferences(t *testing.T) {
 	t.Run("With preferences", func(t *testing.T) {
 		prefs := &UserPreferences{
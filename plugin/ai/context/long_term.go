@@ -4,7 +4,26 @@ package context
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
 	"strings"
+	"time"
+
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+)
+
+// Episode ranking weights.
+// 情景记忆排序权重：语义相似度为主，时间衰减和重要性为辅。
+const (
+	episodeSimilarityWeight = 0.6
+	episodeRecencyWeight    = 0.25
+	episodeImportanceWeight = 0.15
+
+	// episodeHalfLife is the age at which the recency score halves.
+	episodeHalfLife = 14 * 24 * time.Hour
+
+	// episodeCandidateFactor is how many candidates are fetched per returned episode.
+	episodeCandidateFactor = 4
 )
 
 // LongTermExtractor extracts episodic memories and user preferences.
@@ -50,12 +69,13 @@ func (e *LongTermExtractor) Extract(
 
 	// Extract episodic memories
 	if episodicProvider != nil {
-		episodes, err := episodicProvider.SearchEpisodes(ctx, userID, query, e.maxEpisodes)
+		// Over-fetch so that recency and importance can reorder similar candidates
+		episodes, err := episodicProvider.SearchEpisodes(ctx, userID, query, e.maxEpisodes*episodeCandidateFactor)
 		if err != nil {
 			// Non-fatal: continue without episodes
 			episodes = nil
 		}
-		result.Episodes = episodes
+		result.Episodes = RankEpisodes(episodes, time.Now(), e.maxEpisodes)
 	}
 
 	// Extract user preferences
@@ -71,6 +91,93 @@ func (e *LongTermExtractor) Extract(
 	return result, nil
 }
 
+// RankEpisodes orders episodes by a blend of query similarity, recency and importance,
+// and returns at most limit of them. Recency decays exponentially with age.
+func RankEpisodes(episodes []*EpisodicMemory, now time.Time, limit int) []*EpisodicMemory {
+	if len(episodes) == 0 {
+		return episodes
+	}
+
+	scores := make(map[*EpisodicMemory]float64, len(episodes))
+	for _, ep := range episodes {
+		scores[ep] = scoreEpisode(ep, now)
+	}
+
+	ranked := make([]*EpisodicMemory, len(episodes))
+	copy(ranked, episodes)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i]] > scores[ranked[j]]
+	})
+
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// scoreEpisode returns the ranking score of an episode in [0, 1].
+func scoreEpisode(ep *EpisodicMemory, now time.Time) float64 {
+	age := now.Sub(ep.Timestamp)
+	if age < 0 {
+		age = 0
+	}
+	recency := math.Exp2(-float64(age) / float64(episodeHalfLife))
+
+	return episodeSimilarityWeight*float64(ep.Similarity) +
+		episodeRecencyWeight*recency +
+		episodeImportanceWeight*float64(ep.Importance)
+}
+
+// MemoryRecaller recalls episodes relevant to a query.
+// Implemented by *memory.Service.
+type MemoryRecaller interface {
+	RecallEpisodes(ctx context.Context, userID int32, query string, limit int) ([]memory.EpisodicMemory, error)
+}
+
+// MemoryEpisodicProvider adapts a MemoryRecaller to EpisodicProvider.
+type MemoryEpisodicProvider struct {
+	recaller MemoryRecaller
+}
+
+// NewMemoryEpisodicProvider creates an episodic provider backed by the memory service.
+func NewMemoryEpisodicProvider(recaller MemoryRecaller) *MemoryEpisodicProvider {
+	return &MemoryEpisodicProvider{recaller: recaller}
+}
+
+// SearchEpisodes implements EpisodicProvider.
+func (p *MemoryEpisodicProvider) SearchEpisodes(ctx context.Context, userID int32, query string, limit int) ([]*EpisodicMemory, error) {
+	episodes, err := p.recaller.RecallEpisodes(ctx, userID, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*EpisodicMemory, 0, len(episodes))
+	for _, ep := range episodes {
+		result = append(result, &EpisodicMemory{
+			ID:         ep.ID,
+			Timestamp:  ep.Timestamp,
+			Summary:    formatEpisodeSummary(ep),
+			AgentType:  ep.AgentType,
+			Outcome:    ep.Outcome,
+			Importance: ep.Importance,
+			Similarity: ep.Similarity,
+		})
+	}
+	return result, nil
+}
+
+// formatEpisodeSummary combines the user input and outcome summary of an episode.
+func formatEpisodeSummary(ep memory.EpisodicMemory) string {
+	switch {
+	case ep.UserInput == "":
+		return ep.Summary
+	case ep.Summary == "":
+		return ep.UserInput
+	default:
+		return ep.UserInput + " → " + ep.Summary
+	}
+}
+
 // FormatEpisodes formats episodic memories into context.
 func FormatEpisodes(episodes []*EpisodicMemory) string {
 	if len(episodes) == 0 {
@@ -3,6 +3,8 @@ package context
 
 import (
 	"sort"
+
+	"github.com/hrygo/divinesense/plugin/ai/tokenizer"
 )
 
 // ContextPriority represents the priority level of a context segment.
@@ -27,11 +29,18 @@ type ContextSegment struct {
 }
 
 // PriorityRanker ranks and truncates context segments by priority.
-type PriorityRanker struct{}
+type PriorityRanker struct {
+	tokenizer tokenizer.Tokenizer
+}
 
-// NewPriorityRanker creates a new priority ranker.
+// NewPriorityRanker creates a new priority ranker using the default tokenizer.
 func NewPriorityRanker() *PriorityRanker {
-	return &PriorityRanker{}
+	return NewPriorityRankerWithTokenizer(tokenizer.Default())
+}
+
+// NewPriorityRankerWithTokenizer creates a priority ranker that truncates with the given tokenizer.
+func NewPriorityRankerWithTokenizer(tok tokenizer.Tokenizer) *PriorityRanker {
+	return &PriorityRanker{tokenizer: tok}
 }
 
 // RankAndTruncate sorts segments by priority and truncates to fit budget.
@@ -63,7 +72,7 @@ func (r *PriorityRanker) RankAndTruncate(segments []*ContextSegment, budget int)
 			// Try to fit partial segment
 			remaining := budget - usedTokens
 			if remaining >= MinSegmentTokens {
-				truncated := truncateToTokens(seg.Content, remaining)
+				truncated := truncateToTokens(r.tokenizer, seg.Content, remaining)
 				if len(truncated) > 0 {
 					result = append(result, &ContextSegment{
 						Content:   truncated,
@@ -86,54 +95,25 @@ func PrioritizeAndTruncate(segments []*ContextSegment, budget int) []*ContextSeg
 	return NewPriorityRanker().RankAndTruncate(segments, budget)
 }
 
-// truncateToTokens truncates content to approximately fit within token limit.
-// Uses simple heuristic: 1 Chinese char ≈ 2 tokens, 1 English word ≈ 1 token
-func truncateToTokens(content string, maxTokens int) string {
+// truncateToTokens truncates content to fit within the token limit, marking the cut with an ellipsis.
+func truncateToTokens(tok tokenizer.Tokenizer, content string, maxTokens int) string {
 	if maxTokens <= 0 {
 		return ""
 	}
-
-	runes := []rune(content)
-
-	// Rough estimate: average 1.5 tokens per rune for mixed Chinese/English
-	estimatedRunes := int(float64(maxTokens) / 1.5)
-	if estimatedRunes >= len(runes) {
+	if tok.CountTokens(content) <= maxTokens {
 		return content
 	}
 
-	// Truncate and add ellipsis
-	if estimatedRunes > 3 {
-		return string(runes[:estimatedRunes-3]) + "..."
+	// Reserve one token for the ellipsis
+	truncated := tok.Truncate(content, maxTokens-1)
+	if truncated == "" {
+		return ""
 	}
-
-	return string(runes[:estimatedRunes])
+	return truncated + "..."
 }
 
-// EstimateTokens estimates the token count for a string.
-// Uses heuristic: Chinese chars count as ~2 tokens, ASCII as ~0.3 tokens per char.
+// EstimateTokens returns the token count of a string using the default tokenizer.
+// Use tokenizer.ForModel for model-specific counts.
 func EstimateTokens(content string) int {
-	if len(content) == 0 {
-		return 0
-	}
-
-	chineseCount := 0
-	asciiCount := 0
-
-	for _, r := range content {
-		if r >= 0x4E00 && r <= 0x9FFF {
-			chineseCount++
-		} else if r < 128 {
-			asciiCount++
-		} else {
-			chineseCount++ // Other Unicode treated as Chinese
-		}
-	}
-
-	// Heuristic: Chinese ~2 tokens per char, ASCII ~0.25 tokens per char
-	tokens := chineseCount*2 + asciiCount/4
-	if tokens == 0 && len(content) > 0 {
-		tokens = 1
-	}
-
-	return tokens
+	return tokenizer.Default().CountTokens(content)
 }
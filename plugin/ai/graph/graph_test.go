@@ -4,6 +4,9 @@ package graph
 import (
 	"testing"
 	"time"
+
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/store"
 )
 
 func TestExtractTitle(t *testing.T) {
@@ -371,3 +374,31 @@ func TestGraphConstants(t *testing.T) {
 		t.Errorf("NodeTypeTag = %s, want tag", NodeTypeTag)
 	}
 }
+
+func TestTagGraphRelated(t *testing.T) {
+	memo := func(tags ...string) *store.Memo {
+		return &store.Memo{Payload: &storepb.MemoPayload{Tags: tags}}
+	}
+	g := BuildTagGraph([]*store.Memo{
+		memo("k8s", "devops"),
+		memo("K8s", "devops", "helm"),
+		memo("k8s", "helm"),
+		memo("devops"),
+		memo("travel"),
+	})
+
+	related := g.Related("k8s", 2, 0)
+	if len(related) != 2 {
+		t.Fatalf("expected 2 related tags, got %v", related)
+	}
+	// helm: 2 shared of 3 memos; devops: 2 shared of 4 memos
+	if related[0].Tag != "helm" || related[1].Tag != "devops" {
+		t.Errorf("unexpected order: %v", related)
+	}
+	if got := g.Related("k8s", 2, 1); len(got) != 1 {
+		t.Errorf("expected limit to apply, got %v", got)
+	}
+	if got := g.Related("travel", 1, 0); len(got) != 0 {
+		t.Errorf("expected no related tags, got %v", got)
+	}
+}
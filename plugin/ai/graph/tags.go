@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+// RelatedTag is a tag that co-occurs with another tag.
+type RelatedTag struct {
+	Tag    string  `json:"tag"`
+	Weight float64 `json:"weight"` // Jaccard similarity of the memos of both tags, 0-1
+}
+
+// TagGraph is the tag-level view of tag co-occurrence: two tags are
+// connected when they are used on the same memos. The memo-level view is
+// built by buildTagCoOccurrenceEdges.
+type TagGraph struct {
+	counts map[string]int            // tag -> number of memos
+	pairs  map[string]map[string]int // tag -> co-occurring tag -> number of shared memos
+}
+
+// BuildTagGraph builds the tag co-occurrence graph of the memos.
+// Tags are compared case-insensitively.
+func BuildTagGraph(memos []*store.Memo) *TagGraph {
+	g := &TagGraph{
+		counts: make(map[string]int),
+		pairs:  make(map[string]map[string]int),
+	}
+	for _, memo := range memos {
+		seen := make(map[string]bool)
+		var tags []string
+		for _, tag := range extractTags(memo) {
+			tag = strings.ToLower(strings.TrimSpace(tag))
+			if tag == "" || seen[tag] {
+				continue
+			}
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+
+		for i, tag := range tags {
+			g.counts[tag]++
+			for _, other := range tags[i+1:] {
+				g.addPair(tag, other)
+				g.addPair(other, tag)
+			}
+		}
+	}
+	return g
+}
+
+func (g *TagGraph) addPair(tag, other string) {
+	if g.pairs[tag] == nil {
+		g.pairs[tag] = make(map[string]int)
+	}
+	g.pairs[tag][other]++
+}
+
+// Tags returns all tags of the graph, sorted.
+func (g *TagGraph) Tags() []string {
+	tags := make([]string, 0, len(g.counts))
+	for tag := range g.counts {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// Related returns the tags co-occurring with the tag on at least minShared
+// memos, strongest first, at most limit (0 for no limit).
+func (g *TagGraph) Related(tag string, minShared int, limit int) []RelatedTag {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	var related []RelatedTag
+	for other, shared := range g.pairs[tag] {
+		if shared < minShared {
+			continue
+		}
+		union := g.counts[tag] + g.counts[other] - shared
+		related = append(related, RelatedTag{Tag: other, Weight: float64(shared) / float64(union)})
+	}
+
+	sort.Slice(related, func(i, j int) bool {
+		if related[i].Weight != related[j].Weight {
+			return related[i].Weight > related[j].Weight
+		}
+		return related[i].Tag < related[j].Tag
+	})
+	if limit > 0 && len(related) > limit {
+		related = related[:limit]
+	}
+	return related
+}
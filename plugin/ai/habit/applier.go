@@ -62,8 +62,9 @@ func (a *HabitApplier) ApplyToScheduleCreate(ctx context.Context, userID int32,
 	return input
 }
 
-// InferTime infers a specific time from a vague time reference using habits.
-func (a *HabitApplier) InferTime(ctx context.Context, userID int32, query string) time.Time {
+// InferTime infers a specific time from a vague time reference ("下午", "evening")
+// using habits: the user's preferred time in that period, on day's date and in its location.
+func (a *HabitApplier) InferTime(ctx context.Context, userID int32, query string, day time.Time) time.Time {
 	prefs, err := a.memoryService.GetPreferences(ctx, userID)
 	if err != nil {
 		slog.Warn("failed to get preferences for time inference", "user_id", userID, "error", err)
@@ -77,20 +78,50 @@ func (a *HabitApplier) InferTime(ctx context.Context, userID int32, query string
 
 	// Check for period references
 	if containsPeriod(lowerQuery, "上午", "早上", "morning") {
-		return findTimeInPeriod(prefs.PreferredTimes, 6, 12)
+		return findTimeInPeriod(prefs.PreferredTimes, 6, 12, day)
 	}
 
 	if containsPeriod(lowerQuery, "下午", "afternoon") {
-		return findTimeInPeriod(prefs.PreferredTimes, 12, 18)
+		return findTimeInPeriod(prefs.PreferredTimes, 12, 18, day)
 	}
 
 	if containsPeriod(lowerQuery, "晚上", "evening", "night") {
-		return findTimeInPeriod(prefs.PreferredTimes, 18, 24)
+		return findTimeInPeriod(prefs.PreferredTimes, 18, 24, day)
 	}
 
 	return time.Time{}
 }
 
+// NextPreferredTime returns the first of the user's preferred times after the
+// given moment, in its location, looking at most one day ahead.
+// Returns zero time if no preferred times are known.
+func (a *HabitApplier) NextPreferredTime(ctx context.Context, userID int32, after time.Time) time.Time {
+	prefs, err := a.memoryService.GetPreferences(ctx, userID)
+	if err != nil {
+		slog.Warn("failed to get preferences for time suggestion", "user_id", userID, "error", err)
+		return time.Time{}
+	}
+	if prefs == nil {
+		return time.Time{}
+	}
+
+	var next time.Time
+	for _, t := range prefs.PreferredTimes {
+		parsed, err := time.Parse("15:04", t)
+		if err != nil {
+			continue
+		}
+		candidate := time.Date(after.Year(), after.Month(), after.Day(), parsed.Hour(), parsed.Minute(), 0, 0, after.Location())
+		if !candidate.After(after) {
+			candidate = candidate.AddDate(0, 0, 1)
+		}
+		if next.IsZero() || candidate.Before(next) {
+			next = candidate
+		}
+	}
+	return next
+}
+
 // GetSuggestedDuration returns the suggested duration for a schedule.
 func (a *HabitApplier) GetSuggestedDuration(ctx context.Context, userID int32) int {
 	prefs, err := a.memoryService.GetPreferences(ctx, userID)
@@ -172,7 +203,7 @@ func containsPeriod(query string, keywords ...string) bool {
 	return false
 }
 
-func findTimeInPeriod(times []string, startHour, endHour int) time.Time {
+func findTimeInPeriod(times []string, startHour, endHour int, day time.Time) time.Time {
 	for _, t := range times {
 		parsed, err := time.Parse("15:04", t)
 		if err != nil {
@@ -181,8 +212,7 @@ func findTimeInPeriod(times []string, startHour, endHour int) time.Time {
 
 		hour := parsed.Hour()
 		if hour >= startHour && hour < endHour {
-			now := time.Now()
-			return time.Date(now.Year(), now.Month(), now.Day(), hour, parsed.Minute(), 0, 0, now.Location())
+			return time.Date(day.Year(), day.Month(), day.Day(), hour, parsed.Minute(), 0, 0, day.Location())
 		}
 	}
 	return time.Time{}
@@ -0,0 +1,117 @@
+// Package habit provides user habit learning and analysis for AI agents.
+package habit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+)
+
+// suggestionFeedbackKey is the UserPreferences.CustomSettings key holding suggestion feedback.
+const suggestionFeedbackKey = "suggestion_feedback"
+
+// SuggestionAgentType is the episode agent type recorded for suggestion feedback.
+const SuggestionAgentType = "suggestion"
+
+// SuggestionFeedback counts how a user responded to one proactive suggestion.
+type SuggestionFeedback struct {
+	Accepted        int   `json:"accepted"`
+	Dismissed       int   `json:"dismissed"`
+	LastDismissedTs int64 `json:"last_dismissed_ts,omitempty"`
+}
+
+// Weight returns a confidence multiplier learned from the feedback.
+// It is the Laplace-smoothed acceptance rate scaled so that no feedback yields 1.0;
+// accepted suggestions approach 2.0 and dismissed ones approach 0.
+func (f SuggestionFeedback) Weight() float64 {
+	return 2 * float64(f.Accepted+1) / float64(f.Accepted+f.Dismissed+2)
+}
+
+// FeedbackRecorder stores suggestion feedback in the user's habit data.
+type FeedbackRecorder struct {
+	memoryService memory.MemoryService
+}
+
+// NewFeedbackRecorder creates a new FeedbackRecorder.
+func NewFeedbackRecorder(memSvc memory.MemoryService) *FeedbackRecorder {
+	return &FeedbackRecorder{
+		memoryService: memSvc,
+	}
+}
+
+// Load returns the suggestion feedback of a user, keyed by suggestion ID.
+func (r *FeedbackRecorder) Load(ctx context.Context, userID int32) (map[string]SuggestionFeedback, error) {
+	prefs, err := r.memoryService.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return decodeFeedback(prefs), nil
+}
+
+// Record stores an accept or dismiss of a suggestion.
+// Accepted suggestions are also saved as successful episodes, so the habit
+// analyzer learns the hours at which the user acts on suggestions.
+func (r *FeedbackRecorder) Record(ctx context.Context, userID int32, suggestionID, title string, accepted bool, at time.Time) error {
+	prefs, err := r.memoryService.GetPreferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if prefs == nil {
+		prefs = memory.DefaultPreferences()
+	}
+	if prefs.CustomSettings == nil {
+		prefs.CustomSettings = make(map[string]any)
+	}
+
+	feedback := decodeFeedback(prefs)
+	entry := feedback[suggestionID]
+	if accepted {
+		entry.Accepted++
+	} else {
+		entry.Dismissed++
+		entry.LastDismissedTs = at.Unix()
+	}
+	feedback[suggestionID] = entry
+	prefs.CustomSettings[suggestionFeedbackKey] = feedback
+
+	if err := r.memoryService.UpdatePreferences(ctx, userID, prefs); err != nil {
+		return err
+	}
+
+	outcome := "dismissed"
+	if accepted {
+		outcome = "success"
+	}
+	return r.memoryService.SaveEpisode(ctx, memory.EpisodicMemory{
+		UserID:     userID,
+		Timestamp:  at,
+		AgentType:  SuggestionAgentType,
+		UserInput:  title,
+		Outcome:    outcome,
+		Summary:    suggestionID,
+		Importance: 0.3,
+	})
+}
+
+// decodeFeedback reads suggestion feedback from preferences.
+// CustomSettings round-trips through JSON, so values are re-decoded into typed feedback.
+func decodeFeedback(prefs *memory.UserPreferences) map[string]SuggestionFeedback {
+	feedback := make(map[string]SuggestionFeedback)
+	if prefs == nil || prefs.CustomSettings == nil {
+		return feedback
+	}
+	raw, ok := prefs.CustomSettings[suggestionFeedbackKey]
+	if !ok {
+		return feedback
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return feedback
+	}
+	if err := json.Unmarshal(data, &feedback); err != nil {
+		return make(map[string]SuggestionFeedback)
+	}
+	return feedback
+}
@@ -2,6 +2,7 @@ package habit
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -31,6 +32,10 @@ func (m *mockMemoryService) SearchEpisodes(ctx context.Context, userID int32, qu
 	return m.episodes, nil
 }
 
+func (m *mockMemoryService) ListRoutingCorrections(ctx context.Context, userID *int32, limit int) ([]memory.EpisodicMemory, error) {
+	return nil, nil
+}
+
 func (m *mockMemoryService) ListActiveUserIDs(ctx context.Context, lookbackDays int) ([]int32, error) {
 	if m.activeUserIDs != nil {
 		return m.activeUserIDs, nil
@@ -343,12 +348,22 @@ func TestHabitApplier_InferTime(t *testing.T) {
 		{"晚上开会", 19},
 	}
 
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, tokyo)
 	for _, tt := range tests {
-		result := applier.InferTime(context.Background(), 1, tt.query)
-		if !result.IsZero() && result.Hour() != tt.expectedHour {
-			t.Errorf("InferTime(%q) hour = %d, want %d", tt.query, result.Hour(), tt.expectedHour)
+		result := applier.InferTime(context.Background(), 1, tt.query, day)
+		want := time.Date(2026, 3, 10, tt.expectedHour, 0, 0, 0, tokyo)
+		if !result.Equal(want) {
+			t.Errorf("InferTime(%q) = %v, want %v", tt.query, result, want)
 		}
 	}
+
+	if result := applier.InferTime(context.Background(), 1, "开会", day); !result.IsZero() {
+		t.Errorf("InferTime without a period = %v, want zero", result)
+	}
 }
 
 func TestHabitLearner_MergeHabitsToPreferences(t *testing.T) {
@@ -399,10 +414,20 @@ func TestHabitLearner_MergePreservesExisting(t *testing.T) {
 		Timezone:           "Asia/Shanghai",
 		CommunicationStyle: "concise",
 		CustomSettings:     map[string]any{"theme": "dark"},
+		Incognito:          true,
+		SearchLogDisabled:  true,
 	}
 
 	prefs := mergeHabitsToPreferences(habits, existing)
 
+	// Learning must never turn privacy switches off
+	if !prefs.Incognito {
+		t.Error("Incognito should be preserved")
+	}
+	if !prefs.SearchLogDisabled {
+		t.Error("SearchLogDisabled should be preserved")
+	}
+
 	// Verify existing fields are preserved
 	if prefs.Timezone != "Asia/Shanghai" {
 		t.Errorf("Timezone = %s, want Asia/Shanghai", prefs.Timezone)
@@ -426,6 +451,92 @@ func TestHabitLearner_MergePreservesExisting(t *testing.T) {
 	}
 }
 
+func TestHabitLearner_MergeKeepsCorrections(t *testing.T) {
+	habits := &UserHabits{
+		Time:     &TimeHabits{PreferredTimes: []string{"09:00"}},
+		Schedule: &ScheduleHabits{DefaultDuration: 45},
+	}
+
+	existing := memory.DefaultPreferences()
+	ApplyCorrection(existing, &memory.UserPreferences{PreferredTimes: []string{"20:30"}}, []string{FieldPreferredTimes})
+
+	prefs := mergeHabitsToPreferences(habits, existing)
+	if len(prefs.PreferredTimes) != 1 || prefs.PreferredTimes[0] != "20:30" {
+		t.Errorf("Corrected PreferredTimes overwritten: %v", prefs.PreferredTimes)
+	}
+	if prefs.DefaultDuration != 45 {
+		t.Errorf("DefaultDuration = %d, want 45", prefs.DefaultDuration)
+	}
+
+	ResetLearned(prefs)
+	if IsOverridden(prefs, FieldPreferredTimes) {
+		t.Error("Reset should forget corrections")
+	}
+	if prefs.DefaultDuration != memory.DefaultPreferences().DefaultDuration {
+		t.Errorf("DefaultDuration = %d after reset", prefs.DefaultDuration)
+	}
+}
+
+func TestHabitApplier_NextPreferredTime(t *testing.T) {
+	mockSvc := &mockMemoryService{preferences: &memory.UserPreferences{PreferredTimes: []string{"09:00", "14:00"}}}
+	applier := NewHabitApplier(mockSvc)
+	ctx := context.Background()
+
+	after := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+	if got := applier.NextPreferredTime(ctx, 1, after); !got.Equal(time.Date(2026, 3, 7, 14, 0, 0, 0, time.UTC)) {
+		t.Errorf("NextPreferredTime = %v, want 14:00 today", got)
+	}
+	after = time.Date(2026, 3, 7, 15, 0, 0, 0, time.UTC)
+	if got := applier.NextPreferredTime(ctx, 1, after); !got.Equal(time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("NextPreferredTime = %v, want 09:00 tomorrow", got)
+	}
+
+	mockSvc.preferences = nil
+	if got := applier.NextPreferredTime(ctx, 1, after); !got.IsZero() {
+		t.Errorf("NextPreferredTime = %v, want zero without preferences", got)
+	}
+}
+
+func TestFeedbackRecorder_Record(t *testing.T) {
+	mockSvc := &mockMemoryService{}
+	recorder := NewFeedbackRecorder(mockSvc)
+	ctx := context.Background()
+	now := time.Now()
+
+	if err := recorder.Record(ctx, 1, "review_memos", "复习笔记", true, now); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	if err := recorder.Record(ctx, 1, "quick_note", "记录今日想法", false, now); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+
+	// Preferences round-trip through JSON in the real memory service
+	data, _ := json.Marshal(mockSvc.preferences)
+	var decoded memory.UserPreferences
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	mockSvc.preferences = &decoded
+
+	feedback, err := recorder.Load(ctx, 1)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if feedback["review_memos"].Accepted != 1 {
+		t.Errorf("Expected 1 accept, got %+v", feedback["review_memos"])
+	}
+	if entry := feedback["quick_note"]; entry.Dismissed != 1 || entry.LastDismissedTs != now.Unix() {
+		t.Errorf("Expected 1 dismiss at %d, got %+v", now.Unix(), entry)
+	}
+
+	if w := (SuggestionFeedback{}).Weight(); w != 1.0 {
+		t.Errorf("Expected neutral weight 1.0, got %f", w)
+	}
+	if feedback["review_memos"].Weight() <= 1.0 || feedback["quick_note"].Weight() >= 1.0 {
+		t.Errorf("Expected accepted weight > 1 and dismissed weight < 1")
+	}
+}
+
 func TestFilterByFrequency(t *testing.T) {
 	counts := map[string]int{
 		"common":   5,
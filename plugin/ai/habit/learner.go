@@ -185,42 +185,35 @@ func (l *HabitLearner) RunOnce(ctx context.Context, userID int32) (*UserHabits,
 }
 
 // mergeHabitsToPreferences merges learned habits into existing preferences.
-// Only habit-related fields are updated; other fields (Timezone, CommunicationStyle, etc.) are preserved.
+// Only habit-related fields are updated; all other fields (Timezone, CommunicationStyle, Incognito, etc.) are preserved.
 func mergeHabitsToPreferences(habits *UserHabits, existing *memory.UserPreferences) *memory.UserPreferences {
-	// Start with existing preferences or create new
+	// Start with a copy of existing preferences, so fields not learned here
+	// (including privacy switches such as Incognito) are kept
 	var prefs *memory.UserPreferences
 	if existing != nil {
-		// Clone existing to avoid mutation
-		prefs = &memory.UserPreferences{
-			Timezone:           existing.Timezone,
-			DefaultDuration:    existing.DefaultDuration,
-			PreferredTimes:     existing.PreferredTimes,
-			FrequentLocations:  existing.FrequentLocations,
-			CommunicationStyle: existing.CommunicationStyle,
-			TagPreferences:     existing.TagPreferences,
-			CustomSettings:     existing.CustomSettings,
-		}
+		p := *existing
+		prefs = &p
 	} else {
 		prefs = &memory.UserPreferences{
 			CustomSettings: make(map[string]any),
 		}
 	}
 
-	// Merge habit-learned fields (only if we have data)
-	if habits.Time != nil && len(habits.Time.PreferredTimes) > 0 {
+	// Merge habit-learned fields (only if we have data and the user has not corrected them)
+	if habits.Time != nil && len(habits.Time.PreferredTimes) > 0 && !IsOverridden(prefs, FieldPreferredTimes) {
 		prefs.PreferredTimes = habits.Time.PreferredTimes
 	}
 
 	if habits.Schedule != nil {
-		if habits.Schedule.DefaultDuration > 0 {
+		if habits.Schedule.DefaultDuration > 0 && !IsOverridden(prefs, FieldDefaultDuration) {
 			prefs.DefaultDuration = habits.Schedule.DefaultDuration
 		}
-		if len(habits.Schedule.FrequentLocations) > 0 {
+		if len(habits.Schedule.FrequentLocations) > 0 && !IsOverridden(prefs, FieldFrequentLocations) {
 			prefs.FrequentLocations = habits.Schedule.FrequentLocations
 		}
 	}
 
-	if habits.Search != nil && len(habits.Search.FrequentKeywords) > 0 {
+	if habits.Search != nil && len(habits.Search.FrequentKeywords) > 0 && !IsOverridden(prefs, FieldFrequentKeywords) {
 		prefs.TagPreferences = habits.Search.FrequentKeywords
 	}
 
@@ -0,0 +1,91 @@
+// Package habit provides user habit learning and analysis for AI agents.
+package habit
+
+import (
+	"slices"
+
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+)
+
+// habitOverridesKey is the UserPreferences.CustomSettings key listing habit
+// fields corrected by the user. The learner does not overwrite them.
+const habitOverridesKey = "habit_overrides"
+
+// Learned preference fields that users can correct.
+const (
+	FieldPreferredTimes    = "preferred_times"
+	FieldDefaultDuration   = "default_duration"
+	FieldFrequentLocations = "frequent_locations"
+	FieldFrequentKeywords  = "frequent_keywords"
+)
+
+// LearnedFields lists all learned preference fields.
+var LearnedFields = []string{FieldPreferredTimes, FieldDefaultDuration, FieldFrequentLocations, FieldFrequentKeywords}
+
+// Overrides returns the habit fields corrected by the user.
+func Overrides(prefs *memory.UserPreferences) []string {
+	if prefs == nil || prefs.CustomSettings == nil {
+		return nil
+	}
+	raw, ok := prefs.CustomSettings[habitOverridesKey].([]any)
+	if !ok {
+		// Values set in-process have not been through JSON yet
+		if fields, ok := prefs.CustomSettings[habitOverridesKey].([]string); ok {
+			return fields
+		}
+		return nil
+	}
+	fields := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			fields = append(fields, s)
+		}
+	}
+	return fields
+}
+
+// IsOverridden reports whether the user corrected a habit field.
+func IsOverridden(prefs *memory.UserPreferences, field string) bool {
+	return slices.Contains(Overrides(prefs), field)
+}
+
+// ApplyCorrection copies the given fields from corrected into prefs and marks
+// them as user overrides. Unknown fields are ignored.
+func ApplyCorrection(prefs, corrected *memory.UserPreferences, fields []string) {
+	overrides := Overrides(prefs)
+	for _, field := range fields {
+		switch field {
+		case FieldPreferredTimes:
+			prefs.PreferredTimes = corrected.PreferredTimes
+		case FieldDefaultDuration:
+			prefs.DefaultDuration = corrected.DefaultDuration
+		case FieldFrequentLocations:
+			prefs.FrequentLocations = corrected.FrequentLocations
+		case FieldFrequentKeywords:
+			prefs.TagPreferences = corrected.TagPreferences
+		default:
+			continue
+		}
+		if !slices.Contains(overrides, field) {
+			overrides = append(overrides, field)
+		}
+	}
+	if prefs.CustomSettings == nil {
+		prefs.CustomSettings = make(map[string]any)
+	}
+	prefs.CustomSettings[habitOverridesKey] = overrides
+}
+
+// ResetLearned restores learned fields to their defaults and forgets user
+// corrections and suggestion feedback. Other preferences are kept.
+func ResetLearned(prefs *memory.UserPreferences) {
+	defaults := memory.DefaultPreferences()
+	prefs.PreferredTimes = defaults.PreferredTimes
+	prefs.DefaultDuration = defaults.DefaultDuration
+	prefs.FrequentLocations = defaults.FrequentLocations
+	prefs.TagPreferences = defaults.TagPreferences
+	if prefs.CustomSettings != nil {
+		delete(prefs.CustomSettings, habitOverridesKey)
+		delete(prefs.CustomSettings, suggestionFeedbackKey)
+	}
+}
@@ -0,0 +1,98 @@
+// Package locale selects the language of the rule-based AI layers.
+//
+// Rule packs (routing keywords, time expressions) are keyed by Locale. The
+// preferred locale comes from the user's general setting and is carried in the
+// request context; the script of the text decides when it is unambiguous, so a
+// Chinese user typing English still gets English rules.
+package locale
+
+import (
+	"context"
+	"strings"
+	"unicode"
+)
+
+// Locale is a supported rule language.
+type Locale string
+
+const (
+	// Chinese is the default locale.
+	Chinese Locale = "zh"
+	// English covers all English variants (en-US, en-GB, ...).
+	English Locale = "en"
+)
+
+// Default is used when neither the setting nor the text decides.
+const Default = Chinese
+
+// Supported lists all locales with rule packs.
+var Supported = []Locale{Chinese, English}
+
+// Parse maps a BCP 47 tag such as "en-US", "zh-Hans" or "zh_CN" to a supported locale.
+// Unknown or empty tags return the empty Locale.
+func Parse(tag string) Locale {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	switch Locale(tag) {
+	case Chinese, English:
+		return Locale(tag)
+	}
+	return ""
+}
+
+// Detect returns the locale of the script the text is written in.
+// Any Han character makes the text Chinese; otherwise Latin letters make it English.
+// Text without letters (e.g. "15:00") returns the empty Locale.
+func Detect(text string) Locale {
+	hasLatin := false
+	for _, r := range text {
+		if unicode.Is(unicode.Han, r) {
+			return Chinese
+		}
+		if !hasLatin && r < unicode.MaxASCII && unicode.IsLetter(r) {
+			hasLatin = true
+		}
+	}
+	if hasLatin {
+		return English
+	}
+	return ""
+}
+
+// Resolve returns the locale to apply to text: the detected script if any,
+// otherwise the preferred locale, otherwise Default.
+func Resolve(preferred Locale, text string) Locale {
+	if detected := Detect(text); detected != "" {
+		return detected
+	}
+	if preferred != "" {
+		return preferred
+	}
+	return Default
+}
+
+type contextKey struct{}
+
+// WithLocale returns a context carrying the user's preferred locale.
+func WithLocale(ctx context.Context, loc Locale) context.Context {
+	if loc == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, loc)
+}
+
+// FromContext returns the preferred locale carried by ctx, or the empty Locale.
+func FromContext(ctx context.Context) Locale {
+	if ctx == nil {
+		return ""
+	}
+	loc, _ := ctx.Value(contextKey{}).(Locale)
+	return loc
+}
+
+// ForText resolves the locale of text against the preferred locale in ctx.
+func ForText(ctx context.Context, text string) Locale {
+	return Resolve(FromContext(ctx), text)
+}
@@ -0,0 +1,55 @@
+package locale
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want Locale
+	}{
+		{"en", English},
+		{"en-US", English},
+		{"EN_gb", English},
+		{"zh-Hans", Chinese},
+		{"zh_CN", Chinese},
+		{"fr", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Parse(tt.tag), tt.tag)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		preferred Locale
+		text      string
+		want      Locale
+	}{
+		{English, "明天下午3点开会", Chinese},
+		{Chinese, "meeting tomorrow at 3pm", English},
+		{English, "提醒我 review PR", Chinese},
+		{English, "15:00", English},
+		{Chinese, "15:00", Chinese},
+		{"", "15:00", Default},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Resolve(tt.preferred, tt.text), tt.text)
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, Locale(""), FromContext(ctx))
+	assert.Equal(t, Chinese, ForText(ctx, "12:00"))
+
+	ctx = WithLocale(ctx, English)
+	assert.Equal(t, English, FromContext(ctx))
+	assert.Equal(t, English, ForText(ctx, "12:00"))
+	assert.Equal(t, Chinese, ForText(ctx, "中午12点"))
+}
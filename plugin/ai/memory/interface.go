@@ -30,6 +30,11 @@ type MemoryService interface {
 	// limit: maximum number of results to return
 	SearchEpisodes(ctx context.Context, userID int32, query string, limit int) ([]EpisodicMemory, error)
 
+	// ListRoutingCorrections lists routing correction episodes, newest first.
+	// userID: nil lists the corrections of all users
+	// limit: maximum number of results to return
+	ListRoutingCorrections(ctx context.Context, userID *int32, limit int) ([]EpisodicMemory, error)
+
 	// ListActiveUserIDs returns user IDs with recent episodic activity.
 	// lookbackDays: how many days to look back for activity
 	// Returns unique user IDs that have at least one episode within the lookback period.
@@ -61,8 +66,21 @@ type EpisodicMemory struct {
 	Outcome    string    `json:"outcome"` // success/failure
 	Summary    string    `json:"summary"`
 	Importance float32   `json:"importance"` // 0-1
+
+	// Similarity to the recall query (0-1), set only by semantic recall
+	Similarity float32 `json:"similarity,omitempty"`
 }
 
+// Summary prefixes of episodes that record routing rather than conversation content.
+// They are not embedded or recalled.
+const (
+	// RoutingDecisionSummaryPrefix marks routing decisions, followed by the intent.
+	RoutingDecisionSummaryPrefix = "routing_decision:"
+	// RoutingCorrectionSummaryPrefix marks user corrections of a routing decision,
+	// followed by the wrongly predicted intent.
+	RoutingCorrectionSummaryPrefix = "routing_correction:"
+)
+
 // UserPreferences represents user preferences.
 type UserPreferences struct {
 	Timezone           string         `json:"timezone"`
@@ -72,4 +90,9 @@ type UserPreferences struct {
 	CommunicationStyle string         `json:"communication_style"` // concise/detailed
 	TagPreferences     []string       `json:"tag_preferences"`
 	CustomSettings     map[string]any `json:"custom_settings"`
+
+	// Incognito disables episodic memory for chats: nothing is recalled or recorded
+	Incognito bool `json:"incognito,omitempty"`
+	// SearchLogDisabled disables search logging for search insights
+	SearchLogDisabled bool `json:"search_log_disabled,omitempty"`
 }
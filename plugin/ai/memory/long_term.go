@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"log/slog"
+	"strings"
 	"time"
 
+	"github.com/hrygo/divinesense/plugin/ai"
 	"github.com/hrygo/divinesense/store"
 )
 
@@ -14,9 +16,16 @@ import (
 // but the store is not configured (e.g., SQLite mode).
 var ErrLongTermNotConfigured = errors.New("long-term memory not configured (requires PostgreSQL)")
 
+// ErrEpisodeNotFound is returned when an episode does not exist or belongs to another user.
+var ErrEpisodeNotFound = errors.New("episode not found")
+
 // LongTermMemory manages persistent episodic memories and user preferences.
 type LongTermMemory struct {
 	store *store.Store
+
+	// Optional: embeds episodes at creation for semantic recall
+	embeddingService ai.EmbeddingService
+	embeddingModel   string
 }
 
 // NewLongTermMemory creates a new long-term memory manager.
@@ -41,6 +50,17 @@ func (l *LongTermMemory) SaveEpisode(ctx context.Context, episode EpisodicMemory
 		storeEpisode.Timestamp = time.Now()
 	}
 
+	// Embed at creation so recall does not need to embed past episodes
+	if l.embeddingService != nil && !IsRoutingDecision(episode) {
+		vector, err := l.embeddingService.Embed(ctx, episodeText(episode))
+		if err != nil {
+			slog.Warn("failed to embed episode, saving without embedding", "user_id", episode.UserID, "error", err)
+		} else {
+			storeEpisode.Embedding = vector
+			storeEpisode.EmbeddingModel = l.embeddingModel
+		}
+	}
+
 	_, err := l.store.CreateEpisodicMemory(ctx, storeEpisode)
 	return err
 }
@@ -68,6 +88,74 @@ func (l *LongTermMemory) SearchEpisodes(ctx context.Context, userID int32, query
 		return nil, err
 	}
 
+	return convertStoreEpisodes(storeEpisodes), nil
+}
+
+// ListEpisodes returns a page of a user's episodes, newest first, including routing decisions.
+func (l *LongTermMemory) ListEpisodes(ctx context.Context, userID int32, limit, offset int) ([]EpisodicMemory, error) {
+	storeEpisodes, err := l.store.ListEpisodicMemories(ctx, &store.FindEpisodicMemory{
+		UserID: &userID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertStoreEpisodes(storeEpisodes), nil
+}
+
+// ListRoutingCorrections returns the most recent routing corrections of a user,
+// or of all users if userID is nil.
+func (l *LongTermMemory) ListRoutingCorrections(ctx context.Context, userID *int32, limit int) ([]EpisodicMemory, error) {
+	prefix := RoutingCorrectionSummaryPrefix
+	storeEpisodes, err := l.store.ListEpisodicMemories(ctx, &store.FindEpisodicMemory{
+		UserID:        userID,
+		SummaryPrefix: &prefix,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertStoreEpisodes(storeEpisodes), nil
+}
+
+// DeleteEpisode deletes one episode of a user.
+// Returns ErrEpisodeNotFound if the episode does not exist or belongs to another user.
+func (l *LongTermMemory) DeleteEpisode(ctx context.Context, userID int32, id int64) error {
+	episodes, err := l.store.ListEpisodicMemories(ctx, &store.FindEpisodicMemory{
+		ID:     &id,
+		UserID: &userID,
+		Limit:  1,
+	})
+	if err != nil {
+		return err
+	}
+	if len(episodes) == 0 {
+		return ErrEpisodeNotFound
+	}
+	return l.store.DeleteEpisodicMemory(ctx, &store.DeleteEpisodicMemory{ID: &id, UserID: &userID})
+}
+
+// Forget deletes all episodes of a user and resets their preferences to defaults.
+// The incognito and search log flags are kept so that forgetting does not silently
+// re-enable memory or search logging.
+func (l *LongTermMemory) Forget(ctx context.Context, userID int32) error {
+	prefs, err := l.GetPreferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := l.store.DeleteEpisodicMemory(ctx, &store.DeleteEpisodicMemory{UserID: &userID}); err != nil {
+		return err
+	}
+
+	reset := DefaultPreferences()
+	reset.Incognito = prefs.Incognito
+	reset.SearchLogDisabled = prefs.SearchLogDisabled
+	return l.UpdatePreferences(ctx, userID, reset)
+}
+
+func convertStoreEpisodes(storeEpisodes []*store.EpisodicMemory) []EpisodicMemory {
 	episodes := make([]EpisodicMemory, len(storeEpisodes))
 	for i, e := range storeEpisodes {
 		episodes[i] = EpisodicMemory{
@@ -81,8 +169,74 @@ func (l *LongTermMemory) SearchEpisodes(ctx context.Context, userID int32, query
 			Importance: e.Importance,
 		}
 	}
+	return episodes
+}
+
+// RecallEpisodes returns the episodes most similar to the query, with similarity set.
+// Falls back to the most recent episodes when no embedding service is configured.
+// Routing decisions are never returned.
+func (l *LongTermMemory) RecallEpisodes(ctx context.Context, userID int32, query string, limit int) ([]EpisodicMemory, error) {
+	if l.embeddingService == nil || strings.TrimSpace(query) == "" {
+		episodes, err := l.SearchEpisodes(ctx, userID, "", limit)
+		if err != nil {
+			return nil, err
+		}
+		return filterRoutingDecisions(episodes), nil
+	}
+
+	vector, err := l.embeddingService.Embed(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	results, err := l.store.SearchEpisodicMemories(ctx, &store.SearchEpisodicMemory{
+		UserID: userID,
+		Vector: vector,
+		Model:  l.embeddingModel,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	episodes := make([]EpisodicMemory, 0, len(results))
+	for _, e := range results {
+		episodes = append(episodes, EpisodicMemory{
+			ID:         e.ID,
+			UserID:     e.UserID,
+			Timestamp:  e.Timestamp,
+			AgentType:  e.AgentType,
+			UserInput:  e.UserInput,
+			Outcome:    e.Outcome,
+			Summary:    e.Summary,
+			Importance: e.Importance,
+			Similarity: e.Similarity,
+		})
+	}
+	return filterRoutingDecisions(episodes), nil
+}
+
+// IsRoutingDecision reports whether an episode records a routing decision or correction.
+func IsRoutingDecision(episode EpisodicMemory) bool {
+	return strings.HasPrefix(episode.Summary, RoutingDecisionSummaryPrefix) ||
+		strings.HasPrefix(episode.Summary, RoutingCorrectionSummaryPrefix)
+}
+
+// episodeText is the text embedded for an episode.
+func episodeText(episode EpisodicMemory) string {
+	if episode.Summary == "" {
+		return episode.UserInput
+	}
+	return episode.UserInput + "\n" + episode.Summary
+}
 
-	return episodes, nil
+func filterRoutingDecisions(episodes []EpisodicMemory) []EpisodicMemory {
+	result := episodes[:0]
+	for _, ep := range episodes {
+		if !IsRoutingDecision(ep) {
+			result = append(result, ep)
+		}
+	}
+	return result
 }
 
 // ListActiveUserIDs returns user IDs with recent episodic activity.
@@ -163,6 +163,31 @@ func (m *MockMemoryService) SearchEpisodes(ctx context.Context, userID int32, qu
 	return results, nil
 }
 
+// ListRoutingCorrections lists routing correction episodes, newest first.
+func (m *MockMemoryService) ListRoutingCorrections(ctx context.Context, userID *int32, limit int) ([]EpisodicMemory, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var results []EpisodicMemory
+	for _, ep := range m.episodes {
+		if userID != nil && ep.UserID != *userID {
+			continue
+		}
+		if strings.HasPrefix(ep.Summary, RoutingCorrectionSummaryPrefix) {
+			results = append(results, ep)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Timestamp.After(results[j].Timestamp)
+	})
+
+	if limit > 0 && limit < len(results) {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
 // ListActiveUserIDs returns user IDs with recent activity.
 func (m *MockMemoryService) ListActiveUserIDs(ctx context.Context, lookbackDays int) ([]int32, error) {
 	m.mu.RLock()
@@ -2,8 +2,10 @@ package memory
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
+	"github.com/hrygo/divinesense/plugin/ai"
 	"github.com/hrygo/divinesense/store"
 )
 
@@ -70,6 +72,25 @@ func (s *Service) SearchEpisodes(ctx context.Context, userID int32, query string
 	return s.longTerm.SearchEpisodes(ctx, userID, query, limit)
 }
 
+// SetEmbeddingService enables embedding episodes at creation and semantic recall.
+// model identifies the embedding model so vectors of different models are not compared.
+func (s *Service) SetEmbeddingService(es ai.EmbeddingService, model string) {
+	if s.longTerm == nil {
+		return
+	}
+	s.longTerm.embeddingService = es
+	s.longTerm.embeddingModel = model
+}
+
+// RecallEpisodes returns the past episodes of a user most relevant to the query.
+// Returns ErrLongTermNotConfigured if long-term memory is not available.
+func (s *Service) RecallEpisodes(ctx context.Context, userID int32, query string, limit int) ([]EpisodicMemory, error) {
+	if s.longTerm == nil {
+		return nil, ErrLongTermNotConfigured
+	}
+	return s.longTerm.RecallEpisodes(ctx, userID, query, limit)
+}
+
 // ListActiveUserIDs returns user IDs with recent episodic activity.
 // Returns ErrLongTermNotConfigured if long-term memory is not available.
 func (s *Service) ListActiveUserIDs(ctx context.Context, lookbackDays int) ([]int32, error) {
@@ -100,6 +121,81 @@ func (s *Service) UpdatePreferences(ctx context.Context, userID int32, prefs *Us
 	return s.longTerm.UpdatePreferences(ctx, userID, prefs)
 }
 
+// IsIncognito reports whether the user disabled episodic memory for chats.
+// Returns true if preferences cannot be read, so an unreadable privacy switch
+// never records episodes for a user who turned it on.
+func (s *Service) IsIncognito(ctx context.Context, userID int32) bool {
+	if s.longTerm == nil {
+		return false
+	}
+	prefs, err := s.longTerm.GetPreferences(ctx, userID)
+	if errors.Is(err, store.ErrAIFeatureNotSupported) {
+		return false // The database can't store the switch, so it was never turned on
+	}
+	if err != nil {
+		slog.Warn("failed to read incognito preference, treating as incognito", "user_id", userID, "error", err)
+		return true
+	}
+	return prefs.Incognito
+}
+
+// IsSearchLogDisabled reports whether the user disabled search logging.
+// Returns true if preferences cannot be read, so an unreadable privacy switch
+// never logs the searches of a user who turned logging off.
+func (s *Service) IsSearchLogDisabled(ctx context.Context, userID int32) bool {
+	if s.longTerm == nil {
+		return false
+	}
+	prefs, err := s.longTerm.GetPreferences(ctx, userID)
+	if errors.Is(err, store.ErrAIFeatureNotSupported) {
+		return false // The database can't store the switch, so it was never turned off
+	}
+	if err != nil {
+		slog.Warn("failed to read search log preference, treating as disabled", "user_id", userID, "error", err)
+		return true
+	}
+	return prefs.SearchLogDisabled
+}
+
+// ========== Transparency ==========
+
+// ListEpisodes returns a page of a user's episodes, newest first.
+// Returns ErrLongTermNotConfigured if long-term memory is not available.
+func (s *Service) ListEpisodes(ctx context.Context, userID int32, limit, offset int) ([]EpisodicMemory, error) {
+	if s.longTerm == nil {
+		return nil, ErrLongTermNotConfigured
+	}
+	return s.longTerm.ListEpisodes(ctx, userID, limit, offset)
+}
+
+// ListRoutingCorrections returns the most recent routing corrections of a user,
+// or of all users if userID is nil.
+// Returns ErrLongTermNotConfigured if long-term memory is not available.
+func (s *Service) ListRoutingCorrections(ctx context.Context, userID *int32, limit int) ([]EpisodicMemory, error) {
+	if s.longTerm == nil {
+		return nil, ErrLongTermNotConfigured
+	}
+	return s.longTerm.ListRoutingCorrections(ctx, userID, limit)
+}
+
+// DeleteEpisode deletes one episode of a user.
+// Returns ErrLongTermNotConfigured if long-term memory is not available.
+func (s *Service) DeleteEpisode(ctx context.Context, userID int32, id int64) error {
+	if s.longTerm == nil {
+		return ErrLongTermNotConfigured
+	}
+	return s.longTerm.DeleteEpisode(ctx, userID, id)
+}
+
+// Forget deletes everything long-term memory holds about a user, keeping only the incognito flag.
+// Returns ErrLongTermNotConfigured if long-term memory is not available.
+func (s *Service) Forget(ctx context.Context, userID int32) error {
+	if s.longTerm == nil {
+		return ErrLongTermNotConfigured
+	}
+	return s.longTerm.Forget(ctx, userID)
+}
+
 // ========== Session Management ==========
 
 // ClearSession removes all short-term messages from a session.
@@ -2,11 +2,16 @@ package memory
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	"github.com/hrygo/divinesense/store"
 )
 
 func TestService_ShortTermMemory(t *testing.T) {
@@ -141,6 +146,34 @@ func TestService_LongTermMemory_NoStore(t *testing.T) {
 	})
 }
 
+// preferencesDriver is a database driver whose user preferences can't be read.
+type preferencesDriver struct {
+	store.Driver
+	err error
+}
+
+func (d *preferencesDriver) GetUserPreferences(context.Context, *store.FindUserPreferences) (*store.UserPreferences, error) {
+	return nil, d.err
+}
+
+func TestService_PrivacySwitchesFailClosed(t *testing.T) {
+	ctx := context.Background()
+	newService := func(err error) *Service {
+		return NewService(store.New(&preferencesDriver{err: err}, &profile.Profile{}), 10)
+	}
+
+	// A database that can't store the switches never has them turned on
+	unsupported := newService(fmt.Errorf("%w in SQLite", store.ErrAIFeatureNotSupported))
+	defer unsupported.Close()
+	assert.False(t, unsupported.IsIncognito(ctx, 1))
+	assert.False(t, unsupported.IsSearchLogDisabled(ctx, 1))
+
+	failing := newService(errors.New("connection reset"))
+	defer failing.Close()
+	assert.True(t, failing.IsIncognito(ctx, 1))
+	assert.True(t, failing.IsSearchLogDisabled(ctx, 1))
+}
+
 func TestService_SessionManagement(t *testing.T) {
 	svc := NewService(nil, 10)
 	defer svc.Close()
@@ -77,20 +77,26 @@ func NewEngine(habitAnalyzer habit.HabitAnalyzer, memSvc memory.MemoryService) *
 
 // Predict generates predictions for a user based on time, context, and patterns.
 func (e *Engine) Predict(ctx context.Context, userID int32, recentEvents []ContextEvent) ([]Prediction, error) {
+	return e.PredictAt(ctx, userID, time.Now(), recentEvents)
+}
+
+// PredictAt generates predictions for the given moment.
+// now should be in the user's timezone, since time rules use its hour and weekday.
+func (e *Engine) PredictAt(ctx context.Context, userID int32, now time.Time, recentEvents []ContextEvent) ([]Prediction, error) {
 	var predictions []Prediction
 
 	// 1. Time-based predictions
-	timePredictions := e.predictByTime(ctx, userID)
+	timePredictions := e.predictByTime(ctx, userID, now)
 	predictions = append(predictions, timePredictions...)
 
 	// 2. Context-based predictions (based on recent events)
 	if len(recentEvents) > 0 {
-		contextPredictions := e.predictByContext(ctx, userID, recentEvents)
+		contextPredictions := e.predictByContext(ctx, userID, now, recentEvents)
 		predictions = append(predictions, contextPredictions...)
 	}
 
 	// 3. Pattern-based predictions (based on historical habits)
-	patternPredictions, err := e.predictByPattern(ctx, userID)
+	patternPredictions, err := e.predictByPattern(ctx, userID, now)
 	if err == nil {
 		predictions = append(predictions, patternPredictions...)
 	}
@@ -107,8 +113,7 @@ func (e *Engine) Predict(ctx context.Context, userID int32, recentEvents []Conte
 }
 
 // predictByTime generates predictions based on current time.
-func (e *Engine) predictByTime(ctx context.Context, userID int32) []Prediction {
-	now := time.Now()
+func (e *Engine) predictByTime(ctx context.Context, userID int32, now time.Time) []Prediction {
 	hour := now.Hour()
 	weekday := now.Weekday()
 	day := now.Day()
@@ -174,11 +179,11 @@ func (e *Engine) predictByTime(ctx context.Context, userID int32) []Prediction {
 }
 
 // predictByContext generates predictions based on recent user actions.
-func (e *Engine) predictByContext(ctx context.Context, userID int32, events []ContextEvent) []Prediction {
+func (e *Engine) predictByContext(ctx context.Context, userID int32, now time.Time, events []ContextEvent) []Prediction {
 	var predictions []Prediction
 
 	// Only consider recent events (within last 5 minutes)
-	recentCutoff := time.Now().Add(-5 * time.Minute)
+	recentCutoff := now.Add(-5 * time.Minute)
 
 	for _, event := range events {
 		if event.Timestamp.Before(recentCutoff) {
@@ -224,7 +229,7 @@ func (e *Engine) predictByContext(ctx context.Context, userID int32, events []Co
 }
 
 // predictByPattern generates predictions based on historical patterns.
-func (e *Engine) predictByPattern(ctx context.Context, userID int32) ([]Prediction, error) {
+func (e *Engine) predictByPattern(ctx context.Context, userID int32, now time.Time) ([]Prediction, error) {
 	var predictions []Prediction
 
 	// Analyze user habits
@@ -237,7 +242,6 @@ func (e *Engine) predictByPattern(ctx context.Context, userID int32) ([]Predicti
 		return nil, err
 	}
 
-	now := time.Now()
 	hour := now.Hour()
 	weekday := now.Weekday()
 
@@ -28,7 +28,7 @@ func TestEngine_PredictByTime_MondayMorning(t *testing.T) {
 	engine := NewEngine(nil, nil)
 
 	// Test time-based prediction (results depend on current time)
-	predictions := engine.predictByTime(context.Background(), 1)
+	predictions := engine.predictByTime(context.Background(), 1, time.Now())
 
 	// Predictions slice should exist (may be empty depending on time)
 	// This is a basic sanity check that no panic occurs
@@ -46,7 +46,7 @@ func TestEngine_PredictByContext_ScheduleCreated(t *testing.T) {
 		},
 	}
 
-	predictions := engine.predictByContext(context.Background(), 1, events)
+	predictions := engine.predictByContext(context.Background(), 1, time.Now(), events)
 
 	require.Len(t, predictions, 1)
 	assert.Equal(t, ActionSetReminder, predictions[0].Action)
@@ -65,7 +65,7 @@ func TestEngine_PredictByContext_MemoViewed(t *testing.T) {
 		},
 	}
 
-	predictions := engine.predictByContext(context.Background(), 1, events)
+	predictions := engine.predictByContext(context.Background(), 1, time.Now(), events)
 
 	require.Len(t, predictions, 1)
 	assert.Equal(t, ActionSearchRelated, predictions[0].Action)
@@ -83,7 +83,7 @@ func TestEngine_PredictByContext_OldEvent(t *testing.T) {
 		},
 	}
 
-	predictions := engine.predictByContext(context.Background(), 1, events)
+	predictions := engine.predictByContext(context.Background(), 1, time.Now(), events)
 
 	assert.Empty(t, predictions)
 }
@@ -102,7 +102,7 @@ func TestEngine_PredictByPattern_WithActiveHours(t *testing.T) {
 
 	engine := NewEngine(mockAnalyzer, nil)
 
-	predictions, err := engine.predictByPattern(context.Background(), 1)
+	predictions, err := engine.predictByPattern(context.Background(), 1, time.Now())
 
 	require.NoError(t, err)
 	assert.NotEmpty(t, predictions)
@@ -111,7 +111,7 @@ func TestEngine_PredictByPattern_WithActiveHours(t *testing.T) {
 func TestEngine_PredictByPattern_NoAnalyzer(t *testing.T) {
 	engine := NewEngine(nil, nil)
 
-	predictions, err := engine.predictByPattern(context.Background(), 1)
+	predictions, err := engine.predictByPattern(context.Background(), 1, time.Now())
 
 	require.NoError(t, err)
 	assert.Empty(t, predictions)
@@ -203,6 +203,6 @@ func BenchmarkEngine_PredictByTime(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_ = engine.predictByTime(context.Background(), 1)
+		_ = engine.predictByTime(context.Background(), 1, time.Now())
 	}
 }
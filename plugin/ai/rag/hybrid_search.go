@@ -21,6 +21,12 @@ type StrategyConfig struct {
 	BM25Weight   float64
 	VectorWeight float64
 	UseReranker  bool
+
+	// Diversity re-ranking of the final results, see Diversify. It is optional:
+	// retrievers only apply it to strategies with UseMMR when it is switched on.
+	UseMMR             bool
+	MMRLambda          float64 // Weight of relevance against novelty; 1 keeps the relevance order
+	DuplicateThreshold float64 // Cosine similarity at which results are collapsed; 0 disables collapsing
 }
 
 // strategyConfigs maps strategies to their configurations.
@@ -31,24 +37,36 @@ var strategyConfigs = map[SearchStrategy]StrategyConfig{
 		UseReranker:  false,
 	},
 	StrategySemanticOnly: {
-		BM25Weight:   0.0,
-		VectorWeight: 1.0,
-		UseReranker:  false,
+		BM25Weight:         0.0,
+		VectorWeight:       1.0,
+		UseReranker:        false,
+		UseMMR:             true,
+		MMRLambda:          0.7,
+		DuplicateThreshold: 0.95,
 	},
 	StrategyHybridStandard: {
-		BM25Weight:   0.5,
-		VectorWeight: 0.5,
-		UseReranker:  false,
+		BM25Weight:         0.5,
+		VectorWeight:       0.5,
+		UseReranker:        false,
+		UseMMR:             true,
+		MMRLambda:          0.7,
+		DuplicateThreshold: 0.95,
 	},
 	StrategyHybridBM25Heavy: {
-		BM25Weight:   0.7,
-		VectorWeight: 0.3,
-		UseReranker:  false,
+		BM25Weight:         0.7,
+		VectorWeight:       0.3,
+		UseReranker:        false,
+		UseMMR:             true,
+		MMRLambda:          0.8,
+		DuplicateThreshold: 0.95,
 	},
 	StrategyFullPipeline: {
-		BM25Weight:   0.5,
-		VectorWeight: 0.5,
-		UseReranker:  true,
+		BM25Weight:         0.5,
+		VectorWeight:       0.5,
+		UseReranker:        true,
+		UseMMR:             true,
+		MMRLambda:          0.85,
+		DuplicateThreshold: 0.95,
 	},
 }
 
@@ -0,0 +1,114 @@
+// Package rag provides Self-RAG optimization.
+package rag
+
+import (
+	"math"
+)
+
+// MMRCandidate is a ranked result to diversify.
+type MMRCandidate struct {
+	Score     float32   // Relevance score, higher is better
+	Embedding []float32 // Stored embedding; nil if unknown
+}
+
+// MMRSelection is a selected candidate with the near-duplicates collapsed into it.
+type MMRSelection struct {
+	Index      int   // Index of the candidate
+	Duplicates []int // Indexes of the collapsed candidates, best ranked first
+}
+
+// Diversify collapses near-duplicate candidates and reorders the rest by
+// Maximal Marginal Relevance:
+//
+//	MMR(d) = λ · relevance(d) - (1 - λ) · max similarity(d, selected)
+//
+// Candidates must be ordered by relevance. A candidate whose cosine similarity
+// to a better ranked one reaches DuplicateThreshold is collapsed into it.
+// Relevance is the min-max normalized score. Candidates without an embedding
+// are never collapsed and count as dissimilar to every other candidate.
+// Without UseMMR every candidate is returned in its original order.
+func Diversify(candidates []MMRCandidate, config StrategyConfig) []MMRSelection {
+	if !config.UseMMR || len(candidates) < 2 {
+		selections := make([]MMRSelection, len(candidates))
+		for i := range candidates {
+			selections[i] = MMRSelection{Index: i}
+		}
+		return selections
+	}
+
+	// Collapse near-duplicates into the best ranked representative
+	kept := make([]*MMRSelection, 0, len(candidates))
+	for i, candidate := range candidates {
+		var representative *MMRSelection
+		if config.DuplicateThreshold > 0 && candidate.Embedding != nil {
+			for _, k := range kept {
+				if cosineSimilarity(candidate.Embedding, candidates[k.Index].Embedding) >= config.DuplicateThreshold {
+					representative = k
+					break
+				}
+			}
+		}
+		if representative != nil {
+			representative.Duplicates = append(representative.Duplicates, i)
+			continue
+		}
+		kept = append(kept, &MMRSelection{Index: i})
+	}
+
+	// Normalize scores to [0, 1], since fused and reranked scores have different scales
+	minScore, maxScore := candidates[kept[0].Index].Score, candidates[kept[0].Index].Score
+	for _, k := range kept {
+		minScore = min(minScore, candidates[k.Index].Score)
+		maxScore = max(maxScore, candidates[k.Index].Score)
+	}
+	relevance := func(k *MMRSelection) float64 {
+		if maxScore == minScore {
+			return 1
+		}
+		return float64(candidates[k.Index].Score-minScore) / float64(maxScore-minScore)
+	}
+
+	// Greedily select the candidate with the highest marginal relevance
+	selections := make([]MMRSelection, 0, len(kept))
+	maxSimilarity := make([]float64, len(kept)) // Highest similarity to the selected candidates
+	selected := make([]bool, len(kept))
+	for len(selections) < len(kept) {
+		best, bestScore := -1, math.Inf(-1)
+		for i, k := range kept {
+			if selected[i] {
+				continue
+			}
+			score := config.MMRLambda*relevance(k) - (1-config.MMRLambda)*maxSimilarity[i]
+			if score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		selected[best] = true
+		selections = append(selections, *kept[best])
+
+		for i, k := range kept {
+			if !selected[i] {
+				maxSimilarity[i] = max(maxSimilarity[i], cosineSimilarity(candidates[k.Index].Embedding, candidates[kept[best].Index].Embedding))
+			}
+		}
+	}
+	return selections
+}
+
+// cosineSimilarity returns the cosine similarity of two vectors,
+// or 0 if either is missing or their dimensions differ.
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -265,6 +265,40 @@ func TestHybridSearcher(t *testing.T) {
 	})
 }
 
+func TestDiversify(t *testing.T) {
+	candidates := []MMRCandidate{
+		{Score: 1.0, Embedding: []float32{1, 0, 0}},
+		{Score: 0.9, Embedding: []float32{0.99, 0.01, 0}}, // Near-duplicate of the first
+		{Score: 0.8, Embedding: []float32{0.8, 0.6, 0}},
+		{Score: 0.7, Embedding: []float32{0, 0, 1}},
+	}
+
+	t.Run("Collapses duplicates and promotes novel results", func(t *testing.T) {
+		config := StrategyConfig{UseMMR: true, MMRLambda: 0.5, DuplicateThreshold: 0.95}
+		selections := Diversify(candidates, config)
+		assert.Equal(t, []MMRSelection{
+			{Index: 0, Duplicates: []int{1}},
+			{Index: 3},
+			{Index: 2},
+		}, selections)
+	})
+
+	t.Run("Relevance only keeps the order", func(t *testing.T) {
+		config := StrategyConfig{UseMMR: true, MMRLambda: 1, DuplicateThreshold: 0.95}
+		selections := Diversify(candidates, config)
+		assert.Equal(t, []MMRSelection{
+			{Index: 0, Duplicates: []int{1}},
+			{Index: 2},
+			{Index: 3},
+		}, selections)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		selections := Diversify(candidates, GetStrategyConfig(StrategyBM25Only))
+		assert.Equal(t, []MMRSelection{{Index: 0}, {Index: 1}, {Index: 2}, {Index: 3}}, selections)
+	})
+}
+
 // Benchmark tests
 func BenchmarkRetrievalDecision(b *testing.B) {
 	decider := NewRetrievalDecider()
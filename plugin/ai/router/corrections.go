@@ -0,0 +1,130 @@
+package router
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+)
+
+// maxCorrectionLookup is the number of recent corrections compared with the input.
+const maxCorrectionLookup = 100
+
+// CorrectionMatchResult contains the result of matching the input against user corrections.
+type CorrectionMatchResult struct {
+	// Intent is the corrected intent when the user picked the right assistant.
+	Intent Intent
+	// Rejected is the assistant the user flagged as wrong without picking another one.
+	Rejected   AgentType
+	Confidence float32
+	Matched    bool
+}
+
+// SaveCorrection stores a user correction of a routing decision as a labeled example.
+// corrected is the assistant the user picked, or AgentTypeUnknown if the user only
+// flagged the predicted assistant as wrong.
+func (m *HistoryMatcher) SaveCorrection(ctx context.Context, userID int32, input string, predicted Intent, corrected AgentType) error {
+	if m.memoryService == nil {
+		return nil
+	}
+
+	// A positive example labels the right assistant; a negative one the rejected assistant
+	agentType, outcome := string(corrected), "success"
+	if corrected == AgentTypeUnknown || corrected == "" {
+		agentType, outcome = m.intentToAgentType(predicted), "failure"
+	}
+
+	episode := memory.EpisodicMemory{
+		UserID:     userID,
+		Timestamp:  time.Now(),
+		AgentType:  agentType,
+		UserInput:  input,
+		Outcome:    outcome,
+		Summary:    memory.RoutingCorrectionSummaryPrefix + string(predicted),
+		Importance: 1.0, // Explicit feedback outweighs inferred decisions
+	}
+	return m.memoryService.SaveEpisode(ctx, episode)
+}
+
+// MatchCorrection finds the most similar past correction of the user.
+// Corrections are consulted before the rule layer, so the threshold is the
+// same as for history matching to avoid overriding rules on loose matches.
+func (m *HistoryMatcher) MatchCorrection(ctx context.Context, userID int32, input string) (*CorrectionMatchResult, error) {
+	if m.memoryService == nil {
+		return &CorrectionMatchResult{Matched: false}, nil
+	}
+
+	episodes, err := m.memoryService.ListRoutingCorrections(ctx, &userID, maxCorrectionLookup)
+	if err != nil {
+		return nil, err
+	}
+
+	var bestMatch *memory.EpisodicMemory
+	var bestSim float32
+	for i := range episodes {
+		ep := &episodes[i]
+		// Episodes are newest first, so the latest correction wins ties
+		if similarity := m.calculateLexicalSimilarity(input, ep.UserInput); similarity > bestSim {
+			bestSim = similarity
+			bestMatch = ep
+		}
+	}
+
+	if bestMatch == nil || bestSim < m.similarityThreshold {
+		return &CorrectionMatchResult{Matched: false}, nil
+	}
+
+	result := &CorrectionMatchResult{Confidence: bestSim, Matched: true}
+	if bestMatch.Outcome == "success" {
+		result.Intent = m.agentTypeToIntent(bestMatch.AgentType, input)
+	} else {
+		result.Rejected = AgentType(bestMatch.AgentType)
+	}
+	return result, nil
+}
+
+// RoutingConfusion counts corrections from a predicted intent to an assistant.
+type RoutingConfusion struct {
+	Predicted Intent
+	// Corrected is the assistant users picked, AgentTypeUnknown if they only flagged the prediction.
+	Corrected AgentType
+	Count     int
+}
+
+// BuildConfusionReport aggregates routing corrections by predicted intent and
+// corrected assistant, most frequent first.
+func BuildConfusionReport(corrections []memory.EpisodicMemory) []RoutingConfusion {
+	type key struct {
+		predicted Intent
+		corrected AgentType
+	}
+	counts := make(map[key]int)
+	for _, ep := range corrections {
+		predicted, ok := strings.CutPrefix(ep.Summary, memory.RoutingCorrectionSummaryPrefix)
+		if !ok {
+			continue
+		}
+		corrected := AgentType(ep.AgentType)
+		if ep.Outcome != "success" {
+			corrected = AgentTypeUnknown
+		}
+		counts[key{Intent(predicted), corrected}]++
+	}
+
+	report := make([]RoutingConfusion, 0, len(counts))
+	for k, count := range counts {
+		report = append(report, RoutingConfusion{Predicted: k.predicted, Corrected: k.corrected, Count: count})
+	}
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Count != report[j].Count {
+			return report[i].Count > report[j].Count
+		}
+		if report[i].Predicted != report[j].Predicted {
+			return report[i].Predicted < report[j].Predicted
+		}
+		return report[i].Corrected < report[j].Corrected
+	})
+	return report
+}
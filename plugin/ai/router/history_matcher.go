@@ -4,28 +4,30 @@ package router
 import (
 	"context"
 	"log/slog"
-	"math"
 	"strings"
 	"time"
 
 	"github.com/hrygo/divinesense/plugin/ai"
 	"github.com/hrygo/divinesense/plugin/ai/memory"
+	"github.com/hrygo/divinesense/plugin/ai/vector"
 )
 
 // HistoryMatcher implements Layer 2 history-based intent matching.
 // Layer 2a: Lexical similarity (~1ms) - Jaccard on character bigrams
-// Layer 2b: Semantic similarity (~50ms) - Embedding cosine similarity (optional)
+// Layer 2b: Semantic similarity (~50ms) - Vector search over embedded episodes (optional)
 // Target: Handle 30%+ of requests that pass Layer 1.
 type HistoryMatcher struct {
 	memoryService       memory.MemoryService
-	embeddingService    ai.EmbeddingService // Optional: for semantic similarity
+	vectorService       vector.VectorService // Optional: for semantic similarity
+	embeddingService    ai.EmbeddingService  // Embeds the input for vector search
 	similarityThreshold float32
 	semanticThreshold   float32 // Threshold for semantic similarity fallback
 	maxHistoryLookup    int
 }
 
-// SetEmbeddingService sets the embedding service for semantic similarity matching.
-func (m *HistoryMatcher) SetEmbeddingService(es ai.EmbeddingService) {
+// SetVectorService enables semantic similarity matching against the user's embedded episodes.
+func (m *HistoryMatcher) SetVectorService(vs vector.VectorService, es ai.EmbeddingService) {
+	m.vectorService = vs
 	m.embeddingService = es
 }
 
@@ -72,10 +74,12 @@ func (m *HistoryMatcher) Match(ctx context.Context, userID int32, input string)
 
 	for i := range episodes {
 		ep := &episodes[i]
-		similarity := m.calculateLexicalSimilarity(input, ep.UserInput)
-
-		// Only consider successful outcomes
-		if ep.Outcome == "success" && similarity > bestLexicalSim {
+		// Only consider successful routing decisions; other episodes, such as
+		// suggestion feedback, do not record where an input was routed
+		if ep.Outcome != "success" || !strings.HasPrefix(ep.Summary, memory.RoutingDecisionSummaryPrefix) {
+			continue
+		}
+		if similarity := m.calculateLexicalSimilarity(input, ep.UserInput); similarity > bestLexicalSim {
 			bestLexicalSim = similarity
 			bestMatch = ep
 		}
@@ -95,8 +99,8 @@ func (m *HistoryMatcher) Match(ctx context.Context, userID int32, input string)
 	// Layer 2b: Semantic similarity fallback
 	// Triggered when lexical is moderately close but below threshold
 	// This catches cases like "帮我找笔记" vs "搜索备忘"
-	if m.embeddingService != nil && bestLexicalSim >= 0.4 && bestLexicalSim < m.similarityThreshold {
-		semanticResult := m.matchBySemanticSimilarity(ctx, input, episodes)
+	if m.vectorService != nil && m.embeddingService != nil && bestLexicalSim >= 0.4 && bestLexicalSim < m.similarityThreshold {
+		semanticResult := m.matchBySemanticSimilarity(ctx, userID, input)
 		if semanticResult.Matched {
 			slog.Debug("history matched by semantic similarity",
 				"input", truncate(input, 50),
@@ -110,72 +114,49 @@ func (m *HistoryMatcher) Match(ctx context.Context, userID int32, input string)
 	return &HistoryMatchResult{Matched: false}, nil
 }
 
-// matchBySemanticSimilarity matches episodes using embedding cosine similarity.
-func (m *HistoryMatcher) matchBySemanticSimilarity(ctx context.Context, input string, episodes []memory.EpisodicMemory) *HistoryMatchResult {
-	// Get input embedding
+// matchBySemanticSimilarity matches the input against the user's embedded episodes.
+func (m *HistoryMatcher) matchBySemanticSimilarity(ctx context.Context, userID int32, input string) *HistoryMatchResult {
 	inputEmbedding, err := m.embeddingService.Embed(ctx, input)
 	if err != nil {
 		return &HistoryMatchResult{Matched: false}
 	}
 
-	// Find best semantic match by embedding on-the-fly
-	var bestMatch *memory.EpisodicMemory
-	var bestSemanticSim float32
+	results, err := m.vectorService.SearchSimilar(ctx, inputEmbedding, m.maxHistoryLookup, map[string]any{
+		vector.KeyUserID:  userID,
+		vector.KeyDocType: vector.DocTypeEpisode,
+	})
+	if err != nil {
+		slog.Debug("semantic history search failed", "error", err)
+		return &HistoryMatchResult{Matched: false}
+	}
 
-	for i := range episodes {
-		ep := &episodes[i]
-		if ep.Outcome != "success" {
+	// Results are ordered by similarity; take the best successful episode
+	for _, result := range results {
+		if result.Score < m.semanticThreshold {
+			break
+		}
+		if outcome, _ := result.Metadata[vector.KeyOutcome].(string); outcome != "success" {
 			continue
 		}
-
-		// Embed episode input on-the-fly (can be cached later)
-		epEmbedding, err := m.embeddingService.Embed(ctx, ep.UserInput)
+		_, episodeID, err := vector.ParseDocID(result.DocID)
 		if err != nil {
 			continue
 		}
-
-		// Calculate cosine similarity
-		similarity := cosineSimilarity(inputEmbedding, epEmbedding)
-		if similarity > bestSemanticSim {
-			bestSemanticSim = similarity
-			bestMatch = ep
+		// Skip episodes of agents that do not route chats, such as suggestion feedback
+		agentType, _ := result.Metadata[vector.KeyAgentType].(string)
+		intent := m.agentTypeToIntent(agentType, input)
+		if intent == IntentUnknown {
+			continue
+		}
+		return &HistoryMatchResult{
+			Intent:     intent,
+			Confidence: result.Score,
+			SourceID:   episodeID,
+			Matched:    true,
 		}
 	}
 
-	if bestMatch == nil || bestSemanticSim < m.semanticThreshold {
-		return &HistoryMatchResult{Matched: false}
-	}
-
-	intent := m.agentTypeToIntent(bestMatch.AgentType, input)
-	return &HistoryMatchResult{
-		Intent:     intent,
-		Confidence: bestSemanticSim,
-		SourceID:   bestMatch.ID,
-		Matched:    true,
-	}
-}
-
-// cosineSimilarity calculates cosine similarity between two vectors.
-func cosineSimilarity(a, b []float32) float32 {
-	if len(a) != len(b) {
-		return 0
-	}
-
-	var dotProduct float32
-	var normA float32
-	var normB float32
-
-	for i := range a {
-		dotProduct += a[i] * b[i]
-		normA += a[i] * a[i]
-		normB += b[i] * b[i]
-	}
-
-	if normA == 0 || normB == 0 {
-		return 0
-	}
-
-	return dotProduct / (float32(math.Sqrt(float64(normA))) * float32(math.Sqrt(float64(normB))))
+	return &HistoryMatchResult{Matched: false}
 }
 
 // calculateLexicalSimilarity calculates lexical similarity score between two strings.
@@ -273,7 +254,7 @@ func (m *HistoryMatcher) SaveDecision(ctx context.Context, userID int32, input s
 		AgentType:  m.intentToAgentType(intent),
 		UserInput:  input,
 		Outcome:    outcome,
-		Summary:    "routing_decision:" + string(intent),
+		Summary:    memory.RoutingDecisionSummaryPrefix + string(intent),
 		Importance: 0.5,
 	}
 
@@ -2,106 +2,66 @@
 package router
 
 import (
-	"regexp"
 	"strings"
-)
-
-// Pre-defined core keywords for each category (avoid map creation on every call).
-var coreKeywordsByCategory = map[string][]string{
-	"schedule": {"日程", "安排", "会议", "提醒", "预约", "开会"},
-	"memo":     {"笔记", "搜索", "查找", "记录", "memo"},
-	"amazing":  {"综合", "总结", "分析", "周报"},
-}
 
-// Pre-defined intent patterns (avoid slice creation on every call).
-var (
-	updatePatterns = []string{"修改", "更新", "取消", "改", "删除"}
-	queryPatterns  = []string{"查看", "有什么", "哪些", "看看", "什么安排", "有没有"}
-	batchPatterns  = []string{"批量", "多个", "一系列", "每天", "每周"}
-	searchPatterns = []string{"搜索", "查找", "找", "查", "有什么", "哪些"}
-	createPatterns = []string{"记录", "记一下", "写", "保存", "创建"}
+	"github.com/hrygo/divinesense/plugin/ai/locale"
 )
 
 // RuleMatcher implements Layer 1 rule-based intent matching.
 // Target: 0ms latency, handle 60%+ of requests.
+// Rules come in per-locale packs; the pack is chosen by the script of the input,
+// falling back to the user's preferred locale for script-neutral input.
 type RuleMatcher struct {
-	scheduleKeywords map[string]int
-	memoKeywords     map[string]int
-	amazingKeywords  map[string]int
-	timePatterns     []*regexp.Regexp
+	packs map[locale.Locale]*rulePack
 }
 
-// NewRuleMatcher creates a new rule matcher with predefined keyword weights.
+// NewRuleMatcher creates a new rule matcher with the rule packs of all supported locales.
 func NewRuleMatcher() *RuleMatcher {
 	return &RuleMatcher{
-		// Schedule keywords: weight +2 for core, +1 for supporting
-		scheduleKeywords: map[string]int{
-			// Core keywords (+2)
-			"日程": 2, "安排": 2, "会议": 2, "提醒": 2, "预约": 2,
-			"开会": 2, "约会": 2, "设置提醒": 3, "创建日程": 3,
-			// Supporting keywords (+1)
-			"今天": 1, "明天": 1, "后天": 1, "下周": 1, "本周": 1,
-			"上午": 1, "下午": 1, "晚上": 1, "点": 1,
-		},
-		// Memo keywords: weight +2 for core, +1 for supporting
-		memoKeywords: map[string]int{
-			// Core keywords (+2)
-			"笔记": 2, "搜索": 2, "查找": 2, "记录": 2, "写过": 2,
-			"找": 2, "memo": 2, "查": 2,
-			// Supporting keywords (+1)
-			"关于": 1, "提到": 1, "之前": 1, "有关": 1, "记": 1,
-		},
-		// Amazing (general assistant) keywords
-		amazingKeywords: map[string]int{
-			// Core keywords (+2)
-			"综合": 2, "总结": 2, "分析": 2, "周报": 2, "帮我": 2,
-			"怎么": 2, "什么": 2, "为什么": 2,
-			// Supporting keywords (+1)
-			"本周": 1, "工作": 1, "解释": 1, "说说": 1,
-		},
-		// Time patterns for schedule detection
-		timePatterns: []*regexp.Regexp{
-			regexp.MustCompile(`\d{1,2}[:\s时点]\d{0,2}`),       // 10:30, 10点, 10时30
-			regexp.MustCompile(`(上午|下午|晚上|早上|中午)\d{1,2}[点时]`), // 下午3点
-			regexp.MustCompile(`(明天|后天|今天|下周|本周)`),            // Relative dates
-			regexp.MustCompile(`\d{1,2}月\d{1,2}[日号]`),         // 1月15日
-		},
+		packs: defaultRulePacks(),
 	}
 }
 
 // Match attempts to classify intent using rule-based matching.
+// The locale is detected from the input.
 // Returns: intent, confidence, matched (true if rule matched)
 func (m *RuleMatcher) Match(input string) (Intent, float32, bool) {
-	lower := strings.ToLower(input)
+	return m.MatchLocale(input, locale.Resolve("", input))
+}
+
+// MatchLocale classifies intent with the rule pack of the given locale.
+func (m *RuleMatcher) MatchLocale(input string, loc locale.Locale) (Intent, float32, bool) {
+	pack := m.pack(loc)
+	text := pack.prepare(input)
 
 	// Calculate scores for each intent category
-	scheduleScore := m.calculateScore(lower, m.scheduleKeywords)
-	memoScore := m.calculateScore(lower, m.memoKeywords)
-	amazingScore := m.calculateScore(lower, m.amazingKeywords)
+	scheduleScore := m.calculateScore(pack, text, pack.scheduleKeywords)
+	memoScore := m.calculateScore(pack, text, pack.memoKeywords)
+	amazingScore := m.calculateScore(pack, text, pack.amazingKeywords)
 
 	// Time pattern adds score to schedule only if it has core schedule keywords
-	hasTimePattern := m.hasTimePattern(input)
-	hasCoreScheduleKeyword := m.hasCoreKeyword(lower, "schedule")
+	hasTimePattern := pack.hasTimePattern(input)
+	hasCoreScheduleKeyword := m.hasCoreKeyword(pack, text, "schedule")
 	if hasTimePattern && hasCoreScheduleKeyword {
 		scheduleScore += 2
 	}
 
 	// Memo takes priority if it has explicit memo keywords
-	if memoScore >= 3 || (memoScore >= 2 && m.hasCoreKeyword(lower, "memo")) {
-		intent := m.determineMemoIntent(lower)
+	if memoScore >= 3 || (memoScore >= 2 && m.hasCoreKeyword(pack, text, "memo")) {
+		intent := m.determineMemoIntent(pack, text)
 		confidence := m.normalizeConfidence(memoScore, 5)
 		return intent, confidence, true
 	}
 
 	// Schedule needs both high score AND core schedule keyword
 	if scheduleScore >= 3 && hasCoreScheduleKeyword {
-		intent := m.determineScheduleIntent(lower, scheduleScore)
+		intent := m.determineScheduleIntent(pack, text)
 		confidence := m.normalizeConfidence(scheduleScore, 6)
 		return intent, confidence, true
 	}
 
 	// Amazing needs high score AND core amazing keyword (not just "帮我")
-	if amazingScore >= 3 && m.hasCoreKeyword(lower, "amazing") {
+	if amazingScore >= 3 && m.hasCoreKeyword(pack, text, "amazing") {
 		confidence := m.normalizeConfidence(amazingScore, 5)
 		return IntentAmazing, confidence, true
 	}
@@ -110,35 +70,44 @@ func (m *RuleMatcher) Match(input string) (Intent, float32, bool) {
 	return IntentUnknown, 0, false
 }
 
+// pack returns the rule pack of a locale, or the default locale's pack.
+func (m *RuleMatcher) pack(loc locale.Locale) *rulePack {
+	if pack, ok := m.packs[loc]; ok {
+		return pack
+	}
+	return m.packs[locale.Default]
+}
+
 // hasCoreKeyword checks if input contains a core keyword for the given category.
-func (m *RuleMatcher) hasCoreKeyword(input, category string) bool {
-	keywords, ok := coreKeywordsByCategory[category]
+func (m *RuleMatcher) hasCoreKeyword(pack *rulePack, text, category string) bool {
+	keywords, ok := pack.coreKeywords[category]
 	if !ok {
 		return false
 	}
-	for _, kw := range keywords {
-		if strings.Contains(input, kw) {
-			return true
-		}
-	}
-	return false
+	return pack.containsAny(text, keywords)
 }
 
 // calculateScore calculates the weighted score for a keyword set.
-func (m *RuleMatcher) calculateScore(input string, keywords map[string]int) int {
+func (m *RuleMatcher) calculateScore(pack *rulePack, text string, keywords map[string]int) int {
 	score := 0
 	for keyword, weight := range keywords {
-		if strings.Contains(input, keyword) {
+		if pack.contains(text, keyword) {
 			score += weight
 		}
 	}
 	return score
 }
 
-// hasTimePattern checks if input contains time patterns.
+// hasTimePattern checks if input contains time patterns of its detected locale.
 func (m *RuleMatcher) hasTimePattern(input string) bool {
-	for _, pattern := range m.timePatterns {
-		if pattern.MatchString(input) {
+	return m.pack(locale.Resolve("", input)).hasTimePattern(input)
+}
+
+// hasTimePattern checks if input contains time patterns.
+func (p *rulePack) hasTimePattern(input string) bool {
+	lower := strings.ToLower(input)
+	for _, pattern := range p.timePatterns {
+		if pattern.MatchString(lower) {
 			return true
 		}
 	}
@@ -146,50 +115,31 @@ func (m *RuleMatcher) hasTimePattern(input string) bool {
 }
 
 // determineScheduleIntent determines if it's create, query, or update.
-func (m *RuleMatcher) determineScheduleIntent(input string, _ int) Intent {
-	// Update patterns
-	for _, p := range updatePatterns {
-		if strings.Contains(input, p) {
-			return IntentScheduleUpdate
-		}
-	}
-
-	// Query patterns
-	for _, p := range queryPatterns {
-		if strings.Contains(input, p) {
-			return IntentScheduleQuery
-		}
+func (m *RuleMatcher) determineScheduleIntent(pack *rulePack, text string) Intent {
+	switch {
+	case pack.containsAny(text, pack.updatePatterns):
+		return IntentScheduleUpdate
+	case pack.containsAny(text, pack.queryPatterns):
+		return IntentScheduleQuery
+	case pack.containsAny(text, pack.batchPatterns):
+		return IntentBatchSchedule
+	default:
+		// Default to create if time pattern present
+		return IntentScheduleCreate
 	}
-
-	// Batch schedule patterns
-	for _, p := range batchPatterns {
-		if strings.Contains(input, p) {
-			return IntentBatchSchedule
-		}
-	}
-
-	// Default to create if time pattern present
-	return IntentScheduleCreate
 }
 
 // determineMemoIntent determines if it's search or create.
-func (m *RuleMatcher) determineMemoIntent(input string) Intent {
-	// Search patterns
-	for _, p := range searchPatterns {
-		if strings.Contains(input, p) {
-			return IntentMemoSearch
-		}
+func (m *RuleMatcher) determineMemoIntent(pack *rulePack, text string) Intent {
+	switch {
+	case pack.containsAny(text, pack.searchPatterns):
+		return IntentMemoSearch
+	case pack.containsAny(text, pack.createPatterns):
+		return IntentMemoCreate
+	default:
+		// Default to search
+		return IntentMemoSearch
 	}
-
-	// Create patterns
-	for _, p := range createPatterns {
-		if strings.Contains(input, p) {
-			return IntentMemoCreate
-		}
-	}
-
-	// Default to search
-	return IntentMemoSearch
 }
 
 // normalizeConfidence normalizes score to 0-1 confidence range.
@@ -0,0 +1,161 @@
+package router
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hrygo/divinesense/plugin/ai/locale"
+)
+
+// rulePack holds the Layer 1 keyword rules of one locale.
+type rulePack struct {
+	// Keyword weights: +2 for core, +1 for supporting
+	scheduleKeywords map[string]int
+	memoKeywords     map[string]int
+	amazingKeywords  map[string]int
+
+	// coreKeywords by category ("schedule", "memo", "amazing")
+	coreKeywords map[string][]string
+
+	// Time patterns for schedule detection, matched against the lowercased input
+	timePatterns []*regexp.Regexp
+
+	// Sub-intent patterns
+	updatePatterns []string
+	queryPatterns  []string
+	batchPatterns  []string
+	searchPatterns []string
+	createPatterns []string
+
+	// wordBoundary requires keywords to match whole words, for space-delimited languages.
+	wordBoundary bool
+}
+
+// prepare lowercases the input and, for word-boundary packs, reduces it to
+// single-space separated words so that " kw " lookups only hit whole words.
+func (p *rulePack) prepare(input string) string {
+	lower := strings.ToLower(input)
+	if !p.wordBoundary {
+		return lower
+	}
+	words := strings.FieldsFunc(lower, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	})
+	return strings.Join(words, " ")
+}
+
+// contains reports whether the prepared input contains the keyword.
+func (p *rulePack) contains(prepared, keyword string) bool {
+	if !p.wordBoundary {
+		return strings.Contains(prepared, keyword)
+	}
+	return strings.Contains(" "+prepared+" ", " "+keyword+" ")
+}
+
+// containsAny reports whether the prepared input contains any of the keywords.
+func (p *rulePack) containsAny(prepared string, keywords []string) bool {
+	for _, kw := range keywords {
+		if p.contains(prepared, kw) {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultRulePacks returns the rule packs of all supported locales.
+func defaultRulePacks() map[locale.Locale]*rulePack {
+	return map[locale.Locale]*rulePack{
+		locale.Chinese: newChineseRulePack(),
+		locale.English: newEnglishRulePack(),
+	}
+}
+
+func newChineseRulePack() *rulePack {
+	return &rulePack{
+		scheduleKeywords: map[string]int{
+			// Core keywords (+2)
+			"日程": 2, "安排": 2, "会议": 2, "提醒": 2, "预约": 2,
+			"开会": 2, "约会": 2, "设置提醒": 3, "创建日程": 3,
+			// Supporting keywords (+1)
+			"今天": 1, "明天": 1, "后天": 1, "下周": 1, "本周": 1,
+			"上午": 1, "下午": 1, "晚上": 1, "点": 1,
+		},
+		memoKeywords: map[string]int{
+			// Core keywords (+2)
+			"笔记": 2, "搜索": 2, "查找": 2, "记录": 2, "写过": 2,
+			"找": 2, "memo": 2, "查": 2,
+			// Supporting keywords (+1)
+			"关于": 1, "提到": 1, "之前": 1, "有关": 1, "记": 1,
+		},
+		amazingKeywords: map[string]int{
+			// Core keywords (+2)
+			"综合": 2, "总结": 2, "分析": 2, "周报": 2, "帮我": 2,
+			"怎么": 2, "什么": 2, "为什么": 2,
+			// Supporting keywords (+1)
+			"本周": 1, "工作": 1, "解释": 1, "说说": 1,
+		},
+		coreKeywords: map[string][]string{
+			"schedule": {"日程", "安排", "会议", "提醒", "预约", "开会"},
+			"memo":     {"笔记", "搜索", "查找", "记录", "memo"},
+			"amazing":  {"综合", "总结", "分析", "周报"},
+		},
+		timePatterns: []*regexp.Regexp{
+			regexp.MustCompile(`\d{1,2}[:\s时点]\d{0,2}`),       // 10:30, 10点, 10时30
+			regexp.MustCompile(`(上午|下午|晚上|早上|中午)\d{1,2}[点时]`), // 下午3点
+			regexp.MustCompile(`(明天|后天|今天|下周|本周)`),            // Relative dates
+			regexp.MustCompile(`\d{1,2}月\d{1,2}[日号]`),         // 1月15日
+		},
+		updatePatterns: []string{"修改", "更新", "取消", "改", "删除"},
+		queryPatterns:  []string{"查看", "有什么", "哪些", "看看", "什么安排", "有没有"},
+		batchPatterns:  []string{"批量", "多个", "一系列", "每天", "每周"},
+		searchPatterns: []string{"搜索", "查找", "找", "查", "有什么", "哪些"},
+		createPatterns: []string{"记录", "记一下", "写", "保存", "创建"},
+	}
+}
+
+func newEnglishRulePack() *rulePack {
+	return &rulePack{
+		scheduleKeywords: map[string]int{
+			// Core keywords (+2)
+			"schedule": 2, "meeting": 2, "meetings": 2, "appointment": 2, "appointments": 2,
+			"remind": 2, "reminder": 2, "calendar": 2, "event": 2, "events": 2,
+			"remind me": 3, "set a reminder": 3,
+			// Supporting keywords (+1)
+			"today": 1, "tomorrow": 1, "tonight": 1, "next week": 1, "this week": 1,
+			"morning": 1, "afternoon": 1, "evening": 1, "noon": 1,
+			"monday": 1, "tuesday": 1, "wednesday": 1, "thursday": 1, "friday": 1, "saturday": 1, "sunday": 1,
+		},
+		memoKeywords: map[string]int{
+			// Core keywords (+2)
+			"note": 2, "notes": 2, "memo": 2, "memos": 2, "search": 2,
+			"find": 2, "look up": 2, "wrote": 2, "jot": 2,
+			// Supporting keywords (+1)
+			"about": 1, "mentioned": 1, "previously": 1, "earlier": 1, "regarding": 1,
+		},
+		amazingKeywords: map[string]int{
+			// Core keywords (+2)
+			"summarize": 2, "summary": 2, "analyze": 2, "analysis": 2, "overview": 2,
+			"weekly report": 2, "help me": 2, "how": 2, "what": 2, "why": 2,
+			// Supporting keywords (+1)
+			"this week": 1, "work": 1, "explain": 1, "tell me": 1,
+		},
+		coreKeywords: map[string][]string{
+			"schedule": {"schedule", "meeting", "meetings", "appointment", "appointments", "remind", "reminder", "calendar", "event", "events"},
+			"memo":     {"note", "notes", "memo", "memos", "search", "find", "look up"},
+			"amazing":  {"summarize", "summary", "analyze", "analysis", "overview", "weekly report"},
+		},
+		timePatterns: []*regexp.Regexp{
+			regexp.MustCompile(`\b\d{1,2}(:\d{2})?\s*(am|pm|a\.m\.|p\.m\.)`),                             // 3pm, 10:30 am
+			regexp.MustCompile(`\b\d{1,2}:\d{2}\b`),                                                      // 15:00
+			regexp.MustCompile(`\b(today|tomorrow|tonight|noon|midnight|next week|this week)\b`),         // Relative dates
+			regexp.MustCompile(`\b(next|this|on)\s+(mon|tues|wednes|thurs|fri|satur|sun)day\b`),          // next Tuesday
+			regexp.MustCompile(`\b(jan|feb|mar|apr|may|jun|jul|aug|sep|sept|oct|nov|dec)[a-z]*\.?\s+\d`), // Jan 15
+		},
+		updatePatterns: []string{"cancel", "reschedule", "move", "change", "update", "delete", "postpone", "edit"},
+		queryPatterns:  []string{"what", "do i have", "show", "list", "check", "view", "upcoming", "any"},
+		batchPatterns:  []string{"every day", "every week", "daily", "weekly", "every", "each", "recurring", "batch", "multiple"},
+		searchPatterns: []string{"search", "find", "look up", "look for", "what", "which", "where"},
+		createPatterns: []string{"write down", "note down", "jot", "save", "record", "create", "add", "take a note", "remember"},
+		wordBoundary:   true,
+	}
+}
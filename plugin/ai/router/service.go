@@ -6,7 +6,10 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/plugin/ai/locale"
 	"github.com/hrygo/divinesense/plugin/ai/memory"
+	"github.com/hrygo/divinesense/plugin/ai/vector"
 )
 
 // Service implements the three-layer RouterService.
@@ -24,13 +27,21 @@ type Service struct {
 type Config struct {
 	MemoryService memory.MemoryService
 	LLMClient     LLMClient
+
+	// Optional: enable semantic history matching over embedded episodes
+	VectorService    vector.VectorService
+	EmbeddingService ai.EmbeddingService
 }
 
 // NewService creates a new router service.
 func NewService(cfg Config) *Service {
+	historyMatcher := NewHistoryMatcher(cfg.MemoryService)
+	if cfg.VectorService != nil && cfg.EmbeddingService != nil {
+		historyMatcher.SetVectorService(cfg.VectorService, cfg.EmbeddingService)
+	}
 	return &Service{
 		ruleMatcher:    NewRuleMatcher(),
-		historyMatcher: NewHistoryMatcher(cfg.MemoryService),
+		historyMatcher: historyMatcher,
 		llmClassifier:  NewLLMClassifier(cfg.LLMClient),
 		memoryService:  cfg.MemoryService,
 	}
@@ -38,12 +49,50 @@ func NewService(cfg Config) *Service {
 
 // ClassifyIntent classifies user intent from input text.
 // Returns: intent type, confidence (0-1), error
-// Implementation: rule-based first (0ms) -> history match (~10ms) -> LLM fallback (~400ms)
+// Implementation: user corrections -> rule-based (0ms) -> history match (~10ms) -> LLM fallback (~400ms)
 func (s *Service) ClassifyIntent(ctx context.Context, input string) (Intent, float32, error) {
 	start := time.Now()
+	userID := getUserIDFromContext(ctx)
+
+	// Layer 0: User corrections of past routing decisions
+	var rejected AgentType
+	if userID > 0 && s.historyMatcher != nil {
+		correction, err := s.historyMatcher.MatchCorrection(ctx, userID, input)
+		if err != nil {
+			slog.Debug("correction matcher error", "error", err)
+		} else if correction.Matched {
+			if correction.Intent != "" && correction.Intent != IntentUnknown {
+				slog.Debug("intent classified by user correction",
+					"input", truncate(input, 50),
+					"intent", correction.Intent,
+					"confidence", correction.Confidence,
+					"latency_ms", time.Since(start).Milliseconds())
+				return correction.Intent, correction.Confidence, nil
+			}
+			rejected = correction.Rejected
+		}
+	}
+
+	intent, confidence, err := s.classify(ctx, userID, input, start)
+	if err != nil {
+		return intent, confidence, err
+	}
 
+	// The user flagged this assistant as wrong for similar input; fall back to the generalist
+	if rejected != "" && rejected != AgentTypeAmazing && IntentToAgentType(intent) == rejected {
+		slog.Debug("classified intent rejected by user correction",
+			"input", truncate(input, 50),
+			"intent", intent,
+			"rejected", rejected)
+		return IntentAmazing, confidence, nil
+	}
+	return intent, confidence, nil
+}
+
+// classify runs the rule, history and LLM layers.
+func (s *Service) classify(ctx context.Context, userID int32, input string, start time.Time) (Intent, float32, error) {
 	// Layer 1: Rule-based matching
-	intent, confidence, matched := s.ruleMatcher.Match(input)
+	intent, confidence, matched := s.ruleMatcher.MatchLocale(input, locale.ForText(ctx, input))
 	if matched {
 		slog.Debug("intent classified by rule matcher",
 			"input", truncate(input, 50),
@@ -54,7 +103,6 @@ func (s *Service) ClassifyIntent(ctx context.Context, input string) (Intent, flo
 	}
 
 	// Layer 2: History matching (requires userID from context)
-	userID := getUserIDFromContext(ctx)
 	if userID > 0 && s.historyMatcher != nil {
 		result, err := s.historyMatcher.Match(ctx, userID, input)
 		if err != nil {
@@ -108,6 +156,16 @@ func (s *Service) ClassifyIntent(ctx context.Context, input string) (Intent, flo
 	return IntentUnknown, 0, nil
 }
 
+// RecordCorrection stores a user correction of a routing decision.
+// corrected is the assistant the user picked, or AgentTypeUnknown if the user
+// only flagged the predicted assistant as wrong.
+func (s *Service) RecordCorrection(ctx context.Context, userID int32, input string, predicted Intent, corrected AgentType) error {
+	if userID <= 0 || s.historyMatcher == nil {
+		return nil
+	}
+	return s.historyMatcher.SaveCorrection(ctx, userID, input, predicted, corrected)
+}
+
 // SelectModel selects an appropriate model based on task type.
 // Returns: model configuration (local/cloud)
 func (s *Service) SelectModel(ctx context.Context, task TaskType) (ModelConfig, error) {
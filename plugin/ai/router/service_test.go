@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/plugin/ai/memory"
 )
 
 func TestRuleMatcher_ScheduleIntent(t *testing.T) {
@@ -53,6 +55,41 @@ func TestRuleMatcher_ScheduleIntent(t *testing.T) {
 			shouldMatch:    true,
 			minConfidence:  0.6,
 		},
+		{
+			name:           "English schedule create with time",
+			input:          "Schedule a meeting with Bob next Tuesday at 3pm",
+			expectedIntent: IntentScheduleCreate,
+			shouldMatch:    true,
+			minConfidence:  0.8,
+		},
+		{
+			name:           "English reminder",
+			input:          "Remind me to call mom tomorrow at 9am",
+			expectedIntent: IntentScheduleCreate,
+			shouldMatch:    true,
+			minConfidence:  0.8,
+		},
+		{
+			name:           "English schedule query",
+			input:          "What's on my calendar tomorrow?",
+			expectedIntent: IntentScheduleQuery,
+			shouldMatch:    true,
+			minConfidence:  0.5,
+		},
+		{
+			name:           "English schedule update",
+			input:          "Cancel tomorrow's meeting",
+			expectedIntent: IntentScheduleUpdate,
+			shouldMatch:    true,
+			minConfidence:  0.5,
+		},
+		{
+			name:           "English batch schedule",
+			input:          "Set up a standup meeting every weekday morning at 10:00",
+			expectedIntent: IntentBatchSchedule,
+			shouldMatch:    true,
+			minConfidence:  0.6,
+		},
 	}
 
 	for _, tt := range tests {
@@ -94,6 +131,18 @@ func TestRuleMatcher_MemoIntent(t *testing.T) {
 			expectedIntent: IntentMemoCreate,
 			shouldMatch:    true,
 		},
+		{
+			name:           "English memo search",
+			input:          "Find my notes about Kubernetes",
+			expectedIntent: IntentMemoSearch,
+			shouldMatch:    true,
+		},
+		{
+			name:           "English memo create",
+			input:          "Take a note: the launch moved to Q3",
+			expectedIntent: IntentMemoCreate,
+			shouldMatch:    true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -130,6 +179,16 @@ func TestRuleMatcher_AmazingIntent(t *testing.T) {
 			input:       "综合总结一下项目情况",
 			shouldMatch: true,
 		},
+		{
+			name:        "English summary",
+			input:       "Summarize my work this week",
+			shouldMatch: true,
+		},
+		{
+			name:        "English analysis",
+			input:       "Help me analyze the project risks",
+			shouldMatch: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -147,9 +206,11 @@ func TestRuleMatcher_NoMatch(t *testing.T) {
 	matcher := NewRuleMatcher()
 
 	tests := []string{
-		"hi", // Too short
-		"你好", // Simple greeting
-		"ok", // Simple response
+		"hi",                  // Too short
+		"你好",                  // Simple greeting
+		"ok",                  // Simple response
+		"thanks for the help", // English small talk
+		"Notepad is great",    // Keyword inside a longer word
 	}
 
 	for _, input := range tests {
@@ -185,6 +246,16 @@ func TestService_ClassifyIntent_Layer1Only(t *testing.T) {
 			input:          "你好",
 			expectedIntent: IntentUnknown,
 		},
+		{
+			name:           "English schedule create",
+			input:          "Book an appointment with the dentist on Friday at 4pm",
+			expectedIntent: IntentScheduleCreate,
+		},
+		{
+			name:           "English memo search",
+			input:          "search my notes about Go generics",
+			expectedIntent: IntentMemoSearch,
+		},
 	}
 
 	for _, tt := range tests {
@@ -329,6 +400,13 @@ func TestRuleMatcher_TimePatterns(t *testing.T) {
 		{"明天下午", true},
 		{"帮我看看", false},
 		{"搜索笔记", false},
+		{"meeting at 3pm", true},
+		{"lunch at 12:30", true},
+		{"sync next Tuesday", true},
+		{"dentist on Jan 15", true},
+		{"tomorrow", true},
+		{"find my notes", false},
+		{"I am here", false},
 	}
 
 	for _, tt := range tests {
@@ -340,6 +418,26 @@ func TestRuleMatcher_TimePatterns(t *testing.T) {
 }
 
 // Benchmark tests
+func TestHistoryMatcher_Match_RoutingDecisionsOnly(t *testing.T) {
+	mem := memory.NewMockMemoryService()
+	matcher := NewHistoryMatcher(mem)
+	ctx := context.Background()
+
+	// Accepted suggestion feedback is a successful episode, but not a routing decision
+	require.NoError(t, mem.SaveEpisode(ctx, memory.EpisodicMemory{
+		UserID: 42, AgentType: "suggestion", UserInput: "整理本周的读书笔记", Outcome: "success", Summary: "review-notes",
+	}))
+	result, err := matcher.Match(ctx, 42, "整理本周的读书笔记")
+	require.NoError(t, err)
+	assert.False(t, result.Matched)
+
+	require.NoError(t, matcher.SaveDecision(ctx, 42, "整理本周的读书笔记", IntentMemoCreate, true))
+	result, err = matcher.Match(ctx, 42, "整理本周的读书笔记")
+	require.NoError(t, err)
+	assert.True(t, result.Matched)
+	assert.Equal(t, IntentMemoCreate, result.Intent)
+}
+
 func BenchmarkRuleMatcher_Match(b *testing.B) {
 	matcher := NewRuleMatcher()
 	input := "明天下午3点开会"
@@ -360,3 +458,39 @@ func BenchmarkHistoryMatcher_Similarity(b *testing.B) {
 		matcher.calculateLexicalSimilarity(a, c)
 	}
 }
+
+func TestService_ClassifyIntent_Corrections(t *testing.T) {
+	mem := memory.NewMockMemoryService()
+	svc := NewService(Config{MemoryService: mem})
+	ctx := WithUserID(context.Background(), 42)
+
+	// Rules route this to schedule creation
+	intent, _, err := svc.ClassifyIntent(ctx, "明天下午3点开会")
+	require.NoError(t, err)
+	assert.Equal(t, IntentScheduleCreate, intent)
+
+	// Flagged as wrong without a better choice: fall back to the generalist
+	require.NoError(t, svc.RecordCorrection(ctx, 42, "明天下午3点开会", IntentScheduleCreate, AgentTypeUnknown))
+	intent, _, err = svc.ClassifyIntent(ctx, "明天下午3点开会")
+	require.NoError(t, err)
+	assert.Equal(t, IntentAmazing, intent)
+
+	// An explicit override takes precedence over rules
+	require.NoError(t, svc.RecordCorrection(ctx, 42, "明天下午3点开会", IntentScheduleCreate, AgentTypeMemo))
+	intent, _, err = svc.ClassifyIntent(ctx, "明天下午3点开会")
+	require.NoError(t, err)
+	assert.Equal(t, IntentMemoCreate, intent)
+
+	// Corrections are per user
+	intent, _, err = svc.ClassifyIntent(WithUserID(context.Background(), 7), "明天下午3点开会")
+	require.NoError(t, err)
+	assert.Equal(t, IntentScheduleCreate, intent)
+
+	userID := int32(42)
+	corrections, err := mem.ListRoutingCorrections(ctx, &userID, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []RoutingConfusion{
+		{Predicted: IntentScheduleCreate, Corrected: AgentTypeMemo, Count: 1},
+		{Predicted: IntentScheduleCreate, Corrected: AgentTypeUnknown, Count: 1},
+	}, BuildConfusionReport(corrections))
+}
@@ -72,6 +72,12 @@ func (p *FastCreateParser) Parse(ctx context.Context, userID int32, input string
 		result.MissingFields = append(result.MissingFields, "time")
 		return result, nil
 	}
+	// A vague period ("下午开会") takes the user's usual time in it.
+	if p.habitApplier != nil && !explicitClockPattern.MatchString(input) {
+		if inferred := p.habitApplier.InferTime(ctx, userID, input, parsedTime); !inferred.IsZero() {
+			parsedTime = inferred
+		}
+	}
 	result.Schedule.StartTime = parsedTime
 
 	// Step 3: Title extraction
@@ -100,7 +106,7 @@ func (p *FastCreateParser) extractTime(ctx context.Context, input string) (time.
 	}
 
 	// Use ParseNaturalTime to get a time range
-	tr, err := p.timeService.ParseNaturalTime(ctx, input, time.Now())
+	tr, err := p.timeService.ParseNaturalTime(ctx, input, time.Now().In(p.timeService.Location()))
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -146,6 +152,9 @@ func (p *FastCreateParser) applyDefaults(ctx context.Context, userID int32, sche
 	}
 }
 
+// explicitClockPattern matches an explicit clock time such as "3点", "15:30" or "9am".
+var explicitClockPattern = regexp.MustCompile(`(?i)\d{1,2}\s*[点时:：]|[一二三四五六七八九十两]+[点时]|\d{1,2}\s*[ap]\.?m\b`)
+
 // Time patterns for removal during title extraction.
 var timeRemovalPatterns = []*regexp.Regexp{
 	regexp.MustCompile(`今天|明天|后天|大后天`),
@@ -28,6 +28,9 @@ func (m *mockMemoryService) SaveEpisode(ctx context.Context, episode memory.Epis
 func (m *mockMemoryService) SearchEpisodes(ctx context.Context, userID int32, query string, limit int) ([]memory.EpisodicMemory, error) {
 	return nil, nil
 }
+func (m *mockMemoryService) ListRoutingCorrections(ctx context.Context, userID *int32, limit int) ([]memory.EpisodicMemory, error) {
+	return nil, nil
+}
 func (m *mockMemoryService) ListActiveUserIDs(ctx context.Context, lookbackDays int) ([]int32, error) {
 	return nil, nil
 }
@@ -203,6 +206,47 @@ func TestFastCreateParser_Parse(t *testing.T) {
 	}
 }
 
+func TestFastCreateParser_ParseHabitTime(t *testing.T) {
+	timeSvc := aitime.NewService("Asia/Shanghai")
+	mockMem := &mockMemoryService{
+		preferences: &memory.UserPreferences{
+			DefaultDuration: 45,
+			PreferredTimes:  []string{"15:30"},
+		},
+	}
+	parser := NewFastCreateParser(timeSvc, habit.NewHabitApplier(mockMem))
+
+	loc := timeSvc.Location()
+	now := time.Now().In(loc)
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, loc)
+
+	tests := []struct {
+		input     string
+		wantStart time.Time
+	}{
+		{"明天下午开会", tomorrow.Add(15*time.Hour + 30*time.Minute)},
+		{"明天下午3点开会", tomorrow.Add(15 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parser.Parse(context.Background(), 1, tt.input)
+			if err != nil {
+				t.Fatalf("Parse error: %v", err)
+			}
+			if !result.Schedule.StartTime.Equal(tt.wantStart) {
+				t.Errorf("StartTime = %v, want %v", result.Schedule.StartTime, tt.wantStart)
+			}
+			if result.Schedule.Duration != 45 {
+				t.Errorf("Duration = %d, want 45", result.Schedule.Duration)
+			}
+			if want := tt.wantStart.Add(45 * time.Minute); !result.Schedule.EndTime.Equal(want) {
+				t.Errorf("EndTime = %v, want %v", result.Schedule.EndTime, want)
+			}
+		})
+	}
+}
+
 func TestFastCreateHandler_Handle(t *testing.T) {
 	timeSvc := aitime.NewService("Asia/Shanghai")
 	parser := NewFastCreateParser(timeSvc, nil)
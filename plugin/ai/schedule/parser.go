@@ -10,6 +10,7 @@ import (
 	"log/slog"
 
 	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/plugin/ai/locale"
 	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
 )
 
@@ -75,6 +76,38 @@ func (p *Parser) Parse(ctx context.Context, text string) (*ParseResult, error) {
 	return p.parseWithLLM(ctx, text)
 }
 
+// englishPromptExamples supplements the Chinese-centric examples for English input.
+const englishPromptExamples = `
+====================================
+ENGLISH INPUT
+====================================
+The user writes in English. Keep the title in English.
+
+Examples (assuming current time is 2026-01-21 Wednesday 09:00):
+- "next Tuesday at 3pm" -> 2026-01-27 15:00:00 local (Tuesday of next week)
+- "this Friday morning" -> 2026-01-23 09:00:00 local
+- "in two weeks" -> 2026-02-04, same time of day if none is given
+- "end of month" -> 2026-01-31 09:00:00 local
+- "tomorrow 2-4pm" -> start 14:00:00, end 16:00:00 local
+- "at noon" -> 12:00:00, "at midnight" -> 00:00:00, "tonight" -> 20:00:00
+- A bare hour from 1 to 6 without am/pm ("at 3") means the afternoon
+
+Recurrence:
+- "every day" / "daily" -> {"type": "daily", "interval": 1}
+- "every Monday" -> {"type": "weekly", "interval": 1, "weekdays": [1]}
+- "every other week" -> {"type": "weekly", "interval": 2, "weekdays": [1,2,3,4,5]}
+- "every weekday" -> {"type": "weekly", "interval": 1, "weekdays": [1,2,3,4,5]}
+- "on the 15th of every month" -> {"type": "monthly", "interval": 1, "month_day": 15}
+
+Reminders:
+- "remind me 10 minutes before" -> {"type": "before", "value": 10, "unit": "minutes"}
+- "a day ahead" -> {"type": "before", "value": 1, "unit": "days"}
+
+Title examples:
+- "Team sync next Tuesday at 3pm" -> title = "Team sync"
+- "Dentist appointment tomorrow at 9am" -> title = "Dentist appointment"
+`
+
 // llmScheduleResponse is the intermediate JSON structure for LLM output
 type llmScheduleResponse struct {
 	Title       string           `json:"title"`
@@ -253,6 +286,10 @@ Before returning, verify:
 8. All JSON syntax is valid
 `, nowUTC.Format("2006-01-02 15:04:05"), now.Format("2006-01-02 15:04:05"), p.location.String(), now.Format("2006-01-02 15:04:05"), now.Format("2006-01-02"))
 
+	if locale.ForText(ctx, text) == locale.English {
+		systemPrompt += englishPromptExamples
+	}
+
 	userPrompt := fmt.Sprintf("User Input: %s", text)
 
 	response, err := p.llmService.Chat(ctx, []ai.Message{
@@ -2,13 +2,13 @@ package session
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/hrygo/divinesense/plugin/ai/cache"
+	"github.com/hrygo/divinesense/store"
 )
 
 const (
@@ -16,16 +16,25 @@ const (
 	cacheTTL    = 30 * time.Minute
 )
 
-// sessionStore implements SessionService with PostgreSQL persistence and caching.
+// contextData is the JSON stored in conversation_context.context_data.
+type contextData struct {
+	Messages []Message      `json:"messages"`
+	Metadata map[string]any `json:"metadata"`
+}
+
+// sessionStore implements SessionService with database persistence and caching.
+// It works with both the PostgreSQL and SQLite drivers.
 type sessionStore struct {
-	db    *sql.DB
+	store *store.Store
 	cache cache.CacheService
 }
 
 // NewSessionStore creates a new session store with database and cache.
-func NewSessionStore(db *sql.DB, cache cache.CacheService) SessionService {
+// The cache is optional; leave it nil when several server instances share
+// sessions, as a per-instance cache would serve stale contexts.
+func NewSessionStore(st *store.Store, cache cache.CacheService) SessionService {
 	return &sessionStore{
-		db:    db,
+		store: st,
 		cache: cache,
 	}
 }
@@ -41,33 +50,22 @@ func (s *sessionStore) SaveContext(ctx context.Context, sessionID string, contex
 	context.SessionID = sessionID
 
 	// Serialize context data (messages + metadata)
-	contextData := struct {
-		Messages []Message      `json:"messages"`
-		Metadata map[string]any `json:"metadata"`
-	}{
+	data, err := json.Marshal(contextData{
 		Messages: context.Messages,
 		Metadata: context.Metadata,
-	}
-
-	data, err := json.Marshal(contextData)
+	})
 	if err != nil {
 		return fmt.Errorf("failed to marshal context: %w", err)
 	}
 
-	// Upsert to database
-	query := `
-		INSERT INTO conversation_context (session_id, user_id, agent_type, context_data, created_ts, updated_ts)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		ON CONFLICT (session_id) 
-		DO UPDATE SET 
-			agent_type = EXCLUDED.agent_type,
-			context_data = EXCLUDED.context_data,
-			updated_ts = EXCLUDED.updated_ts
-	`
-
-	_, err = s.db.ExecContext(ctx, query,
-		sessionID, context.UserID, context.AgentType, data, context.CreatedAt, context.UpdatedAt)
-	if err != nil {
+	if _, err := s.store.UpsertConversationContext(ctx, &store.UpsertConversationContext{
+		SessionID:   sessionID,
+		UserID:      context.UserID,
+		AgentType:   context.AgentType,
+		ContextData: string(data),
+		CreatedTs:   context.CreatedAt,
+		UpdatedTs:   context.UpdatedAt,
+	}); err != nil {
 		return fmt.Errorf("failed to save context: %w", err)
 	}
 
@@ -84,112 +82,73 @@ func (s *sessionStore) LoadContext(ctx context.Context, sessionID string) (*Conv
 		return cached, nil
 	}
 
-	// Query database
-	query := `
-		SELECT session_id, user_id, agent_type, context_data, created_ts, updated_ts
-		FROM conversation_context 
-		WHERE session_id = $1
-	`
-
-	var (
-		result    ConversationContext
-		data      []byte
-		agentType string
-	)
-
-	err := s.db.QueryRowContext(ctx, query, sessionID).Scan(
-		&result.SessionID, &result.UserID, &agentType, &data, &result.CreatedAt, &result.UpdatedAt,
-	)
-	if err == sql.ErrNoRows {
-		return nil, nil // New session
-	}
+	row, err := s.store.GetConversationContext(ctx, &store.FindConversationContext{SessionID: &sessionID})
 	if err != nil {
 		return nil, fmt.Errorf("failed to load context: %w", err)
 	}
+	if row == nil {
+		return nil, nil // New session
+	}
 
-	result.AgentType = agentType
+	result := &ConversationContext{
+		SessionID: row.SessionID,
+		UserID:    row.UserID,
+		AgentType: row.AgentType,
+		CreatedAt: row.CreatedTs,
+		UpdatedAt: row.UpdatedTs,
+	}
 
 	// Deserialize context data
-	var contextData struct {
-		Messages []Message      `json:"messages"`
-		Metadata map[string]any `json:"metadata"`
-	}
-	if err := json.Unmarshal(data, &contextData); err != nil {
+	var data contextData
+	if err := json.Unmarshal([]byte(row.ContextData), &data); err != nil {
 		slog.Warn("failed to unmarshal context data", "session_id", sessionID, "error", err)
 		// Return with empty messages/metadata rather than failing
 		result.Messages = []Message{}
 		result.Metadata = map[string]any{}
 	} else {
-		result.Messages = contextData.Messages
-		result.Metadata = contextData.Metadata
+		result.Messages = data.Messages
+		result.Metadata = data.Metadata
 	}
 
 	// Update cache
-	s.updateCache(ctx, sessionID, &result)
+	s.updateCache(ctx, sessionID, result)
 
-	return &result, nil
+	return result, nil
 }
 
 // ListSessions lists user sessions.
 func (s *sessionStore) ListSessions(ctx context.Context, userID int32, limit int) ([]SessionSummary, error) {
-	query := `
-		SELECT session_id, agent_type, context_data, updated_ts
-		FROM conversation_context 
-		WHERE user_id = $1
-		ORDER BY updated_ts DESC
-		LIMIT $2
-	`
-
-	rows, err := s.db.QueryContext(ctx, query, userID, limit)
+	rows, err := s.store.ListConversationContexts(ctx, &store.FindConversationContext{
+		UserID: &userID,
+		Limit:  limit,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list sessions: %w", err)
 	}
-	defer rows.Close()
-
-	var summaries []SessionSummary
-	for rows.Next() {
-		var (
-			sessionID string
-			agentType string
-			data      []byte
-			updatedAt int64
-		)
-
-		if err := rows.Scan(&sessionID, &agentType, &data, &updatedAt); err != nil {
-			slog.Warn("failed to scan session row", "error", err)
-			continue
-		}
 
+	summaries := make([]SessionSummary, 0, len(rows))
+	for _, row := range rows {
 		// Extract last message
-		var contextData struct {
-			Messages []Message `json:"messages"`
-		}
+		var data contextData
 		var lastMessage string
-		if err := json.Unmarshal(data, &contextData); err == nil && len(contextData.Messages) > 0 {
-			lastMessage = contextData.Messages[len(contextData.Messages)-1].Content
+		if err := json.Unmarshal([]byte(row.ContextData), &data); err == nil && len(data.Messages) > 0 {
+			lastMessage = data.Messages[len(data.Messages)-1].Content
 		}
 
 		summaries = append(summaries, SessionSummary{
-			SessionID:   sessionID,
-			AgentType:   agentType,
+			SessionID:   row.SessionID,
+			AgentType:   row.AgentType,
 			LastMessage: lastMessage,
-			UpdatedAt:   updatedAt,
+			UpdatedAt:   row.UpdatedTs,
 		})
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("failed to iterate sessions: %w", err)
-	}
-
 	return summaries, nil
 }
 
 // DeleteSession deletes a session.
 func (s *sessionStore) DeleteSession(ctx context.Context, sessionID string) error {
-	query := `DELETE FROM conversation_context WHERE session_id = $1`
-
-	_, err := s.db.ExecContext(ctx, query, sessionID)
-	if err != nil {
+	if _, err := s.store.DeleteConversationContexts(ctx, &store.DeleteConversationContext{SessionID: &sessionID}); err != nil {
 		return fmt.Errorf("failed to delete session: %w", err)
 	}
 
@@ -203,14 +162,12 @@ func (s *sessionStore) DeleteSession(ctx context.Context, sessionID string) erro
 func (s *sessionStore) CleanupExpired(ctx context.Context, retentionDays int) (int64, error) {
 	cutoff := time.Now().AddDate(0, 0, -retentionDays).Unix()
 
-	query := `DELETE FROM conversation_context WHERE updated_ts < $1`
-
-	result, err := s.db.ExecContext(ctx, query, cutoff)
+	deleted, err := s.store.DeleteConversationContexts(ctx, &store.DeleteConversationContext{UpdatedBefore: &cutoff})
 	if err != nil {
 		return 0, fmt.Errorf("failed to cleanup expired sessions: %w", err)
 	}
 
-	return result.RowsAffected()
+	return deleted, nil
 }
 
 // updateCache stores context in cache.
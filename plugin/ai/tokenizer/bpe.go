@@ -0,0 +1,273 @@
+package tokenizer
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// BPE is a byte-level byte pair encoding tokenizer using tiktoken rank files.
+// Text is split with the cl100k pre-tokenization rules, then each piece is
+// merged pairwise by rank.
+type BPE struct {
+	name    string
+	ranks   map[string]int
+	decoder map[int]string
+}
+
+// NewBPE creates a BPE tokenizer from token ranks.
+// Every single byte must have a rank so that any input can be encoded.
+func NewBPE(name string, ranks map[string]int) (*BPE, error) {
+	for b := 0; b < 256; b++ {
+		if _, ok := ranks[string([]byte{byte(b)})]; !ok {
+			return nil, fmt.Errorf("vocabulary %s has no rank for byte 0x%02x", name, b)
+		}
+	}
+	decoder := make(map[int]string, len(ranks))
+	for token, rank := range ranks {
+		decoder[rank] = token
+	}
+	return &BPE{
+		name:    name,
+		ranks:   ranks,
+		decoder: decoder,
+	}, nil
+}
+
+// LoadBPE reads a tiktoken rank file, one "<base64 token> <rank>" per line.
+func LoadBPE(name string, r io.Reader) (*BPE, error) {
+	ranks := make(map[string]int)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		encoded, rankText, ok := strings.Cut(line, " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid vocabulary line %q", line)
+		}
+		token, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token %q: %w", encoded, err)
+		}
+		rank, err := strconv.Atoi(rankText)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rank %q: %w", rankText, err)
+		}
+		ranks[string(token)] = rank
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewBPE(name, ranks)
+}
+
+// Name returns the encoding name.
+func (b *BPE) Name() string {
+	return b.name
+}
+
+// Encode returns the token IDs of text.
+func (b *BPE) Encode(text string) []int {
+	var ids []int
+	for _, piece := range splitPieces(text) {
+		ids = b.encodePiece(piece, ids)
+	}
+	return ids
+}
+
+// Decode returns the text of token IDs. Unknown IDs are skipped.
+func (b *BPE) Decode(ids []int) string {
+	var sb strings.Builder
+	for _, id := range ids {
+		sb.WriteString(b.decoder[id])
+	}
+	return sb.String()
+}
+
+// CountTokens returns the number of tokens in text.
+func (b *BPE) CountTokens(text string) int {
+	return len(b.Encode(text))
+}
+
+// Truncate returns the longest prefix of text that fits in maxTokens.
+// A multi-byte character split across the cut is dropped.
+func (b *BPE) Truncate(text string, maxTokens int) string {
+	if maxTokens <= 0 {
+		return ""
+	}
+	ids := b.Encode(text)
+	if len(ids) <= maxTokens {
+		return text
+	}
+	prefix := b.Decode(ids[:maxTokens])
+	for len(prefix) > 0 {
+		r, size := utf8.DecodeLastRuneInString(prefix)
+		if r != utf8.RuneError || size > 1 {
+			break
+		}
+		prefix = prefix[:len(prefix)-size]
+	}
+	return prefix
+}
+
+// encodePiece appends the tokens of one pre-tokenized piece to ids.
+func (b *BPE) encodePiece(piece string, ids []int) []int {
+	if id, ok := b.ranks[piece]; ok {
+		return append(ids, id)
+	}
+
+	// parts[i] starts a token; rank is the rank of merging it with the next part
+	type part struct {
+		start int
+		rank  int
+	}
+	parts := make([]part, len(piece)+1)
+	for i := range parts {
+		parts[i] = part{start: i, rank: math.MaxInt}
+	}
+	pairRank := func(i int) int {
+		if i+2 < len(parts) {
+			if rank, ok := b.ranks[piece[parts[i].start:parts[i+2].start]]; ok {
+				return rank
+			}
+		}
+		return math.MaxInt
+	}
+	for i := 0; i+2 < len(parts); i++ {
+		parts[i].rank = pairRank(i)
+	}
+
+	for len(parts) > 2 {
+		minIdx, minRank := -1, math.MaxInt
+		for i := 0; i+1 < len(parts); i++ {
+			if parts[i].rank < minRank {
+				minIdx, minRank = i, parts[i].rank
+			}
+		}
+		if minIdx < 0 {
+			break
+		}
+		parts = append(parts[:minIdx+1], parts[minIdx+2:]...)
+		parts[minIdx].rank = pairRank(minIdx)
+		if minIdx > 0 {
+			parts[minIdx-1].rank = pairRank(minIdx - 1)
+		}
+	}
+
+	for i := 0; i+1 < len(parts); i++ {
+		ids = append(ids, b.ranks[piece[parts[i].start:parts[i+1].start]])
+	}
+	return ids
+}
+
+// splitPieces splits text following the cl100k pre-tokenization pattern:
+//
+//	(?i:'s|'t|'re|'ve|'m|'ll|'d)|[^\r\n\p{L}\p{N}]?\p{L}+|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n]*|\s*[\r\n]+|\s+(?!\S)|\s+
+//
+// Go's regexp has no lookahead, so the alternatives are matched by hand in order.
+func splitPieces(text string) []string {
+	runes := []rune(text)
+	pieces := make([]string, 0, len(runes)/3+1)
+	for i := 0; i < len(runes); {
+		n := matchPiece(runes, i)
+		pieces = append(pieces, string(runes[i:i+n]))
+		i += n
+	}
+	return pieces
+}
+
+// matchPiece returns the length of the piece starting at runes[i].
+func matchPiece(runes []rune, i int) int {
+	r := runes[i]
+	at := func(j int) rune {
+		if j < len(runes) {
+			return runes[j]
+		}
+		return -1
+	}
+
+	// 's 't 're 've 'm 'll 'd
+	if r == '\'' {
+		next := unicode.ToLower(at(i + 1))
+		switch next {
+		case 's', 't', 'm', 'd':
+			return 2
+		case 'r', 'v', 'l':
+			second := unicode.ToLower(at(i + 2))
+			if (next == 'r' || next == 'v') && second == 'e' || next == 'l' && second == 'l' {
+				return 3
+			}
+		}
+	}
+
+	// Letters, optionally preceded by one non-letter, non-number, non-newline
+	if unicode.IsLetter(r) || (r != '\r' && r != '\n' && !unicode.IsNumber(r) && unicode.IsLetter(at(i+1))) {
+		j := i + 1
+		for j < len(runes) && unicode.IsLetter(runes[j]) {
+			j++
+		}
+		return j - i
+	}
+
+	// Up to three digits
+	if unicode.IsNumber(r) {
+		j := i + 1
+		for j < len(runes) && j-i < 3 && unicode.IsNumber(runes[j]) {
+			j++
+		}
+		return j - i
+	}
+
+	// Punctuation run, optionally preceded by a space, followed by newlines
+	j := i
+	if r == ' ' {
+		j++
+	}
+	if isPunct(at(j)) {
+		for j < len(runes) && isPunct(runes[j]) {
+			j++
+		}
+		for j < len(runes) && (runes[j] == '\r' || runes[j] == '\n') {
+			j++
+		}
+		return j - i
+	}
+
+	if unicode.IsSpace(r) {
+		end := i
+		lastNewline := -1
+		for end < len(runes) && unicode.IsSpace(runes[end]) {
+			if runes[end] == '\r' || runes[end] == '\n' {
+				lastNewline = end
+			}
+			end++
+		}
+		// Whitespace up to the last newline
+		if lastNewline >= 0 {
+			return lastNewline + 1 - i
+		}
+		// Whitespace not followed by non-space, leaving one space for the next word
+		if end == len(runes) {
+			return end - i
+		}
+		if end-i > 1 {
+			return end - 1 - i
+		}
+		return 1
+	}
+
+	return 1
+}
+
+// isPunct reports whether r matches [^\s\p{L}\p{N}].
+func isPunct(r rune) bool {
+	return r >= 0 && !unicode.IsSpace(r) && !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
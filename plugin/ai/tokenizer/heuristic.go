@@ -0,0 +1,79 @@
+package tokenizer
+
+import (
+	"math"
+	"unicode"
+)
+
+// Heuristic estimates tokens from character classes.
+// ASCII averages about 4 characters per token in BPE vocabularies; CJK cost
+// depends on how many CJK merges the model's vocabulary has.
+type Heuristic struct {
+	cjkTokensPerRune float64
+}
+
+// asciiCharsPerToken is the average number of ASCII characters per token.
+const asciiCharsPerToken = 4.0
+
+// DefaultCJKTokensPerRune is the CJK cost used for unknown models.
+// It errs on the high side so prompts do not overflow the context window.
+const DefaultCJKTokensPerRune = 1.5
+
+// NewHeuristic creates a heuristic tokenizer with the given CJK cost per rune.
+func NewHeuristic(cjkTokensPerRune float64) *Heuristic {
+	if cjkTokensPerRune <= 0 {
+		cjkTokensPerRune = DefaultCJKTokensPerRune
+	}
+	return &Heuristic{cjkTokensPerRune: cjkTokensPerRune}
+}
+
+// Name returns "heuristic".
+func (h *Heuristic) Name() string {
+	return "heuristic"
+}
+
+// CountTokens estimates the number of tokens in text.
+func (h *Heuristic) CountTokens(text string) int {
+	if text == "" {
+		return 0
+	}
+	var cost float64
+	for _, r := range text {
+		cost += h.runeCost(r)
+	}
+	return max(int(math.Ceil(cost)), 1)
+}
+
+// Truncate returns the longest prefix of text whose estimated cost fits in maxTokens.
+func (h *Heuristic) Truncate(text string, maxTokens int) string {
+	if maxTokens <= 0 {
+		return ""
+	}
+	var cost float64
+	for i, r := range text {
+		cost += h.runeCost(r)
+		if math.Ceil(cost) > float64(maxTokens) {
+			return text[:i]
+		}
+	}
+	return text
+}
+
+func (h *Heuristic) runeCost(r rune) float64 {
+	switch {
+	case r <= unicode.MaxASCII:
+		return 1 / asciiCharsPerToken
+	case isCJK(r):
+		return h.cjkTokensPerRune
+	default:
+		// Other scripts and emoji are usually split into byte-level tokens
+		return 1
+	}
+}
+
+// isCJK reports whether r is a Chinese, Japanese or Korean character or punctuation.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) ||
+		(r >= 0x3000 && r <= 0x303F) || // CJK symbols and punctuation
+		(r >= 0xFF00 && r <= 0xFFEF) // Full-width forms
+}
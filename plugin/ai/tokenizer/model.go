@@ -0,0 +1,83 @@
+package tokenizer
+
+import (
+	"sort"
+	"strings"
+)
+
+// ModelSpec describes the token limits of a model family.
+type ModelSpec struct {
+	// ContextWindow is the total number of tokens the model accepts, prompt and completion.
+	ContextWindow int
+	// MaxOutputTokens is reserved for the completion.
+	MaxOutputTokens int
+	// Encoding is the BPE vocabulary name, empty if not shipped.
+	Encoding string
+	// CJKTokensPerRune calibrates the heuristic fallback.
+	// Models trained with large Chinese vocabularies use well under one token per character.
+	CJKTokensPerRune float64
+}
+
+// defaultModelSpec is used for unknown models.
+var defaultModelSpec = ModelSpec{
+	ContextWindow:    32768,
+	MaxOutputTokens:  4096,
+	Encoding:         "cl100k_base",
+	CJKTokensPerRune: DefaultCJKTokensPerRune,
+}
+
+// modelSpecs maps model name prefixes to specs. The longest matching prefix wins.
+var modelSpecs = map[string]ModelSpec{
+	"gpt-4o":           {ContextWindow: 128000, MaxOutputTokens: 16384, Encoding: "cl100k_base", CJKTokensPerRune: 1.0},
+	"gpt-4-turbo":      {ContextWindow: 128000, MaxOutputTokens: 4096, Encoding: "cl100k_base", CJKTokensPerRune: 1.3},
+	"gpt-4":            {ContextWindow: 8192, MaxOutputTokens: 2048, Encoding: "cl100k_base", CJKTokensPerRune: 1.3},
+	"gpt-3.5-turbo":    {ContextWindow: 16385, MaxOutputTokens: 4096, Encoding: "cl100k_base", CJKTokensPerRune: 1.3},
+	"deepseek":         {ContextWindow: 65536, MaxOutputTokens: 8192, CJKTokensPerRune: 0.7},
+	"qwen":             {ContextWindow: 32768, MaxOutputTokens: 8192, CJKTokensPerRune: 0.7},
+	"qwen3":            {ContextWindow: 131072, MaxOutputTokens: 8192, CJKTokensPerRune: 0.7},
+	"glm-4":            {ContextWindow: 128000, MaxOutputTokens: 4096, CJKTokensPerRune: 0.7},
+	"moonshot-v1-8k":   {ContextWindow: 8192, MaxOutputTokens: 2048, CJKTokensPerRune: 0.7},
+	"moonshot-v1-32k":  {ContextWindow: 32768, MaxOutputTokens: 4096, CJKTokensPerRune: 0.7},
+	"moonshot-v1-128k": {ContextWindow: 131072, MaxOutputTokens: 4096, CJKTokensPerRune: 0.7},
+	"claude":           {ContextWindow: 200000, MaxOutputTokens: 8192, CJKTokensPerRune: 1.3},
+}
+
+// LookupModel returns the spec of a model, e.g. "deepseek-chat" or "Qwen/Qwen2.5-7B-Instruct".
+// Provider prefixes and case are ignored. Unknown models get conservative defaults.
+func LookupModel(model string) ModelSpec {
+	name := strings.ToLower(model)
+	if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		name = name[idx+1:]
+	}
+
+	spec, matched := defaultModelSpec, ""
+	for prefix, s := range modelSpecs {
+		if strings.HasPrefix(name, prefix) && len(prefix) > len(matched) {
+			spec, matched = s, prefix
+		}
+	}
+	return spec
+}
+
+// Encodings returns the BPE vocabulary names used by known models, sorted.
+func Encodings() []string {
+	seen := map[string]bool{defaultModelSpec.Encoding: true}
+	for _, spec := range modelSpecs {
+		if spec.Encoding != "" {
+			seen[spec.Encoding] = true
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// InputBudget returns the number of prompt tokens available for a model
+// after reserving room for the completion.
+func InputBudget(model string) int {
+	spec := LookupModel(model)
+	return spec.ContextWindow - spec.MaxOutputTokens
+}
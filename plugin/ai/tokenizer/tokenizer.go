@@ -0,0 +1,82 @@
+// Package tokenizer counts and truncates text in model tokens, so prompt
+// budgets match what the LLM actually sees for mixed Chinese/English input.
+//
+// A byte-level BPE tokenizer is used when the model's vocabulary is shipped in
+// the binary (see the vocab directory) or loaded with LoadVocabDir; otherwise a
+// model-aware heuristic is used.
+package tokenizer
+
+import (
+	"log/slog"
+	"sync"
+)
+
+// Tokenizer counts and truncates text in tokens.
+type Tokenizer interface {
+	// Name identifies the tokenizer, e.g. "cl100k_base" or "heuristic".
+	Name() string
+
+	// CountTokens returns the number of tokens in text.
+	CountTokens(text string) int
+
+	// Truncate returns the longest prefix of text that fits in maxTokens.
+	Truncate(text string, maxTokens int) string
+}
+
+var (
+	encodingsMu sync.Mutex
+	encodings   = make(map[string]*BPE)
+	missing     = make(map[string]bool)
+)
+
+// Default returns the tokenizer used when the model is unknown.
+func Default() Tokenizer {
+	return ForModel("")
+}
+
+// ForModel returns the tokenizer for a model.
+// Falls back to a heuristic calibrated for the model family when its vocabulary is not available.
+func ForModel(model string) Tokenizer {
+	spec := LookupModel(model)
+	if spec.Encoding != "" {
+		if bpe := loadEncoding(spec.Encoding); bpe != nil {
+			return bpe
+		}
+	}
+	return NewHeuristic(spec.CJKTokensPerRune)
+}
+
+// RegisterEncoding makes a BPE encoding available to ForModel, replacing any embedded one.
+func RegisterEncoding(bpe *BPE) {
+	encodingsMu.Lock()
+	defer encodingsMu.Unlock()
+	encodings[bpe.Name()] = bpe
+	delete(missing, bpe.Name())
+}
+
+// loadEncoding returns a registered or embedded encoding, or nil if unavailable.
+// Embedded vocabularies are parsed once on first use.
+func loadEncoding(name string) *BPE {
+	encodingsMu.Lock()
+	defer encodingsMu.Unlock()
+
+	if bpe, ok := encodings[name]; ok {
+		return bpe
+	}
+	if missing[name] {
+		return nil
+	}
+
+	bpe, err := loadEmbedded(name)
+	if err != nil {
+		slog.Warn("failed to load tokenizer vocabulary, using heuristic", "encoding", name, "error", err)
+	} else if bpe == nil {
+		slog.Warn("tokenizer vocabulary not available, using heuristic; set DIVINESENSE_AI_TOKENIZER_VOCAB_DIR to count exactly", "encoding", name)
+	}
+	if bpe == nil {
+		missing[name] = true
+		return nil
+	}
+	encodings[name] = bpe
+	return bpe
+}
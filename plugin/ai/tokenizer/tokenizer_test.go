@@ -0,0 +1,130 @@
+package tokenizer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testVocab returns a tiny tiktoken file: all bytes plus merges for "hello".
+func testVocab() string {
+	var sb strings.Builder
+	for b := 0; b < 256; b++ {
+		fmt.Fprintf(&sb, "%s %d\n", base64.StdEncoding.EncodeToString([]byte{byte(b)}), b)
+	}
+	for i, token := range []string{"he", "ll", "hell", "hello"} {
+		fmt.Fprintf(&sb, "%s %d\n", base64.StdEncoding.EncodeToString([]byte(token)), 256+i)
+	}
+	return sb.String()
+}
+
+func TestBPE(t *testing.T) {
+	bpe, err := LoadBPE("test", strings.NewReader(testVocab()))
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{259}, bpe.Encode("hello"))
+	assert.Equal(t, []int{' ', 259}, bpe.Encode(" hello"))
+	assert.Equal(t, []int{258, 'p'}, bpe.Encode("hellp"))
+	assert.Equal(t, "hello 你好", bpe.Decode(bpe.Encode("hello 你好")))
+	assert.Equal(t, 8, bpe.CountTokens("hello 你好"))
+
+	// A character split by the cut is dropped
+	assert.Equal(t, "你", bpe.Truncate("你好", 4))
+	assert.Equal(t, "你好", bpe.Truncate("你好", 6))
+
+	_, err = NewBPE("incomplete", map[string]int{"a": 0})
+	assert.Error(t, err)
+}
+
+func TestSplitPieces(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"Hello world's 12345", []string{"Hello", " world", "'s", " ", "123", "45"}},
+		{"a\n\n  end", []string{"a", "\n\n", " ", " end"}},
+		{"你好，世界!", []string{"你好", "，世界", "!"}},
+		{"x = (1+2);\n", []string{"x", " =", " (", "1", "+", "2", ");\n"}},
+		{"trailing  ", []string{"trailing", "  "}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, splitPieces(tt.input), tt.input)
+	}
+}
+
+func TestHeuristic(t *testing.T) {
+	h := NewHeuristic(1.5)
+	assert.Equal(t, 0, h.CountTokens(""))
+	assert.Equal(t, 3, h.CountTokens("hello world"))
+	assert.Equal(t, 6, h.CountTokens("你好世界"))
+	assert.Equal(t, "你好", h.Truncate("你好世界", 3))
+	assert.Equal(t, "你好世界", h.Truncate("你好世界", 6))
+
+	// CJK-optimized models are cheaper per character
+	assert.Less(t, NewHeuristic(0.7).CountTokens("你好世界"), h.CountTokens("你好世界"))
+}
+
+func TestLookupModel(t *testing.T) {
+	assert.Equal(t, 65536, LookupModel("deepseek-chat").ContextWindow)
+	assert.Equal(t, 32768, LookupModel("Qwen/Qwen2.5-7B-Instruct").ContextWindow)
+	assert.Equal(t, 131072, LookupModel("Qwen/Qwen3-8B").ContextWindow)
+	assert.Equal(t, 8192, LookupModel("gpt-4").ContextWindow)
+	assert.Equal(t, 128000, LookupModel("gpt-4-turbo-preview").ContextWindow)
+	assert.Equal(t, defaultModelSpec, LookupModel("unknown-model"))
+	assert.Equal(t, 65536-8192, InputBudget("deepseek-chat"))
+}
+
+func TestForModel(t *testing.T) {
+	bpe, err := LoadBPE("cl100k_base", strings.NewReader(testVocab()))
+	require.NoError(t, err)
+	RegisterEncoding(bpe)
+	t.Cleanup(func() {
+		encodingsMu.Lock()
+		defer encodingsMu.Unlock()
+		delete(encodings, "cl100k_base")
+	})
+
+	assert.Equal(t, "cl100k_base", ForModel("gpt-4o").Name())
+	assert.Equal(t, "heuristic", ForModel("deepseek-chat").Name())
+}
+
+func TestForModelEmbedded(t *testing.T) {
+	tok := ForModel("gpt-4")
+	require.Equal(t, "cl100k_base", tok.Name(), "cl100k_base is shipped in the binary")
+
+	bpe := tok.(*BPE)
+	assert.Equal(t, []int{83, 1609, 5963, 374, 2294, 0}, bpe.Encode("tiktoken is great!"))
+	assert.Equal(t, 2, bpe.CountTokens("hello world"))
+	assert.Equal(t, "你好，世界", bpe.Decode(bpe.Encode("你好，世界")))
+}
+
+func TestLoadVocabDir(t *testing.T) {
+	t.Cleanup(func() {
+		encodingsMu.Lock()
+		defer encodingsMu.Unlock()
+		delete(encodings, "cl100k_base")
+	})
+
+	dir := t.TempDir()
+	err := LoadVocabDir(dir)
+	require.Error(t, err, "a configured directory without vocabularies fails")
+	assert.Contains(t, err.Error(), "cl100k_base")
+
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	_, err = w.Write([]byte(testVocab()))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cl100k_base.tiktoken.gz"), gz.Bytes(), 0o600))
+
+	require.NoError(t, LoadVocabDir(dir))
+	assert.Equal(t, "cl100k_base", ForModel("gpt-4o").Name())
+}
@@ -0,0 +1,65 @@
+package tokenizer
+
+import (
+	"compress/gzip"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+)
+
+// vocabFS holds the BPE rank files shipped in the binary.
+//
+//go:embed vocab
+var vocabFS embed.FS
+
+// loadEmbedded parses the embedded vocabulary of an encoding.
+// Returns nil without error if the encoding is not embedded.
+func loadEmbedded(name string) (*BPE, error) {
+	return loadVocab(vocabFS, "vocab", name)
+}
+
+// LoadVocabDir registers the vocabulary of every encoding used by known models
+// from dir, which holds "<encoding>.tiktoken" or "<encoding>.tiktoken.gz" files.
+// Returns an error naming the first encoding that is missing or invalid, so a
+// misconfigured directory is not silently replaced by the heuristic.
+func LoadVocabDir(dir string) error {
+	fsys := os.DirFS(dir)
+	for _, name := range Encodings() {
+		bpe, err := loadVocab(fsys, ".", name)
+		if err != nil {
+			return fmt.Errorf("failed to load tokenizer vocabulary %s from %s: %w", name, dir, err)
+		}
+		if bpe == nil {
+			return fmt.Errorf("tokenizer vocabulary %s not found in %s", name, dir)
+		}
+		RegisterEncoding(bpe)
+	}
+	return nil
+}
+
+// loadVocab parses the vocabulary of an encoding from dir in fsys.
+// Returns nil without error if the vocabulary does not exist.
+func loadVocab(fsys fs.FS, dir, name string) (*BPE, error) {
+	var r io.Reader
+	if f, err := fsys.Open(path.Join(dir, name+".tiktoken.gz")); err == nil {
+		defer f.Close()
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	} else if f, err := fsys.Open(path.Join(dir, name+".tiktoken")); err == nil {
+		defer f.Close()
+		r = f
+	} else if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else {
+		return nil, err
+	}
+	return LoadBPE(name, r)
+}
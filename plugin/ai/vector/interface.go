@@ -18,6 +18,14 @@ type VectorService interface {
 	HybridSearch(ctx context.Context, query string, limit int) ([]SearchResult, error)
 }
 
+// EmbeddingReader is implemented by vector services that can return stored
+// embeddings, e.g. to compare search results with each other.
+type EmbeddingReader interface {
+	// GetEmbeddings returns the stored embeddings by doc ID.
+	// Documents without an embedding are omitted.
+	GetEmbeddings(ctx context.Context, docIDs []string) (map[string][]float32, error)
+}
+
 // VectorResult represents a vector search result.
 type VectorResult struct {
 	DocID    string         `json:"doc_id"`
@@ -108,6 +108,20 @@ func (m *MockVectorService) StoreEmbedding(ctx context.Context, docID string, ve
 	return nil
 }
 
+// GetEmbeddings returns the stored embeddings by doc ID.
+func (m *MockVectorService) GetEmbeddings(ctx context.Context, docIDs []string) (map[string][]float32, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	embeddings := make(map[string][]float32, len(docIDs))
+	for _, docID := range docIDs {
+		if stored, ok := m.embeddings[docID]; ok {
+			embeddings[docID] = stored.Vector
+		}
+	}
+	return embeddings, nil
+}
+
 // SearchSimilar performs similarity search on vectors.
 func (m *MockVectorService) SearchSimilar(ctx context.Context, vector []float32, limit int, filter map[string]any) ([]VectorResult, error) {
 	m.mu.RLock()
@@ -0,0 +1,648 @@
+package vector
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/store"
+)
+
+// Document types, the first part of a doc ID ("memo/42").
+const (
+	DocTypeMemo       = "memo"
+	DocTypeSchedule   = store.DocumentTypeSchedule
+	DocTypeAttachment = store.DocumentTypeAttachment
+	DocTypeMessage    = store.DocumentTypeMessage
+	DocTypeEpisode    = "episode"
+)
+
+// Filter and metadata keys.
+const (
+	KeyUserID        = "user_id"        // int32
+	KeyDocType       = "doc_type"       // string or []string
+	KeyCreatedAfter  = "created_after"  // time.Time or unix seconds
+	KeyCreatedBefore = "created_before" // time.Time or unix seconds
+	KeyTags          = "tags"           // string or []string, matches any
+	KeyVisibility    = "visibility"     // string or []string
+	KeyContent       = "content"        // string
+	KeyCreatedTs     = "created_ts"     // unix seconds
+	KeyMemoFilter    = "memo_filter"    // CEL memo filter of plugin/filter, restricts results to memos
+
+	// Result-only metadata.
+	KeyMemo      = "memo"       // *store.Memo of memo results
+	KeyAgentType = "agent_type" // Agent of episode results
+	KeyOutcome   = "outcome"    // Outcome of episode results
+)
+
+// DefaultEmbeddingModel is used when no embedding model is configured.
+const DefaultEmbeddingModel = "BAAI/bge-m3"
+
+// rrfK is the Reciprocal Rank Fusion constant used by HybridSearch.
+const rrfK = 60
+
+var allDocTypes = []string{DocTypeMemo, DocTypeSchedule, DocTypeAttachment, DocTypeMessage, DocTypeEpisode}
+
+// FormatDocID returns the doc ID of a document, e.g. "schedule/7".
+func FormatDocID(docType string, id int64) string {
+	return docType + "/" + strconv.FormatInt(id, 10)
+}
+
+// ParseDocID splits a doc ID into its document type and ID.
+func ParseDocID(docID string) (string, int64, error) {
+	docType, rawID, ok := strings.Cut(docID, "/")
+	if !ok || !isDocType(docType) {
+		return "", 0, fmt.Errorf("invalid doc ID %q", docID)
+	}
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || id <= 0 {
+		return "", 0, fmt.Errorf("invalid doc ID %q", docID)
+	}
+	return docType, id, nil
+}
+
+type userIDContextKey struct{}
+
+// WithUserID returns a context whose HybridSearch calls are scoped to the user.
+func WithUserID(ctx context.Context, userID int32) context.Context {
+	return context.WithValue(ctx, userIDContextKey{}, userID)
+}
+
+// StoreVectorService implements VectorService on top of the store.
+//
+// Memo embeddings live in memo_embedding and episode embeddings in
+// episodic_memory; schedules, attachments and conversation messages are kept
+// in document_embedding. SearchSimilar queries every requested source and
+// merges the results by score. Vector search requires PostgreSQL.
+type StoreVectorService struct {
+	store            *store.Store
+	embeddingService ai.EmbeddingService // Embeds HybridSearch queries; optional
+	model            string
+}
+
+var (
+	_ VectorService   = (*StoreVectorService)(nil)
+	_ EmbeddingReader = (*StoreVectorService)(nil)
+)
+
+// NewStoreVectorService creates a new store-backed vector service.
+// model is the embedding model of stored vectors, DefaultEmbeddingModel if empty.
+func NewStoreVectorService(st *store.Store, embeddingService ai.EmbeddingService, model string) *StoreVectorService {
+	if model == "" {
+		model = DefaultEmbeddingModel
+	}
+	return &StoreVectorService{
+		store:            st,
+		embeddingService: embeddingService,
+		model:            model,
+	}
+}
+
+// StoreEmbedding stores the embedding of a document.
+// Memo metadata is read from the memo itself; other documents need the user_id
+// metadata and may set visibility, tags, content and created_ts.
+// Episodes are embedded when they are saved and cannot be stored here.
+func (s *StoreVectorService) StoreEmbedding(ctx context.Context, docID string, vector []float32, metadata map[string]any) error {
+	if len(vector) == 0 {
+		return fmt.Errorf("vector cannot be empty")
+	}
+	docType, id, err := ParseDocID(docID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	switch docType {
+	case DocTypeMemo:
+		_, err := s.store.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+			MemoID:    int32(id),
+			Embedding: vector,
+			Model:     s.model,
+			CreatedTs: now,
+			UpdatedTs: now,
+		})
+		return err
+	case DocTypeEpisode:
+		return fmt.Errorf("episode embeddings are stored with the episode")
+	}
+
+	userID, err := int32Value(metadata[KeyUserID])
+	if err != nil || userID <= 0 {
+		return fmt.Errorf("metadata %s is required for %s documents", KeyUserID, docType)
+	}
+	visibility, err := stringsValue(metadata[KeyVisibility])
+	if err != nil || len(visibility) > 1 {
+		return fmt.Errorf("invalid metadata %s", KeyVisibility)
+	}
+	tags, err := stringsValue(metadata[KeyTags])
+	if err != nil {
+		return fmt.Errorf("invalid metadata %s: %w", KeyTags, err)
+	}
+	content, _ := metadata[KeyContent].(string)
+	createdTs := now
+	if raw, ok := metadata[KeyCreatedTs]; ok {
+		if createdTs, err = unixValue(raw); err != nil {
+			return fmt.Errorf("invalid metadata %s: %w", KeyCreatedTs, err)
+		}
+	}
+
+	embedding := &store.DocumentEmbedding{
+		DocType:      docType,
+		DocID:        int32(id),
+		UserID:       userID,
+		Tags:         tags,
+		Content:      content,
+		Embedding:    vector,
+		Model:        s.model,
+		DocCreatedTs: createdTs,
+		CreatedTs:    now,
+		UpdatedTs:    now,
+	}
+	if len(visibility) == 1 {
+		embedding.Visibility = store.Visibility(visibility[0])
+	}
+	_, err = s.store.UpsertDocumentEmbedding(ctx, embedding)
+	return err
+}
+
+// DeleteEmbedding deletes the embedding of a document, e.g. after the document was deleted.
+func (s *StoreVectorService) DeleteEmbedding(ctx context.Context, docID string) error {
+	docType, id, err := ParseDocID(docID)
+	if err != nil {
+		return err
+	}
+	docIDValue := int32(id)
+	switch docType {
+	case DocTypeMemo:
+		return s.store.DeleteMemoEmbedding(ctx, docIDValue)
+	case DocTypeEpisode:
+		return fmt.Errorf("episode embeddings are deleted with the episode")
+	default:
+		return s.store.DeleteDocumentEmbeddings(ctx, &store.DeleteDocumentEmbedding{DocType: docType, DocID: &docIDValue})
+	}
+}
+
+// GetEmbeddings returns the stored embeddings of the configured model by doc ID.
+// Only memo embeddings can be read.
+func (s *StoreVectorService) GetEmbeddings(ctx context.Context, docIDs []string) (map[string][]float32, error) {
+	memoIDs := make([]int32, 0, len(docIDs))
+	for _, docID := range docIDs {
+		docType, id, err := ParseDocID(docID)
+		if err != nil {
+			return nil, err
+		}
+		if docType != DocTypeMemo {
+			return nil, fmt.Errorf("reading %s embeddings is not supported", docType)
+		}
+		memoIDs = append(memoIDs, int32(id))
+	}
+	embeddings := make(map[string][]float32, len(memoIDs))
+	if len(memoIDs) == 0 {
+		return embeddings, nil
+	}
+
+	list, err := s.store.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{
+		MemoIDList: memoIDs,
+		Model:      &s.model,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, embedding := range list {
+		embeddings[FormatDocID(DocTypeMemo, int64(embedding.MemoID))] = embedding.Embedding
+	}
+	return embeddings, nil
+}
+
+// SearchSimilar searches the documents most similar to vector.
+// Without a doc_type filter all document types are searched; memos and episodes
+// are only searched when the user_id filter is set.
+func (s *StoreVectorService) SearchSimilar(ctx context.Context, vector []float32, limit int, filter map[string]any) ([]VectorResult, error) {
+	if len(vector) == 0 {
+		return nil, fmt.Errorf("vector cannot be empty")
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	f, err := parseFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []VectorResult
+	var documentTypes []string
+	for _, docType := range f.docTypes {
+		switch docType {
+		case DocTypeMemo:
+			if f.userID == nil {
+				continue
+			}
+			memoResults, err := s.searchMemos(ctx, vector, limit, f)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, memoResults...)
+		case DocTypeEpisode:
+			if f.userID == nil {
+				continue
+			}
+			episodeResults, err := s.searchEpisodes(ctx, vector, limit, f)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, episodeResults...)
+		default:
+			documentTypes = append(documentTypes, docType)
+		}
+	}
+	if len(documentTypes) > 0 {
+		documentResults, err := s.searchDocuments(ctx, vector, limit, documentTypes, f)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, documentResults...)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// HybridSearch searches the memos of the context user (see WithUserID),
+// fusing vector and BM25 keyword rankings with Reciprocal Rank Fusion.
+// It falls back to keyword search if the query cannot be embedded.
+func (s *StoreVectorService) HybridSearch(ctx context.Context, query string, limit int) ([]SearchResult, error) {
+	userID, ok := ctx.Value(userIDContextKey{}).(int32)
+	if !ok || userID <= 0 {
+		return nil, fmt.Errorf("hybrid search requires a user, see WithUserID")
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	type fused struct {
+		memo       *store.Memo
+		score      float32
+		vectorHit  bool
+		keywordHit bool
+	}
+	byID := make(map[int32]*fused)
+	get := func(memo *store.Memo) *fused {
+		item, ok := byID[memo.ID]
+		if !ok {
+			item = &fused{memo: memo}
+			byID[memo.ID] = item
+		}
+		return item
+	}
+
+	var vectorErr error
+	if s.embeddingService == nil {
+		vectorErr = fmt.Errorf("no embedding service")
+	} else if queryVector, err := s.embeddingService.Embed(ctx, query); err != nil {
+		vectorErr = err
+	} else {
+		results, err := s.SearchSimilar(ctx, queryVector, limit*2, map[string]any{KeyUserID: userID, KeyDocType: DocTypeMemo})
+		vectorErr = err
+		for rank, result := range results {
+			memo, ok := result.Metadata[KeyMemo].(*store.Memo)
+			if !ok {
+				continue
+			}
+			item := get(memo)
+			item.score += 1.0 / float32(rrfK+rank+1)
+			item.vectorHit = true
+		}
+	}
+
+	keywordResults, keywordErr := s.store.BM25Search(ctx, &store.BM25SearchOptions{
+		UserID: userID,
+		Query:  query,
+		Limit:  limit * 2,
+	})
+	if vectorErr != nil && keywordErr != nil {
+		return nil, fmt.Errorf("both vector and keyword search failed: vector=%v, keyword=%v", vectorErr, keywordErr)
+	}
+	for rank, result := range keywordResults {
+		item := get(result.Memo)
+		item.score += 1.0 / float32(rrfK+rank+1)
+		item.keywordHit = true
+	}
+
+	// Normalize so a memo ranked first by both searches scores 1
+	maxScore := float32(2.0 / (rrfK + 1))
+	results := make([]SearchResult, 0, len(byID))
+	for _, item := range byID {
+		matchType := "hybrid"
+		switch {
+		case !item.keywordHit:
+			matchType = "vector"
+		case !item.vectorHit:
+			matchType = "keyword"
+		}
+		results = append(results, SearchResult{
+			Name:      item.memo.UID,
+			Content:   item.memo.Content,
+			Score:     min(item.score/maxScore, 1),
+			MatchType: matchType,
+		})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Name < results[j].Name
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func (s *StoreVectorService) searchMemos(ctx context.Context, vector []float32, limit int, f *searchFilter) ([]VectorResult, error) {
+	memos, err := s.store.VectorSearch(ctx, &store.VectorSearchOptions{
+		UserID:        *f.userID,
+		Vector:        vector,
+		Limit:         limit,
+		Model:         s.model,
+		CreatedAfter:  f.createdAfter,
+		CreatedBefore: f.createdBefore,
+		Tags:          f.tags,
+		Visibilities:  f.visibilities,
+		Filters:       memoFilters(f.memoFilter),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]VectorResult, 0, len(memos))
+	for _, m := range memos {
+		var tags []string
+		if m.Memo.Payload != nil {
+			tags = m.Memo.Payload.Tags
+		}
+		results = append(results, VectorResult{
+			DocID: FormatDocID(DocTypeMemo, int64(m.Memo.ID)),
+			Score: clampScore(m.Score),
+			Metadata: map[string]any{
+				KeyDocType:    DocTypeMemo,
+				KeyUserID:     m.Memo.CreatorID,
+				KeyCreatedTs:  m.Memo.CreatedTs,
+				KeyVisibility: string(m.Memo.Visibility),
+				KeyTags:       tags,
+				KeyContent:    m.Memo.Content,
+				KeyMemo:       m.Memo,
+			},
+		})
+	}
+	return results, nil
+}
+
+// searchEpisodes searches embedded episodes. Episodes are private and untagged.
+func (s *StoreVectorService) searchEpisodes(ctx context.Context, vector []float32, limit int, f *searchFilter) ([]VectorResult, error) {
+	if len(f.tags) > 0 || (len(f.visibilities) > 0 && !containsVisibility(f.visibilities, store.Private)) {
+		return nil, nil
+	}
+
+	episodes, err := s.store.SearchEpisodicMemories(ctx, &store.SearchEpisodicMemory{
+		UserID: *f.userID,
+		Vector: vector,
+		Model:  s.model,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]VectorResult, 0, len(episodes))
+	for _, e := range episodes {
+		if (f.createdAfter != nil && e.CreatedTs < *f.createdAfter) || (f.createdBefore != nil && e.CreatedTs >= *f.createdBefore) {
+			continue
+		}
+		results = append(results, VectorResult{
+			DocID: FormatDocID(DocTypeEpisode, e.ID),
+			Score: clampScore(e.Similarity),
+			Metadata: map[string]any{
+				KeyDocType:    DocTypeEpisode,
+				KeyUserID:     e.UserID,
+				KeyCreatedTs:  e.CreatedTs,
+				KeyVisibility: string(store.Private),
+				KeyContent:    e.UserInput,
+				KeyAgentType:  e.AgentType,
+				KeyOutcome:    e.Outcome,
+			},
+		})
+	}
+	return results, nil
+}
+
+func (s *StoreVectorService) searchDocuments(ctx context.Context, vector []float32, limit int, docTypes []string, f *searchFilter) ([]VectorResult, error) {
+	documents, err := s.store.SearchDocumentEmbeddings(ctx, &store.SearchDocumentEmbeddingOptions{
+		Vector:        vector,
+		Model:         s.model,
+		UserID:        f.userID,
+		DocTypes:      docTypes,
+		CreatedAfter:  f.createdAfter,
+		CreatedBefore: f.createdBefore,
+		Tags:          f.tags,
+		Visibilities:  f.visibilities,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]VectorResult, 0, len(documents))
+	for _, d := range documents {
+		results = append(results, VectorResult{
+			DocID: FormatDocID(d.DocType, int64(d.DocID)),
+			Score: clampScore(d.Score),
+			Metadata: map[string]any{
+				KeyDocType:    d.DocType,
+				KeyUserID:     d.UserID,
+				KeyCreatedTs:  d.DocCreatedTs,
+				KeyVisibility: string(d.Visibility),
+				KeyTags:       d.Tags,
+				KeyContent:    d.Content,
+			},
+		})
+	}
+	return results, nil
+}
+
+// searchFilter is the parsed filter of SearchSimilar.
+type searchFilter struct {
+	userID        *int32
+	docTypes      []string
+	createdAfter  *int64
+	createdBefore *int64
+	tags          []string
+	visibilities  []store.Visibility
+	memoFilter    string
+}
+
+func parseFilter(filter map[string]any) (*searchFilter, error) {
+	f := &searchFilter{docTypes: allDocTypes}
+	for key, value := range filter {
+		switch key {
+		case KeyUserID:
+			userID, err := int32Value(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter %s: %w", key, err)
+			}
+			f.userID = &userID
+		case KeyDocType:
+			docTypes, err := stringsValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter %s: %w", key, err)
+			}
+			for _, docType := range docTypes {
+				if !isDocType(docType) {
+					return nil, fmt.Errorf("unknown doc type %q", docType)
+				}
+			}
+			if len(docTypes) > 0 {
+				f.docTypes = docTypes
+			}
+		case KeyCreatedAfter, KeyCreatedBefore:
+			ts, err := unixValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter %s: %w", key, err)
+			}
+			if key == KeyCreatedAfter {
+				f.createdAfter = &ts
+			} else {
+				f.createdBefore = &ts
+			}
+		case KeyTags:
+			tags, err := stringsValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter %s: %w", key, err)
+			}
+			f.tags = tags
+		case KeyVisibility:
+			visibilities, err := stringsValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter %s: %w", key, err)
+			}
+			for _, visibility := range visibilities {
+				f.visibilities = append(f.visibilities, store.Visibility(visibility))
+			}
+		case KeyMemoFilter:
+			memoFilter, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid filter %s: expected a string, got %T", key, value)
+			}
+			f.memoFilter = memoFilter
+		default:
+			return nil, fmt.Errorf("unsupported filter %q", key)
+		}
+	}
+	// Other document types have no memo fields to filter on
+	if f.memoFilter != "" {
+		f.docTypes = []string{DocTypeMemo}
+	}
+	return f, nil
+}
+
+// memoFilters returns the store filters of a CEL memo filter.
+func memoFilters(memoFilter string) []string {
+	if memoFilter == "" {
+		return nil
+	}
+	return []string{memoFilter}
+}
+
+func isDocType(docType string) bool {
+	for _, t := range allDocTypes {
+		if t == docType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsVisibility(visibilities []store.Visibility, visibility store.Visibility) bool {
+	for _, v := range visibilities {
+		if v == visibility {
+			return true
+		}
+	}
+	return false
+}
+
+// clampScore maps cosine similarity to the 0-1 score range of VectorResult.
+func clampScore(score float32) float32 {
+	return max(0, min(score, 1))
+}
+
+func int32Value(value any) (int32, error) {
+	switch v := value.(type) {
+	case int32:
+		return v, nil
+	case int:
+		return int32(v), nil
+	case int64:
+		return int32(v), nil
+	case float64:
+		return int32(v), nil
+	default:
+		return 0, fmt.Errorf("expected an integer, got %T", value)
+	}
+}
+
+func unixValue(value any) (int64, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Unix(), nil
+	case *time.Time:
+		if v == nil {
+			return 0, fmt.Errorf("nil time")
+		}
+		return v.Unix(), nil
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case float64:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("expected a time or unix timestamp, got %T", value)
+	}
+}
+
+func stringsValue(value any) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		return []string{v}, nil
+	case []string:
+		return v, nil
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected strings, got %T", item)
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("expected a string or strings, got %T", value)
+	}
+}
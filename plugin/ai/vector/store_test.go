@@ -0,0 +1,75 @@
+package vector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+func TestDocID(t *testing.T) {
+	docID := FormatDocID(DocTypeSchedule, 42)
+	if docID != "schedule/42" {
+		t.Fatalf("FormatDocID = %q", docID)
+	}
+	docType, id, err := ParseDocID(docID)
+	if err != nil || docType != DocTypeSchedule || id != 42 {
+		t.Fatalf("ParseDocID(%q) = %q, %d, %v", docID, docType, id, err)
+	}
+
+	for _, invalid := range []string{"", "memo", "memo/", "memo/abc", "memo/0", "note/1"} {
+		if _, _, err := ParseDocID(invalid); err == nil {
+			t.Errorf("ParseDocID(%q) should fail", invalid)
+		}
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f, err := parseFilter(map[string]any{
+		KeyUserID:        1,
+		KeyDocType:       []string{DocTypeMemo, DocTypeMessage},
+		KeyCreatedAfter:  after,
+		KeyCreatedBefore: int64(1800000000),
+		KeyTags:          "work",
+		KeyVisibility:    []any{"PRIVATE", "PROTECTED"},
+	})
+	if err != nil {
+		t.Fatalf("parseFilter failed: %v", err)
+	}
+	if f.userID == nil || *f.userID != 1 {
+		t.Errorf("userID = %v", f.userID)
+	}
+	if len(f.docTypes) != 2 {
+		t.Errorf("docTypes = %v", f.docTypes)
+	}
+	if f.createdAfter == nil || *f.createdAfter != after.Unix() {
+		t.Errorf("createdAfter = %v", f.createdAfter)
+	}
+	if f.createdBefore == nil || *f.createdBefore != 1800000000 {
+		t.Errorf("createdBefore = %v", f.createdBefore)
+	}
+	if len(f.tags) != 1 || f.tags[0] != "work" {
+		t.Errorf("tags = %v", f.tags)
+	}
+	if len(f.visibilities) != 2 || f.visibilities[1] != store.Protected {
+		t.Errorf("visibilities = %v", f.visibilities)
+	}
+
+	// Without a doc type filter all document types are searched
+	f, err = parseFilter(nil)
+	if err != nil || len(f.docTypes) != len(allDocTypes) {
+		t.Errorf("parseFilter(nil) = %v, %v", f, err)
+	}
+
+	for _, invalid := range []map[string]any{
+		{KeyDocType: "note"},
+		{KeyUserID: "1"},
+		{KeyCreatedAfter: "yesterday"},
+		{"unknown": 1},
+	} {
+		if _, err := parseFilter(invalid); err == nil {
+			t.Errorf("parseFilter(%v) should fail", invalid)
+		}
+	}
+}
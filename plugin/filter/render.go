@@ -141,6 +141,12 @@ func (r *renderer) renderFieldPredicate(cond *FieldPredicateCondition) (renderRe
 			return renderResult{}, err
 		}
 		return renderResult{sql: sql}, nil
+	case FieldKindExists:
+		sql, err := r.existsExpression(field)
+		if err != nil {
+			return renderResult{}, err
+		}
+		return renderResult{sql: sql}, nil
 	default:
 		return renderResult{}, errors.Errorf("field %q cannot be used as a predicate", cond.Field)
 	}
@@ -160,6 +166,8 @@ func (r *renderer) renderComparison(cond *ComparisonCondition) (renderResult, er
 			return r.renderJSONBoolComparison(field, cond.Operator, cond.Right)
 		case FieldKindScalar:
 			return r.renderScalarComparison(field, cond.Operator, cond.Right)
+		case FieldKindExists:
+			return r.renderExistsComparison(field, cond.Operator, cond.Right)
 		default:
 			return renderResult{}, errors.Errorf("field %q does not support comparison", field.Name)
 		}
@@ -302,6 +310,26 @@ func (r *renderer) renderJSONBoolComparison(field Field, op ComparisonOperator,
 	}
 }
 
+func (r *renderer) renderExistsComparison(field Field, op ComparisonOperator, right ValueExpr) (renderResult, error) {
+	value, err := expectBool(right)
+	if err != nil {
+		return renderResult{}, err
+	}
+	sql, err := r.existsExpression(field)
+	if err != nil {
+		return renderResult{}, err
+	}
+	switch op {
+	case CompareEq, CompareNeq:
+		if value == (op == CompareEq) {
+			return renderResult{sql: sql}, nil
+		}
+		return renderResult{sql: fmt.Sprintf("NOT (%s)", sql)}, nil
+	default:
+		return renderResult{}, errors.Errorf("operator %s not supported for field %q", op, field.Name)
+	}
+}
+
 func (r *renderer) renderInCondition(cond *InCondition) (renderResult, error) {
 	fieldRef, ok := cond.Left.(*FieldRef)
 	if !ok {
@@ -551,6 +579,14 @@ func (r *renderer) jsonBoolPredicate(field Field) (string, error) {
 	}
 }
 
+func (r *renderer) existsExpression(field Field) (string, error) {
+	sql, ok := field.Expressions[r.dialect]
+	if !ok {
+		return "", errors.Errorf("field %q is not supported for dialect %s", field.Name, r.dialect)
+	}
+	return sql, nil
+}
+
 func combineAnd(left, right renderResult) renderResult {
 	if left.unsatisfiable || right.unsatisfiable {
 		return renderResult{sql: "1 = 0", unsatisfiable: true}
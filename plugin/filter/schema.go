@@ -36,6 +36,8 @@ const (
 	FieldKindJSONBool     FieldKind = "json_bool"
 	FieldKindJSONList     FieldKind = "json_list"
 	FieldKindVirtualAlias FieldKind = "virtual_alias"
+	// FieldKindExists is a boolean computed by a per-dialect SQL expression in Expressions.
+	FieldKindExists FieldKind = "exists"
 )
 
 // Column identifies the backing table column.
@@ -219,6 +221,33 @@ func NewSchema() Schema {
 				CompareNeq: true,
 			},
 		},
+		"has_attachment": {
+			Name: "has_attachment",
+			Kind: FieldKindExists,
+			Type: FieldTypeBool,
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "EXISTS (SELECT 1 FROM `attachment` WHERE `attachment`.`memo_id` = `memo`.`id`)",
+				DialectPostgres: "EXISTS (SELECT 1 FROM attachment WHERE attachment.memo_id = memo.id)",
+			},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		// attachment_text is the extracted and OCR text of all attachments of a memo,
+		// only usable with contains().
+		"attachment_text": {
+			Name:             "attachment_text",
+			Kind:             FieldKindScalar,
+			Type:             FieldTypeString,
+			Column:           Column{Table: "memo", Name: "id"},
+			SupportsContains: true,
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "(SELECT group_concat(`attachment`.`extracted_text` || ' ' || `attachment`.`ocr_text`, ' ') FROM `attachment` WHERE `attachment`.`memo_id` = %s)",
+				DialectPostgres: "(SELECT string_agg(COALESCE(attachment.extracted_text, '') || ' ' || COALESCE(attachment.ocr_text, ''), ' ') FROM attachment WHERE attachment.memo_id = %s)",
+			},
+			AllowedComparisonOps: map[ComparisonOperator]bool{},
+		},
 	}
 
 	envOptions := []cel.EnvOption{
@@ -234,6 +263,8 @@ func NewSchema() Schema {
 		cel.Variable("has_link", cel.BoolType),
 		cel.Variable("has_code", cel.BoolType),
 		cel.Variable("has_incomplete_tasks", cel.BoolType),
+		cel.Variable("has_attachment", cel.BoolType),
+		cel.Variable("attachment_text", cel.StringType),
 		nowFunction,
 	}
 
@@ -255,6 +286,19 @@ func NewAttachmentSchema() Schema {
 			SupportsContains: true,
 			Expressions:      map[DialectName]string{},
 		},
+		// text is the extracted and OCR text of the attachment, only usable with contains().
+		"text": {
+			Name:             "text",
+			Kind:             FieldKindScalar,
+			Type:             FieldTypeString,
+			Column:           Column{Table: "attachment", Name: "extracted_text"},
+			SupportsContains: true,
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "(%s || ' ' || `attachment`.`ocr_text`)",
+				DialectPostgres: "(COALESCE(%s, '') || ' ' || COALESCE(attachment.ocr_text, ''))",
+			},
+			AllowedComparisonOps: map[ComparisonOperator]bool{},
+		},
 		"mime_type": {
 			Name:        "mime_type",
 			Kind:        FieldKindScalar,
@@ -288,6 +332,7 @@ func NewAttachmentSchema() Schema {
 
 	envOptions := []cel.EnvOption{
 		cel.Variable("filename", cel.StringType),
+		cel.Variable("text", cel.StringType),
 		cel.Variable("mime_type", cel.StringType),
 		cel.Variable("create_time", cel.IntType),
 		cel.Variable("memo_id", cel.AnyType),
@@ -0,0 +1,607 @@
+package httpgetter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// maxArticleBodySize limits how much of a page is read when extracting an article.
+const maxArticleBodySize = 5 << 20 // 5 MiB
+
+// articleFetchTimeout bounds fetching a page when the caller's context has a later deadline.
+const articleFetchTimeout = 30 * time.Second
+
+// minArticleTextLength is the amount of text a semantic container (<article>, <main>)
+// must hold to be trusted as the main content without scoring.
+const minArticleTextLength = 250
+
+// Article is the readable main content of a web page.
+type Article struct {
+	URL         string `json:"url"`
+	Title       string `json:"title"`
+	SiteName    string `json:"site_name"`
+	Byline      string `json:"byline"`
+	Description string `json:"description"`
+	Image       string `json:"image"`
+	// Markdown is the main content converted to markdown.
+	Markdown string `json:"markdown"`
+}
+
+var (
+	// unlikelyCandidates matches class/id values of boilerplate blocks.
+	unlikelyCandidates = regexp.MustCompile(`(?i)banner|breadcrumb|combx|comment|community|cookie|disqus|extra|footer|gdpr|header|legends|menu|modal|nav|newsletter|pager|pagination|popup|promo|related|remark|rss|share|shoutbox|sidebar|skyscraper|social|sponsor|subscribe|tags|toolbar|widget|advert|\bads?\b`)
+	// maybeCandidates matches class/id values that rescue a block from removal.
+	maybeCandidates = regexp.MustCompile(`(?i)and|article|body|column|content|main|post|shadow|entry|story|text`)
+	// codeLanguage extracts the language from code block classes like "language-go".
+	codeLanguage = regexp.MustCompile(`(?:^|\s)(?:language|lang)-([A-Za-z0-9_+-]+)`)
+	// spaces collapses runs of whitespace in inline text.
+	spaces = regexp.MustCompile(`\s+`)
+	// blankLines collapses runs of blank lines in the rendered markdown.
+	blankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+// GetArticle fetches a web page and extracts its main content as markdown.
+// Internal addresses are rejected, including through redirects. The fetch is
+// canceled with ctx, and after articleFetchTimeout at the latest.
+func GetArticle(ctx context.Context, urlStr string) (*Article, error) {
+	if err := validateURL(urlStr); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, articleFetchTimeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 400 {
+		return nil, errors.Errorf("unexpected status code %d", response.StatusCode)
+	}
+
+	mediatype, err := getMediatype(response)
+	if err != nil {
+		return nil, err
+	}
+	if mediatype != "text/html" && mediatype != "application/xhtml+xml" {
+		return nil, errors.New("not a HTML page")
+	}
+
+	return ExtractArticle(io.LimitReader(response.Body, maxArticleBodySize), response.Request.URL)
+}
+
+// ExtractArticle extracts the readable main content of an HTML document.
+// Relative links and images are resolved against baseURL.
+func ExtractArticle(r io.Reader, baseURL *url.URL) (*Article, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse HTML")
+	}
+
+	article := &Article{}
+	if baseURL != nil {
+		article.URL = baseURL.String()
+	}
+	extractArticleMeta(doc, article)
+
+	body := findFirst(doc, atom.Body)
+	if body == nil {
+		return nil, errors.New("document has no body")
+	}
+	removeBoilerplate(body)
+
+	content := selectMainContent(body)
+	renderer := &markdownRenderer{base: baseURL}
+	renderer.renderBlock(content)
+	article.Markdown = strings.TrimSpace(blankLines.ReplaceAllString(renderer.String(), "\n\n"))
+
+	if article.Title == "" {
+		if h1 := findFirst(content, atom.H1); h1 != nil {
+			article.Title = collapseText(h1)
+		}
+	}
+	if article.Markdown == "" {
+		return nil, errors.New("no readable content found")
+	}
+	return article, nil
+}
+
+// extractArticleMeta fills title, description, site name, byline and image from <head>.
+func extractArticleMeta(doc *html.Node, article *Article) {
+	var ogTitle, title string
+	walk(doc, func(n *html.Node) bool {
+		if n.Type != html.ElementNode {
+			return true
+		}
+		switch n.DataAtom {
+		case atom.Body:
+			return false
+		case atom.Title:
+			title = collapseText(n)
+		case atom.Meta:
+			key := strings.ToLower(attr(n, "property"))
+			if key == "" {
+				key = strings.ToLower(attr(n, "name"))
+			}
+			content := strings.TrimSpace(attr(n, "content"))
+			switch key {
+			case "og:title":
+				ogTitle = content
+			case "description", "og:description":
+				article.Description = content
+			case "og:site_name":
+				article.SiteName = content
+			case "author", "article:author":
+				article.Byline = content
+			case "og:image":
+				article.Image = content
+			}
+		}
+		return true
+	})
+	if ogTitle != "" {
+		article.Title = ogTitle
+	} else {
+		article.Title = title
+	}
+}
+
+// removeBoilerplate drops non-content elements and blocks whose class or id
+// look like navigation, ads, comments and the like.
+func removeBoilerplate(root *html.Node) {
+	var remove []*html.Node
+	walk(root, func(n *html.Node) bool {
+		switch n.Type {
+		case html.CommentNode:
+			remove = append(remove, n)
+			return false
+		case html.ElementNode:
+		default:
+			return true
+		}
+
+		switch n.DataAtom {
+		case atom.Script, atom.Style, atom.Noscript, atom.Iframe, atom.Form, atom.Nav,
+			atom.Footer, atom.Aside, atom.Svg, atom.Button, atom.Input, atom.Select,
+			atom.Textarea, atom.Object, atom.Embed, atom.Canvas, atom.Template:
+			remove = append(remove, n)
+			return false
+		case atom.Header:
+			// Page headers are boilerplate; headers inside an article hold its title.
+			if !hasAncestor(n, atom.Article) {
+				remove = append(remove, n)
+				return false
+			}
+		case atom.Body, atom.Article, atom.Main, atom.Pre, atom.Code:
+			return true
+		}
+
+		if attr(n, "hidden") != "" || strings.EqualFold(attr(n, "aria-hidden"), "true") {
+			remove = append(remove, n)
+			return false
+		}
+		switch strings.ToLower(attr(n, "role")) {
+		case "navigation", "banner", "complementary", "contentinfo", "dialog", "alert":
+			remove = append(remove, n)
+			return false
+		}
+
+		matchString := attr(n, "class") + " " + attr(n, "id")
+		if unlikelyCandidates.MatchString(matchString) && !maybeCandidates.MatchString(matchString) {
+			remove = append(remove, n)
+			return false
+		}
+		return true
+	})
+	for _, n := range remove {
+		if n.Parent != nil {
+			n.Parent.RemoveChild(n)
+		}
+	}
+}
+
+// selectMainContent picks the node holding the article body.
+// Semantic containers win when they hold enough text; otherwise paragraphs
+// are scored and the best-scoring ancestor is chosen.
+func selectMainContent(body *html.Node) *html.Node {
+	var semantic *html.Node
+	semanticLength := 0
+	walk(body, func(n *html.Node) bool {
+		if n.Type == html.ElementNode && (n.DataAtom == atom.Article || n.DataAtom == atom.Main || strings.EqualFold(attr(n, "role"), "main")) {
+			if length := utf8.RuneCountInString(collapseText(n)); length > semanticLength {
+				semantic, semanticLength = n, length
+			}
+		}
+		return true
+	})
+	if semantic != nil && semanticLength >= minArticleTextLength {
+		return semantic
+	}
+
+	scores := make(map[*html.Node]float64)
+	walk(body, func(n *html.Node) bool {
+		if n.Type != html.ElementNode || (n.DataAtom != atom.P && n.DataAtom != atom.Pre && n.DataAtom != atom.Td) {
+			return true
+		}
+		text := collapseText(n)
+		length := utf8.RuneCountInString(text)
+		if length < 25 {
+			return false
+		}
+		score := 1 + float64(strings.Count(text, ",")+strings.Count(text, "，")+strings.Count(text, "。"))
+		score += min(float64(length)/100, 3)
+
+		if parent := n.Parent; parent != nil {
+			scores[parent] += score
+			if grandparent := parent.Parent; grandparent != nil {
+				scores[grandparent] += score / 2
+			}
+		}
+		return false
+	})
+
+	var best *html.Node
+	bestScore := 0.0
+	for n, score := range scores {
+		score *= 1 - linkDensity(n)
+		if score > bestScore {
+			best, bestScore = n, score
+		}
+	}
+	if best == nil {
+		if semantic != nil {
+			return semantic
+		}
+		return body
+	}
+	return best
+}
+
+// linkDensity returns the share of a node's text that sits inside links.
+func linkDensity(n *html.Node) float64 {
+	total := utf8.RuneCountInString(collapseText(n))
+	if total == 0 {
+		return 0
+	}
+	linkLength := 0
+	walk(n, func(c *html.Node) bool {
+		if c.Type == html.ElementNode && c.DataAtom == atom.A {
+			linkLength += utf8.RuneCountInString(collapseText(c))
+			return false
+		}
+		return true
+	})
+	return float64(linkLength) / float64(total)
+}
+
+// markdownRenderer converts an HTML subtree to markdown, keeping headings,
+// lists, code, quotes, links, images and simple tables.
+type markdownRenderer struct {
+	base   *url.URL
+	b      strings.Builder
+	indent string // prefix for nested list items and quotes
+}
+
+func (r *markdownRenderer) String() string {
+	return r.b.String()
+}
+
+// writeBlock writes a block of text separated from its neighbours by a blank line.
+func (r *markdownRenderer) writeBlock(text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			r.b.WriteString("\n")
+		}
+		r.b.WriteString(r.indent + line)
+	}
+	r.b.WriteString("\n\n")
+}
+
+// renderBlock renders n and its children as block-level markdown.
+func (r *markdownRenderer) renderBlock(n *html.Node) {
+	var inline strings.Builder
+	flush := func() {
+		r.writeBlock(inline.String())
+		inline.Reset()
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.TextNode || (c.Type == html.ElementNode && !isBlockElement(c)) {
+			inline.WriteString(r.renderInline(c))
+			continue
+		}
+		if c.Type != html.ElementNode {
+			continue
+		}
+		flush()
+
+		switch c.DataAtom {
+		case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+			level := int(c.Data[1] - '0')
+			if text := strings.TrimSpace(r.renderInlineChildren(c)); text != "" {
+				r.writeBlock(strings.Repeat("#", level) + " " + text)
+			}
+		case atom.Ul, atom.Ol:
+			r.renderList(c)
+		case atom.Pre:
+			r.renderCode(c)
+		case atom.Blockquote:
+			quoted := &markdownRenderer{base: r.base}
+			quoted.renderBlock(c)
+			var lines []string
+			for _, line := range strings.Split(strings.TrimSpace(quoted.String()), "\n") {
+				lines = append(lines, strings.TrimRight("> "+line, " "))
+			}
+			r.writeBlock(strings.Join(lines, "\n"))
+		case atom.Hr:
+			r.writeBlock("---")
+		case atom.Table:
+			r.renderTable(c)
+		default:
+			r.renderBlock(c)
+		}
+	}
+	flush()
+}
+
+// renderList renders <ul>/<ol> items, nesting sub-lists by indentation.
+func (r *markdownRenderer) renderList(list *html.Node) {
+	index := 0
+	for li := list.FirstChild; li != nil; li = li.NextSibling {
+		if li.Type != html.ElementNode || li.DataAtom != atom.Li {
+			continue
+		}
+		index++
+		marker := "- "
+		if list.DataAtom == atom.Ol {
+			marker = fmt.Sprintf("%d. ", index)
+		}
+
+		item := &markdownRenderer{base: r.base}
+		item.renderBlock(li)
+		lines := strings.Split(strings.TrimSpace(blankLines.ReplaceAllString(item.String(), "\n\n")), "\n")
+		var b strings.Builder
+		for i, line := range lines {
+			if i == 0 {
+				b.WriteString(marker + line)
+			} else if line != "" {
+				b.WriteString("\n" + strings.Repeat(" ", len(marker)) + line)
+			}
+		}
+		if b.Len() > 0 {
+			r.b.WriteString(r.indent + strings.ReplaceAll(b.String(), "\n", "\n"+r.indent) + "\n")
+		}
+	}
+	r.b.WriteString("\n")
+}
+
+// renderCode renders a <pre> block as a fenced code block.
+func (r *markdownRenderer) renderCode(pre *html.Node) {
+	language := ""
+	if m := codeLanguage.FindStringSubmatch(attr(pre, "class")); m != nil {
+		language = m[1]
+	} else if code := findFirst(pre, atom.Code); code != nil {
+		if m := codeLanguage.FindStringSubmatch(attr(code, "class")); m != nil {
+			language = m[1]
+		}
+	}
+	code := strings.Trim(rawText(pre), "\n")
+	if strings.TrimSpace(code) == "" {
+		return
+	}
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+	r.writeBlock(fence + language + "\n" + code + "\n" + fence)
+}
+
+// renderTable renders a table as a markdown pipe table, using the first row as header.
+func (r *markdownRenderer) renderTable(table *html.Node) {
+	var rows [][]string
+	walk(table, func(n *html.Node) bool {
+		if n.Type != html.ElementNode || n.DataAtom != atom.Tr {
+			return true
+		}
+		var cells []string
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.ElementNode && (c.DataAtom == atom.Td || c.DataAtom == atom.Th) {
+				cell := strings.TrimSpace(r.renderInlineChildren(c))
+				cells = append(cells, strings.ReplaceAll(cell, "|", `\|`))
+			}
+		}
+		if len(cells) > 0 {
+			rows = append(rows, cells)
+		}
+		return false
+	})
+	if len(rows) == 0 {
+		return
+	}
+
+	columns := 0
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+	var b strings.Builder
+	for i, row := range rows {
+		for len(row) < columns {
+			row = append(row, "")
+		}
+		b.WriteString("| " + strings.Join(row, " | ") + " |\n")
+		if i == 0 {
+			b.WriteString("|" + strings.Repeat(" --- |", columns) + "\n")
+		}
+	}
+	r.writeBlock(b.String())
+}
+
+// renderInlineChildren renders the children of n as inline markdown.
+func (r *markdownRenderer) renderInlineChildren(n *html.Node) string {
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(r.renderInline(c))
+	}
+	return b.String()
+}
+
+// renderInline renders a text or phrasing node as inline markdown.
+func (r *markdownRenderer) renderInline(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return spaces.ReplaceAllString(n.Data, " ")
+	}
+	if n.Type != html.ElementNode {
+		return ""
+	}
+
+	switch n.DataAtom {
+	case atom.Br:
+		return "\n"
+	case atom.Img:
+		src := r.resolve(attr(n, "src"))
+		if src == "" {
+			return ""
+		}
+		return fmt.Sprintf("![%s](%s)", strings.TrimSpace(attr(n, "alt")), src)
+	case atom.Code, atom.Kbd, atom.Samp:
+		text := strings.TrimSpace(rawText(n))
+		if text == "" {
+			return ""
+		}
+		return "`" + text + "`"
+	}
+
+	inner := r.renderInlineChildren(n)
+	trimmed := strings.TrimSpace(inner)
+	if trimmed == "" {
+		return inner
+	}
+	switch n.DataAtom {
+	case atom.A:
+		href := r.resolve(attr(n, "href"))
+		if href == "" || strings.HasPrefix(href, "#") {
+			return inner
+		}
+		return fmt.Sprintf("[%s](%s)", trimmed, href)
+	case atom.Strong, atom.B:
+		return "**" + trimmed + "**"
+	case atom.Em, atom.I:
+		return "*" + trimmed + "*"
+	case atom.Del, atom.S:
+		return "~~" + trimmed + "~~"
+	default:
+		return inner
+	}
+}
+
+// resolve resolves a link against the page URL, dropping javascript: and data: links.
+func (r *markdownRenderer) resolve(ref string) string {
+	ref = strings.TrimSpace(ref)
+	lower := strings.ToLower(ref)
+	if ref == "" || strings.HasPrefix(lower, "javascript:") || strings.HasPrefix(lower, "data:") {
+		return ""
+	}
+	if r.base == nil || strings.HasPrefix(ref, "#") {
+		return ref
+	}
+	u, err := r.base.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return u.String()
+}
+
+// isBlockElement reports whether an element starts a new markdown block.
+func isBlockElement(n *html.Node) bool {
+	switch n.DataAtom {
+	case atom.Address, atom.Article, atom.Blockquote, atom.Dd, atom.Details, atom.Div, atom.Dl,
+		atom.Dt, atom.Figcaption, atom.Figure, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5,
+		atom.H6, atom.Header, atom.Hr, atom.Li, atom.Main, atom.Ol, atom.P, atom.Pre,
+		atom.Section, atom.Summary, atom.Table, atom.Ul:
+		return true
+	}
+	return false
+}
+
+// walk visits n and its descendants depth-first; returning false skips children.
+func walk(n *html.Node, visit func(*html.Node) bool) {
+	if !visit(n) {
+		return
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, visit)
+	}
+}
+
+// findFirst returns the first descendant element with the given tag.
+func findFirst(n *html.Node, tag atom.Atom) *html.Node {
+	var found *html.Node
+	walk(n, func(c *html.Node) bool {
+		if found != nil {
+			return false
+		}
+		if c.Type == html.ElementNode && c.DataAtom == tag {
+			found = c
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// hasAncestor reports whether n is nested inside an element with the given tag.
+func hasAncestor(n *html.Node, tag atom.Atom) bool {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p.Type == html.ElementNode && p.DataAtom == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// attr returns the value of an attribute, or "" when missing.
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// rawText returns the concatenated text of n, preserving whitespace.
+func rawText(n *html.Node) string {
+	var b strings.Builder
+	walk(n, func(c *html.Node) bool {
+		if c.Type == html.TextNode {
+			b.WriteString(c.Data)
+		}
+		return true
+	})
+	return b.String()
+}
+
+// collapseText returns the text of n with whitespace collapsed.
+func collapseText(n *html.Node) string {
+	return strings.TrimSpace(spaces.ReplaceAllString(rawText(n), " "))
+}
@@ -0,0 +1,93 @@
+package httpgetter
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const articleFixture = `<!DOCTYPE html>
+<html>
+<head>
+  <title>Fallback title</title>
+  <meta property="og:title" content="Understanding Go Channels">
+  <meta property="og:site_name" content="Example Blog">
+  <meta name="author" content="Jane Doe">
+  <meta name="description" content="A short tour of channels.">
+  <script>var tracking = true;</script>
+</head>
+<body>
+  <header class="site-header"><a href="/">Home</a> <a href="/about">About</a></header>
+  <nav><ul><li><a href="/a">A</a></li><li><a href="/b">B</a></li></ul></nav>
+  <div class="sidebar-widget">Subscribe to our newsletter!</div>
+  <article>
+    <h1>Understanding Go Channels</h1>
+    <p>Channels are the pipes that connect concurrent goroutines. You can send values into channels from one goroutine, and receive those values into another goroutine.</p>
+    <h2>Buffered channels</h2>
+    <p>By default sends and receives block until both sides are ready. A <strong>buffered</strong> channel accepts a limited number of values without a <em>corresponding</em> receiver, see <a href="/docs/buffers">the docs</a>.</p>
+    <pre><code class="language-go">ch := make(chan int, 2)
+ch &lt;- 1</code></pre>
+    <ul><li>Unbuffered</li><li>Buffered</li></ul>
+    <img src="images/pipe.png" alt="pipe">
+    <table><tr><th>Kind</th><th>Blocks</th></tr><tr><td>unbuffered</td><td>always</td></tr></table>
+    <div class="comments">Great post!</div>
+  </article>
+  <footer>Copyright</footer>
+</body>
+</html>`
+
+func TestExtractArticle(t *testing.T) {
+	base, err := url.Parse("https://blog.example.com/posts/channels")
+	require.NoError(t, err)
+
+	article, err := ExtractArticle(strings.NewReader(articleFixture), base)
+	require.NoError(t, err)
+
+	require.Equal(t, "Understanding Go Channels", article.Title)
+	require.Equal(t, "Example Blog", article.SiteName)
+	require.Equal(t, "Jane Doe", article.Byline)
+	require.Equal(t, "A short tour of channels.", article.Description)
+
+	md := article.Markdown
+	require.True(t, strings.HasPrefix(md, "# Understanding Go Channels\n\n"))
+	require.Contains(t, md, "## Buffered channels")
+	require.Contains(t, md, "A **buffered** channel")
+	require.Contains(t, md, "*corresponding*")
+	require.Contains(t, md, "[the docs](https://blog.example.com/docs/buffers)")
+	require.Contains(t, md, "```go\nch := make(chan int, 2)\nch <- 1\n```")
+	require.Contains(t, md, "- Unbuffered\n- Buffered")
+	require.Contains(t, md, "![pipe](https://blog.example.com/posts/images/pipe.png)")
+	require.Contains(t, md, "| Kind | Blocks |\n| --- | --- |\n| unbuffered | always |")
+
+	for _, boilerplate := range []string{"tracking", "Subscribe", "Home", "Copyright", "Great post"} {
+		require.NotContains(t, md, boilerplate)
+	}
+}
+
+func TestExtractArticleScoresParagraphs(t *testing.T) {
+	page := `<html><body>
+<div id="menu"><a href="/1">One</a><a href="/2">Two</a></div>
+<div class="links"><p><a href="/x">A very long link list entry that is mostly links, and nothing else at all</a></p></div>
+<div class="entry">
+<p>The first paragraph of the story is long enough to be counted, and it has a few commas, too.</p>
+<p>The second paragraph continues the story, adding detail, nuance, and more commas for scoring.</p>
+</div>
+</body></html>`
+
+	article, err := ExtractArticle(strings.NewReader(page), nil)
+	require.NoError(t, err)
+	require.Contains(t, article.Markdown, "The first paragraph")
+	require.Contains(t, article.Markdown, "The second paragraph")
+	require.NotContains(t, article.Markdown, "link list")
+	require.NotContains(t, article.Markdown, "One")
+}
+
+func TestGetArticleForInternal(t *testing.T) {
+	if _, err := GetArticle(context.Background(), "http://127.0.0.1/post"); !errors.Is(err, ErrInternalIP) {
+		t.Errorf("Expected error for internal IP, got %v", err)
+	}
+}
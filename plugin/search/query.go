@@ -0,0 +1,263 @@
+// Package search parses the memo search query syntax.
+//
+// A query mixes free text with operators:
+//
+//	tag:work -tag:draft              memos tagged work (or work/...) but not draft
+//	before:2026-01-01                created before the date
+//	after:"last month"               created since the start of a natural time expression
+//	has:attachment has:task          has attachments / a task list (also has:link, has:code)
+//	is:pinned visibility:private     pinned / private memos
+//	"exact phrase"                   content contains the phrase
+//	a OR b                           either term matches
+//
+// Operators compile into a CEL expression for the plugin/filter memo engine;
+// the remaining words form the text used for BM25 and vector retrieval. Words
+// joined by OR only go into the filter, because BM25 requires every word of the text.
+package search
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hrygo/divinesense/plugin/ai/aitime"
+)
+
+// Query is a parsed search query.
+type Query struct {
+	// Text is the free text used for BM25 and vector retrieval, with phrase quotes
+	// removed. It excludes words joined by OR, which only match through Filter.
+	Text string
+	// Alternatives are the free words joined by OR, for embedding and highlighting.
+	Alternatives []string
+	// Filter is the CEL memo filter of the operators, empty if there are none.
+	Filter string
+}
+
+// term is a single operator, word or phrase of a query.
+type term struct {
+	negated bool
+	key     string // Operator name, empty for free text
+	value   string
+	phrase  bool
+}
+
+var timeService = aitime.NewService("UTC")
+
+// ParseQuery parses a search query. Relative dates are resolved against now,
+// in the location of now.
+func ParseQuery(ctx context.Context, input string, now time.Time) (*Query, error) {
+	var texts, alternatives, filters []string
+	var group []term
+	flush := func() error {
+		if len(group) == 0 {
+			return nil
+		}
+		text, filter, err := compileGroup(ctx, group, now)
+		if err != nil {
+			return err
+		}
+		if len(group) > 1 {
+			alternatives = append(alternatives, text...)
+		} else {
+			texts = append(texts, text...)
+		}
+		group = nil
+		if filter != "" {
+			filters = append(filters, filter)
+		}
+		return nil
+	}
+
+	terms := lex(input)
+	for i := 0; i < len(terms); i++ {
+		t := terms[i]
+		// OR joins the previous and the next term into one group
+		if isOr(t) && len(group) > 0 && i+1 < len(terms) && !isOr(terms[i+1]) {
+			group = append(group, terms[i+1])
+			i++
+			continue
+		}
+		if err := flush(); err != nil {
+			return nil, err
+		}
+		group = []term{t}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return &Query{
+		Text:         strings.Join(texts, " "),
+		Alternatives: alternatives,
+		Filter:       strings.Join(filters, " && "),
+	}, nil
+}
+
+// compileGroup compiles terms joined by OR and returns their free words. Inside
+// a group free words become content filters, so that "a OR b" requires either
+// word to appear; callers keep them out of the retrieval text, whose words must all match.
+func compileGroup(ctx context.Context, group []term, now time.Time) ([]string, string, error) {
+	var texts, filters []string
+	for _, t := range group {
+		text, filter, err := compileTerm(ctx, t, now, len(group) > 1)
+		if err != nil {
+			return nil, "", err
+		}
+		if text != "" {
+			texts = append(texts, text)
+		}
+		if filter != "" {
+			filters = append(filters, filter)
+		}
+	}
+	if len(group) == 1 || len(filters) == 0 {
+		return texts, strings.Join(filters, ""), nil
+	}
+	return texts, "(" + strings.Join(filters, " || ") + ")", nil
+}
+
+// compileTerm returns the retrieval text and the CEL filter of a term.
+func compileTerm(ctx context.Context, t term, now time.Time, inGroup bool) (string, string, error) {
+	var text, filter string
+	switch t.key {
+	case "":
+		if !t.negated {
+			text = t.value
+		}
+		if t.phrase || t.negated || inGroup {
+			filter = "content.contains(" + strconv.Quote(t.value) + ")"
+		}
+	case "tag":
+		filter = "tag in [" + strconv.Quote(strings.TrimPrefix(t.value, "#")) + "]"
+	case "before", "after":
+		ts, err := parseTime(ctx, t.value, now)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid date in %s:%s: %w", t.key, t.value, err)
+		}
+		if t.key == "before" {
+			filter = fmt.Sprintf("created_ts < %d", ts)
+		} else {
+			filter = fmt.Sprintf("created_ts >= %d", ts)
+		}
+	case "has":
+		switch strings.ToLower(t.value) {
+		case "attachment", "attachments", "file":
+			filter = "has_attachment"
+		case "task", "tasks", "todo":
+			filter = "has_task_list"
+		case "link", "links":
+			filter = "has_link"
+		case "code":
+			filter = "has_code"
+		default:
+			return "", "", fmt.Errorf("unknown has:%s, expected attachment, task, link or code", t.value)
+		}
+	case "is":
+		switch strings.ToLower(t.value) {
+		case "pinned":
+			filter = "pinned"
+		default:
+			return "", "", fmt.Errorf("unknown is:%s, expected pinned", t.value)
+		}
+	case "visibility":
+		visibility := strings.ToUpper(t.value)
+		switch visibility {
+		case "PUBLIC", "PROTECTED", "PRIVATE":
+			filter = "visibility == " + strconv.Quote(visibility)
+		default:
+			return "", "", fmt.Errorf("unknown visibility:%s, expected public, protected or private", t.value)
+		}
+	}
+
+	if t.negated && t.key != "" {
+		filter = "!(" + filter + ")"
+	} else if t.negated {
+		filter = "!" + filter
+	}
+	return text, filter, nil
+}
+
+// parseTime returns the unix start of a date or natural time expression such as "last month".
+func parseTime(ctx context.Context, value string, now time.Time) (int64, error) {
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t.Unix(), nil
+	}
+	tr, err := timeService.ParseNaturalTime(ctx, value, now)
+	if err != nil {
+		return 0, err
+	}
+	return tr.Start.Unix(), nil
+}
+
+// lex splits a query into terms. Values may be quoted, e.g. after:"last month".
+func lex(input string) []term {
+	var terms []term
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		var t term
+		if runes[i] == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			t.negated = true
+			i++
+		}
+		if runes[i] == '"' {
+			t.value, i = readQuoted(runes, i)
+			t.phrase = true
+		} else {
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != '"' {
+				i++
+			}
+			word := string(runes[start:i])
+			if key, value, ok := strings.Cut(word, ":"); ok && isOperator(key) {
+				t.key = strings.ToLower(key)
+				if value == "" && i < len(runes) && runes[i] == '"' {
+					value, i = readQuoted(runes, i)
+				}
+				t.value = value
+			} else {
+				t.value = word
+			}
+		}
+		if t.value == "" {
+			continue
+		}
+		terms = append(terms, t)
+	}
+	return terms
+}
+
+// readQuoted reads a quoted string starting at the opening quote. An unclosed
+// quote extends to the end of the input.
+func readQuoted(runes []rune, i int) (string, int) {
+	start := i + 1
+	end := start
+	for end < len(runes) && runes[end] != '"' {
+		end++
+	}
+	value := strings.TrimSpace(string(runes[start:end]))
+	if end < len(runes) {
+		end++
+	}
+	return value, end
+}
+
+func isOperator(key string) bool {
+	switch strings.ToLower(key) {
+	case "tag", "before", "after", "has", "is", "visibility":
+		return true
+	}
+	return false
+}
+
+func isOr(t term) bool {
+	return t.key == "" && !t.phrase && !t.negated && t.value == "OR"
+}
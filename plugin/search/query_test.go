@@ -0,0 +1,69 @@
+package search
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/plugin/filter"
+)
+
+func TestParseQuery(t *testing.T) {
+	now := time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC)
+	jan1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+	tests := []struct {
+		input        string
+		text         string
+		alternatives []string
+		filter       string
+	}{
+		{input: "weekly report", text: "weekly report"},
+		{input: "report tag:work -tag:draft", text: "report", filter: `tag in ["work"] && !(tag in ["draft"])`},
+		{input: "before:2026-01-01", filter: "created_ts < " + strconv.FormatInt(jan1, 10)},
+		{input: `after:"last month" 周报`, text: "周报", filter: "created_ts >= " + strconv.FormatInt(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC).Unix(), 10)},
+		{input: "has:attachment has:task is:pinned visibility:private", filter: `has_attachment && has_task_list && pinned && visibility == "PRIVATE"`},
+		{input: `"release plan" notes`, text: "release plan notes", filter: `content.contains("release plan")`},
+		{input: "budget -draft", text: "budget", filter: `!content.contains("draft")`},
+		{input: "alpha OR beta gamma", text: "gamma", alternatives: []string{"alpha", "beta"}, filter: `(content.contains("alpha") || content.contains("beta"))`},
+		{input: "tag:work OR tag:home", filter: `(tag in ["work"] || tag in ["home"])`},
+		{input: "url:example.com", text: "url:example.com"},
+	}
+
+	engine, err := filter.DefaultEngine()
+	require.NoError(t, err)
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			q, err := ParseQuery(context.Background(), tt.input, now)
+			require.NoError(t, err)
+			require.Equal(t, tt.text, q.Text)
+			require.Equal(t, tt.alternatives, q.Alternatives)
+			require.Equal(t, tt.filter, q.Filter)
+			if q.Filter != "" {
+				_, err := engine.CompileToStatement(context.Background(), q.Filter, filter.RenderOptions{Dialect: filter.DialectSQLite})
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseQueryTimezone(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	// 2026-03-01 01:00 in Shanghai is still February in UTC
+	now := time.Date(2026, 3, 1, 1, 0, 0, 0, shanghai)
+
+	q, err := ParseQuery(context.Background(), `after:"last month"`, now)
+	require.NoError(t, err)
+	require.Equal(t, "created_ts >= "+strconv.FormatInt(time.Date(2026, 2, 1, 0, 0, 0, 0, shanghai).Unix(), 10), q.Filter)
+}
+
+func TestParseQueryInvalid(t *testing.T) {
+	for _, input := range []string{"has:everything", "is:archived", "visibility:secret", "before:someday"} {
+		_, err := ParseQuery(context.Background(), input, time.Now())
+		require.Error(t, err, input)
+	}
+}
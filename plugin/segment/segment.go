@@ -0,0 +1,290 @@
+// Package segment provides a dictionary-based word segmenter for full-text search.
+//
+// Chinese text has no spaces between words, so search engines that split on
+// whitespace index a whole sentence as one token. The segmenter cuts Chinese
+// runs into words using a jieba-style maximum probability path over a prefix
+// dictionary, and lowercases runs of other letters and digits. Characters that
+// are not covered by the dictionary are indexed as overlapping bigrams, so
+// unknown words still match as long as the query is segmented the same way.
+//
+// A user dictionary extends the built-in one with the words of a user, such as
+// the Chinese words of their tags (see TagWords), so that "灵犀号" stays one
+// token. Documents are indexed with the words of their owner on top of the
+// tokens of the built-in dictionary, and queries over one user's documents are
+// cut with the same words. Callers reindex a user's documents that contain a
+// word when it is added to or removed from the user dictionary.
+package segment
+
+import (
+	"bufio"
+	_ "embed"
+	"maps"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed dict.txt
+var builtinDict string
+
+const (
+	// defaultWordFreq is the frequency of dictionary words listed without one.
+	defaultWordFreq = 10000
+
+	// userWordFreq is the frequency of user words, high enough for a user word
+	// to win over the dictionary words it contains.
+	userWordFreq = 10000
+
+	// maxTokenLen caps the length of letter and digit tokens in runes.
+	maxTokenLen = 64
+)
+
+// stopwords are single characters dropped from the output. They also split
+// unknown runs, so "周会的记录" and "周会记录" share the same tokens.
+var stopwords = map[rune]bool{
+	'的': true, '了': true, '着': true, '吗': true, '呢': true, '吧': true, '啊': true,
+	'和': true, '与': true, '及': true, '或': true, '在': true, '是': true,
+}
+
+// Segmenter cuts text into search tokens. It is safe for concurrent use.
+type Segmenter struct {
+	freq   map[string]float64
+	total  float64
+	maxLen int
+}
+
+// builtinSegmenter is used by IndexText and QueryText.
+var builtinSegmenter = New()
+
+// New creates a segmenter with the built-in dictionary.
+func New() *Segmenter {
+	s := &Segmenter{freq: make(map[string]float64)}
+	scanner := bufio.NewScanner(strings.NewReader(builtinDict))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		freq := float64(defaultWordFreq)
+		if len(fields) > 1 {
+			if f, err := strconv.ParseFloat(fields[1], 64); err == nil && f > 0 {
+				freq = f
+			}
+		}
+		s.addWord(fields[0], freq)
+	}
+	return s
+}
+
+// WithWords returns a copy of the segmenter that also knows words, e.g. the tag
+// words of a user. Words shorter than two characters are ignored.
+func (s *Segmenter) WithWords(words []string) *Segmenter {
+	c := &Segmenter{freq: maps.Clone(s.freq), total: s.total, maxLen: s.maxLen}
+	for _, word := range words {
+		if _, ok := c.freq[word]; !ok && utf8.RuneCountInString(word) >= 2 {
+			c.addWord(word, userWordFreq)
+		}
+	}
+	return c
+}
+
+// TagWords returns the Chinese words of memo tags for a user dictionary, sorted
+// and without duplicates. Hierarchical tags such as "工作/项目周会" give each level separately.
+func TagWords(tags []string) []string {
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		for _, part := range strings.Split(tag, "/") {
+			var run []rune
+			for _, r := range part + " " {
+				if unicode.Is(unicode.Han, r) {
+					run = append(run, r)
+					continue
+				}
+				if len(run) >= 2 {
+					seen[string(run)] = true
+				}
+				run = run[:0]
+			}
+		}
+	}
+	words := make([]string, 0, len(seen))
+	for word := range seen {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	return words
+}
+
+// Cut segments text into tokens in reading order.
+func (s *Segmenter) Cut(text string) []string {
+	var tokens []string
+	var run []rune
+	runHan := false
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		if runHan {
+			tokens = append(tokens, s.cutHan(run)...)
+		} else if len(run) <= maxTokenLen {
+			tokens = append(tokens, strings.ToLower(string(run)))
+		}
+		run = run[:0]
+	}
+
+	for _, r := range text {
+		isHan := unicode.Is(unicode.Han, r)
+		if !isHan && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if isHan != runHan {
+			flush()
+			runHan = isHan
+		}
+		run = append(run, r)
+	}
+	flush()
+	return tokens
+}
+
+// CutForSearch segments text like Cut, and additionally emits the dictionary
+// words contained in long words so that "项目管理" also matches "项目".
+// Use it for indexed documents and Cut for queries.
+func (s *Segmenter) CutForSearch(text string) []string {
+	words := s.Cut(text)
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		runes := []rune(word)
+		if len(runes) > 2 && unicode.Is(unicode.Han, runes[0]) {
+			for n := 2; n <= 3 && n < len(runes); n++ {
+				for i := 0; i+n <= len(runes); i++ {
+					if sub := string(runes[i : i+n]); s.freq[sub] > 0 {
+						tokens = append(tokens, sub)
+					}
+				}
+			}
+		}
+		tokens = append(tokens, word)
+	}
+	return tokens
+}
+
+// IndexText returns the space-separated search tokens of a document, cut with
+// the user words of its owner. The tokens of the built-in dictionary are always
+// included, so the document also matches queries cut without the words.
+func IndexText(text string, words []string) string {
+	tokens := builtinSegmenter.CutForSearch(text)
+	if words = containedWords(text, words); len(words) > 0 {
+		tokens = mergeTokens(builtinSegmenter.WithWords(words).CutForSearch(text), tokens)
+	}
+	return strings.Join(tokens, " ")
+}
+
+// QueryText returns the space-separated search tokens of a query, cut with the
+// user words of the owner of the searched documents. Pass nil words when the
+// documents may belong to several users.
+func QueryText(text string, words []string) string {
+	s := builtinSegmenter
+	if words = containedWords(text, words); len(words) > 0 {
+		s = s.WithWords(words)
+	}
+	return strings.Join(s.Cut(text), " ")
+}
+
+// containedWords returns the words that occur in text.
+func containedWords(text string, words []string) []string {
+	var contained []string
+	for _, word := range words {
+		if strings.Contains(text, word) {
+			contained = append(contained, word)
+		}
+	}
+	return contained
+}
+
+// mergeTokens appends the tokens of more that are missing from tokens, counting repeats.
+func mergeTokens(tokens, more []string) []string {
+	counts := make(map[string]int, len(tokens))
+	for _, token := range tokens {
+		counts[token]++
+	}
+	for _, token := range more {
+		if counts[token] > 0 {
+			counts[token]--
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// cutHan segments a run of Chinese characters along the maximum probability path.
+func (s *Segmenter) cutHan(runes []rune) []string {
+	n := len(runes)
+	logTotal := math.Log(s.total)
+	maxLen := max(s.maxLen, 1)
+	route := make([]float64, n+1)
+	next := make([]int, n+1)
+	for i := n - 1; i >= 0; i-- {
+		route[i] = math.Inf(-1)
+		for j := i + 1; j <= n && j-i <= maxLen; j++ {
+			freq, ok := s.freq[string(runes[i:j])]
+			if !ok {
+				if j-i > 1 {
+					continue
+				}
+				freq = 1
+			}
+			if score := math.Log(freq) - logTotal + route[j]; score > route[i] {
+				route[i], next[i] = score, j
+			}
+		}
+	}
+
+	// Unknown characters are buffered and emitted as overlapping bigrams.
+	var tokens []string
+	var unknown []rune
+	flush := func() {
+		switch {
+		case len(unknown) == 1:
+			tokens = append(tokens, string(unknown))
+		case len(unknown) > 1:
+			for i := 0; i+1 < len(unknown); i++ {
+				tokens = append(tokens, string(unknown[i:i+2]))
+			}
+		}
+		unknown = unknown[:0]
+	}
+	for i := 0; i < n; i = next[i] {
+		word := runes[i:next[i]]
+		if len(word) == 1 {
+			if stopwords[word[0]] {
+				flush()
+			} else if _, ok := s.freq[string(word)]; ok {
+				flush()
+				tokens = append(tokens, string(word))
+			} else {
+				unknown = append(unknown, word[0])
+			}
+			continue
+		}
+		flush()
+		tokens = append(tokens, string(word))
+	}
+	flush()
+	return tokens
+}
+
+// addWord adds a word with the given frequency.
+func (s *Segmenter) addWord(word string, freq float64) {
+	s.total += freq - s.freq[word]
+	s.freq[word] = freq
+	if n := utf8.RuneCountInString(word); n > s.maxLen {
+		s.maxLen = n
+	}
+}
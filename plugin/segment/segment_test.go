@@ -0,0 +1,81 @@
+package segment
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCut(t *testing.T) {
+	s := New()
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"明天下午开项目周会", []string{"明天", "下午", "开", "项目", "周会"}},
+		{"周会的记录", []string{"周会", "记录"}},
+		{"Deploy v2 到服务器", []string{"deploy", "v2", "到", "服务器"}},
+		{"龙虾泡面", []string{"龙虾", "虾泡", "泡面"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := s.Cut(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Cut(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCutForSearch(t *testing.T) {
+	s := New()
+	got := strings.Join(s.CutForSearch("项目管理很重要"), " ")
+	for _, want := range []string{"项目", "管理", "项目管理", "重要"} {
+		if !strings.Contains(" "+got+" ", " "+want+" ") {
+			t.Errorf("CutForSearch missing %q in %q", want, got)
+		}
+	}
+}
+
+func TestTagWords(t *testing.T) {
+	got := TagWords([]string{"工作/灵犀号", "灵犀号", "ignored", "项目A周会", "读"})
+	if want := []string{"周会", "工作", "灵犀号", "项目"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TagWords = %v, want %v", got, want)
+	}
+}
+
+func TestWithWords(t *testing.T) {
+	s := New()
+	if got, want := s.Cut("灵犀号计划"), []string{"灵犀", "犀号", "计划"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Cut = %v, want %v", got, want)
+	}
+	if got, want := s.WithWords([]string{"灵犀号"}).Cut("灵犀号计划"), []string{"灵犀号", "计划"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Cut with words = %v, want %v", got, want)
+	}
+	if got, want := s.Cut("灵犀号计划"), []string{"灵犀", "犀号", "计划"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Cut after WithWords = %v, want %v, the segmenter must not change", got, want)
+	}
+}
+
+func TestQueryTextMatchesIndexText(t *testing.T) {
+	words := TagWords([]string{"工作/灵犀号"})
+	text := "#工作/灵犀号 下周启动灵犀号计划"
+	for _, indexWords := range [][]string{nil, words} {
+		index := " " + IndexText(text, indexWords) + " "
+		for _, query := range []string{"灵犀号计划", "灵犀号", "工作"} {
+			// Queries cut without the words match documents indexed with them
+			for _, queryWords := range [][]string{nil, indexWords} {
+				for _, token := range strings.Fields(QueryText(query, queryWords)) {
+					if !strings.Contains(index, " "+token+" ") {
+						t.Errorf("QueryText(%q, %v) token %q not in index %q", query, queryWords, token, index)
+					}
+				}
+			}
+		}
+	}
+
+	if got, want := QueryText("灵犀号计划", nil), "灵犀 犀号 计划"; got != want {
+		t.Errorf("QueryText = %q, want %q", got, want)
+	}
+	if got, want := QueryText("灵犀号计划", words), "灵犀号 计划"; got != want {
+		t.Errorf("QueryText with words = %q, want %q", got, want)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -185,6 +186,56 @@ func (ReviewQuality) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{2}
 }
 
+// Type is the kind of memory.
+type AIMemory_Type int32
+
+const (
+	AIMemory_TYPE_UNSPECIFIED     AIMemory_Type = 0
+	AIMemory_EPISODE              AIMemory_Type = 1 // A past interaction, recalled in later chats
+	AIMemory_CONVERSATION_SUMMARY AIMemory_Type = 2 // A summary of older turns of a conversation
+)
+
+// Enum value maps for AIMemory_Type.
+var (
+	AIMemory_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "EPISODE",
+		2: "CONVERSATION_SUMMARY",
+	}
+	AIMemory_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":     0,
+		"EPISODE":              1,
+		"CONVERSATION_SUMMARY": 2,
+	}
+)
+
+func (x AIMemory_Type) Enum() *AIMemory_Type {
+	p := new(AIMemory_Type)
+	*p = x
+	return p
+}
+
+func (x AIMemory_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AIMemory_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_ai_service_proto_enumTypes[3].Descriptor()
+}
+
+func (AIMemory_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_ai_service_proto_enumTypes[3]
+}
+
+func (x AIMemory_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AIMemory_Type.Descriptor instead.
+func (AIMemory_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{48, 0}
+}
+
 // ScheduleAgentChatRequest is the request for schedule agent chat.
 type ScheduleAgentChatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -348,7 +399,8 @@ func (x *ScheduleAgentStreamResponse) GetDone() bool {
 type SemanticSearchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // default: 10, max: 50
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                  // default: 10, max: 50
+	UserTimezone  string                 `protobuf:"bytes,3,opt,name=user_timezone,json=userTimezone,proto3" json:"user_timezone,omitempty"` // User's timezone in IANA format for relative dates such as after:"last month". Defaults to the server default timezone.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -397,6 +449,13 @@ func (x *SemanticSearchRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *SemanticSearchRequest) GetUserTimezone() string {
+	if x != nil {
+		return x.UserTimezone
+	}
+	return ""
+}
+
 // SemanticSearchResponse is the response for SemanticSearch.
 type SemanticSearchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -611,8 +670,13 @@ type ChatRequest struct {
 	AgentType          AgentType              `protobuf:"varint,5,opt,name=agent_type,json=agentType,proto3,enum=memos.api.v1.AgentType" json:"agent_type,omitempty"`                                   // Agent type (optional, defaults to DEFAULT)
 	ConversationId     int32                  `protobuf:"varint,6,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`                                                // Conversation ID to persist message to
 	IsTempConversation bool                   `protobuf:"varint,7,opt,name=is_temp_conversation,json=isTempConversation,proto3" json:"is_temp_conversation,omitempty"`                                  // Whether to create a temporary conversation (true) or fixed conversation (false)
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// The previous auto-routed reply to this same message came from the wrong assistant.
+	// Combined with agent_type it labels the right assistant; otherwise the message is re-routed
+	// away from the wrong one. Re-sending the same message with an explicit agent_type after
+	// auto-routing counts as a correction too.
+	WrongAgent    bool `protobuf:"varint,8,opt,name=wrong_agent,json=wrongAgent,proto3" json:"wrong_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChatRequest) Reset() {
@@ -694,6 +758,13 @@ func (x *ChatRequest) GetIsTempConversation() bool {
 	return false
 }
 
+func (x *ChatRequest) GetWrongAgent() bool {
+	if x != nil {
+		return x.WrongAgent
+	}
+	return false
+}
+
 // AIConversation represents an AI chat session.
 type AIConversation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1356,50 +1427,2248 @@ func (x *ListMessagesResponse) GetHasMore() bool {
 	return false
 }
 
-func (x *ListMessagesResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
+func (x *ListMessagesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListMessagesResponse) GetLatestMessageUid() string {
+	if x != nil {
+		return x.LatestMessageUid
+	}
+	return ""
+}
+
+func (x *ListMessagesResponse) GetSyncRequired() bool {
+	if x != nil {
+		return x.SyncRequired
+	}
+	return false
+}
+
+// ClearConversationMessagesRequest is the request for ClearConversationMessages.
+type ClearConversationMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int32                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClearConversationMessagesRequest) Reset() {
+	*x = ClearConversationMessagesRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearConversationMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearConversationMessagesRequest) ProtoMessage() {}
+
+func (x *ClearConversationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearConversationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ClearConversationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ClearConversationMessagesRequest) GetConversationId() int32 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+// SaveConversationAsMemoRequest is the request for SaveConversationAsMemo.
+type SaveConversationAsMemoRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int32                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageUids    []string               `protobuf:"bytes,2,rep,name=message_uids,json=messageUids,proto3" json:"message_uids,omitempty"`    // Messages to include (optional, default: all visible messages)
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                   // Memo heading (optional, default: conversation title)
+	Visibility     string                 `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`                         // "PRIVATE", "PROTECTED" or "PUBLIC" (default: PRIVATE)
+	UserTimezone   string                 `protobuf:"bytes,5,opt,name=user_timezone,json=userTimezone,proto3" json:"user_timezone,omitempty"` // IANA timezone for rendering schedule times (default: server timezone)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SaveConversationAsMemoRequest) Reset() {
+	*x = SaveConversationAsMemoRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveConversationAsMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConversationAsMemoRequest) ProtoMessage() {}
+
+func (x *SaveConversationAsMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConversationAsMemoRequest.ProtoReflect.Descriptor instead.
+func (*SaveConversationAsMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SaveConversationAsMemoRequest) GetConversationId() int32 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *SaveConversationAsMemoRequest) GetMessageUids() []string {
+	if x != nil {
+		return x.MessageUids
+	}
+	return nil
+}
+
+func (x *SaveConversationAsMemoRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SaveConversationAsMemoRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *SaveConversationAsMemoRequest) GetUserTimezone() string {
+	if x != nil {
+		return x.UserTimezone
+	}
+	return ""
+}
+
+// SaveMessageAsMemoRequest is the request for SaveMessageAsMemo.
+type SaveMessageAsMemoRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId  int32                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageUid      string                 `protobuf:"bytes,2,opt,name=message_uid,json=messageUid,proto3" json:"message_uid,omitempty"`
+	IncludeQuestion bool                   `protobuf:"varint,3,opt,name=include_question,json=includeQuestion,proto3" json:"include_question,omitempty"` // Prepend the user message that preceded the answer
+	Visibility      string                 `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`                                   // "PRIVATE", "PROTECTED" or "PUBLIC" (default: PRIVATE)
+	UserTimezone    string                 `protobuf:"bytes,5,opt,name=user_timezone,json=userTimezone,proto3" json:"user_timezone,omitempty"`           // IANA timezone for rendering schedule times (default: server timezone)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SaveMessageAsMemoRequest) Reset() {
+	*x = SaveMessageAsMemoRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveMessageAsMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveMessageAsMemoRequest) ProtoMessage() {}
+
+func (x *SaveMessageAsMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveMessageAsMemoRequest.ProtoReflect.Descriptor instead.
+func (*SaveMessageAsMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SaveMessageAsMemoRequest) GetConversationId() int32 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *SaveMessageAsMemoRequest) GetMessageUid() string {
+	if x != nil {
+		return x.MessageUid
+	}
+	return ""
+}
+
+func (x *SaveMessageAsMemoRequest) GetIncludeQuestion() bool {
+	if x != nil {
+		return x.IncludeQuestion
+	}
+	return false
+}
+
+func (x *SaveMessageAsMemoRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *SaveMessageAsMemoRequest) GetUserTimezone() string {
+	if x != nil {
+		return x.UserTimezone
+	}
+	return ""
+}
+
+// SaveAsMemoResponse is the response for SaveConversationAsMemo and SaveMessageAsMemo.
+type SaveAsMemoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoName      string                 `protobuf:"bytes,1,opt,name=memo_name,json=memoName,proto3" json:"memo_name,omitempty"` // Created memo name (memos/{uid})
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                   // Rendered markdown content
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`                         // Suggested tags applied to the memo
+	References    []string               `protobuf:"bytes,4,rep,name=references,proto3" json:"references,omitempty"`             // Cited memos linked with REFERENCE relations (memos/{uid})
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveAsMemoResponse) Reset() {
+	*x = SaveAsMemoResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveAsMemoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAsMemoResponse) ProtoMessage() {}
+
+func (x *SaveAsMemoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAsMemoResponse.ProtoReflect.Descriptor instead.
+func (*SaveAsMemoResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SaveAsMemoResponse) GetMemoName() string {
+	if x != nil {
+		return x.MemoName
+	}
+	return ""
+}
+
+func (x *SaveAsMemoResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SaveAsMemoResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SaveAsMemoResponse) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+// CaptureURLRequest is the request for CaptureURL.
+type CaptureURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`               // Absolute http(s) URL of the page
+	Visibility    string                 `protobuf:"bytes,2,opt,name=visibility,proto3" json:"visibility,omitempty"` // "PRIVATE", "PROTECTED" or "PUBLIC" (default: PRIVATE)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureURLRequest) Reset() {
+	*x = CaptureURLRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureURLRequest) ProtoMessage() {}
+
+func (x *CaptureURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureURLRequest.ProtoReflect.Descriptor instead.
+func (*CaptureURLRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CaptureURLRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CaptureURLRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+// CaptureURLResponse is the response for CaptureURL.
+type CaptureURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoName      string                 `protobuf:"bytes,1,opt,name=memo_name,json=memoName,proto3" json:"memo_name,omitempty"`    // Created memo name (memos/{uid})
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                          // Article title
+	SourceUrl     string                 `protobuf:"bytes,3,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"` // Final URL after redirects
+	Summary       string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`                      // LLM summary (empty when no LLM is configured)
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                            // Suggested tags applied to the memo
+	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`                      // Memo content
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureURLResponse) Reset() {
+	*x = CaptureURLResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureURLResponse) ProtoMessage() {}
+
+func (x *CaptureURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureURLResponse.ProtoReflect.Descriptor instead.
+func (*CaptureURLResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CaptureURLResponse) GetMemoName() string {
+	if x != nil {
+		return x.MemoName
+	}
+	return ""
+}
+
+func (x *CaptureURLResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CaptureURLResponse) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *CaptureURLResponse) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *CaptureURLResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CaptureURLResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// Digest is a scheduled AI digest saved as a memo tagged #digest.
+type Digest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`             // Client-chosen identifier, unique per user
+	Kind             string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`         // "DAILY_BRIEF" or "WEEKLY_REVIEW"
+	Cron             string                 `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`         // Cron expression, e.g. "0 8 * * *"
+	Timezone         string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA timezone the cron expression is evaluated in
+	Enabled          bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Tags             []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"` // Only include memos with these tags (empty: all memos)
+	IncludeMemos     bool                   `protobuf:"varint,7,opt,name=include_memos,json=includeMemos,proto3" json:"include_memos,omitempty"`
+	IncludeSchedules bool                   `protobuf:"varint,8,opt,name=include_schedules,json=includeSchedules,proto3" json:"include_schedules,omitempty"`
+	IncludeReviews   bool                   `protobuf:"varint,9,opt,name=include_reviews,json=includeReviews,proto3" json:"include_reviews,omitempty"`
+	Channels         []string               `protobuf:"bytes,10,rep,name=channels,proto3" json:"channels,omitempty"` // Extra delivery: "INBOX", "EMAIL", "WEBHOOK"
+	LastRunTs        int64                  `protobuf:"varint,11,opt,name=last_run_ts,json=lastRunTs,proto3" json:"last_run_ts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Digest) Reset() {
+	*x = Digest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Digest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Digest) ProtoMessage() {}
+
+func (x *Digest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Digest.ProtoReflect.Descriptor instead.
+func (*Digest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Digest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Digest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Digest) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Digest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Digest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Digest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Digest) GetIncludeMemos() bool {
+	if x != nil {
+		return x.IncludeMemos
+	}
+	return false
+}
+
+func (x *Digest) GetIncludeSchedules() bool {
+	if x != nil {
+		return x.IncludeSchedules
+	}
+	return false
+}
+
+func (x *Digest) GetIncludeReviews() bool {
+	if x != nil {
+		return x.IncludeReviews
+	}
+	return false
+}
+
+func (x *Digest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Digest) GetLastRunTs() int64 {
+	if x != nil {
+		return x.LastRunTs
+	}
+	return 0
+}
+
+// ListDigestsRequest is the request for ListDigests.
+type ListDigestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDigestsRequest) Reset() {
+	*x = ListDigestsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDigestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDigestsRequest) ProtoMessage() {}
+
+func (x *ListDigestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDigestsRequest.ProtoReflect.Descriptor instead.
+func (*ListDigestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{27}
+}
+
+// ListDigestsResponse is the response for ListDigests and UpdateDigests.
+type ListDigestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digests       []*Digest              `protobuf:"bytes,1,rep,name=digests,proto3" json:"digests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDigestsResponse) Reset() {
+	*x = ListDigestsResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDigestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDigestsResponse) ProtoMessage() {}
+
+func (x *ListDigestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDigestsResponse.ProtoReflect.Descriptor instead.
+func (*ListDigestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListDigestsResponse) GetDigests() []*Digest {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+// UpdateDigestsRequest is the request for UpdateDigests.
+type UpdateDigestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digests       []*Digest              `protobuf:"bytes,1,rep,name=digests,proto3" json:"digests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDigestsRequest) Reset() {
+	*x = UpdateDigestsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDigestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDigestsRequest) ProtoMessage() {}
+
+func (x *UpdateDigestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDigestsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDigestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateDigestsRequest) GetDigests() []*Digest {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+// RunDigestRequest is the request for RunDigest.
+type RunDigestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunDigestRequest) Reset() {
+	*x = RunDigestRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunDigestRequest) ProtoMessage() {}
+
+func (x *RunDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunDigestRequest.ProtoReflect.Descriptor instead.
+func (*RunDigestRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RunDigestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RunDigestResponse is the response for RunDigest.
+type RunDigestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoName      string                 `protobuf:"bytes,1,opt,name=memo_name,json=memoName,proto3" json:"memo_name,omitempty"` // Created memo name (memos/{uid})
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                   // Memo content
+	Delivered     []string               `protobuf:"bytes,3,rep,name=delivered,proto3" json:"delivered,omitempty"`               // Channels the digest was delivered to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunDigestResponse) Reset() {
+	*x = RunDigestResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunDigestResponse) ProtoMessage() {}
+
+func (x *RunDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunDigestResponse.ProtoReflect.Descriptor instead.
+func (*RunDigestResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RunDigestResponse) GetMemoName() string {
+	if x != nil {
+		return x.MemoName
+	}
+	return ""
+}
+
+func (x *RunDigestResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RunDigestResponse) GetDelivered() []string {
+	if x != nil {
+		return x.Delivered
+	}
+	return nil
+}
+
+// SynonymGroup is a group of terms searched as one, e.g. "k8s" and "kubernetes".
+type SynonymGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []string               `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"` // At least two distinct terms, matched case-insensitively
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SynonymGroup) Reset() {
+	*x = SynonymGroup{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SynonymGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynonymGroup) ProtoMessage() {}
+
+func (x *SynonymGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynonymGroup.ProtoReflect.Descriptor instead.
+func (*SynonymGroup) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SynonymGroup) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+// ListSearchSynonymsRequest is the request for ListSearchSynonyms.
+type ListSearchSynonymsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchSynonymsRequest) Reset() {
+	*x = ListSearchSynonymsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchSynonymsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchSynonymsRequest) ProtoMessage() {}
+
+func (x *ListSearchSynonymsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchSynonymsRequest.ProtoReflect.Descriptor instead.
+func (*ListSearchSynonymsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{33}
+}
+
+// ListSearchSynonymsResponse is the response for ListSearchSynonyms and UpdateSearchSynonyms.
+type ListSearchSynonymsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*SynonymGroup        `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchSynonymsResponse) Reset() {
+	*x = ListSearchSynonymsResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchSynonymsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchSynonymsResponse) ProtoMessage() {}
+
+func (x *ListSearchSynonymsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchSynonymsResponse.ProtoReflect.Descriptor instead.
+func (*ListSearchSynonymsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListSearchSynonymsResponse) GetGroups() []*SynonymGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// UpdateSearchSynonymsRequest is the request for UpdateSearchSynonyms.
+type UpdateSearchSynonymsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*SynonymGroup        `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSearchSynonymsRequest) Reset() {
+	*x = UpdateSearchSynonymsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSearchSynonymsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSearchSynonymsRequest) ProtoMessage() {}
+
+func (x *UpdateSearchSynonymsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSearchSynonymsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSearchSynonymsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateSearchSynonymsRequest) GetGroups() []*SynonymGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// RecordSearchClickRequest is the request for RecordSearchClick.
+type RecordSearchClickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SearchId      int64                  `protobuf:"varint,1,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"` // SearchWithHighlightResponse.search_id
+	Memo          string                 `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`                          // Opened memo (memos/{uid})
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSearchClickRequest) Reset() {
+	*x = RecordSearchClickRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSearchClickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSearchClickRequest) ProtoMessage() {}
+
+func (x *RecordSearchClickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSearchClickRequest.ProtoReflect.Descriptor instead.
+func (*RecordSearchClickRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RecordSearchClickRequest) GetSearchId() int64 {
+	if x != nil {
+		return x.SearchId
+	}
+	return 0
+}
+
+func (x *RecordSearchClickRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// GetSearchInsightsRequest is the request for GetSearchInsights.
+type GetSearchInsightsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format: users/{id}, or users/- for all users (admin only). Defaults to the current user.
+	User          string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Days          int32  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`   // Look-back window (default: 30, max: the retention period)
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // Max entries per list (default: 10, max: 100)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchInsightsRequest) Reset() {
+	*x = GetSearchInsightsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchInsightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchInsightsRequest) ProtoMessage() {}
+
+func (x *GetSearchInsightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchInsightsRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchInsightsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetSearchInsightsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *GetSearchInsightsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetSearchInsightsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SearchInsights summarizes logged searches.
+type SearchInsights struct {
+	state              protoimpl.MessageState      `protogen:"open.v1"`
+	TotalSearches      int32                       `protobuf:"varint,1,opt,name=total_searches,json=totalSearches,proto3" json:"total_searches,omitempty"`
+	ZeroResultSearches int32                       `protobuf:"varint,2,opt,name=zero_result_searches,json=zeroResultSearches,proto3" json:"zero_result_searches,omitempty"`
+	AvgLatencyMs       int32                       `protobuf:"varint,3,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	ClickRate          float64                     `protobuf:"fixed64,4,opt,name=click_rate,json=clickRate,proto3" json:"click_rate,omitempty"`                                                           // Share of searches followed by opening a result (0-1)
+	TopQueries         []*SearchInsights_QueryStat `protobuf:"bytes,5,rep,name=top_queries,json=topQueries,proto3" json:"top_queries,omitempty"`                                                          // Most frequent first
+	ZeroResultQueries  []*SearchInsights_QueryStat `protobuf:"bytes,6,rep,name=zero_result_queries,json=zeroResultQueries,proto3" json:"zero_result_queries,omitempty"`                                   // Queries that never found anything, most frequent first
+	SlowQueries        []*SearchInsights_QueryStat `protobuf:"bytes,7,rep,name=slow_queries,json=slowQueries,proto3" json:"slow_queries,omitempty"`                                                       // Queries averaging at least 1s, slowest first
+	Strategies         map[string]int32            `protobuf:"bytes,8,rep,name=strategies,proto3" json:"strategies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Searches by retrieval strategy
+	// Memos missing from semantic search because they have no embedding yet (memos/{uid}).
+	// Only for a single user.
+	MemosWithoutEmbedding []string `protobuf:"bytes,9,rep,name=memos_without_embedding,json=memosWithoutEmbedding,proto3" json:"memos_without_embedding,omitempty"`
+	// Memos without tags, which tag filters and tag expansion cannot find (memos/{uid}). Only for a single user.
+	MemosWithoutTags []string `protobuf:"bytes,10,rep,name=memos_without_tags,json=memosWithoutTags,proto3" json:"memos_without_tags,omitempty"`
+	RetentionDays    int32    `protobuf:"varint,11,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`       // Logs older than this are deleted
+	LoggingDisabled  bool     `protobuf:"varint,12,opt,name=logging_disabled,json=loggingDisabled,proto3" json:"logging_disabled,omitempty"` // The user disabled search logging (single user only)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchInsights) Reset() {
+	*x = SearchInsights{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchInsights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchInsights) ProtoMessage() {}
+
+func (x *SearchInsights) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchInsights.ProtoReflect.Descriptor instead.
+func (*SearchInsights) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SearchInsights) GetTotalSearches() int32 {
+	if x != nil {
+		return x.TotalSearches
+	}
+	return 0
+}
+
+func (x *SearchInsights) GetZeroResultSearches() int32 {
+	if x != nil {
+		return x.ZeroResultSearches
+	}
+	return 0
+}
+
+func (x *SearchInsights) GetAvgLatencyMs() int32 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+func (x *SearchInsights) GetClickRate() float64 {
+	if x != nil {
+		return x.ClickRate
+	}
+	return 0
+}
+
+func (x *SearchInsights) GetTopQueries() []*SearchInsights_QueryStat {
+	if x != nil {
+		return x.TopQueries
+	}
+	return nil
+}
+
+func (x *SearchInsights) GetZeroResultQueries() []*SearchInsights_QueryStat {
+	if x != nil {
+		return x.ZeroResultQueries
+	}
+	return nil
+}
+
+func (x *SearchInsights) GetSlowQueries() []*SearchInsights_QueryStat {
+	if x != nil {
+		return x.SlowQueries
+	}
+	return nil
+}
+
+func (x *SearchInsights) GetStrategies() map[string]int32 {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+func (x *SearchInsights) GetMemosWithoutEmbedding() []string {
+	if x != nil {
+		return x.MemosWithoutEmbedding
+	}
+	return nil
+}
+
+func (x *SearchInsights) GetMemosWithoutTags() []string {
+	if x != nil {
+		return x.MemosWithoutTags
+	}
+	return nil
+}
+
+func (x *SearchInsights) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *SearchInsights) GetLoggingDisabled() bool {
+	if x != nil {
+		return x.LoggingDisabled
+	}
+	return false
+}
+
+// GetSuggestionsRequest is the request for GetSuggestions.
+type GetSuggestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserTimezone  string                 `protobuf:"bytes,1,opt,name=user_timezone,json=userTimezone,proto3" json:"user_timezone,omitempty"` // User's timezone in IANA format (e.g., "Asia/Shanghai")
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                  // Max suggestions to return (default: 5, max: 10)
+	RecentEvents  []*SuggestionEvent     `protobuf:"bytes,3,rep,name=recent_events,json=recentEvents,proto3" json:"recent_events,omitempty"` // Recent user actions for context-based suggestions
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSuggestionsRequest) Reset() {
+	*x = GetSuggestionsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuggestionsRequest) ProtoMessage() {}
+
+func (x *GetSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetSuggestionsRequest) GetUserTimezone() string {
+	if x != nil {
+		return x.UserTimezone
+	}
+	return ""
+}
+
+func (x *GetSuggestionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSuggestionsRequest) GetRecentEvents() []*SuggestionEvent {
+	if x != nil {
+		return x.RecentEvents
+	}
+	return nil
+}
+
+// SuggestionEvent is a recent user action.
+type SuggestionEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                         // "schedule_created", "schedule_completed", "memo_viewed"
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // ID of the schedule or memo
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`              // Unix timestamp of the action
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestionEvent) Reset() {
+	*x = SuggestionEvent{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionEvent) ProtoMessage() {}
+
+func (x *SuggestionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionEvent.ProtoReflect.Descriptor instead.
+func (*SuggestionEvent) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SuggestionEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SuggestionEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SuggestionEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// GetSuggestionsResponse is the response for GetSuggestions.
+type GetSuggestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"` // Sorted by confidence, highest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSuggestionsResponse) Reset() {
+	*x = GetSuggestionsResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuggestionsResponse) ProtoMessage() {}
+
+func (x *GetSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetSuggestionsResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// Suggestion is a proactive next action.
+type Suggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                     // Stable ID used for feedback
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                                                                 // "action", "query" or "reminder"
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                                                                             // e.g. "create_usual_schedule", "review_memos", "write_memo"
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                                                               // Short label
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                                                             // Why it is suggested
+	Confidence    float64                `protobuf:"fixed64,6,opt,name=confidence,proto3" json:"confidence,omitempty"`                                                                   // 0-1, after feedback weighting
+	Payload       map[string]string      `protobuf:"bytes,7,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Action parameters (e.g. "title", "start_ts", "tag")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Suggestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Suggestion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Suggestion) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Suggestion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Suggestion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Suggestion) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *Suggestion) GetPayload() map[string]string {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// RecordSuggestionFeedbackRequest is the request for RecordSuggestionFeedback.
+type RecordSuggestionFeedbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SuggestionId  string                 `protobuf:"bytes,1,opt,name=suggestion_id,json=suggestionId,proto3" json:"suggestion_id,omitempty"`
+	Accepted      bool                   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"` // true when accepted, false when dismissed
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`        // Suggestion title, recorded in the habit history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSuggestionFeedbackRequest) Reset() {
+	*x = RecordSuggestionFeedbackRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSuggestionFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSuggestionFeedbackRequest) ProtoMessage() {}
+
+func (x *RecordSuggestionFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSuggestionFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*RecordSuggestionFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RecordSuggestionFeedbackRequest) GetSuggestionId() string {
+	if x != nil {
+		return x.SuggestionId
+	}
+	return ""
+}
+
+func (x *RecordSuggestionFeedbackRequest) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *RecordSuggestionFeedbackRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// UserHabits are the preferences learned from a user's activity.
+type UserHabits struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PreferredTimes    []string               `protobuf:"bytes,1,rep,name=preferred_times,json=preferredTimes,proto3" json:"preferred_times,omitempty"`          // Usual times of day, "HH:MM"
+	DefaultDuration   int32                  `protobuf:"varint,2,opt,name=default_duration,json=defaultDuration,proto3" json:"default_duration,omitempty"`      // Usual schedule duration in minutes
+	FrequentLocations []string               `protobuf:"bytes,3,rep,name=frequent_locations,json=frequentLocations,proto3" json:"frequent_locations,omitempty"` // Common schedule locations
+	FrequentKeywords  []string               `protobuf:"bytes,4,rep,name=frequent_keywords,json=frequentKeywords,proto3" json:"frequent_keywords,omitempty"`    // Common search keywords
+	// Fields corrected by the user, e.g. "preferred_times"
+	OverriddenFields []string `protobuf:"bytes,5,rep,name=overridden_fields,json=overriddenFields,proto3" json:"overridden_fields,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserHabits) Reset() {
+	*x = UserHabits{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserHabits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserHabits) ProtoMessage() {}
+
+func (x *UserHabits) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserHabits.ProtoReflect.Descriptor instead.
+func (*UserHabits) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UserHabits) GetPreferredTimes() []string {
+	if x != nil {
+		return x.PreferredTimes
+	}
+	return nil
+}
+
+func (x *UserHabits) GetDefaultDuration() int32 {
+	if x != nil {
+		return x.DefaultDuration
+	}
+	return 0
+}
+
+func (x *UserHabits) GetFrequentLocations() []string {
+	if x != nil {
+		return x.FrequentLocations
+	}
+	return nil
+}
+
+func (x *UserHabits) GetFrequentKeywords() []string {
+	if x != nil {
+		return x.FrequentKeywords
+	}
+	return nil
+}
+
+func (x *UserHabits) GetOverriddenFields() []string {
+	if x != nil {
+		return x.OverriddenFields
+	}
+	return nil
+}
+
+// GetUserHabitsRequest is the request for GetUserHabits.
+type GetUserHabitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserHabitsRequest) Reset() {
+	*x = GetUserHabitsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserHabitsRequest) ProtoMessage() {}
+
+func (x *GetUserHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserHabitsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{45}
+}
+
+// UpdateUserHabitsRequest is the request for UpdateUserHabits.
+type UpdateUserHabitsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Habits *UserHabits            `protobuf:"bytes,1,opt,name=habits,proto3" json:"habits,omitempty"`
+	// Fields to correct: preferred_times, default_duration, frequent_locations, frequent_keywords
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserHabitsRequest) Reset() {
+	*x = UpdateUserHabitsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserHabitsRequest) ProtoMessage() {}
+
+func (x *UpdateUserHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserHabitsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateUserHabitsRequest) GetHabits() *UserHabits {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+func (x *UpdateUserHabitsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// ResetUserHabitsRequest is the request for ResetUserHabits.
+type ResetUserHabitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserHabitsRequest) Reset() {
+	*x = ResetUserHabitsRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserHabitsRequest) ProtoMessage() {}
+
+func (x *ResetUserHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserHabitsRequest.ProtoReflect.Descriptor instead.
+func (*ResetUserHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{47}
+}
+
+// AIMemory is something the assistant remembers about a user.
+type AIMemory struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name, e.g. "ai/memories/episode-42" or "ai/memories/summary-7"
+	Name           string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type           AIMemory_Type `protobuf:"varint,2,opt,name=type,proto3,enum=memos.api.v1.AIMemory_Type" json:"type,omitempty"`
+	AgentType      string        `protobuf:"bytes,3,opt,name=agent_type,json=agentType,proto3" json:"agent_type,omitempty"`                 // Agent that produced the episode
+	UserInput      string        `protobuf:"bytes,4,opt,name=user_input,json=userInput,proto3" json:"user_input,omitempty"`                 // What the user said
+	Content        string        `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`                                      // Episode summary or conversation summary
+	Outcome        string        `protobuf:"bytes,6,opt,name=outcome,proto3" json:"outcome,omitempty"`                                      // Episode outcome, e.g. "success"
+	Importance     float32       `protobuf:"fixed32,7,opt,name=importance,proto3" json:"importance,omitempty"`                              // Episode importance (0-1)
+	ConversationId int32         `protobuf:"varint,8,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // Conversation of a summary
+	CreateTime     int64         `protobuf:"varint,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`             // Unix timestamp in seconds
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AIMemory) Reset() {
+	*x = AIMemory{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIMemory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIMemory) ProtoMessage() {}
+
+func (x *AIMemory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIMemory.ProtoReflect.Descriptor instead.
+func (*AIMemory) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AIMemory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AIMemory) GetType() AIMemory_Type {
+	if x != nil {
+		return x.Type
+	}
+	return AIMemory_TYPE_UNSPECIFIED
+}
+
+func (x *AIMemory) GetAgentType() string {
+	if x != nil {
+		return x.AgentType
+	}
+	return ""
+}
+
+func (x *AIMemory) GetUserInput() string {
+	if x != nil {
+		return x.UserInput
+	}
+	return ""
+}
+
+func (x *AIMemory) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AIMemory) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AIMemory) GetImportance() float32 {
+	if x != nil {
+		return x.Importance
+	}
+	return 0
+}
+
+func (x *AIMemory) GetConversationId() int32 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *AIMemory) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+// AIPreferences are the AI settings of a user.
+type AIPreferences struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Timezone           string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CommunicationStyle string                 `protobuf:"bytes,2,opt,name=communication_style,json=communicationStyle,proto3" json:"communication_style,omitempty"` // "concise" or "detailed"
+	Incognito          bool                   `protobuf:"varint,3,opt,name=incognito,proto3" json:"incognito,omitempty"`                                            // Chats neither recall nor record episodic memory
+	SearchLogDisabled  bool                   `protobuf:"varint,4,opt,name=search_log_disabled,json=searchLogDisabled,proto3" json:"search_log_disabled,omitempty"` // Searches are not logged for search insights
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AIPreferences) Reset() {
+	*x = AIPreferences{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIPreferences) ProtoMessage() {}
+
+func (x *AIPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIPreferences.ProtoReflect.Descriptor instead.
+func (*AIPreferences) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AIPreferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AIPreferences) GetCommunicationStyle() string {
+	if x != nil {
+		return x.CommunicationStyle
+	}
+	return ""
+}
+
+func (x *AIPreferences) GetIncognito() bool {
+	if x != nil {
+		return x.Incognito
+	}
+	return false
+}
+
+func (x *AIPreferences) GetSearchLogDisabled() bool {
+	if x != nil {
+		return x.SearchLogDisabled
+	}
+	return false
+}
+
+// ListAIMemoriesRequest is the request for ListAIMemories.
+type ListAIMemoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          AIMemory_Type          `protobuf:"varint,1,opt,name=type,proto3,enum=memos.api.v1.AIMemory_Type" json:"type,omitempty"` // Optional filter
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIMemoriesRequest) Reset() {
+	*x = ListAIMemoriesRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIMemoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIMemoriesRequest) ProtoMessage() {}
+
+func (x *ListAIMemoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIMemoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAIMemoriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListAIMemoriesRequest) GetType() AIMemory_Type {
+	if x != nil {
+		return x.Type
+	}
+	return AIMemory_TYPE_UNSPECIFIED
+}
+
+func (x *ListAIMemoriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAIMemoriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListAIMemoriesResponse is the response for ListAIMemories.
+type ListAIMemoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memories      []*AIMemory            `protobuf:"bytes,1,rep,name=memories,proto3" json:"memories,omitempty"` // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Preferences   *AIPreferences         `protobuf:"bytes,3,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Habits        *UserHabits            `protobuf:"bytes,4,opt,name=habits,proto3" json:"habits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIMemoriesResponse) Reset() {
+	*x = ListAIMemoriesResponse{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIMemoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIMemoriesResponse) ProtoMessage() {}
+
+func (x *ListAIMemoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIMemoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAIMemoriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListAIMemoriesResponse) GetMemories() []*AIMemory {
+	if x != nil {
+		return x.Memories
+	}
+	return nil
+}
+
+func (x *ListAIMemoriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAIMemoriesResponse) GetPreferences() *AIPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *ListAIMemoriesResponse) GetHabits() *UserHabits {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+// DeleteAIMemoryRequest is the request for DeleteAIMemory.
+type DeleteAIMemoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format: ai/memories/{kind}-{id}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAIMemoryRequest) Reset() {
+	*x = DeleteAIMemoryRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAIMemoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAIMemoryRequest) ProtoMessage() {}
+
+func (x *DeleteAIMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAIMemoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAIMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteAIMemoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// UpdateUserPreferencesRequest is the request for UpdateUserPreferences.
+type UpdateUserPreferencesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Preferences *AIPreferences         `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	// Fields to update: timezone, communication_style, incognito, search_log_disabled
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserPreferencesRequest) Reset() {
+	*x = UpdateUserPreferencesRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateUserPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateUserPreferencesRequest) GetPreferences() *AIPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UpdateUserPreferencesRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// ForgetEverythingRequest is the request for ForgetEverything.
+type ForgetEverythingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgetEverythingRequest) Reset() {
+	*x = ForgetEverythingRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgetEverythingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetEverythingRequest) ProtoMessage() {}
+
+func (x *ForgetEverythingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetEverythingRequest.ProtoReflect.Descriptor instead.
+func (*ForgetEverythingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{54}
+}
+
+// GetRoutingReportRequest is the request for GetRoutingReport.
+type GetRoutingReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoutingReportRequest) Reset() {
+	*x = GetRoutingReportRequest{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoutingReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListMessagesResponse) GetLatestMessageUid() string {
+func (*GetRoutingReportRequest) ProtoMessage() {}
+
+func (x *GetRoutingReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[55]
 	if x != nil {
-		return x.LatestMessageUid
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListMessagesResponse) GetSyncRequired() bool {
-	if x != nil {
-		return x.SyncRequired
-	}
-	return false
+// Deprecated: Use GetRoutingReportRequest.ProtoReflect.Descriptor instead.
+func (*GetRoutingReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{55}
 }
 
-// ClearConversationMessagesRequest is the request for ClearConversationMessages.
-type ClearConversationMessagesRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ConversationId int32                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// RoutingReport summarizes routing corrections.
+type RoutingReport struct {
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	Confusions       []*RoutingReport_Confusion `protobuf:"bytes,1,rep,name=confusions,proto3" json:"confusions,omitempty"`                                      // Most frequent first
+	TotalCorrections int32                      `protobuf:"varint,2,opt,name=total_corrections,json=totalCorrections,proto3" json:"total_corrections,omitempty"` // Corrections considered, most recent first up to a limit
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ClearConversationMessagesRequest) Reset() {
-	*x = ClearConversationMessagesRequest{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[20]
+func (x *RoutingReport) Reset() {
+	*x = RoutingReport{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearConversationMessagesRequest) String() string {
+func (x *RoutingReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearConversationMessagesRequest) ProtoMessage() {}
+func (*RoutingReport) ProtoMessage() {}
 
-func (x *ClearConversationMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[20]
+func (x *RoutingReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1410,14 +3679,21 @@ func (x *ClearConversationMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearConversationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*ClearConversationMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use RoutingReport.ProtoReflect.Descriptor instead.
+func (*RoutingReport) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ClearConversationMessagesRequest) GetConversationId() int32 {
+func (x *RoutingReport) GetConfusions() []*RoutingReport_Confusion {
 	if x != nil {
-		return x.ConversationId
+		return x.Confusions
+	}
+	return nil
+}
+
+func (x *RoutingReport) GetTotalCorrections() int32 {
+	if x != nil {
+		return x.TotalCorrections
 	}
 	return 0
 }
@@ -1431,7 +3707,7 @@ type ChatResponse struct {
 	ScheduleCreationIntent *ScheduleCreationIntent `protobuf:"bytes,4,opt,name=schedule_creation_intent,json=scheduleCreationIntent,proto3" json:"schedule_creation_intent,omitempty"` // AI-detected schedule creation intent (sent in final chunk)
 	ScheduleQueryResult    *ScheduleQueryResult    `protobuf:"bytes,5,opt,name=schedule_query_result,json=scheduleQueryResult,proto3" json:"schedule_query_result,omitempty"`          // AI-detected schedule query result (sent in final chunk)
 	// Agent event signaling (for schedule agent integration)
-	EventType     string `protobuf:"bytes,6,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // Event type: "thinking", "tool_use", "tool_result", "answer", "error", "schedule_updated"
+	EventType     string `protobuf:"bytes,6,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // Event type: "routing", "thinking", "tool_use", "tool_result", "answer", "error", "schedule_updated"
 	EventData     string `protobuf:"bytes,7,opt,name=event_data,json=eventData,proto3" json:"event_data,omitempty"` // Event data (JSON or plain text depending on event type)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1439,7 +3715,7 @@ type ChatResponse struct {
 
 func (x *ChatResponse) Reset() {
 	*x = ChatResponse{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[21]
+	mi := &file_api_v1_ai_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1451,7 +3727,7 @@ func (x *ChatResponse) String() string {
 func (*ChatResponse) ProtoMessage() {}
 
 func (x *ChatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[21]
+	mi := &file_api_v1_ai_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,7 +3740,7 @@ func (x *ChatResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatResponse.ProtoReflect.Descriptor instead.
 func (*ChatResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{21}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ChatResponse) GetContent() string {
@@ -1528,7 +3804,7 @@ type ScheduleCreationIntent struct {
 
 func (x *ScheduleCreationIntent) Reset() {
 	*x = ScheduleCreationIntent{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[22]
+	mi := &file_api_v1_ai_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1540,7 +3816,7 @@ func (x *ScheduleCreationIntent) String() string {
 func (*ScheduleCreationIntent) ProtoMessage() {}
 
 func (x *ScheduleCreationIntent) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[22]
+	mi := &file_api_v1_ai_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1553,7 +3829,7 @@ func (x *ScheduleCreationIntent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleCreationIntent.ProtoReflect.Descriptor instead.
 func (*ScheduleCreationIntent) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{22}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ScheduleCreationIntent) GetDetected() bool {
@@ -1590,7 +3866,7 @@ type ScheduleQueryResult struct {
 
 func (x *ScheduleQueryResult) Reset() {
 	*x = ScheduleQueryResult{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[23]
+	mi := &file_api_v1_ai_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1602,7 +3878,7 @@ func (x *ScheduleQueryResult) String() string {
 func (*ScheduleQueryResult) ProtoMessage() {}
 
 func (x *ScheduleQueryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[23]
+	mi := &file_api_v1_ai_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1615,7 +3891,7 @@ func (x *ScheduleQueryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleQueryResult.ProtoReflect.Descriptor instead.
 func (*ScheduleQueryResult) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{23}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ScheduleQueryResult) GetDetected() bool {
@@ -1664,7 +3940,7 @@ type ScheduleSummary struct {
 
 func (x *ScheduleSummary) Reset() {
 	*x = ScheduleSummary{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[24]
+	mi := &file_api_v1_ai_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1676,7 +3952,7 @@ func (x *ScheduleSummary) String() string {
 func (*ScheduleSummary) ProtoMessage() {}
 
 func (x *ScheduleSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[24]
+	mi := &file_api_v1_ai_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,7 +3965,7 @@ func (x *ScheduleSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleSummary.ProtoReflect.Descriptor instead.
 func (*ScheduleSummary) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{24}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ScheduleSummary) GetUid() string {
@@ -1759,7 +4035,7 @@ type GetRelatedMemosRequest struct {
 
 func (x *GetRelatedMemosRequest) Reset() {
 	*x = GetRelatedMemosRequest{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[25]
+	mi := &file_api_v1_ai_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1771,7 +4047,7 @@ func (x *GetRelatedMemosRequest) String() string {
 func (*GetRelatedMemosRequest) ProtoMessage() {}
 
 func (x *GetRelatedMemosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[25]
+	mi := &file_api_v1_ai_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1784,7 +4060,7 @@ func (x *GetRelatedMemosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRelatedMemosRequest.ProtoReflect.Descriptor instead.
 func (*GetRelatedMemosRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{25}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetRelatedMemosRequest) GetName() string {
@@ -1811,7 +4087,7 @@ type GetRelatedMemosResponse struct {
 
 func (x *GetRelatedMemosResponse) Reset() {
 	*x = GetRelatedMemosResponse{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[26]
+	mi := &file_api_v1_ai_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1823,7 +4099,7 @@ func (x *GetRelatedMemosResponse) String() string {
 func (*GetRelatedMemosResponse) ProtoMessage() {}
 
 func (x *GetRelatedMemosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[26]
+	mi := &file_api_v1_ai_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1836,7 +4112,7 @@ func (x *GetRelatedMemosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRelatedMemosResponse.ProtoReflect.Descriptor instead.
 func (*GetRelatedMemosResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{26}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetRelatedMemosResponse) GetMemos() []*SearchResult {
@@ -1865,7 +4141,7 @@ type ParrotSelfCognition struct {
 
 func (x *ParrotSelfCognition) Reset() {
 	*x = ParrotSelfCognition{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[27]
+	mi := &file_api_v1_ai_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1877,7 +4153,7 @@ func (x *ParrotSelfCognition) String() string {
 func (*ParrotSelfCognition) ProtoMessage() {}
 
 func (x *ParrotSelfCognition) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[27]
+	mi := &file_api_v1_ai_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,7 +4166,7 @@ func (x *ParrotSelfCognition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParrotSelfCognition.ProtoReflect.Descriptor instead.
 func (*ParrotSelfCognition) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{27}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ParrotSelfCognition) GetName() string {
@@ -1973,7 +4249,7 @@ type GetParrotSelfCognitionRequest struct {
 
 func (x *GetParrotSelfCognitionRequest) Reset() {
 	*x = GetParrotSelfCognitionRequest{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[28]
+	mi := &file_api_v1_ai_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1985,7 +4261,7 @@ func (x *GetParrotSelfCognitionRequest) String() string {
 func (*GetParrotSelfCognitionRequest) ProtoMessage() {}
 
 func (x *GetParrotSelfCognitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[28]
+	mi := &file_api_v1_ai_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1998,7 +4274,7 @@ func (x *GetParrotSelfCognitionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetParrotSelfCognitionRequest.ProtoReflect.Descriptor instead.
 func (*GetParrotSelfCognitionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{28}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetParrotSelfCognitionRequest) GetAgentType() AgentType {
@@ -2018,7 +4294,7 @@ type GetParrotSelfCognitionResponse struct {
 
 func (x *GetParrotSelfCognitionResponse) Reset() {
 	*x = GetParrotSelfCognitionResponse{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[29]
+	mi := &file_api_v1_ai_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2030,7 +4306,7 @@ func (x *GetParrotSelfCognitionResponse) String() string {
 func (*GetParrotSelfCognitionResponse) ProtoMessage() {}
 
 func (x *GetParrotSelfCognitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[29]
+	mi := &file_api_v1_ai_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2043,7 +4319,7 @@ func (x *GetParrotSelfCognitionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetParrotSelfCognitionResponse.ProtoReflect.Descriptor instead.
 func (*GetParrotSelfCognitionResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{29}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *GetParrotSelfCognitionResponse) GetSelfCognition() *ParrotSelfCognition {
@@ -2062,7 +4338,7 @@ type ListParrotsRequest struct {
 
 func (x *ListParrotsRequest) Reset() {
 	*x = ListParrotsRequest{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[30]
+	mi := &file_api_v1_ai_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2074,7 +4350,7 @@ func (x *ListParrotsRequest) String() string {
 func (*ListParrotsRequest) ProtoMessage() {}
 
 func (x *ListParrotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[30]
+	mi := &file_api_v1_ai_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2087,7 +4363,7 @@ func (x *ListParrotsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListParrotsRequest.ProtoReflect.Descriptor instead.
 func (*ListParrotsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{30}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{66}
 }
 
 // ListParrotsResponse is the response for ListParrots.
@@ -2100,7 +4376,7 @@ type ListParrotsResponse struct {
 
 func (x *ListParrotsResponse) Reset() {
 	*x = ListParrotsResponse{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[31]
+	mi := &file_api_v1_ai_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2112,7 +4388,7 @@ func (x *ListParrotsResponse) String() string {
 func (*ListParrotsResponse) ProtoMessage() {}
 
 func (x *ListParrotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[31]
+	mi := &file_api_v1_ai_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2125,7 +4401,7 @@ func (x *ListParrotsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListParrotsResponse.ProtoReflect.Descriptor instead.
 func (*ListParrotsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{31}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ListParrotsResponse) GetParrots() []*ParrotInfo {
@@ -2147,7 +4423,7 @@ type ParrotInfo struct {
 
 func (x *ParrotInfo) Reset() {
 	*x = ParrotInfo{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[32]
+	mi := &file_api_v1_ai_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2159,7 +4435,7 @@ func (x *ParrotInfo) String() string {
 func (*ParrotInfo) ProtoMessage() {}
 
 func (x *ParrotInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[32]
+	mi := &file_api_v1_ai_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,7 +4448,7 @@ func (x *ParrotInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParrotInfo.ProtoReflect.Descriptor instead.
 func (*ParrotInfo) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{32}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ParrotInfo) GetAgentType() AgentType {
@@ -2209,7 +4485,7 @@ type DetectDuplicatesRequest struct {
 
 func (x *DetectDuplicatesRequest) Reset() {
 	*x = DetectDuplicatesRequest{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[33]
+	mi := &file_api_v1_ai_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2221,7 +4497,7 @@ func (x *DetectDuplicatesRequest) String() string {
 func (*DetectDuplicatesRequest) ProtoMessage() {}
 
 func (x *DetectDuplicatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[33]
+	mi := &file_api_v1_ai_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2234,7 +4510,7 @@ func (x *DetectDuplicatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetectDuplicatesRequest.ProtoReflect.Descriptor instead.
 func (*DetectDuplicatesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{33}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *DetectDuplicatesRequest) GetTitle() string {
@@ -2279,7 +4555,7 @@ type DetectDuplicatesResponse struct {
 
 func (x *DetectDuplicatesResponse) Reset() {
 	*x = DetectDuplicatesResponse{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[34]
+	mi := &file_api_v1_ai_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2291,7 +4567,7 @@ func (x *DetectDuplicatesResponse) String() string {
 func (*DetectDuplicatesResponse) ProtoMessage() {}
 
 func (x *DetectDuplicatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[34]
+	mi := &file_api_v1_ai_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2304,7 +4580,7 @@ func (x *DetectDuplicatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetectDuplicatesResponse.ProtoReflect.Descriptor instead.
 func (*DetectDuplicatesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{34}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *DetectDuplicatesResponse) GetHasDuplicate() bool {
@@ -2359,7 +4635,7 @@ type SimilarMemo struct {
 
 func (x *SimilarMemo) Reset() {
 	*x = SimilarMemo{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[35]
+	mi := &file_api_v1_ai_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2371,7 +4647,7 @@ func (x *SimilarMemo) String() string {
 func (*SimilarMemo) ProtoMessage() {}
 
 func (x *SimilarMemo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[35]
+	mi := &file_api_v1_ai_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2384,7 +4660,7 @@ func (x *SimilarMemo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimilarMemo.ProtoReflect.Descriptor instead.
 func (*SimilarMemo) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{35}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *SimilarMemo) GetId() string {
@@ -2455,7 +4731,7 @@ type SimilarityBreakdown struct {
 
 func (x *SimilarityBreakdown) Reset() {
 	*x = SimilarityBreakdown{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[36]
+	mi := &file_api_v1_ai_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2467,7 +4743,7 @@ func (x *SimilarityBreakdown) String() string {
 func (*SimilarityBreakdown) ProtoMessage() {}
 
 func (x *SimilarityBreakdown) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[36]
+	mi := &file_api_v1_ai_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2480,7 +4756,7 @@ func (x *SimilarityBreakdown) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimilarityBreakdown.ProtoReflect.Descriptor instead.
 func (*SimilarityBreakdown) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{36}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *SimilarityBreakdown) GetVector() float64 {
@@ -2515,7 +4791,7 @@ type MergeMemosRequest struct {
 
 func (x *MergeMemosRequest) Reset() {
 	*x = MergeMemosRequest{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[37]
+	mi := &file_api_v1_ai_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2527,7 +4803,7 @@ func (x *MergeMemosRequest) String() string {
 func (*MergeMemosRequest) ProtoMessage() {}
 
 func (x *MergeMemosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[37]
+	mi := &file_api_v1_ai_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2540,7 +4816,7 @@ func (x *MergeMemosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MergeMemosRequest.ProtoReflect.Descriptor instead.
 func (*MergeMemosRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{37}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *MergeMemosRequest) GetSourceName() string {
@@ -2567,7 +4843,7 @@ type MergeMemosResponse struct {
 
 func (x *MergeMemosResponse) Reset() {
 	*x = MergeMemosResponse{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[38]
+	mi := &file_api_v1_ai_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2579,7 +4855,7 @@ func (x *MergeMemosResponse) String() string {
 func (*MergeMemosResponse) ProtoMessage() {}
 
 func (x *MergeMemosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[38]
+	mi := &file_api_v1_ai_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2592,7 +4868,7 @@ func (x *MergeMemosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MergeMemosResponse.ProtoReflect.Descriptor instead.
 func (*MergeMemosResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{38}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *MergeMemosResponse) GetMergedName() string {
@@ -2613,7 +4889,7 @@ type LinkMemosRequest struct {
 
 func (x *LinkMemosRequest) Reset() {
 	*x = LinkMemosRequest{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[39]
+	mi := &file_api_v1_ai_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2625,7 +4901,7 @@ func (x *LinkMemosRequest) String() string {
 func (*LinkMemosRequest) ProtoMessage() {}
 
 func (x *LinkMemosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[39]
+	mi := &file_api_v1_ai_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2638,7 +4914,7 @@ func (x *LinkMemosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkMemosRequest.ProtoReflect.Descriptor instead.
 func (*LinkMemosRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{39}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *LinkMemosRequest) GetMemoName_1() string {
@@ -2665,7 +4941,7 @@ type LinkMemosResponse struct {
 
 func (x *LinkMemosResponse) Reset() {
 	*x = LinkMemosResponse{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[40]
+	mi := &file_api_v1_ai_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2677,7 +4953,7 @@ func (x *LinkMemosResponse) String() string {
 func (*LinkMemosResponse) ProtoMessage() {}
 
 func (x *LinkMemosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[40]
+	mi := &file_api_v1_ai_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2690,7 +4966,7 @@ func (x *LinkMemosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkMemosResponse.ProtoReflect.Descriptor instead.
 func (*LinkMemosResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{40}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *LinkMemosResponse) GetSuccess() bool {
@@ -2712,7 +4988,7 @@ type GetKnowledgeGraphRequest struct {
 
 func (x *GetKnowledgeGraphRequest) Reset() {
 	*x = GetKnowledgeGraphRequest{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[41]
+	mi := &file_api_v1_ai_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2724,7 +5000,7 @@ func (x *GetKnowledgeGraphRequest) String() string {
 func (*GetKnowledgeGraphRequest) ProtoMessage() {}
 
 func (x *GetKnowledgeGraphRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[41]
+	mi := &file_api_v1_ai_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2737,7 +5013,7 @@ func (x *GetKnowledgeGraphRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKnowledgeGraphRequest.ProtoReflect.Descriptor instead.
 func (*GetKnowledgeGraphRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{41}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *GetKnowledgeGraphRequest) GetTags() []string {
@@ -2774,7 +5050,7 @@ type GetKnowledgeGraphResponse struct {
 
 func (x *GetKnowledgeGraphResponse) Reset() {
 	*x = GetKnowledgeGraphResponse{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[42]
+	mi := &file_api_v1_ai_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2786,7 +5062,7 @@ func (x *GetKnowledgeGraphResponse) String() string {
 func (*GetKnowledgeGraphResponse) ProtoMessage() {}
 
 func (x *GetKnowledgeGraphResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[42]
+	mi := &file_api_v1_ai_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2799,7 +5075,7 @@ func (x *GetKnowledgeGraphResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKnowledgeGraphResponse.ProtoReflect.Descriptor instead.
 func (*GetKnowledgeGraphResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{42}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *GetKnowledgeGraphResponse) GetNodes() []*GraphNode {
@@ -2846,7 +5122,7 @@ type GraphNode struct {
 
 func (x *GraphNode) Reset() {
 	*x = GraphNode{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[43]
+	mi := &file_api_v1_ai_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2858,7 +5134,7 @@ func (x *GraphNode) String() string {
 func (*GraphNode) ProtoMessage() {}
 
 func (x *GraphNode) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[43]
+	mi := &file_api_v1_ai_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2871,7 +5147,7 @@ func (x *GraphNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphNode.ProtoReflect.Descriptor instead.
 func (*GraphNode) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{43}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GraphNode) GetId() string {
@@ -2936,7 +5212,7 @@ type GraphEdge struct {
 
 func (x *GraphEdge) Reset() {
 	*x = GraphEdge{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[44]
+	mi := &file_api_v1_ai_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2948,7 +5224,7 @@ func (x *GraphEdge) String() string {
 func (*GraphEdge) ProtoMessage() {}
 
 func (x *GraphEdge) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[44]
+	mi := &file_api_v1_ai_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2961,7 +5237,7 @@ func (x *GraphEdge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphEdge.ProtoReflect.Descriptor instead.
 func (*GraphEdge) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{44}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *GraphEdge) GetSource() string {
@@ -3007,7 +5283,7 @@ type GraphStats struct {
 
 func (x *GraphStats) Reset() {
 	*x = GraphStats{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[45]
+	mi := &file_api_v1_ai_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3019,7 +5295,7 @@ func (x *GraphStats) String() string {
 func (*GraphStats) ProtoMessage() {}
 
 func (x *GraphStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[45]
+	mi := &file_api_v1_ai_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3032,7 +5308,7 @@ func (x *GraphStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphStats.ProtoReflect.Descriptor instead.
 func (*GraphStats) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{45}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *GraphStats) GetNodeCount() int32 {
@@ -3087,7 +5363,7 @@ type GetDueReviewsRequest struct {
 
 func (x *GetDueReviewsRequest) Reset() {
 	*x = GetDueReviewsRequest{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[46]
+	mi := &file_api_v1_ai_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3099,7 +5375,7 @@ func (x *GetDueReviewsRequest) String() string {
 func (*GetDueReviewsRequest) ProtoMessage() {}
 
 func (x *GetDueReviewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[46]
+	mi := &file_api_v1_ai_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3112,7 +5388,7 @@ func (x *GetDueReviewsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDueReviewsRequest.ProtoReflect.Descriptor instead.
 func (*GetDueReviewsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{46}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *GetDueReviewsRequest) GetLimit() int32 {
@@ -3133,7 +5409,7 @@ type GetDueReviewsResponse struct {
 
 func (x *GetDueReviewsResponse) Reset() {
 	*x = GetDueReviewsResponse{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[47]
+	mi := &file_api_v1_ai_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3145,7 +5421,7 @@ func (x *GetDueReviewsResponse) String() string {
 func (*GetDueReviewsResponse) ProtoMessage() {}
 
 func (x *GetDueReviewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[47]
+	mi := &file_api_v1_ai_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3158,7 +5434,7 @@ func (x *GetDueReviewsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDueReviewsResponse.ProtoReflect.Descriptor instead.
 func (*GetDueReviewsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{47}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GetDueReviewsResponse) GetItems() []*ReviewItem {
@@ -3194,7 +5470,7 @@ type ReviewItem struct {
 
 func (x *ReviewItem) Reset() {
 	*x = ReviewItem{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[48]
+	mi := &file_api_v1_ai_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3206,7 +5482,7 @@ func (x *ReviewItem) String() string {
 func (*ReviewItem) ProtoMessage() {}
 
 func (x *ReviewItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[48]
+	mi := &file_api_v1_ai_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3219,7 +5495,7 @@ func (x *ReviewItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReviewItem.ProtoReflect.Descriptor instead.
 func (*ReviewItem) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{48}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *ReviewItem) GetMemoUid() string {
@@ -3303,7 +5579,7 @@ type RecordReviewRequest struct {
 
 func (x *RecordReviewRequest) Reset() {
 	*x = RecordReviewRequest{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[49]
+	mi := &file_api_v1_ai_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3315,7 +5591,7 @@ func (x *RecordReviewRequest) String() string {
 func (*RecordReviewRequest) ProtoMessage() {}
 
 func (x *RecordReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[49]
+	mi := &file_api_v1_ai_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3328,7 +5604,7 @@ func (x *RecordReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordReviewRequest.ProtoReflect.Descriptor instead.
 func (*RecordReviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{49}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *RecordReviewRequest) GetMemoUid() string {
@@ -3354,7 +5630,7 @@ type GetReviewStatsRequest struct {
 
 func (x *GetReviewStatsRequest) Reset() {
 	*x = GetReviewStatsRequest{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[50]
+	mi := &file_api_v1_ai_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3366,7 +5642,7 @@ func (x *GetReviewStatsRequest) String() string {
 func (*GetReviewStatsRequest) ProtoMessage() {}
 
 func (x *GetReviewStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[50]
+	mi := &file_api_v1_ai_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3379,7 +5655,7 @@ func (x *GetReviewStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReviewStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetReviewStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{50}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{86}
 }
 
 // GetReviewStatsResponse is the response for GetReviewStats.
@@ -3399,7 +5675,7 @@ type GetReviewStatsResponse struct {
 
 func (x *GetReviewStatsResponse) Reset() {
 	*x = GetReviewStatsResponse{}
-	mi := &file_api_v1_ai_service_proto_msgTypes[51]
+	mi := &file_api_v1_ai_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3411,7 +5687,7 @@ func (x *GetReviewStatsResponse) String() string {
 func (*GetReviewStatsResponse) ProtoMessage() {}
 
 func (x *GetReviewStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_ai_service_proto_msgTypes[51]
+	mi := &file_api_v1_ai_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3424,7 +5700,7 @@ func (x *GetReviewStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReviewStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetReviewStatsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{51}
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetReviewStatsResponse) GetTotalMemos() int32 {
@@ -3483,11 +5759,157 @@ func (x *GetReviewStatsResponse) GetAverageAccuracy() int32 {
 	return 0
 }
 
+// QueryStat aggregates the searches of one query, compared case-insensitively.
+type SearchInsights_QueryStat struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Count          int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Number of searches
+	AvgResultCount float64                `protobuf:"fixed64,3,opt,name=avg_result_count,json=avgResultCount,proto3" json:"avg_result_count,omitempty"`
+	Clicks         int32                  `protobuf:"varint,4,opt,name=clicks,proto3" json:"clicks,omitempty"` // Searches followed by opening a result
+	AvgLatencyMs   int32                  `protobuf:"varint,5,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	LastSearchTime int64                  `protobuf:"varint,6,opt,name=last_search_time,json=lastSearchTime,proto3" json:"last_search_time,omitempty"` // Unix timestamp in seconds
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchInsights_QueryStat) Reset() {
+	*x = SearchInsights_QueryStat{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchInsights_QueryStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchInsights_QueryStat) ProtoMessage() {}
+
+func (x *SearchInsights_QueryStat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchInsights_QueryStat.ProtoReflect.Descriptor instead.
+func (*SearchInsights_QueryStat) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *SearchInsights_QueryStat) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchInsights_QueryStat) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SearchInsights_QueryStat) GetAvgResultCount() float64 {
+	if x != nil {
+		return x.AvgResultCount
+	}
+	return 0
+}
+
+func (x *SearchInsights_QueryStat) GetClicks() int32 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *SearchInsights_QueryStat) GetAvgLatencyMs() int32 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+func (x *SearchInsights_QueryStat) GetLastSearchTime() int64 {
+	if x != nil {
+		return x.LastSearchTime
+	}
+	return 0
+}
+
+// Confusion counts corrections from a predicted intent to an assistant.
+type RoutingReport_Confusion struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PredictedIntent string                 `protobuf:"bytes,1,opt,name=predicted_intent,json=predictedIntent,proto3" json:"predicted_intent,omitempty"` // e.g. "memo_search", "schedule_create"
+	CorrectedAgent  string                 `protobuf:"bytes,2,opt,name=corrected_agent,json=correctedAgent,proto3" json:"corrected_agent,omitempty"`    // "memo", "schedule", "amazing", or "unknown" when only flagged as wrong
+	Count           int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RoutingReport_Confusion) Reset() {
+	*x = RoutingReport_Confusion{}
+	mi := &file_api_v1_ai_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutingReport_Confusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutingReport_Confusion) ProtoMessage() {}
+
+func (x *RoutingReport_Confusion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_ai_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutingReport_Confusion.ProtoReflect.Descriptor instead.
+func (*RoutingReport_Confusion) Descriptor() ([]byte, []int) {
+	return file_api_v1_ai_service_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *RoutingReport_Confusion) GetPredictedIntent() string {
+	if x != nil {
+		return x.PredictedIntent
+	}
+	return ""
+}
+
+func (x *RoutingReport_Confusion) GetCorrectedAgent() string {
+	if x != nil {
+		return x.CorrectedAgent
+	}
+	return ""
+}
+
+func (x *RoutingReport_Confusion) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_api_v1_ai_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_ai_service_proto_rawDesc = "" +
 	"\n" +
-	"\x17api/v1/ai_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\"^\n" +
+	"\x17api/v1/ai_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"^\n" +
 	"\x18ScheduleAgentChatRequest\x12\x1d\n" +
 	"\amessage\x18\x01 \x01(\tB\x03\xe0A\x02R\amessage\x12#\n" +
 	"\ruser_timezone\x18\x02 \x01(\tR\fuserTimezone\"7\n" +
@@ -3496,10 +5918,11 @@ const file_api_v1_ai_service_proto_rawDesc = "" +
 	"\x1bScheduleAgentStreamResponse\x12\x14\n" +
 	"\x05event\x18\x01 \x01(\tR\x05event\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x12\n" +
-	"\x04done\x18\x03 \x01(\bR\x04done\"H\n" +
+	"\x04done\x18\x03 \x01(\bR\x04done\"m\n" +
 	"\x15SemanticSearchRequest\x12\x19\n" +
 	"\x05query\x18\x01 \x01(\tB\x03\xe0A\x02R\x05query\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"N\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12#\n" +
+	"\ruser_timezone\x18\x03 \x01(\tR\fuserTimezone\"N\n" +
 	"\x16SemanticSearchResponse\x124\n" +
 	"\aresults\x18\x01 \x03(\v2\x1a.memos.api.v1.SearchResultR\aresults\"R\n" +
 	"\fSearchResult\x12\x12\n" +
@@ -3510,7 +5933,7 @@ const file_api_v1_ai_service_proto_rawDesc = "" +
 	"\acontent\x18\x01 \x01(\tB\x03\xe0A\x02R\acontent\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\")\n" +
 	"\x13SuggestTagsResponse\x12\x12\n" +
-	"\x04tags\x18\x01 \x03(\tR\x04tags\"\xcf\x02\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"\xf0\x02\n" +
 	"\vChatRequest\x12\x1d\n" +
 	"\amessage\x18\x01 \x01(\tB\x03\xe0A\x02R\amessage\x12\x18\n" +
 	"\ahistory\x18\x02 \x03(\tR\ahistory\x12#\n" +
@@ -3519,7 +5942,9 @@ const file_api_v1_ai_service_proto_rawDesc = "" +
 	"\n" +
 	"agent_type\x18\x05 \x01(\x0e2\x17.memos.api.v1.AgentTypeR\tagentType\x12'\n" +
 	"\x0fconversation_id\x18\x06 \x01(\x05R\x0econversationId\x120\n" +
-	"\x14is_temp_conversation\x18\a \x01(\bR\x12isTempConversation\"\xcd\x02\n" +
+	"\x14is_temp_conversation\x18\a \x01(\bR\x12isTempConversation\x12\x1f\n" +
+	"\vwrong_agent\x18\b \x01(\bR\n" +
+	"wrongAgent\"\xcd\x02\n" +
 	"\x0eAIConversation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
 	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x1d\n" +
@@ -3575,7 +6000,202 @@ const file_api_v1_ai_service_proto_rawDesc = "" +
 	"\x12latest_message_uid\x18\x04 \x01(\tR\x10latestMessageUid\x12#\n" +
 	"\rsync_required\x18\x05 \x01(\bR\fsyncRequired\"P\n" +
 	" ClearConversationMessagesRequest\x12,\n" +
-	"\x0fconversation_id\x18\x01 \x01(\x05B\x03\xe0A\x02R\x0econversationId\"\xcb\x02\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x05B\x03\xe0A\x02R\x0econversationId\"\xcb\x01\n" +
+	"\x1dSaveConversationAsMemoRequest\x12,\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x05B\x03\xe0A\x02R\x0econversationId\x12!\n" +
+	"\fmessage_uids\x18\x02 \x03(\tR\vmessageUids\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tR\n" +
+	"visibility\x12#\n" +
+	"\ruser_timezone\x18\x05 \x01(\tR\fuserTimezone\"\xde\x01\n" +
+	"\x18SaveMessageAsMemoRequest\x12,\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x05B\x03\xe0A\x02R\x0econversationId\x12$\n" +
+	"\vmessage_uid\x18\x02 \x01(\tB\x03\xe0A\x02R\n" +
+	"messageUid\x12)\n" +
+	"\x10include_question\x18\x03 \x01(\bR\x0fincludeQuestion\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tR\n" +
+	"visibility\x12#\n" +
+	"\ruser_timezone\x18\x05 \x01(\tR\fuserTimezone\"\x7f\n" +
+	"\x12SaveAsMemoResponse\x12\x1b\n" +
+	"\tmemo_name\x18\x01 \x01(\tR\bmemoName\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"references\x18\x04 \x03(\tR\n" +
+	"references\"J\n" +
+	"\x11CaptureURLRequest\x12\x15\n" +
+	"\x03url\x18\x01 \x01(\tB\x03\xe0A\x02R\x03url\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x02 \x01(\tR\n" +
+	"visibility\"\xae\x01\n" +
+	"\x12CaptureURLResponse\x12\x1b\n" +
+	"\tmemo_name\x18\x01 \x01(\tR\bmemoName\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\x03 \x01(\tR\tsourceUrl\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\"\xc6\x02\n" +
+	"\x06Digest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04cron\x18\x03 \x01(\tR\x04cron\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12#\n" +
+	"\rinclude_memos\x18\a \x01(\bR\fincludeMemos\x12+\n" +
+	"\x11include_schedules\x18\b \x01(\bR\x10includeSchedules\x12'\n" +
+	"\x0finclude_reviews\x18\t \x01(\bR\x0eincludeReviews\x12\x1a\n" +
+	"\bchannels\x18\n" +
+	" \x03(\tR\bchannels\x12#\n" +
+	"\vlast_run_ts\x18\v \x01(\x03B\x03\xe0A\x03R\tlastRunTs\"\x14\n" +
+	"\x12ListDigestsRequest\"E\n" +
+	"\x13ListDigestsResponse\x12.\n" +
+	"\adigests\x18\x01 \x03(\v2\x14.memos.api.v1.DigestR\adigests\"F\n" +
+	"\x14UpdateDigestsRequest\x12.\n" +
+	"\adigests\x18\x01 \x03(\v2\x14.memos.api.v1.DigestR\adigests\"'\n" +
+	"\x10RunDigestRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"h\n" +
+	"\x11RunDigestResponse\x12\x1b\n" +
+	"\tmemo_name\x18\x01 \x01(\tR\bmemoName\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1c\n" +
+	"\tdelivered\x18\x03 \x03(\tR\tdelivered\"$\n" +
+	"\fSynonymGroup\x12\x14\n" +
+	"\x05terms\x18\x01 \x03(\tR\x05terms\"\x1b\n" +
+	"\x19ListSearchSynonymsRequest\"P\n" +
+	"\x1aListSearchSynonymsResponse\x122\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1a.memos.api.v1.SynonymGroupR\x06groups\"Q\n" +
+	"\x1bUpdateSearchSynonymsRequest\x122\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1a.memos.api.v1.SynonymGroupR\x06groups\"U\n" +
+	"\x18RecordSearchClickRequest\x12 \n" +
+	"\tsearch_id\x18\x01 \x01(\x03B\x03\xe0A\x02R\bsearchId\x12\x17\n" +
+	"\x04memo\x18\x02 \x01(\tB\x03\xe0A\x02R\x04memo\"X\n" +
+	"\x18GetSearchInsightsRequest\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xab\a\n" +
+	"\x0eSearchInsights\x12%\n" +
+	"\x0etotal_searches\x18\x01 \x01(\x05R\rtotalSearches\x120\n" +
+	"\x14zero_result_searches\x18\x02 \x01(\x05R\x12zeroResultSearches\x12$\n" +
+	"\x0eavg_latency_ms\x18\x03 \x01(\x05R\favgLatencyMs\x12\x1d\n" +
+	"\n" +
+	"click_rate\x18\x04 \x01(\x01R\tclickRate\x12G\n" +
+	"\vtop_queries\x18\x05 \x03(\v2&.memos.api.v1.SearchInsights.QueryStatR\n" +
+	"topQueries\x12V\n" +
+	"\x13zero_result_queries\x18\x06 \x03(\v2&.memos.api.v1.SearchInsights.QueryStatR\x11zeroResultQueries\x12I\n" +
+	"\fslow_queries\x18\a \x03(\v2&.memos.api.v1.SearchInsights.QueryStatR\vslowQueries\x12L\n" +
+	"\n" +
+	"strategies\x18\b \x03(\v2,.memos.api.v1.SearchInsights.StrategiesEntryR\n" +
+	"strategies\x126\n" +
+	"\x17memos_without_embedding\x18\t \x03(\tR\x15memosWithoutEmbedding\x12,\n" +
+	"\x12memos_without_tags\x18\n" +
+	" \x03(\tR\x10memosWithoutTags\x12%\n" +
+	"\x0eretention_days\x18\v \x01(\x05R\rretentionDays\x12)\n" +
+	"\x10logging_disabled\x18\f \x01(\bR\x0floggingDisabled\x1a\xc9\x01\n" +
+	"\tQueryStat\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12(\n" +
+	"\x10avg_result_count\x18\x03 \x01(\x01R\x0eavgResultCount\x12\x16\n" +
+	"\x06clicks\x18\x04 \x01(\x05R\x06clicks\x12$\n" +
+	"\x0eavg_latency_ms\x18\x05 \x01(\x05R\favgLatencyMs\x12(\n" +
+	"\x10last_search_time\x18\x06 \x01(\x03R\x0elastSearchTime\x1a=\n" +
+	"\x0fStrategiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x96\x01\n" +
+	"\x15GetSuggestionsRequest\x12#\n" +
+	"\ruser_timezone\x18\x01 \x01(\tR\fuserTimezone\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12B\n" +
+	"\rrecent_events\x18\x03 \x03(\v2\x1d.memos.api.v1.SuggestionEventR\frecentEvents\"`\n" +
+	"\x0fSuggestionEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"T\n" +
+	"\x16GetSuggestionsResponse\x12:\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x18.memos.api.v1.SuggestionR\vsuggestions\"\x93\x02\n" +
+	"\n" +
+	"Suggestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x06 \x01(\x01R\n" +
+	"confidence\x12?\n" +
+	"\apayload\x18\a \x03(\v2%.memos.api.v1.Suggestion.PayloadEntryR\apayload\x1a:\n" +
+	"\fPayloadEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"}\n" +
+	"\x1fRecordSuggestionFeedbackRequest\x12(\n" +
+	"\rsuggestion_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsuggestionId\x12\x1a\n" +
+	"\baccepted\x18\x02 \x01(\bR\baccepted\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\"\xee\x01\n" +
+	"\n" +
+	"UserHabits\x12'\n" +
+	"\x0fpreferred_times\x18\x01 \x03(\tR\x0epreferredTimes\x12)\n" +
+	"\x10default_duration\x18\x02 \x01(\x05R\x0fdefaultDuration\x12-\n" +
+	"\x12frequent_locations\x18\x03 \x03(\tR\x11frequentLocations\x12+\n" +
+	"\x11frequent_keywords\x18\x04 \x03(\tR\x10frequentKeywords\x120\n" +
+	"\x11overridden_fields\x18\x05 \x03(\tB\x03\xe0A\x03R\x10overriddenFields\"\x16\n" +
+	"\x14GetUserHabitsRequest\"\x92\x01\n" +
+	"\x17UpdateUserHabitsRequest\x125\n" +
+	"\x06habits\x18\x01 \x01(\v2\x18.memos.api.v1.UserHabitsB\x03\xe0A\x02R\x06habits\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"\x18\n" +
+	"\x16ResetUserHabitsRequest\"\xf0\x02\n" +
+	"\bAIMemory\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1b.memos.api.v1.AIMemory.TypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"agent_type\x18\x03 \x01(\tR\tagentType\x12\x1d\n" +
+	"\n" +
+	"user_input\x18\x04 \x01(\tR\tuserInput\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x18\n" +
+	"\aoutcome\x18\x06 \x01(\tR\aoutcome\x12\x1e\n" +
+	"\n" +
+	"importance\x18\a \x01(\x02R\n" +
+	"importance\x12'\n" +
+	"\x0fconversation_id\x18\b \x01(\x05R\x0econversationId\x12\x1f\n" +
+	"\vcreate_time\x18\t \x01(\x03R\n" +
+	"createTime\"C\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aEPISODE\x10\x01\x12\x18\n" +
+	"\x14CONVERSATION_SUMMARY\x10\x02\"\xaa\x01\n" +
+	"\rAIPreferences\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12/\n" +
+	"\x13communication_style\x18\x02 \x01(\tR\x12communicationStyle\x12\x1c\n" +
+	"\tincognito\x18\x03 \x01(\bR\tincognito\x12.\n" +
+	"\x13search_log_disabled\x18\x04 \x01(\bR\x11searchLogDisabled\"\x84\x01\n" +
+	"\x15ListAIMemoriesRequest\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.memos.api.v1.AIMemory.TypeR\x04type\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xe5\x01\n" +
+	"\x16ListAIMemoriesResponse\x122\n" +
+	"\bmemories\x18\x01 \x03(\v2\x16.memos.api.v1.AIMemoryR\bmemories\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12=\n" +
+	"\vpreferences\x18\x03 \x01(\v2\x1b.memos.api.v1.AIPreferencesR\vpreferences\x120\n" +
+	"\x06habits\x18\x04 \x01(\v2\x18.memos.api.v1.UserHabitsR\x06habits\"0\n" +
+	"\x15DeleteAIMemoryRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xa4\x01\n" +
+	"\x1cUpdateUserPreferencesRequest\x12B\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1b.memos.api.v1.AIPreferencesB\x03\xe0A\x02R\vpreferences\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"\x19\n" +
+	"\x17ForgetEverythingRequest\"\x19\n" +
+	"\x17GetRoutingReportRequest\"\xfa\x01\n" +
+	"\rRoutingReport\x12E\n" +
+	"\n" +
+	"confusions\x18\x01 \x03(\v2%.memos.api.v1.RoutingReport.ConfusionR\n" +
+	"confusions\x12+\n" +
+	"\x11total_corrections\x18\x02 \x01(\x05R\x10totalCorrections\x1au\n" +
+	"\tConfusion\x12)\n" +
+	"\x10predicted_intent\x18\x01 \x01(\tR\x0fpredictedIntent\x12'\n" +
+	"\x0fcorrected_agent\x18\x02 \x01(\tR\x0ecorrectedAgent\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\xcb\x02\n" +
 	"\fChatResponse\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x12\x18\n" +
 	"\asources\x18\x02 \x03(\tR\asources\x12\x12\n" +
@@ -3767,7 +6387,7 @@ const file_api_v1_ai_service_proto_rawDesc = "" +
 	"\x14REVIEW_QUALITY_AGAIN\x10\x01\x12\x17\n" +
 	"\x13REVIEW_QUALITY_HARD\x10\x02\x12\x17\n" +
 	"\x13REVIEW_QUALITY_GOOD\x10\x03\x12\x17\n" +
-	"\x13REVIEW_QUALITY_EASY\x10\x042\xee\x15\n" +
+	"\x13REVIEW_QUALITY_EASY\x10\x042\xd4*\n" +
 	"\tAIService\x12y\n" +
 	"\x0eSemanticSearch\x12#.memos.api.v1.SemanticSearchRequest\x1a$.memos.api.v1.SemanticSearchResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/ai/search\x12v\n" +
 	"\vSuggestTags\x12 .memos.api.v1.SuggestTagsRequest\x1a!.memos.api.v1.SuggestTagsResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/ai/suggest-tags\x12[\n" +
@@ -3790,7 +6410,28 @@ const file_api_v1_ai_service_proto_rawDesc = "" +
 	"\x14DeleteAIConversation\x12).memos.api.v1.DeleteAIConversationRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/ai/conversations/{id}\x12\x98\x01\n" +
 	"\x13AddContextSeparator\x12(.memos.api.v1.AddContextSeparatorRequest\x1a\x16.google.protobuf.Empty\"?\x82\xd3\xe4\x93\x029:\x01*\"4/api/v1/ai/conversations/{conversation_id}/separator\x12\x92\x01\n" +
 	"\fListMessages\x12!.memos.api.v1.ListMessagesRequest\x1a\".memos.api.v1.ListMessagesResponse\";\x82\xd3\xe4\x93\x025\x123/api/v1/ai/conversations/{conversation_id}/messages\x12\xa0\x01\n" +
-	"\x19ClearConversationMessages\x12..memos.api.v1.ClearConversationMessagesRequest\x1a\x16.google.protobuf.Empty\";\x82\xd3\xe4\x93\x025*3/api/v1/ai/conversations/{conversation_id}/messages2\xaa\x02\n" +
+	"\x19ClearConversationMessages\x12..memos.api.v1.ClearConversationMessagesRequest\x1a\x16.google.protobuf.Empty\";\x82\xd3\xe4\x93\x025*3/api/v1/ai/conversations/{conversation_id}/messages\x12\xa3\x01\n" +
+	"\x16SaveConversationAsMemo\x12+.memos.api.v1.SaveConversationAsMemoRequest\x1a .memos.api.v1.SaveAsMemoResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/ai/conversations/{conversation_id}/memo\x12\xb0\x01\n" +
+	"\x11SaveMessageAsMemo\x12&.memos.api.v1.SaveMessageAsMemoRequest\x1a .memos.api.v1.SaveAsMemoResponse\"Q\x82\xd3\xe4\x93\x02K:\x01*\"F/api/v1/ai/conversations/{conversation_id}/messages/{message_uid}/memo\x12n\n" +
+	"\n" +
+	"CaptureURL\x12\x1f.memos.api.v1.CaptureURLRequest\x1a .memos.api.v1.CaptureURLResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/ai/capture\x12n\n" +
+	"\vListDigests\x12 .memos.api.v1.ListDigestsRequest\x1a!.memos.api.v1.ListDigestsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/ai/digests\x12u\n" +
+	"\rUpdateDigests\x12\".memos.api.v1.UpdateDigestsRequest\x1a!.memos.api.v1.ListDigestsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/api/v1/ai/digests\x12t\n" +
+	"\tRunDigest\x12\x1e.memos.api.v1.RunDigestRequest\x1a\x1f.memos.api.v1.RunDigestResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/ai/digests/{id}:run\x12\x8b\x01\n" +
+	"\x12ListSearchSynonyms\x12'.memos.api.v1.ListSearchSynonymsRequest\x1a(.memos.api.v1.ListSearchSynonymsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/ai/search-synonyms\x12\x92\x01\n" +
+	"\x14UpdateSearchSynonyms\x12).memos.api.v1.UpdateSearchSynonymsRequest\x1a(.memos.api.v1.ListSearchSynonymsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/api/v1/ai/search-synonyms\x12x\n" +
+	"\x11RecordSearchClick\x12&.memos.api.v1.RecordSearchClickRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/ai/search-clicks\x12}\n" +
+	"\x11GetSearchInsights\x12&.memos.api.v1.GetSearchInsightsRequest\x1a\x1c.memos.api.v1.SearchInsights\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/ai/search-insights\x12~\n" +
+	"\x0eGetSuggestions\x12#.memos.api.v1.GetSuggestionsRequest\x1a$.memos.api.v1.GetSuggestionsResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/ai/suggestions\x12\x9d\x01\n" +
+	"\x18RecordSuggestionFeedback\x12-.memos.api.v1.RecordSuggestionFeedbackRequest\x1a\x16.google.protobuf.Empty\":\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/ai/suggestions/{suggestion_id}/feedback\x12h\n" +
+	"\rGetUserHabits\x12\".memos.api.v1.GetUserHabitsRequest\x1a\x18.memos.api.v1.UserHabits\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/ai/habits\x12\x8b\x01\n" +
+	"\x10UpdateUserHabits\x12%.memos.api.v1.UpdateUserHabitsRequest\x1a\x18.memos.api.v1.UserHabits\"6\xdaA\x12habits,update_mask\x82\xd3\xe4\x93\x02\x1b:\x06habits2\x11/api/v1/ai/habits\x12u\n" +
+	"\x0fResetUserHabits\x12$.memos.api.v1.ResetUserHabitsRequest\x1a\x18.memos.api.v1.UserHabits\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/ai/habits:reset\x12x\n" +
+	"\x0eListAIMemories\x12#.memos.api.v1.ListAIMemoriesRequest\x1a$.memos.api.v1.ListAIMemoriesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/ai/memories\x12z\n" +
+	"\x0eDeleteAIMemory\x12#.memos.api.v1.DeleteAIMemoryRequest\x1a\x16.google.protobuf.Empty\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/{name=ai/memories/*}\x12\xa7\x01\n" +
+	"\x15UpdateUserPreferences\x12*.memos.api.v1.UpdateUserPreferencesRequest\x1a\x1b.memos.api.v1.AIPreferences\"E\xdaA\x17preferences,update_mask\x82\xd3\xe4\x93\x02%:\vpreferences2\x16/api/v1/ai/preferences\x12y\n" +
+	"\x10GetRoutingReport\x12%.memos.api.v1.GetRoutingReportRequest\x1a\x1b.memos.api.v1.RoutingReport\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/ai/routing/report\x12x\n" +
+	"\x10ForgetEverything\x12%.memos.api.v1.ForgetEverythingRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/ai/memories:forget2\xaa\x02\n" +
 	"\x14ScheduleAgentService\x12\x7f\n" +
 	"\x04Chat\x12&.memos.api.v1.ScheduleAgentChatRequest\x1a'.memos.api.v1.ScheduleAgentChatResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/schedule-agent/chat\x12\x90\x01\n" +
 	"\n" +
@@ -3809,143 +6450,246 @@ func file_api_v1_ai_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_ai_service_proto_rawDescData
 }
 
-var file_api_v1_ai_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_api_v1_ai_service_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_api_v1_ai_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_v1_ai_service_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
 var file_api_v1_ai_service_proto_goTypes = []any{
 	(ScheduleQueryMode)(0),                   // 0: memos.api.v1.ScheduleQueryMode
 	(AgentType)(0),                           // 1: memos.api.v1.AgentType
 	(ReviewQuality)(0),                       // 2: memos.api.v1.ReviewQuality
-	(*ScheduleAgentChatRequest)(nil),         // 3: memos.api.v1.ScheduleAgentChatRequest
-	(*ScheduleAgentChatResponse)(nil),        // 4: memos.api.v1.ScheduleAgentChatResponse
-	(*ScheduleAgentStreamResponse)(nil),      // 5: memos.api.v1.ScheduleAgentStreamResponse
-	(*SemanticSearchRequest)(nil),            // 6: memos.api.v1.SemanticSearchRequest
-	(*SemanticSearchResponse)(nil),           // 7: memos.api.v1.SemanticSearchResponse
-	(*SearchResult)(nil),                     // 8: memos.api.v1.SearchResult
-	(*SuggestTagsRequest)(nil),               // 9: memos.api.v1.SuggestTagsRequest
-	(*SuggestTagsResponse)(nil),              // 10: memos.api.v1.SuggestTagsResponse
-	(*ChatRequest)(nil),                      // 11: memos.api.v1.ChatRequest
-	(*AIConversation)(nil),                   // 12: memos.api.v1.AIConversation
-	(*AIMessage)(nil),                        // 13: memos.api.v1.AIMessage
-	(*ListAIConversationsRequest)(nil),       // 14: memos.api.v1.ListAIConversationsRequest
-	(*ListAIConversationsResponse)(nil),      // 15: memos.api.v1.ListAIConversationsResponse
-	(*GetAIConversationRequest)(nil),         // 16: memos.api.v1.GetAIConversationRequest
-	(*CreateAIConversationRequest)(nil),      // 17: memos.api.v1.CreateAIConversationRequest
-	(*UpdateAIConversationRequest)(nil),      // 18: memos.api.v1.UpdateAIConversationRequest
-	(*DeleteAIConversationRequest)(nil),      // 19: memos.api.v1.DeleteAIConversationRequest
-	(*AddContextSeparatorRequest)(nil),       // 20: memos.api.v1.AddContextSeparatorRequest
-	(*ListMessagesRequest)(nil),              // 21: memos.api.v1.ListMessagesRequest
-	(*ListMessagesResponse)(nil),             // 22: memos.api.v1.ListMessagesResponse
-	(*ClearConversationMessagesRequest)(nil), // 23: memos.api.v1.ClearConversationMessagesRequest
-	(*ChatResponse)(nil),                     // 24: memos.api.v1.ChatResponse
-	(*ScheduleCreationIntent)(nil),           // 25: memos.api.v1.ScheduleCreationIntent
-	(*ScheduleQueryResult)(nil),              // 26: memos.api.v1.ScheduleQueryResult
-	(*ScheduleSummary)(nil),                  // 27: memos.api.v1.ScheduleSummary
-	(*GetRelatedMemosRequest)(nil),           // 28: memos.api.v1.GetRelatedMemosRequest
-	(*GetRelatedMemosResponse)(nil),          // 29: memos.api.v1.GetRelatedMemosResponse
-	(*ParrotSelfCognition)(nil),              // 30: memos.api.v1.ParrotSelfCognition
-	(*GetParrotSelfCognitionRequest)(nil),    // 31: memos.api.v1.GetParrotSelfCognitionRequest
-	(*GetParrotSelfCognitionResponse)(nil),   // 32: memos.api.v1.GetParrotSelfCognitionResponse
-	(*ListParrotsRequest)(nil),               // 33: memos.api.v1.ListParrotsRequest
-	(*ListParrotsResponse)(nil),              // 34: memos.api.v1.ListParrotsResponse
-	(*ParrotInfo)(nil),                       // 35: memos.api.v1.ParrotInfo
-	(*DetectDuplicatesRequest)(nil),          // 36: memos.api.v1.DetectDuplicatesRequest
-	(*DetectDuplicatesResponse)(nil),         // 37: memos.api.v1.DetectDuplicatesResponse
-	(*SimilarMemo)(nil),                      // 38: memos.api.v1.SimilarMemo
-	(*SimilarityBreakdown)(nil),              // 39: memos.api.v1.SimilarityBreakdown
-	(*MergeMemosRequest)(nil),                // 40: memos.api.v1.MergeMemosRequest
-	(*MergeMemosResponse)(nil),               // 41: memos.api.v1.MergeMemosResponse
-	(*LinkMemosRequest)(nil),                 // 42: memos.api.v1.LinkMemosRequest
-	(*LinkMemosResponse)(nil),                // 43: memos.api.v1.LinkMemosResponse
-	(*GetKnowledgeGraphRequest)(nil),         // 44: memos.api.v1.GetKnowledgeGraphRequest
-	(*GetKnowledgeGraphResponse)(nil),        // 45: memos.api.v1.GetKnowledgeGraphResponse
-	(*GraphNode)(nil),                        // 46: memos.api.v1.GraphNode
-	(*GraphEdge)(nil),                        // 47: memos.api.v1.GraphEdge
-	(*GraphStats)(nil),                       // 48: memos.api.v1.GraphStats
-	(*GetDueReviewsRequest)(nil),             // 49: memos.api.v1.GetDueReviewsRequest
-	(*GetDueReviewsResponse)(nil),            // 50: memos.api.v1.GetDueReviewsResponse
-	(*ReviewItem)(nil),                       // 51: memos.api.v1.ReviewItem
-	(*RecordReviewRequest)(nil),              // 52: memos.api.v1.RecordReviewRequest
-	(*GetReviewStatsRequest)(nil),            // 53: memos.api.v1.GetReviewStatsRequest
-	(*GetReviewStatsResponse)(nil),           // 54: memos.api.v1.GetReviewStatsResponse
-	(*emptypb.Empty)(nil),                    // 55: google.protobuf.Empty
+	(AIMemory_Type)(0),                       // 3: memos.api.v1.AIMemory.Type
+	(*ScheduleAgentChatRequest)(nil),         // 4: memos.api.v1.ScheduleAgentChatRequest
+	(*ScheduleAgentChatResponse)(nil),        // 5: memos.api.v1.ScheduleAgentChatResponse
+	(*ScheduleAgentStreamResponse)(nil),      // 6: memos.api.v1.ScheduleAgentStreamResponse
+	(*SemanticSearchRequest)(nil),            // 7: memos.api.v1.SemanticSearchRequest
+	(*SemanticSearchResponse)(nil),           // 8: memos.api.v1.SemanticSearchResponse
+	(*SearchResult)(nil),                     // 9: memos.api.v1.SearchResult
+	(*SuggestTagsRequest)(nil),               // 10: memos.api.v1.SuggestTagsRequest
+	(*SuggestTagsResponse)(nil),              // 11: memos.api.v1.SuggestTagsResponse
+	(*ChatRequest)(nil),                      // 12: memos.api.v1.ChatRequest
+	(*AIConversation)(nil),                   // 13: memos.api.v1.AIConversation
+	(*AIMessage)(nil),                        // 14: memos.api.v1.AIMessage
+	(*ListAIConversationsRequest)(nil),       // 15: memos.api.v1.ListAIConversationsRequest
+	(*ListAIConversationsResponse)(nil),      // 16: memos.api.v1.ListAIConversationsResponse
+	(*GetAIConversationRequest)(nil),         // 17: memos.api.v1.GetAIConversationRequest
+	(*CreateAIConversationRequest)(nil),      // 18: memos.api.v1.CreateAIConversationRequest
+	(*UpdateAIConversationRequest)(nil),      // 19: memos.api.v1.UpdateAIConversationRequest
+	(*DeleteAIConversationRequest)(nil),      // 20: memos.api.v1.DeleteAIConversationRequest
+	(*AddContextSeparatorRequest)(nil),       // 21: memos.api.v1.AddContextSeparatorRequest
+	(*ListMessagesRequest)(nil),              // 22: memos.api.v1.ListMessagesRequest
+	(*ListMessagesResponse)(nil),             // 23: memos.api.v1.ListMessagesResponse
+	(*ClearConversationMessagesRequest)(nil), // 24: memos.api.v1.ClearConversationMessagesRequest
+	(*SaveConversationAsMemoRequest)(nil),    // 25: memos.api.v1.SaveConversationAsMemoRequest
+	(*SaveMessageAsMemoRequest)(nil),         // 26: memos.api.v1.SaveMessageAsMemoRequest
+	(*SaveAsMemoResponse)(nil),               // 27: memos.api.v1.SaveAsMemoResponse
+	(*CaptureURLRequest)(nil),                // 28: memos.api.v1.CaptureURLRequest
+	(*CaptureURLResponse)(nil),               // 29: memos.api.v1.CaptureURLResponse
+	(*Digest)(nil),                           // 30: memos.api.v1.Digest
+	(*ListDigestsRequest)(nil),               // 31: memos.api.v1.ListDigestsRequest
+	(*ListDigestsResponse)(nil),              // 32: memos.api.v1.ListDigestsResponse
+	(*UpdateDigestsRequest)(nil),             // 33: memos.api.v1.UpdateDigestsRequest
+	(*RunDigestRequest)(nil),                 // 34: memos.api.v1.RunDigestRequest
+	(*RunDigestResponse)(nil),                // 35: memos.api.v1.RunDigestResponse
+	(*SynonymGroup)(nil),                     // 36: memos.api.v1.SynonymGroup
+	(*ListSearchSynonymsRequest)(nil),        // 37: memos.api.v1.ListSearchSynonymsRequest
+	(*ListSearchSynonymsResponse)(nil),       // 38: memos.api.v1.ListSearchSynonymsResponse
+	(*UpdateSearchSynonymsRequest)(nil),      // 39: memos.api.v1.UpdateSearchSynonymsRequest
+	(*RecordSearchClickRequest)(nil),         // 40: memos.api.v1.RecordSearchClickRequest
+	(*GetSearchInsightsRequest)(nil),         // 41: memos.api.v1.GetSearchInsightsRequest
+	(*SearchInsights)(nil),                   // 42: memos.api.v1.SearchInsights
+	(*GetSuggestionsRequest)(nil),            // 43: memos.api.v1.GetSuggestionsRequest
+	(*SuggestionEvent)(nil),                  // 44: memos.api.v1.SuggestionEvent
+	(*GetSuggestionsResponse)(nil),           // 45: memos.api.v1.GetSuggestionsResponse
+	(*Suggestion)(nil),                       // 46: memos.api.v1.Suggestion
+	(*RecordSuggestionFeedbackRequest)(nil),  // 47: memos.api.v1.RecordSuggestionFeedbackRequest
+	(*UserHabits)(nil),                       // 48: memos.api.v1.UserHabits
+	(*GetUserHabitsRequest)(nil),             // 49: memos.api.v1.GetUserHabitsRequest
+	(*UpdateUserHabitsRequest)(nil),          // 50: memos.api.v1.UpdateUserHabitsRequest
+	(*ResetUserHabitsRequest)(nil),           // 51: memos.api.v1.ResetUserHabitsRequest
+	(*AIMemory)(nil),                         // 52: memos.api.v1.AIMemory
+	(*AIPreferences)(nil),                    // 53: memos.api.v1.AIPreferences
+	(*ListAIMemoriesRequest)(nil),            // 54: memos.api.v1.ListAIMemoriesRequest
+	(*ListAIMemoriesResponse)(nil),           // 55: memos.api.v1.ListAIMemoriesResponse
+	(*DeleteAIMemoryRequest)(nil),            // 56: memos.api.v1.DeleteAIMemoryRequest
+	(*UpdateUserPreferencesRequest)(nil),     // 57: memos.api.v1.UpdateUserPreferencesRequest
+	(*ForgetEverythingRequest)(nil),          // 58: memos.api.v1.ForgetEverythingRequest
+	(*GetRoutingReportRequest)(nil),          // 59: memos.api.v1.GetRoutingReportRequest
+	(*RoutingReport)(nil),                    // 60: memos.api.v1.RoutingReport
+	(*ChatResponse)(nil),                     // 61: memos.api.v1.ChatResponse
+	(*ScheduleCreationIntent)(nil),           // 62: memos.api.v1.ScheduleCreationIntent
+	(*ScheduleQueryResult)(nil),              // 63: memos.api.v1.ScheduleQueryResult
+	(*ScheduleSummary)(nil),                  // 64: memos.api.v1.ScheduleSummary
+	(*GetRelatedMemosRequest)(nil),           // 65: memos.api.v1.GetRelatedMemosRequest
+	(*GetRelatedMemosResponse)(nil),          // 66: memos.api.v1.GetRelatedMemosResponse
+	(*ParrotSelfCognition)(nil),              // 67: memos.api.v1.ParrotSelfCognition
+	(*GetParrotSelfCognitionRequest)(nil),    // 68: memos.api.v1.GetParrotSelfCognitionRequest
+	(*GetParrotSelfCognitionResponse)(nil),   // 69: memos.api.v1.GetParrotSelfCognitionResponse
+	(*ListParrotsRequest)(nil),               // 70: memos.api.v1.ListParrotsRequest
+	(*ListParrotsResponse)(nil),              // 71: memos.api.v1.ListParrotsResponse
+	(*ParrotInfo)(nil),                       // 72: memos.api.v1.ParrotInfo
+	(*DetectDuplicatesRequest)(nil),          // 73: memos.api.v1.DetectDuplicatesRequest
+	(*DetectDuplicatesResponse)(nil),         // 74: memos.api.v1.DetectDuplicatesResponse
+	(*SimilarMemo)(nil),                      // 75: memos.api.v1.SimilarMemo
+	(*SimilarityBreakdown)(nil),              // 76: memos.api.v1.SimilarityBreakdown
+	(*MergeMemosRequest)(nil),                // 77: memos.api.v1.MergeMemosRequest
+	(*MergeMemosResponse)(nil),               // 78: memos.api.v1.MergeMemosResponse
+	(*LinkMemosRequest)(nil),                 // 79: memos.api.v1.LinkMemosRequest
+	(*LinkMemosResponse)(nil),                // 80: memos.api.v1.LinkMemosResponse
+	(*GetKnowledgeGraphRequest)(nil),         // 81: memos.api.v1.GetKnowledgeGraphRequest
+	(*GetKnowledgeGraphResponse)(nil),        // 82: memos.api.v1.GetKnowledgeGraphResponse
+	(*GraphNode)(nil),                        // 83: memos.api.v1.GraphNode
+	(*GraphEdge)(nil),                        // 84: memos.api.v1.GraphEdge
+	(*GraphStats)(nil),                       // 85: memos.api.v1.GraphStats
+	(*GetDueReviewsRequest)(nil),             // 86: memos.api.v1.GetDueReviewsRequest
+	(*GetDueReviewsResponse)(nil),            // 87: memos.api.v1.GetDueReviewsResponse
+	(*ReviewItem)(nil),                       // 88: memos.api.v1.ReviewItem
+	(*RecordReviewRequest)(nil),              // 89: memos.api.v1.RecordReviewRequest
+	(*GetReviewStatsRequest)(nil),            // 90: memos.api.v1.GetReviewStatsRequest
+	(*GetReviewStatsResponse)(nil),           // 91: memos.api.v1.GetReviewStatsResponse
+	(*SearchInsights_QueryStat)(nil),         // 92: memos.api.v1.SearchInsights.QueryStat
+	nil,                                      // 93: memos.api.v1.SearchInsights.StrategiesEntry
+	nil,                                      // 94: memos.api.v1.Suggestion.PayloadEntry
+	(*RoutingReport_Confusion)(nil),          // 95: memos.api.v1.RoutingReport.Confusion
+	(*fieldmaskpb.FieldMask)(nil),            // 96: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                    // 97: google.protobuf.Empty
 }
 var file_api_v1_ai_service_proto_depIdxs = []int32{
-	8,  // 0: memos.api.v1.SemanticSearchResponse.results:type_name -> memos.api.v1.SearchResult
+	9,  // 0: memos.api.v1.SemanticSearchResponse.results:type_name -> memos.api.v1.SearchResult
 	0,  // 1: memos.api.v1.ChatRequest.schedule_query_mode:type_name -> memos.api.v1.ScheduleQueryMode
 	1,  // 2: memos.api.v1.ChatRequest.agent_type:type_name -> memos.api.v1.AgentType
 	1,  // 3: memos.api.v1.AIConversation.parrot_id:type_name -> memos.api.v1.AgentType
-	13, // 4: memos.api.v1.AIConversation.messages:type_name -> memos.api.v1.AIMessage
-	12, // 5: memos.api.v1.ListAIConversationsResponse.conversations:type_name -> memos.api.v1.AIConversation
+	14, // 4: memos.api.v1.AIConversation.messages:type_name -> memos.api.v1.AIMessage
+	13, // 5: memos.api.v1.ListAIConversationsResponse.conversations:type_name -> memos.api.v1.AIConversation
 	1,  // 6: memos.api.v1.CreateAIConversationRequest.parrot_id:type_name -> memos.api.v1.AgentType
-	13, // 7: memos.api.v1.ListMessagesResponse.messages:type_name -> memos.api.v1.AIMessage
-	25, // 8: memos.api.v1.ChatResponse.schedule_creation_intent:type_name -> memos.api.v1.ScheduleCreationIntent
-	26, // 9: memos.api.v1.ChatResponse.schedule_query_result:type_name -> memos.api.v1.ScheduleQueryResult
-	27, // 10: memos.api.v1.ScheduleQueryResult.schedules:type_name -> memos.api.v1.ScheduleSummary
-	8,  // 11: memos.api.v1.GetRelatedMemosResponse.memos:type_name -> memos.api.v1.SearchResult
-	1,  // 12: memos.api.v1.GetParrotSelfCognitionRequest.agent_type:type_name -> memos.api.v1.AgentType
-	30, // 13: memos.api.v1.GetParrotSelfCognitionResponse.self_cognition:type_name -> memos.api.v1.ParrotSelfCognition
-	35, // 14: memos.api.v1.ListParrotsResponse.parrots:type_name -> memos.api.v1.ParrotInfo
-	1,  // 15: memos.api.v1.ParrotInfo.agent_type:type_name -> memos.api.v1.AgentType
-	30, // 16: memos.api.v1.ParrotInfo.self_cognition:type_name -> memos.api.v1.ParrotSelfCognition
-	38, // 17: memos.api.v1.DetectDuplicatesResponse.duplicates:type_name -> memos.api.v1.SimilarMemo
-	38, // 18: memos.api.v1.DetectDuplicatesResponse.related:type_name -> memos.api.v1.SimilarMemo
-	39, // 19: memos.api.v1.SimilarMemo.breakdown:type_name -> memos.api.v1.SimilarityBreakdown
-	46, // 20: memos.api.v1.GetKnowledgeGraphResponse.nodes:type_name -> memos.api.v1.GraphNode
-	47, // 21: memos.api.v1.GetKnowledgeGraphResponse.edges:type_name -> memos.api.v1.GraphEdge
-	48, // 22: memos.api.v1.GetKnowledgeGraphResponse.stats:type_name -> memos.api.v1.GraphStats
-	51, // 23: memos.api.v1.GetDueReviewsResponse.items:type_name -> memos.api.v1.ReviewItem
-	2,  // 24: memos.api.v1.RecordReviewRequest.quality:type_name -> memos.api.v1.ReviewQuality
-	6,  // 25: memos.api.v1.AIService.SemanticSearch:input_type -> memos.api.v1.SemanticSearchRequest
-	9,  // 26: memos.api.v1.AIService.SuggestTags:input_type -> memos.api.v1.SuggestTagsRequest
-	11, // 27: memos.api.v1.AIService.Chat:input_type -> memos.api.v1.ChatRequest
-	28, // 28: memos.api.v1.AIService.GetRelatedMemos:input_type -> memos.api.v1.GetRelatedMemosRequest
-	31, // 29: memos.api.v1.AIService.GetParrotSelfCognition:input_type -> memos.api.v1.GetParrotSelfCognitionRequest
-	33, // 30: memos.api.v1.AIService.ListParrots:input_type -> memos.api.v1.ListParrotsRequest
-	36, // 31: memos.api.v1.AIService.DetectDuplicates:input_type -> memos.api.v1.DetectDuplicatesRequest
-	40, // 32: memos.api.v1.AIService.MergeMemos:input_type -> memos.api.v1.MergeMemosRequest
-	42, // 33: memos.api.v1.AIService.LinkMemos:input_type -> memos.api.v1.LinkMemosRequest
-	44, // 34: memos.api.v1.AIService.GetKnowledgeGraph:input_type -> memos.api.v1.GetKnowledgeGraphRequest
-	49, // 35: memos.api.v1.AIService.GetDueReviews:input_type -> memos.api.v1.GetDueReviewsRequest
-	52, // 36: memos.api.v1.AIService.RecordReview:input_type -> memos.api.v1.RecordReviewRequest
-	53, // 37: memos.api.v1.AIService.GetReviewStats:input_type -> memos.api.v1.GetReviewStatsRequest
-	14, // 38: memos.api.v1.AIService.ListAIConversations:input_type -> memos.api.v1.ListAIConversationsRequest
-	16, // 39: memos.api.v1.AIService.GetAIConversation:input_type -> memos.api.v1.GetAIConversationRequest
-	17, // 40: memos.api.v1.AIService.CreateAIConversation:input_type -> memos.api.v1.CreateAIConversationRequest
-	18, // 41: memos.api.v1.AIService.UpdateAIConversation:input_type -> memos.api.v1.UpdateAIConversationRequest
-	19, // 42: memos.api.v1.AIService.DeleteAIConversation:input_type -> memos.api.v1.DeleteAIConversationRequest
-	20, // 43: memos.api.v1.AIService.AddContextSeparator:input_type -> memos.api.v1.AddContextSeparatorRequest
-	21, // 44: memos.api.v1.AIService.ListMessages:input_type -> memos.api.v1.ListMessagesRequest
-	23, // 45: memos.api.v1.AIService.ClearConversationMessages:input_type -> memos.api.v1.ClearConversationMessagesRequest
-	3,  // 46: memos.api.v1.ScheduleAgentService.Chat:input_type -> memos.api.v1.ScheduleAgentChatRequest
-	3,  // 47: memos.api.v1.ScheduleAgentService.ChatStream:input_type -> memos.api.v1.ScheduleAgentChatRequest
-	7,  // 48: memos.api.v1.AIService.SemanticSearch:output_type -> memos.api.v1.SemanticSearchResponse
-	10, // 49: memos.api.v1.AIService.SuggestTags:output_type -> memos.api.v1.SuggestTagsResponse
-	24, // 50: memos.api.v1.AIService.Chat:output_type -> memos.api.v1.ChatResponse
-	29, // 51: memos.api.v1.AIService.GetRelatedMemos:output_type -> memos.api.v1.GetRelatedMemosResponse
-	32, // 52: memos.api.v1.AIService.GetParrotSelfCognition:output_type -> memos.api.v1.GetParrotSelfCognitionResponse
-	34, // 53: memos.api.v1.AIService.ListParrots:output_type -> memos.api.v1.ListParrotsResponse
-	37, // 54: memos.api.v1.AIService.DetectDuplicates:output_type -> memos.api.v1.DetectDuplicatesResponse
-	41, // 55: memos.api.v1.AIService.MergeMemos:output_type -> memos.api.v1.MergeMemosResponse
-	43, // 56: memos.api.v1.AIService.LinkMemos:output_type -> memos.api.v1.LinkMemosResponse
-	45, // 57: memos.api.v1.AIService.GetKnowledgeGraph:output_type -> memos.api.v1.GetKnowledgeGraphResponse
-	50, // 58: memos.api.v1.AIService.GetDueReviews:output_type -> memos.api.v1.GetDueReviewsResponse
-	55, // 59: memos.api.v1.AIService.RecordReview:output_type -> google.protobuf.Empty
-	54, // 60: memos.api.v1.AIService.GetReviewStats:output_type -> memos.api.v1.GetReviewStatsResponse
-	15, // 61: memos.api.v1.AIService.ListAIConversations:output_type -> memos.api.v1.ListAIConversationsResponse
-	12, // 62: memos.api.v1.AIService.GetAIConversation:output_type -> memos.api.v1.AIConversation
-	12, // 63: memos.api.v1.AIService.CreateAIConversation:output_type -> memos.api.v1.AIConversation
-	12, // 64: memos.api.v1.AIService.UpdateAIConversation:output_type -> memos.api.v1.AIConversation
-	55, // 65: memos.api.v1.AIService.DeleteAIConversation:output_type -> google.protobuf.Empty
-	55, // 66: memos.api.v1.AIService.AddContextSeparator:output_type -> google.protobuf.Empty
-	22, // 67: memos.api.v1.AIService.ListMessages:output_type -> memos.api.v1.ListMessagesResponse
-	55, // 68: memos.api.v1.AIService.ClearConversationMessages:output_type -> google.protobuf.Empty
-	4,  // 69: memos.api.v1.ScheduleAgentService.Chat:output_type -> memos.api.v1.ScheduleAgentChatResponse
-	5,  // 70: memos.api.v1.ScheduleAgentService.ChatStream:output_type -> memos.api.v1.ScheduleAgentStreamResponse
-	48, // [48:71] is the sub-list for method output_type
-	25, // [25:48] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	14, // 7: memos.api.v1.ListMessagesResponse.messages:type_name -> memos.api.v1.AIMessage
+	30, // 8: memos.api.v1.ListDigestsResponse.digests:type_name -> memos.api.v1.Digest
+	30, // 9: memos.api.v1.UpdateDigestsRequest.digests:type_name -> memos.api.v1.Digest
+	36, // 10: memos.api.v1.ListSearchSynonymsResponse.groups:type_name -> memos.api.v1.SynonymGroup
+	36, // 11: memos.api.v1.UpdateSearchSynonymsRequest.groups:type_name -> memos.api.v1.SynonymGroup
+	92, // 12: memos.api.v1.SearchInsights.top_queries:type_name -> memos.api.v1.SearchInsights.QueryStat
+	92, // 13: memos.api.v1.SearchInsights.zero_result_queries:type_name -> memos.api.v1.SearchInsights.QueryStat
+	92, // 14: memos.api.v1.SearchInsights.slow_queries:type_name -> memos.api.v1.SearchInsights.QueryStat
+	93, // 15: memos.api.v1.SearchInsights.strategies:type_name -> memos.api.v1.SearchInsights.StrategiesEntry
+	44, // 16: memos.api.v1.GetSuggestionsRequest.recent_events:type_name -> memos.api.v1.SuggestionEvent
+	46, // 17: memos.api.v1.GetSuggestionsResponse.suggestions:type_name -> memos.api.v1.Suggestion
+	94, // 18: memos.api.v1.Suggestion.payload:type_name -> memos.api.v1.Suggestion.PayloadEntry
+	48, // 19: memos.api.v1.UpdateUserHabitsRequest.habits:type_name -> memos.api.v1.UserHabits
+	96, // 20: memos.api.v1.UpdateUserHabitsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 21: memos.api.v1.AIMemory.type:type_name -> memos.api.v1.AIMemory.Type
+	3,  // 22: memos.api.v1.ListAIMemoriesRequest.type:type_name -> memos.api.v1.AIMemory.Type
+	52, // 23: memos.api.v1.ListAIMemoriesResponse.memories:type_name -> memos.api.v1.AIMemory
+	53, // 24: memos.api.v1.ListAIMemoriesResponse.preferences:type_name -> memos.api.v1.AIPreferences
+	48, // 25: memos.api.v1.ListAIMemoriesResponse.habits:type_name -> memos.api.v1.UserHabits
+	53, // 26: memos.api.v1.UpdateUserPreferencesRequest.preferences:type_name -> memos.api.v1.AIPreferences
+	96, // 27: memos.api.v1.UpdateUserPreferencesRequest.update_mask:type_name -> google.protobuf.FieldMask
+	95, // 28: memos.api.v1.RoutingReport.confusions:type_name -> memos.api.v1.RoutingReport.Confusion
+	62, // 29: memos.api.v1.ChatResponse.schedule_creation_intent:type_name -> memos.api.v1.ScheduleCreationIntent
+	63, // 30: memos.api.v1.ChatResponse.schedule_query_result:type_name -> memos.api.v1.ScheduleQueryResult
+	64, // 31: memos.api.v1.ScheduleQueryResult.schedules:type_name -> memos.api.v1.ScheduleSummary
+	9,  // 32: memos.api.v1.GetRelatedMemosResponse.memos:type_name -> memos.api.v1.SearchResult
+	1,  // 33: memos.api.v1.GetParrotSelfCognitionRequest.agent_type:type_name -> memos.api.v1.AgentType
+	67, // 34: memos.api.v1.GetParrotSelfCognitionResponse.self_cognition:type_name -> memos.api.v1.ParrotSelfCognition
+	72, // 35: memos.api.v1.ListParrotsResponse.parrots:type_name -> memos.api.v1.ParrotInfo
+	1,  // 36: memos.api.v1.ParrotInfo.agent_type:type_name -> memos.api.v1.AgentType
+	67, // 37: memos.api.v1.ParrotInfo.self_cognition:type_name -> memos.api.v1.ParrotSelfCognition
+	75, // 38: memos.api.v1.DetectDuplicatesResponse.duplicates:type_name -> memos.api.v1.SimilarMemo
+	75, // 39: memos.api.v1.DetectDuplicatesResponse.related:type_name -> memos.api.v1.SimilarMemo
+	76, // 40: memos.api.v1.SimilarMemo.breakdown:type_name -> memos.api.v1.SimilarityBreakdown
+	83, // 41: memos.api.v1.GetKnowledgeGraphResponse.nodes:type_name -> memos.api.v1.GraphNode
+	84, // 42: memos.api.v1.GetKnowledgeGraphResponse.edges:type_name -> memos.api.v1.GraphEdge
+	85, // 43: memos.api.v1.GetKnowledgeGraphResponse.stats:type_name -> memos.api.v1.GraphStats
+	88, // 44: memos.api.v1.GetDueReviewsResponse.items:type_name -> memos.api.v1.ReviewItem
+	2,  // 45: memos.api.v1.RecordReviewRequest.quality:type_name -> memos.api.v1.ReviewQuality
+	7,  // 46: memos.api.v1.AIService.SemanticSearch:input_type -> memos.api.v1.SemanticSearchRequest
+	10, // 47: memos.api.v1.AIService.SuggestTags:input_type -> memos.api.v1.SuggestTagsRequest
+	12, // 48: memos.api.v1.AIService.Chat:input_type -> memos.api.v1.ChatRequest
+	65, // 49: memos.api.v1.AIService.GetRelatedMemos:input_type -> memos.api.v1.GetRelatedMemosRequest
+	68, // 50: memos.api.v1.AIService.GetParrotSelfCognition:input_type -> memos.api.v1.GetParrotSelfCognitionRequest
+	70, // 51: memos.api.v1.AIService.ListParrots:input_type -> memos.api.v1.ListParrotsRequest
+	73, // 52: memos.api.v1.AIService.DetectDuplicates:input_type -> memos.api.v1.DetectDuplicatesRequest
+	77, // 53: memos.api.v1.AIService.MergeMemos:input_type -> memos.api.v1.MergeMemosRequest
+	79, // 54: memos.api.v1.AIService.LinkMemos:input_type -> memos.api.v1.LinkMemosRequest
+	81, // 55: memos.api.v1.AIService.GetKnowledgeGraph:input_type -> memos.api.v1.GetKnowledgeGraphRequest
+	86, // 56: memos.api.v1.AIService.GetDueReviews:input_type -> memos.api.v1.GetDueReviewsRequest
+	89, // 57: memos.api.v1.AIService.RecordReview:input_type -> memos.api.v1.RecordReviewRequest
+	90, // 58: memos.api.v1.AIService.GetReviewStats:input_type -> memos.api.v1.GetReviewStatsRequest
+	15, // 59: memos.api.v1.AIService.ListAIConversations:input_type -> memos.api.v1.ListAIConversationsRequest
+	17, // 60: memos.api.v1.AIService.GetAIConversation:input_type -> memos.api.v1.GetAIConversationRequest
+	18, // 61: memos.api.v1.AIService.CreateAIConversation:input_type -> memos.api.v1.CreateAIConversationRequest
+	19, // 62: memos.api.v1.AIService.UpdateAIConversation:input_type -> memos.api.v1.UpdateAIConversationRequest
+	20, // 63: memos.api.v1.AIService.DeleteAIConversation:input_type -> memos.api.v1.DeleteAIConversationRequest
+	21, // 64: memos.api.v1.AIService.AddContextSeparator:input_type -> memos.api.v1.AddContextSeparatorRequest
+	22, // 65: memos.api.v1.AIService.ListMessages:input_type -> memos.api.v1.ListMessagesRequest
+	24, // 66: memos.api.v1.AIService.ClearConversationMessages:input_type -> memos.api.v1.ClearConversationMessagesRequest
+	25, // 67: memos.api.v1.AIService.SaveConversationAsMemo:input_type -> memos.api.v1.SaveConversationAsMemoRequest
+	26, // 68: memos.api.v1.AIService.SaveMessageAsMemo:input_type -> memos.api.v1.SaveMessageAsMemoRequest
+	28, // 69: memos.api.v1.AIService.CaptureURL:input_type -> memos.api.v1.CaptureURLRequest
+	31, // 70: memos.api.v1.AIService.ListDigests:input_type -> memos.api.v1.ListDigestsRequest
+	33, // 71: memos.api.v1.AIService.UpdateDigests:input_type -> memos.api.v1.UpdateDigestsRequest
+	34, // 72: memos.api.v1.AIService.RunDigest:input_type -> memos.api.v1.RunDigestRequest
+	37, // 73: memos.api.v1.AIService.ListSearchSynonyms:input_type -> memos.api.v1.ListSearchSynonymsRequest
+	39, // 74: memos.api.v1.AIService.UpdateSearchSynonyms:input_type -> memos.api.v1.UpdateSearchSynonymsRequest
+	40, // 75: memos.api.v1.AIService.RecordSearchClick:input_type -> memos.api.v1.RecordSearchClickRequest
+	41, // 76: memos.api.v1.AIService.GetSearchInsights:input_type -> memos.api.v1.GetSearchInsightsRequest
+	43, // 77: memos.api.v1.AIService.GetSuggestions:input_type -> memos.api.v1.GetSuggestionsRequest
+	47, // 78: memos.api.v1.AIService.RecordSuggestionFeedback:input_type -> memos.api.v1.RecordSuggestionFeedbackRequest
+	49, // 79: memos.api.v1.AIService.GetUserHabits:input_type -> memos.api.v1.GetUserHabitsRequest
+	50, // 80: memos.api.v1.AIService.UpdateUserHabits:input_type -> memos.api.v1.UpdateUserHabitsRequest
+	51, // 81: memos.api.v1.AIService.ResetUserHabits:input_type -> memos.api.v1.ResetUserHabitsRequest
+	54, // 82: memos.api.v1.AIService.ListAIMemories:input_type -> memos.api.v1.ListAIMemoriesRequest
+	56, // 83: memos.api.v1.AIService.DeleteAIMemory:input_type -> memos.api.v1.DeleteAIMemoryRequest
+	57, // 84: memos.api.v1.AIService.UpdateUserPreferences:input_type -> memos.api.v1.UpdateUserPreferencesRequest
+	59, // 85: memos.api.v1.AIService.GetRoutingReport:input_type -> memos.api.v1.GetRoutingReportRequest
+	58, // 86: memos.api.v1.AIService.ForgetEverything:input_type -> memos.api.v1.ForgetEverythingRequest
+	4,  // 87: memos.api.v1.ScheduleAgentService.Chat:input_type -> memos.api.v1.ScheduleAgentChatRequest
+	4,  // 88: memos.api.v1.ScheduleAgentService.ChatStream:input_type -> memos.api.v1.ScheduleAgentChatRequest
+	8,  // 89: memos.api.v1.AIService.SemanticSearch:output_type -> memos.api.v1.SemanticSearchResponse
+	11, // 90: memos.api.v1.AIService.SuggestTags:output_type -> memos.api.v1.SuggestTagsResponse
+	61, // 91: memos.api.v1.AIService.Chat:output_type -> memos.api.v1.ChatResponse
+	66, // 92: memos.api.v1.AIService.GetRelatedMemos:output_type -> memos.api.v1.GetRelatedMemosResponse
+	69, // 93: memos.api.v1.AIService.GetParrotSelfCognition:output_type -> memos.api.v1.GetParrotSelfCognitionResponse
+	71, // 94: memos.api.v1.AIService.ListParrots:output_type -> memos.api.v1.ListParrotsResponse
+	74, // 95: memos.api.v1.AIService.DetectDuplicates:output_type -> memos.api.v1.DetectDuplicatesResponse
+	78, // 96: memos.api.v1.AIService.MergeMemos:output_type -> memos.api.v1.MergeMemosResponse
+	80, // 97: memos.api.v1.AIService.LinkMemos:output_type -> memos.api.v1.LinkMemosResponse
+	82, // 98: memos.api.v1.AIService.GetKnowledgeGraph:output_type -> memos.api.v1.GetKnowledgeGraphResponse
+	87, // 99: memos.api.v1.AIService.GetDueReviews:output_type -> memos.api.v1.GetDueReviewsResponse
+	97, // 100: memos.api.v1.AIService.RecordReview:output_type -> google.protobuf.Empty
+	91, // 101: memos.api.v1.AIService.GetReviewStats:output_type -> memos.api.v1.GetReviewStatsResponse
+	16, // 102: memos.api.v1.AIService.ListAIConversations:output_type -> memos.api.v1.ListAIConversationsResponse
+	13, // 103: memos.api.v1.AIService.GetAIConversation:output_type -> memos.api.v1.AIConversation
+	13, // 104: memos.api.v1.AIService.CreateAIConversation:output_type -> memos.api.v1.AIConversation
+	13, // 105: memos.api.v1.AIService.UpdateAIConversation:output_type -> memos.api.v1.AIConversation
+	97, // 106: memos.api.v1.AIService.DeleteAIConversation:output_type -> google.protobuf.Empty
+	97, // 107: memos.api.v1.AIService.AddContextSeparator:output_type -> google.protobuf.Empty
+	23, // 108: memos.api.v1.AIService.ListMessages:output_type -> memos.api.v1.ListMessagesResponse
+	97, // 109: memos.api.v1.AIService.ClearConversationMessages:output_type -> google.protobuf.Empty
+	27, // 110: memos.api.v1.AIService.SaveConversationAsMemo:output_type -> memos.api.v1.SaveAsMemoResponse
+	27, // 111: memos.api.v1.AIService.SaveMessageAsMemo:output_type -> memos.api.v1.SaveAsMemoResponse
+	29, // 112: memos.api.v1.AIService.CaptureURL:output_type -> memos.api.v1.CaptureURLResponse
+	32, // 113: memos.api.v1.AIService.ListDigests:output_type -> memos.api.v1.ListDigestsResponse
+	32, // 114: memos.api.v1.AIService.UpdateDigests:output_type -> memos.api.v1.ListDigestsResponse
+	35, // 115: memos.api.v1.AIService.RunDigest:output_type -> memos.api.v1.RunDigestResponse
+	38, // 116: memos.api.v1.AIService.ListSearchSynonyms:output_type -> memos.api.v1.ListSearchSynonymsResponse
+	38, // 117: memos.api.v1.AIService.UpdateSearchSynonyms:output_type -> memos.api.v1.ListSearchSynonymsResponse
+	97, // 118: memos.api.v1.AIService.RecordSearchClick:output_type -> google.protobuf.Empty
+	42, // 119: memos.api.v1.AIService.GetSearchInsights:output_type -> memos.api.v1.SearchInsights
+	45, // 120: memos.api.v1.AIService.GetSuggestions:output_type -> memos.api.v1.GetSuggestionsResponse
+	97, // 121: memos.api.v1.AIService.RecordSuggestionFeedback:output_type -> google.protobuf.Empty
+	48, // 122: memos.api.v1.AIService.GetUserHabits:output_type -> memos.api.v1.UserHabits
+	48, // 123: memos.api.v1.AIService.UpdateUserHabits:output_type -> memos.api.v1.UserHabits
+	48, // 124: memos.api.v1.AIService.ResetUserHabits:output_type -> memos.api.v1.UserHabits
+	55, // 125: memos.api.v1.AIService.ListAIMemories:output_type -> memos.api.v1.ListAIMemoriesResponse
+	97, // 126: memos.api.v1.AIService.DeleteAIMemory:output_type -> google.protobuf.Empty
+	53, // 127: memos.api.v1.AIService.UpdateUserPreferences:output_type -> memos.api.v1.AIPreferences
+	60, // 128: memos.api.v1.AIService.GetRoutingReport:output_type -> memos.api.v1.RoutingReport
+	97, // 129: memos.api.v1.AIService.ForgetEverything:output_type -> google.protobuf.Empty
+	5,  // 130: memos.api.v1.ScheduleAgentService.Chat:output_type -> memos.api.v1.ScheduleAgentChatResponse
+	6,  // 131: memos.api.v1.ScheduleAgentService.ChatStream:output_type -> memos.api.v1.ScheduleAgentStreamResponse
+	89, // [89:132] is the sub-list for method output_type
+	46, // [46:89] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_ai_service_proto_init() }
@@ -3959,8 +6703,8 @@ func file_api_v1_ai_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_ai_service_proto_rawDesc), len(file_api_v1_ai_service_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   52,
+			NumEnums:      4,
+			NumMessages:   92,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
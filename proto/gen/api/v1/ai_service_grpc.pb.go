@@ -41,6 +41,26 @@ const (
 	AIService_AddContextSeparator_FullMethodName       = "/memos.api.v1.AIService/AddContextSeparator"
 	AIService_ListMessages_FullMethodName              = "/memos.api.v1.AIService/ListMessages"
 	AIService_ClearConversationMessages_FullMethodName = "/memos.api.v1.AIService/ClearConversationMessages"
+	AIService_SaveConversationAsMemo_FullMethodName    = "/memos.api.v1.AIService/SaveConversationAsMemo"
+	AIService_SaveMessageAsMemo_FullMethodName         = "/memos.api.v1.AIService/SaveMessageAsMemo"
+	AIService_CaptureURL_FullMethodName                = "/memos.api.v1.AIService/CaptureURL"
+	AIService_ListDigests_FullMethodName               = "/memos.api.v1.AIService/ListDigests"
+	AIService_UpdateDigests_FullMethodName             = "/memos.api.v1.AIService/UpdateDigests"
+	AIService_RunDigest_FullMethodName                 = "/memos.api.v1.AIService/RunDigest"
+	AIService_ListSearchSynonyms_FullMethodName        = "/memos.api.v1.AIService/ListSearchSynonyms"
+	AIService_UpdateSearchSynonyms_FullMethodName      = "/memos.api.v1.AIService/UpdateSearchSynonyms"
+	AIService_RecordSearchClick_FullMethodName         = "/memos.api.v1.AIService/RecordSearchClick"
+	AIService_GetSearchInsights_FullMethodName         = "/memos.api.v1.AIService/GetSearchInsights"
+	AIService_GetSuggestions_FullMethodName            = "/memos.api.v1.AIService/GetSuggestions"
+	AIService_RecordSuggestionFeedback_FullMethodName  = "/memos.api.v1.AIService/RecordSuggestionFeedback"
+	AIService_GetUserHabits_FullMethodName             = "/memos.api.v1.AIService/GetUserHabits"
+	AIService_UpdateUserHabits_FullMethodName          = "/memos.api.v1.AIService/UpdateUserHabits"
+	AIService_ResetUserHabits_FullMethodName           = "/memos.api.v1.AIService/ResetUserHabits"
+	AIService_ListAIMemories_FullMethodName            = "/memos.api.v1.AIService/ListAIMemories"
+	AIService_DeleteAIMemory_FullMethodName            = "/memos.api.v1.AIService/DeleteAIMemory"
+	AIService_UpdateUserPreferences_FullMethodName     = "/memos.api.v1.AIService/UpdateUserPreferences"
+	AIService_GetRoutingReport_FullMethodName          = "/memos.api.v1.AIService/GetRoutingReport"
+	AIService_ForgetEverything_FullMethodName          = "/memos.api.v1.AIService/ForgetEverything"
 )
 
 // AIServiceClient is the client API for AIService service.
@@ -91,6 +111,50 @@ type AIServiceClient interface {
 	ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error)
 	// ClearConversationMessages deletes all messages in a conversation.
 	ClearConversationMessages(ctx context.Context, in *ClearConversationMessagesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SaveConversationAsMemo renders a conversation (or selected messages) to markdown and saves it as a memo.
+	SaveConversationAsMemo(ctx context.Context, in *SaveConversationAsMemoRequest, opts ...grpc.CallOption) (*SaveAsMemoResponse, error)
+	// SaveMessageAsMemo saves a single assistant answer (with its question) as a memo.
+	SaveMessageAsMemo(ctx context.Context, in *SaveMessageAsMemoRequest, opts ...grpc.CallOption) (*SaveAsMemoResponse, error)
+	// CaptureURL fetches a web page and saves its article, summary and tags as a memo.
+	CaptureURL(ctx context.Context, in *CaptureURLRequest, opts ...grpc.CallOption) (*CaptureURLResponse, error)
+	// ListDigests returns the scheduled AI digests of the current user.
+	ListDigests(ctx context.Context, in *ListDigestsRequest, opts ...grpc.CallOption) (*ListDigestsResponse, error)
+	// UpdateDigests replaces the scheduled AI digests of the current user.
+	UpdateDigests(ctx context.Context, in *UpdateDigestsRequest, opts ...grpc.CallOption) (*ListDigestsResponse, error)
+	// RunDigest generates a digest immediately, regardless of its schedule.
+	RunDigest(ctx context.Context, in *RunDigestRequest, opts ...grpc.CallOption) (*RunDigestResponse, error)
+	// ListSearchSynonyms returns the search synonyms of the current user.
+	ListSearchSynonyms(ctx context.Context, in *ListSearchSynonymsRequest, opts ...grpc.CallOption) (*ListSearchSynonymsResponse, error)
+	// UpdateSearchSynonyms replaces the search synonyms of the current user.
+	UpdateSearchSynonyms(ctx context.Context, in *UpdateSearchSynonymsRequest, opts ...grpc.CallOption) (*ListSearchSynonymsResponse, error)
+	// RecordSearchClick records that a memo was opened from the results of a logged search.
+	RecordSearchClick(ctx context.Context, in *RecordSearchClickRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetSearchInsights summarizes the logged searches of the current user, or of all users for an admin:
+	// top, zero-result and slow queries, and memos that keyword or semantic search cannot find well.
+	GetSearchInsights(ctx context.Context, in *GetSearchInsightsRequest, opts ...grpc.CallOption) (*SearchInsights, error)
+	// GetSuggestions returns ranked, explainable next actions for the current user.
+	GetSuggestions(ctx context.Context, in *GetSuggestionsRequest, opts ...grpc.CallOption) (*GetSuggestionsResponse, error)
+	// RecordSuggestionFeedback records that a suggestion was accepted or dismissed.
+	RecordSuggestionFeedback(ctx context.Context, in *RecordSuggestionFeedbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetUserHabits returns the habits learned for the current user.
+	GetUserHabits(ctx context.Context, in *GetUserHabitsRequest, opts ...grpc.CallOption) (*UserHabits, error)
+	// UpdateUserHabits corrects learned habits. Corrected fields are no longer overwritten by learning.
+	UpdateUserHabits(ctx context.Context, in *UpdateUserHabitsRequest, opts ...grpc.CallOption) (*UserHabits, error)
+	// ResetUserHabits forgets learned habits and corrections of the current user.
+	ResetUserHabits(ctx context.Context, in *ResetUserHabitsRequest, opts ...grpc.CallOption) (*UserHabits, error)
+	// ListAIMemories lists what the assistant remembers about the current user.
+	ListAIMemories(ctx context.Context, in *ListAIMemoriesRequest, opts ...grpc.CallOption) (*ListAIMemoriesResponse, error)
+	// DeleteAIMemory forgets a single memory.
+	DeleteAIMemory(ctx context.Context, in *DeleteAIMemoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UpdateUserPreferences updates the AI preferences of the current user, including incognito mode
+	// and search logging. Disabling search logging deletes the searches logged so far.
+	UpdateUserPreferences(ctx context.Context, in *UpdateUserPreferencesRequest, opts ...grpc.CallOption) (*AIPreferences, error)
+	// GetRoutingReport summarizes how users corrected assistant routing, by predicted intent.
+	// Requires an admin. Used to tune the routing keyword tables.
+	GetRoutingReport(ctx context.Context, in *GetRoutingReportRequest, opts ...grpc.CallOption) (*RoutingReport, error)
+	// ForgetEverything deletes all memories, preferences, habits, conversation summaries and search logs
+	// of the current user. Incognito mode and the search log setting are kept.
+	ForgetEverything(ctx context.Context, in *ForgetEverythingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type aIServiceClient struct {
@@ -320,6 +384,206 @@ func (c *aIServiceClient) ClearConversationMessages(ctx context.Context, in *Cle
 	return out, nil
 }
 
+func (c *aIServiceClient) SaveConversationAsMemo(ctx context.Context, in *SaveConversationAsMemoRequest, opts ...grpc.CallOption) (*SaveAsMemoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveAsMemoResponse)
+	err := c.cc.Invoke(ctx, AIService_SaveConversationAsMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) SaveMessageAsMemo(ctx context.Context, in *SaveMessageAsMemoRequest, opts ...grpc.CallOption) (*SaveAsMemoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveAsMemoResponse)
+	err := c.cc.Invoke(ctx, AIService_SaveMessageAsMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) CaptureURL(ctx context.Context, in *CaptureURLRequest, opts ...grpc.CallOption) (*CaptureURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaptureURLResponse)
+	err := c.cc.Invoke(ctx, AIService_CaptureURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ListDigests(ctx context.Context, in *ListDigestsRequest, opts ...grpc.CallOption) (*ListDigestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDigestsResponse)
+	err := c.cc.Invoke(ctx, AIService_ListDigests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) UpdateDigests(ctx context.Context, in *UpdateDigestsRequest, opts ...grpc.CallOption) (*ListDigestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDigestsResponse)
+	err := c.cc.Invoke(ctx, AIService_UpdateDigests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) RunDigest(ctx context.Context, in *RunDigestRequest, opts ...grpc.CallOption) (*RunDigestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunDigestResponse)
+	err := c.cc.Invoke(ctx, AIService_RunDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ListSearchSynonyms(ctx context.Context, in *ListSearchSynonymsRequest, opts ...grpc.CallOption) (*ListSearchSynonymsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSearchSynonymsResponse)
+	err := c.cc.Invoke(ctx, AIService_ListSearchSynonyms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) UpdateSearchSynonyms(ctx context.Context, in *UpdateSearchSynonymsRequest, opts ...grpc.CallOption) (*ListSearchSynonymsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSearchSynonymsResponse)
+	err := c.cc.Invoke(ctx, AIService_UpdateSearchSynonyms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) RecordSearchClick(ctx context.Context, in *RecordSearchClickRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AIService_RecordSearchClick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) GetSearchInsights(ctx context.Context, in *GetSearchInsightsRequest, opts ...grpc.CallOption) (*SearchInsights, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchInsights)
+	err := c.cc.Invoke(ctx, AIService_GetSearchInsights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) GetSuggestions(ctx context.Context, in *GetSuggestionsRequest, opts ...grpc.CallOption) (*GetSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSuggestionsResponse)
+	err := c.cc.Invoke(ctx, AIService_GetSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) RecordSuggestionFeedback(ctx context.Context, in *RecordSuggestionFeedbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AIService_RecordSuggestionFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) GetUserHabits(ctx context.Context, in *GetUserHabitsRequest, opts ...grpc.CallOption) (*UserHabits, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHabits)
+	err := c.cc.Invoke(ctx, AIService_GetUserHabits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) UpdateUserHabits(ctx context.Context, in *UpdateUserHabitsRequest, opts ...grpc.CallOption) (*UserHabits, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHabits)
+	err := c.cc.Invoke(ctx, AIService_UpdateUserHabits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ResetUserHabits(ctx context.Context, in *ResetUserHabitsRequest, opts ...grpc.CallOption) (*UserHabits, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserHabits)
+	err := c.cc.Invoke(ctx, AIService_ResetUserHabits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ListAIMemories(ctx context.Context, in *ListAIMemoriesRequest, opts ...grpc.CallOption) (*ListAIMemoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAIMemoriesResponse)
+	err := c.cc.Invoke(ctx, AIService_ListAIMemories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) DeleteAIMemory(ctx context.Context, in *DeleteAIMemoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AIService_DeleteAIMemory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) UpdateUserPreferences(ctx context.Context, in *UpdateUserPreferencesRequest, opts ...grpc.CallOption) (*AIPreferences, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AIPreferences)
+	err := c.cc.Invoke(ctx, AIService_UpdateUserPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) GetRoutingReport(ctx context.Context, in *GetRoutingReportRequest, opts ...grpc.CallOption) (*RoutingReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoutingReport)
+	err := c.cc.Invoke(ctx, AIService_GetRoutingReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIServiceClient) ForgetEverything(ctx context.Context, in *ForgetEverythingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AIService_ForgetEverything_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AIServiceServer is the server API for AIService service.
 // All implementations must embed UnimplementedAIServiceServer
 // for forward compatibility.
@@ -368,6 +632,50 @@ type AIServiceServer interface {
 	ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error)
 	// ClearConversationMessages deletes all messages in a conversation.
 	ClearConversationMessages(context.Context, *ClearConversationMessagesRequest) (*emptypb.Empty, error)
+	// SaveConversationAsMemo renders a conversation (or selected messages) to markdown and saves it as a memo.
+	SaveConversationAsMemo(context.Context, *SaveConversationAsMemoRequest) (*SaveAsMemoResponse, error)
+	// SaveMessageAsMemo saves a single assistant answer (with its question) as a memo.
+	SaveMessageAsMemo(context.Context, *SaveMessageAsMemoRequest) (*SaveAsMemoResponse, error)
+	// CaptureURL fetches a web page and saves its article, summary and tags as a memo.
+	CaptureURL(context.Context, *CaptureURLRequest) (*CaptureURLResponse, error)
+	// ListDigests returns the scheduled AI digests of the current user.
+	ListDigests(context.Context, *ListDigestsRequest) (*ListDigestsResponse, error)
+	// UpdateDigests replaces the scheduled AI digests of the current user.
+	UpdateDigests(context.Context, *UpdateDigestsRequest) (*ListDigestsResponse, error)
+	// RunDigest generates a digest immediately, regardless of its schedule.
+	RunDigest(context.Context, *RunDigestRequest) (*RunDigestResponse, error)
+	// ListSearchSynonyms returns the search synonyms of the current user.
+	ListSearchSynonyms(context.Context, *ListSearchSynonymsRequest) (*ListSearchSynonymsResponse, error)
+	// UpdateSearchSynonyms replaces the search synonyms of the current user.
+	UpdateSearchSynonyms(context.Context, *UpdateSearchSynonymsRequest) (*ListSearchSynonymsResponse, error)
+	// RecordSearchClick records that a memo was opened from the results of a logged search.
+	RecordSearchClick(context.Context, *RecordSearchClickRequest) (*emptypb.Empty, error)
+	// GetSearchInsights summarizes the logged searches of the current user, or of all users for an admin:
+	// top, zero-result and slow queries, and memos that keyword or semantic search cannot find well.
+	GetSearchInsights(context.Context, *GetSearchInsightsRequest) (*SearchInsights, error)
+	// GetSuggestions returns ranked, explainable next actions for the current user.
+	GetSuggestions(context.Context, *GetSuggestionsRequest) (*GetSuggestionsResponse, error)
+	// RecordSuggestionFeedback records that a suggestion was accepted or dismissed.
+	RecordSuggestionFeedback(context.Context, *RecordSuggestionFeedbackRequest) (*emptypb.Empty, error)
+	// GetUserHabits returns the habits learned for the current user.
+	GetUserHabits(context.Context, *GetUserHabitsRequest) (*UserHabits, error)
+	// UpdateUserHabits corrects learned habits. Corrected fields are no longer overwritten by learning.
+	UpdateUserHabits(context.Context, *UpdateUserHabitsRequest) (*UserHabits, error)
+	// ResetUserHabits forgets learned habits and corrections of the current user.
+	ResetUserHabits(context.Context, *ResetUserHabitsRequest) (*UserHabits, error)
+	// ListAIMemories lists what the assistant remembers about the current user.
+	ListAIMemories(context.Context, *ListAIMemoriesRequest) (*ListAIMemoriesResponse, error)
+	// DeleteAIMemory forgets a single memory.
+	DeleteAIMemory(context.Context, *DeleteAIMemoryRequest) (*emptypb.Empty, error)
+	// UpdateUserPreferences updates the AI preferences of the current user, including incognito mode
+	// and search logging. Disabling search logging deletes the searches logged so far.
+	UpdateUserPreferences(context.Context, *UpdateUserPreferencesRequest) (*AIPreferences, error)
+	// GetRoutingReport summarizes how users corrected assistant routing, by predicted intent.
+	// Requires an admin. Used to tune the routing keyword tables.
+	GetRoutingReport(context.Context, *GetRoutingReportRequest) (*RoutingReport, error)
+	// ForgetEverything deletes all memories, preferences, habits, conversation summaries and search logs
+	// of the current user. Incognito mode and the search log setting are kept.
+	ForgetEverything(context.Context, *ForgetEverythingRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAIServiceServer()
 }
 
@@ -441,6 +749,66 @@ func (UnimplementedAIServiceServer) ListMessages(context.Context, *ListMessagesR
 func (UnimplementedAIServiceServer) ClearConversationMessages(context.Context, *ClearConversationMessagesRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ClearConversationMessages not implemented")
 }
+func (UnimplementedAIServiceServer) SaveConversationAsMemo(context.Context, *SaveConversationAsMemoRequest) (*SaveAsMemoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveConversationAsMemo not implemented")
+}
+func (UnimplementedAIServiceServer) SaveMessageAsMemo(context.Context, *SaveMessageAsMemoRequest) (*SaveAsMemoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveMessageAsMemo not implemented")
+}
+func (UnimplementedAIServiceServer) CaptureURL(context.Context, *CaptureURLRequest) (*CaptureURLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CaptureURL not implemented")
+}
+func (UnimplementedAIServiceServer) ListDigests(context.Context, *ListDigestsRequest) (*ListDigestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDigests not implemented")
+}
+func (UnimplementedAIServiceServer) UpdateDigests(context.Context, *UpdateDigestsRequest) (*ListDigestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateDigests not implemented")
+}
+func (UnimplementedAIServiceServer) RunDigest(context.Context, *RunDigestRequest) (*RunDigestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunDigest not implemented")
+}
+func (UnimplementedAIServiceServer) ListSearchSynonyms(context.Context, *ListSearchSynonymsRequest) (*ListSearchSynonymsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSearchSynonyms not implemented")
+}
+func (UnimplementedAIServiceServer) UpdateSearchSynonyms(context.Context, *UpdateSearchSynonymsRequest) (*ListSearchSynonymsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSearchSynonyms not implemented")
+}
+func (UnimplementedAIServiceServer) RecordSearchClick(context.Context, *RecordSearchClickRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordSearchClick not implemented")
+}
+func (UnimplementedAIServiceServer) GetSearchInsights(context.Context, *GetSearchInsightsRequest) (*SearchInsights, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSearchInsights not implemented")
+}
+func (UnimplementedAIServiceServer) GetSuggestions(context.Context, *GetSuggestionsRequest) (*GetSuggestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSuggestions not implemented")
+}
+func (UnimplementedAIServiceServer) RecordSuggestionFeedback(context.Context, *RecordSuggestionFeedbackRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordSuggestionFeedback not implemented")
+}
+func (UnimplementedAIServiceServer) GetUserHabits(context.Context, *GetUserHabitsRequest) (*UserHabits, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserHabits not implemented")
+}
+func (UnimplementedAIServiceServer) UpdateUserHabits(context.Context, *UpdateUserHabitsRequest) (*UserHabits, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUserHabits not implemented")
+}
+func (UnimplementedAIServiceServer) ResetUserHabits(context.Context, *ResetUserHabitsRequest) (*UserHabits, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetUserHabits not implemented")
+}
+func (UnimplementedAIServiceServer) ListAIMemories(context.Context, *ListAIMemoriesRequest) (*ListAIMemoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAIMemories not implemented")
+}
+func (UnimplementedAIServiceServer) DeleteAIMemory(context.Context, *DeleteAIMemoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAIMemory not implemented")
+}
+func (UnimplementedAIServiceServer) UpdateUserPreferences(context.Context, *UpdateUserPreferencesRequest) (*AIPreferences, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUserPreferences not implemented")
+}
+func (UnimplementedAIServiceServer) GetRoutingReport(context.Context, *GetRoutingReportRequest) (*RoutingReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRoutingReport not implemented")
+}
+func (UnimplementedAIServiceServer) ForgetEverything(context.Context, *ForgetEverythingRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForgetEverything not implemented")
+}
 func (UnimplementedAIServiceServer) mustEmbedUnimplementedAIServiceServer() {}
 func (UnimplementedAIServiceServer) testEmbeddedByValue()                   {}
 
@@ -833,6 +1201,366 @@ func _AIService_ClearConversationMessages_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AIService_SaveConversationAsMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveConversationAsMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).SaveConversationAsMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_SaveConversationAsMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).SaveConversationAsMemo(ctx, req.(*SaveConversationAsMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_SaveMessageAsMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveMessageAsMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).SaveMessageAsMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_SaveMessageAsMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).SaveMessageAsMemo(ctx, req.(*SaveMessageAsMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_CaptureURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).CaptureURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_CaptureURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).CaptureURL(ctx, req.(*CaptureURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ListDigests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDigestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ListDigests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ListDigests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ListDigests(ctx, req.(*ListDigestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_UpdateDigests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDigestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).UpdateDigests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_UpdateDigests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).UpdateDigests(ctx, req.(*UpdateDigestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_RunDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).RunDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_RunDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).RunDigest(ctx, req.(*RunDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ListSearchSynonyms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSearchSynonymsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ListSearchSynonyms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ListSearchSynonyms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ListSearchSynonyms(ctx, req.(*ListSearchSynonymsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_UpdateSearchSynonyms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSearchSynonymsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).UpdateSearchSynonyms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_UpdateSearchSynonyms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).UpdateSearchSynonyms(ctx, req.(*UpdateSearchSynonymsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_RecordSearchClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSearchClickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).RecordSearchClick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_RecordSearchClick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).RecordSearchClick(ctx, req.(*RecordSearchClickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_GetSearchInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchInsightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).GetSearchInsights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_GetSearchInsights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).GetSearchInsights(ctx, req.(*GetSearchInsightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_GetSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).GetSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_GetSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).GetSuggestions(ctx, req.(*GetSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_RecordSuggestionFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSuggestionFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).RecordSuggestionFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_RecordSuggestionFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).RecordSuggestionFeedback(ctx, req.(*RecordSuggestionFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_GetUserHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserHabitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).GetUserHabits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_GetUserHabits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).GetUserHabits(ctx, req.(*GetUserHabitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_UpdateUserHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserHabitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).UpdateUserHabits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_UpdateUserHabits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).UpdateUserHabits(ctx, req.(*UpdateUserHabitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ResetUserHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserHabitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ResetUserHabits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ResetUserHabits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ResetUserHabits(ctx, req.(*ResetUserHabitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ListAIMemories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAIMemoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ListAIMemories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ListAIMemories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ListAIMemories(ctx, req.(*ListAIMemoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_DeleteAIMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAIMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).DeleteAIMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_DeleteAIMemory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).DeleteAIMemory(ctx, req.(*DeleteAIMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_UpdateUserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).UpdateUserPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_UpdateUserPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).UpdateUserPreferences(ctx, req.(*UpdateUserPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_GetRoutingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoutingReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).GetRoutingReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_GetRoutingReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).GetRoutingReport(ctx, req.(*GetRoutingReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIService_ForgetEverything_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetEverythingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).ForgetEverything(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_ForgetEverything_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).ForgetEverything(ctx, req.(*ForgetEverythingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AIService_ServiceDesc is the grpc.ServiceDesc for AIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -920,6 +1648,86 @@ var AIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearConversationMessages",
 			Handler:    _AIService_ClearConversationMessages_Handler,
 		},
+		{
+			MethodName: "SaveConversationAsMemo",
+			Handler:    _AIService_SaveConversationAsMemo_Handler,
+		},
+		{
+			MethodName: "SaveMessageAsMemo",
+			Handler:    _AIService_SaveMessageAsMemo_Handler,
+		},
+		{
+			MethodName: "CaptureURL",
+			Handler:    _AIService_CaptureURL_Handler,
+		},
+		{
+			MethodName: "ListDigests",
+			Handler:    _AIService_ListDigests_Handler,
+		},
+		{
+			MethodName: "UpdateDigests",
+			Handler:    _AIService_UpdateDigests_Handler,
+		},
+		{
+			MethodName: "RunDigest",
+			Handler:    _AIService_RunDigest_Handler,
+		},
+		{
+			MethodName: "ListSearchSynonyms",
+			Handler:    _AIService_ListSearchSynonyms_Handler,
+		},
+		{
+			MethodName: "UpdateSearchSynonyms",
+			Handler:    _AIService_UpdateSearchSynonyms_Handler,
+		},
+		{
+			MethodName: "RecordSearchClick",
+			Handler:    _AIService_RecordSearchClick_Handler,
+		},
+		{
+			MethodName: "GetSearchInsights",
+			Handler:    _AIService_GetSearchInsights_Handler,
+		},
+		{
+			MethodName: "GetSuggestions",
+			Handler:    _AIService_GetSuggestions_Handler,
+		},
+		{
+			MethodName: "RecordSuggestionFeedback",
+			Handler:    _AIService_RecordSuggestionFeedback_Handler,
+		},
+		{
+			MethodName: "GetUserHabits",
+			Handler:    _AIService_GetUserHabits_Handler,
+		},
+		{
+			MethodName: "UpdateUserHabits",
+			Handler:    _AIService_UpdateUserHabits_Handler,
+		},
+		{
+			MethodName: "ResetUserHabits",
+			Handler:    _AIService_ResetUserHabits_Handler,
+		},
+		{
+			MethodName: "ListAIMemories",
+			Handler:    _AIService_ListAIMemories_Handler,
+		},
+		{
+			MethodName: "DeleteAIMemory",
+			Handler:    _AIService_DeleteAIMemory_Handler,
+		},
+		{
+			MethodName: "UpdateUserPreferences",
+			Handler:    _AIService_UpdateUserPreferences_Handler,
+		},
+		{
+			MethodName: "GetRoutingReport",
+			Handler:    _AIService_GetRoutingReport_Handler,
+		},
+		{
+			MethodName: "ForgetEverything",
+			Handler:    _AIService_ForgetEverything_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -91,6 +91,61 @@ const (
 	// AIServiceClearConversationMessagesProcedure is the fully-qualified name of the AIService's
 	// ClearConversationMessages RPC.
 	AIServiceClearConversationMessagesProcedure = "/memos.api.v1.AIService/ClearConversationMessages"
+	// AIServiceSaveConversationAsMemoProcedure is the fully-qualified name of the AIService's
+	// SaveConversationAsMemo RPC.
+	AIServiceSaveConversationAsMemoProcedure = "/memos.api.v1.AIService/SaveConversationAsMemo"
+	// AIServiceSaveMessageAsMemoProcedure is the fully-qualified name of the AIService's
+	// SaveMessageAsMemo RPC.
+	AIServiceSaveMessageAsMemoProcedure = "/memos.api.v1.AIService/SaveMessageAsMemo"
+	// AIServiceCaptureURLProcedure is the fully-qualified name of the AIService's CaptureURL RPC.
+	AIServiceCaptureURLProcedure = "/memos.api.v1.AIService/CaptureURL"
+	// AIServiceListDigestsProcedure is the fully-qualified name of the AIService's ListDigests RPC.
+	AIServiceListDigestsProcedure = "/memos.api.v1.AIService/ListDigests"
+	// AIServiceUpdateDigestsProcedure is the fully-qualified name of the AIService's UpdateDigests RPC.
+	AIServiceUpdateDigestsProcedure = "/memos.api.v1.AIService/UpdateDigests"
+	// AIServiceRunDigestProcedure is the fully-qualified name of the AIService's RunDigest RPC.
+	AIServiceRunDigestProcedure = "/memos.api.v1.AIService/RunDigest"
+	// AIServiceListSearchSynonymsProcedure is the fully-qualified name of the AIService's
+	// ListSearchSynonyms RPC.
+	AIServiceListSearchSynonymsProcedure = "/memos.api.v1.AIService/ListSearchSynonyms"
+	// AIServiceUpdateSearchSynonymsProcedure is the fully-qualified name of the AIService's
+	// UpdateSearchSynonyms RPC.
+	AIServiceUpdateSearchSynonymsProcedure = "/memos.api.v1.AIService/UpdateSearchSynonyms"
+	// AIServiceRecordSearchClickProcedure is the fully-qualified name of the AIService's
+	// RecordSearchClick RPC.
+	AIServiceRecordSearchClickProcedure = "/memos.api.v1.AIService/RecordSearchClick"
+	// AIServiceGetSearchInsightsProcedure is the fully-qualified name of the AIService's
+	// GetSearchInsights RPC.
+	AIServiceGetSearchInsightsProcedure = "/memos.api.v1.AIService/GetSearchInsights"
+	// AIServiceGetSuggestionsProcedure is the fully-qualified name of the AIService's GetSuggestions
+	// RPC.
+	AIServiceGetSuggestionsProcedure = "/memos.api.v1.AIService/GetSuggestions"
+	// AIServiceRecordSuggestionFeedbackProcedure is the fully-qualified name of the AIService's
+	// RecordSuggestionFeedback RPC.
+	AIServiceRecordSuggestionFeedbackProcedure = "/memos.api.v1.AIService/RecordSuggestionFeedback"
+	// AIServiceGetUserHabitsProcedure is the fully-qualified name of the AIService's GetUserHabits RPC.
+	AIServiceGetUserHabitsProcedure = "/memos.api.v1.AIService/GetUserHabits"
+	// AIServiceUpdateUserHabitsProcedure is the fully-qualified name of the AIService's
+	// UpdateUserHabits RPC.
+	AIServiceUpdateUserHabitsProcedure = "/memos.api.v1.AIService/UpdateUserHabits"
+	// AIServiceResetUserHabitsProcedure is the fully-qualified name of the AIService's ResetUserHabits
+	// RPC.
+	AIServiceResetUserHabitsProcedure = "/memos.api.v1.AIService/ResetUserHabits"
+	// AIServiceListAIMemoriesProcedure is the fully-qualified name of the AIService's ListAIMemories
+	// RPC.
+	AIServiceListAIMemoriesProcedure = "/memos.api.v1.AIService/ListAIMemories"
+	// AIServiceDeleteAIMemoryProcedure is the fully-qualified name of the AIService's DeleteAIMemory
+	// RPC.
+	AIServiceDeleteAIMemoryProcedure = "/memos.api.v1.AIService/DeleteAIMemory"
+	// AIServiceUpdateUserPreferencesProcedure is the fully-qualified name of the AIService's
+	// UpdateUserPreferences RPC.
+	AIServiceUpdateUserPreferencesProcedure = "/memos.api.v1.AIService/UpdateUserPreferences"
+	// AIServiceGetRoutingReportProcedure is the fully-qualified name of the AIService's
+	// GetRoutingReport RPC.
+	AIServiceGetRoutingReportProcedure = "/memos.api.v1.AIService/GetRoutingReport"
+	// AIServiceForgetEverythingProcedure is the fully-qualified name of the AIService's
+	// ForgetEverything RPC.
+	AIServiceForgetEverythingProcedure = "/memos.api.v1.AIService/ForgetEverything"
 	// ScheduleAgentServiceChatProcedure is the fully-qualified name of the ScheduleAgentService's Chat
 	// RPC.
 	ScheduleAgentServiceChatProcedure = "/memos.api.v1.ScheduleAgentService/Chat"
@@ -143,6 +198,50 @@ type AIServiceClient interface {
 	ListMessages(context.Context, *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error)
 	// ClearConversationMessages deletes all messages in a conversation.
 	ClearConversationMessages(context.Context, *connect.Request[v1.ClearConversationMessagesRequest]) (*connect.Response[emptypb.Empty], error)
+	// SaveConversationAsMemo renders a conversation (or selected messages) to markdown and saves it as a memo.
+	SaveConversationAsMemo(context.Context, *connect.Request[v1.SaveConversationAsMemoRequest]) (*connect.Response[v1.SaveAsMemoResponse], error)
+	// SaveMessageAsMemo saves a single assistant answer (with its question) as a memo.
+	SaveMessageAsMemo(context.Context, *connect.Request[v1.SaveMessageAsMemoRequest]) (*connect.Response[v1.SaveAsMemoResponse], error)
+	// CaptureURL fetches a web page and saves its article, summary and tags as a memo.
+	CaptureURL(context.Context, *connect.Request[v1.CaptureURLRequest]) (*connect.Response[v1.CaptureURLResponse], error)
+	// ListDigests returns the scheduled AI digests of the current user.
+	ListDigests(context.Context, *connect.Request[v1.ListDigestsRequest]) (*connect.Response[v1.ListDigestsResponse], error)
+	// UpdateDigests replaces the scheduled AI digests of the current user.
+	UpdateDigests(context.Context, *connect.Request[v1.UpdateDigestsRequest]) (*connect.Response[v1.ListDigestsResponse], error)
+	// RunDigest generates a digest immediately, regardless of its schedule.
+	RunDigest(context.Context, *connect.Request[v1.RunDigestRequest]) (*connect.Response[v1.RunDigestResponse], error)
+	// ListSearchSynonyms returns the search synonyms of the current user.
+	ListSearchSynonyms(context.Context, *connect.Request[v1.ListSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error)
+	// UpdateSearchSynonyms replaces the search synonyms of the current user.
+	UpdateSearchSynonyms(context.Context, *connect.Request[v1.UpdateSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error)
+	// RecordSearchClick records that a memo was opened from the results of a logged search.
+	RecordSearchClick(context.Context, *connect.Request[v1.RecordSearchClickRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetSearchInsights summarizes the logged searches of the current user, or of all users for an admin:
+	// top, zero-result and slow queries, and memos that keyword or semantic search cannot find well.
+	GetSearchInsights(context.Context, *connect.Request[v1.GetSearchInsightsRequest]) (*connect.Response[v1.SearchInsights], error)
+	// GetSuggestions returns ranked, explainable next actions for the current user.
+	GetSuggestions(context.Context, *connect.Request[v1.GetSuggestionsRequest]) (*connect.Response[v1.GetSuggestionsResponse], error)
+	// RecordSuggestionFeedback records that a suggestion was accepted or dismissed.
+	RecordSuggestionFeedback(context.Context, *connect.Request[v1.RecordSuggestionFeedbackRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserHabits returns the habits learned for the current user.
+	GetUserHabits(context.Context, *connect.Request[v1.GetUserHabitsRequest]) (*connect.Response[v1.UserHabits], error)
+	// UpdateUserHabits corrects learned habits. Corrected fields are no longer overwritten by learning.
+	UpdateUserHabits(context.Context, *connect.Request[v1.UpdateUserHabitsRequest]) (*connect.Response[v1.UserHabits], error)
+	// ResetUserHabits forgets learned habits and corrections of the current user.
+	ResetUserHabits(context.Context, *connect.Request[v1.ResetUserHabitsRequest]) (*connect.Response[v1.UserHabits], error)
+	// ListAIMemories lists what the assistant remembers about the current user.
+	ListAIMemories(context.Context, *connect.Request[v1.ListAIMemoriesRequest]) (*connect.Response[v1.ListAIMemoriesResponse], error)
+	// DeleteAIMemory forgets a single memory.
+	DeleteAIMemory(context.Context, *connect.Request[v1.DeleteAIMemoryRequest]) (*connect.Response[emptypb.Empty], error)
+	// UpdateUserPreferences updates the AI preferences of the current user, including incognito mode
+	// and search logging. Disabling search logging deletes the searches logged so far.
+	UpdateUserPreferences(context.Context, *connect.Request[v1.UpdateUserPreferencesRequest]) (*connect.Response[v1.AIPreferences], error)
+	// GetRoutingReport summarizes how users corrected assistant routing, by predicted intent.
+	// Requires an admin. Used to tune the routing keyword tables.
+	GetRoutingReport(context.Context, *connect.Request[v1.GetRoutingReportRequest]) (*connect.Response[v1.RoutingReport], error)
+	// ForgetEverything deletes all memories, preferences, habits, conversation summaries and search logs
+	// of the current user. Incognito mode and the search log setting are kept.
+	ForgetEverything(context.Context, *connect.Request[v1.ForgetEverythingRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewAIServiceClient constructs a client for the memos.api.v1.AIService service. By default, it
@@ -282,6 +381,126 @@ func NewAIServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...c
 			connect.WithSchema(aIServiceMethods.ByName("ClearConversationMessages")),
 			connect.WithClientOptions(opts...),
 		),
+		saveConversationAsMemo: connect.NewClient[v1.SaveConversationAsMemoRequest, v1.SaveAsMemoResponse](
+			httpClient,
+			baseURL+AIServiceSaveConversationAsMemoProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("SaveConversationAsMemo")),
+			connect.WithClientOptions(opts...),
+		),
+		saveMessageAsMemo: connect.NewClient[v1.SaveMessageAsMemoRequest, v1.SaveAsMemoResponse](
+			httpClient,
+			baseURL+AIServiceSaveMessageAsMemoProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("SaveMessageAsMemo")),
+			connect.WithClientOptions(opts...),
+		),
+		captureURL: connect.NewClient[v1.CaptureURLRequest, v1.CaptureURLResponse](
+			httpClient,
+			baseURL+AIServiceCaptureURLProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("CaptureURL")),
+			connect.WithClientOptions(opts...),
+		),
+		listDigests: connect.NewClient[v1.ListDigestsRequest, v1.ListDigestsResponse](
+			httpClient,
+			baseURL+AIServiceListDigestsProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("ListDigests")),
+			connect.WithClientOptions(opts...),
+		),
+		updateDigests: connect.NewClient[v1.UpdateDigestsRequest, v1.ListDigestsResponse](
+			httpClient,
+			baseURL+AIServiceUpdateDigestsProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("UpdateDigests")),
+			connect.WithClientOptions(opts...),
+		),
+		runDigest: connect.NewClient[v1.RunDigestRequest, v1.RunDigestResponse](
+			httpClient,
+			baseURL+AIServiceRunDigestProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("RunDigest")),
+			connect.WithClientOptions(opts...),
+		),
+		listSearchSynonyms: connect.NewClient[v1.ListSearchSynonymsRequest, v1.ListSearchSynonymsResponse](
+			httpClient,
+			baseURL+AIServiceListSearchSynonymsProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("ListSearchSynonyms")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSearchSynonyms: connect.NewClient[v1.UpdateSearchSynonymsRequest, v1.ListSearchSynonymsResponse](
+			httpClient,
+			baseURL+AIServiceUpdateSearchSynonymsProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("UpdateSearchSynonyms")),
+			connect.WithClientOptions(opts...),
+		),
+		recordSearchClick: connect.NewClient[v1.RecordSearchClickRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AIServiceRecordSearchClickProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("RecordSearchClick")),
+			connect.WithClientOptions(opts...),
+		),
+		getSearchInsights: connect.NewClient[v1.GetSearchInsightsRequest, v1.SearchInsights](
+			httpClient,
+			baseURL+AIServiceGetSearchInsightsProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("GetSearchInsights")),
+			connect.WithClientOptions(opts...),
+		),
+		getSuggestions: connect.NewClient[v1.GetSuggestionsRequest, v1.GetSuggestionsResponse](
+			httpClient,
+			baseURL+AIServiceGetSuggestionsProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("GetSuggestions")),
+			connect.WithClientOptions(opts...),
+		),
+		recordSuggestionFeedback: connect.NewClient[v1.RecordSuggestionFeedbackRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AIServiceRecordSuggestionFeedbackProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("RecordSuggestionFeedback")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserHabits: connect.NewClient[v1.GetUserHabitsRequest, v1.UserHabits](
+			httpClient,
+			baseURL+AIServiceGetUserHabitsProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("GetUserHabits")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUserHabits: connect.NewClient[v1.UpdateUserHabitsRequest, v1.UserHabits](
+			httpClient,
+			baseURL+AIServiceUpdateUserHabitsProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("UpdateUserHabits")),
+			connect.WithClientOptions(opts...),
+		),
+		resetUserHabits: connect.NewClient[v1.ResetUserHabitsRequest, v1.UserHabits](
+			httpClient,
+			baseURL+AIServiceResetUserHabitsProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("ResetUserHabits")),
+			connect.WithClientOptions(opts...),
+		),
+		listAIMemories: connect.NewClient[v1.ListAIMemoriesRequest, v1.ListAIMemoriesResponse](
+			httpClient,
+			baseURL+AIServiceListAIMemoriesProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("ListAIMemories")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAIMemory: connect.NewClient[v1.DeleteAIMemoryRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AIServiceDeleteAIMemoryProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("DeleteAIMemory")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUserPreferences: connect.NewClient[v1.UpdateUserPreferencesRequest, v1.AIPreferences](
+			httpClient,
+			baseURL+AIServiceUpdateUserPreferencesProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("UpdateUserPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		getRoutingReport: connect.NewClient[v1.GetRoutingReportRequest, v1.RoutingReport](
+			httpClient,
+			baseURL+AIServiceGetRoutingReportProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("GetRoutingReport")),
+			connect.WithClientOptions(opts...),
+		),
+		forgetEverything: connect.NewClient[v1.ForgetEverythingRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AIServiceForgetEverythingProcedure,
+			connect.WithSchema(aIServiceMethods.ByName("ForgetEverything")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -308,6 +527,26 @@ type aIServiceClient struct {
 	addContextSeparator       *connect.Client[v1.AddContextSeparatorRequest, emptypb.Empty]
 	listMessages              *connect.Client[v1.ListMessagesRequest, v1.ListMessagesResponse]
 	clearConversationMessages *connect.Client[v1.ClearConversationMessagesRequest, emptypb.Empty]
+	saveConversationAsMemo    *connect.Client[v1.SaveConversationAsMemoRequest, v1.SaveAsMemoResponse]
+	saveMessageAsMemo         *connect.Client[v1.SaveMessageAsMemoRequest, v1.SaveAsMemoResponse]
+	captureURL                *connect.Client[v1.CaptureURLRequest, v1.CaptureURLResponse]
+	listDigests               *connect.Client[v1.ListDigestsRequest, v1.ListDigestsResponse]
+	updateDigests             *connect.Client[v1.UpdateDigestsRequest, v1.ListDigestsResponse]
+	runDigest                 *connect.Client[v1.RunDigestRequest, v1.RunDigestResponse]
+	listSearchSynonyms        *connect.Client[v1.ListSearchSynonymsRequest, v1.ListSearchSynonymsResponse]
+	updateSearchSynonyms      *connect.Client[v1.UpdateSearchSynonymsRequest, v1.ListSearchSynonymsResponse]
+	recordSearchClick         *connect.Client[v1.RecordSearchClickRequest, emptypb.Empty]
+	getSearchInsights         *connect.Client[v1.GetSearchInsightsRequest, v1.SearchInsights]
+	getSuggestions            *connect.Client[v1.GetSuggestionsRequest, v1.GetSuggestionsResponse]
+	recordSuggestionFeedback  *connect.Client[v1.RecordSuggestionFeedbackRequest, emptypb.Empty]
+	getUserHabits             *connect.Client[v1.GetUserHabitsRequest, v1.UserHabits]
+	updateUserHabits          *connect.Client[v1.UpdateUserHabitsRequest, v1.UserHabits]
+	resetUserHabits           *connect.Client[v1.ResetUserHabitsRequest, v1.UserHabits]
+	listAIMemories            *connect.Client[v1.ListAIMemoriesRequest, v1.ListAIMemoriesResponse]
+	deleteAIMemory            *connect.Client[v1.DeleteAIMemoryRequest, emptypb.Empty]
+	updateUserPreferences     *connect.Client[v1.UpdateUserPreferencesRequest, v1.AIPreferences]
+	getRoutingReport          *connect.Client[v1.GetRoutingReportRequest, v1.RoutingReport]
+	forgetEverything          *connect.Client[v1.ForgetEverythingRequest, emptypb.Empty]
 }
 
 // SemanticSearch calls memos.api.v1.AIService.SemanticSearch.
@@ -415,6 +654,106 @@ func (c *aIServiceClient) ClearConversationMessages(ctx context.Context, req *co
 	return c.clearConversationMessages.CallUnary(ctx, req)
 }
 
+// SaveConversationAsMemo calls memos.api.v1.AIService.SaveConversationAsMemo.
+func (c *aIServiceClient) SaveConversationAsMemo(ctx context.Context, req *connect.Request[v1.SaveConversationAsMemoRequest]) (*connect.Response[v1.SaveAsMemoResponse], error) {
+	return c.saveConversationAsMemo.CallUnary(ctx, req)
+}
+
+// SaveMessageAsMemo calls memos.api.v1.AIService.SaveMessageAsMemo.
+func (c *aIServiceClient) SaveMessageAsMemo(ctx context.Context, req *connect.Request[v1.SaveMessageAsMemoRequest]) (*connect.Response[v1.SaveAsMemoResponse], error) {
+	return c.saveMessageAsMemo.CallUnary(ctx, req)
+}
+
+// CaptureURL calls memos.api.v1.AIService.CaptureURL.
+func (c *aIServiceClient) CaptureURL(ctx context.Context, req *connect.Request[v1.CaptureURLRequest]) (*connect.Response[v1.CaptureURLResponse], error) {
+	return c.captureURL.CallUnary(ctx, req)
+}
+
+// ListDigests calls memos.api.v1.AIService.ListDigests.
+func (c *aIServiceClient) ListDigests(ctx context.Context, req *connect.Request[v1.ListDigestsRequest]) (*connect.Response[v1.ListDigestsResponse], error) {
+	return c.listDigests.CallUnary(ctx, req)
+}
+
+// UpdateDigests calls memos.api.v1.AIService.UpdateDigests.
+func (c *aIServiceClient) UpdateDigests(ctx context.Context, req *connect.Request[v1.UpdateDigestsRequest]) (*connect.Response[v1.ListDigestsResponse], error) {
+	return c.updateDigests.CallUnary(ctx, req)
+}
+
+// RunDigest calls memos.api.v1.AIService.RunDigest.
+func (c *aIServiceClient) RunDigest(ctx context.Context, req *connect.Request[v1.RunDigestRequest]) (*connect.Response[v1.RunDigestResponse], error) {
+	return c.runDigest.CallUnary(ctx, req)
+}
+
+// ListSearchSynonyms calls memos.api.v1.AIService.ListSearchSynonyms.
+func (c *aIServiceClient) ListSearchSynonyms(ctx context.Context, req *connect.Request[v1.ListSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error) {
+	return c.listSearchSynonyms.CallUnary(ctx, req)
+}
+
+// UpdateSearchSynonyms calls memos.api.v1.AIService.UpdateSearchSynonyms.
+func (c *aIServiceClient) UpdateSearchSynonyms(ctx context.Context, req *connect.Request[v1.UpdateSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error) {
+	return c.updateSearchSynonyms.CallUnary(ctx, req)
+}
+
+// RecordSearchClick calls memos.api.v1.AIService.RecordSearchClick.
+func (c *aIServiceClient) RecordSearchClick(ctx context.Context, req *connect.Request[v1.RecordSearchClickRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.recordSearchClick.CallUnary(ctx, req)
+}
+
+// GetSearchInsights calls memos.api.v1.AIService.GetSearchInsights.
+func (c *aIServiceClient) GetSearchInsights(ctx context.Context, req *connect.Request[v1.GetSearchInsightsRequest]) (*connect.Response[v1.SearchInsights], error) {
+	return c.getSearchInsights.CallUnary(ctx, req)
+}
+
+// GetSuggestions calls memos.api.v1.AIService.GetSuggestions.
+func (c *aIServiceClient) GetSuggestions(ctx context.Context, req *connect.Request[v1.GetSuggestionsRequest]) (*connect.Response[v1.GetSuggestionsResponse], error) {
+	return c.getSuggestions.CallUnary(ctx, req)
+}
+
+// RecordSuggestionFeedback calls memos.api.v1.AIService.RecordSuggestionFeedback.
+func (c *aIServiceClient) RecordSuggestionFeedback(ctx context.Context, req *connect.Request[v1.RecordSuggestionFeedbackRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.recordSuggestionFeedback.CallUnary(ctx, req)
+}
+
+// GetUserHabits calls memos.api.v1.AIService.GetUserHabits.
+func (c *aIServiceClient) GetUserHabits(ctx context.Context, req *connect.Request[v1.GetUserHabitsRequest]) (*connect.Response[v1.UserHabits], error) {
+	return c.getUserHabits.CallUnary(ctx, req)
+}
+
+// UpdateUserHabits calls memos.api.v1.AIService.UpdateUserHabits.
+func (c *aIServiceClient) UpdateUserHabits(ctx context.Context, req *connect.Request[v1.UpdateUserHabitsRequest]) (*connect.Response[v1.UserHabits], error) {
+	return c.updateUserHabits.CallUnary(ctx, req)
+}
+
+// ResetUserHabits calls memos.api.v1.AIService.ResetUserHabits.
+func (c *aIServiceClient) ResetUserHabits(ctx context.Context, req *connect.Request[v1.ResetUserHabitsRequest]) (*connect.Response[v1.UserHabits], error) {
+	return c.resetUserHabits.CallUnary(ctx, req)
+}
+
+// ListAIMemories calls memos.api.v1.AIService.ListAIMemories.
+func (c *aIServiceClient) ListAIMemories(ctx context.Context, req *connect.Request[v1.ListAIMemoriesRequest]) (*connect.Response[v1.ListAIMemoriesResponse], error) {
+	return c.listAIMemories.CallUnary(ctx, req)
+}
+
+// DeleteAIMemory calls memos.api.v1.AIService.DeleteAIMemory.
+func (c *aIServiceClient) DeleteAIMemory(ctx context.Context, req *connect.Request[v1.DeleteAIMemoryRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteAIMemory.CallUnary(ctx, req)
+}
+
+// UpdateUserPreferences calls memos.api.v1.AIService.UpdateUserPreferences.
+func (c *aIServiceClient) UpdateUserPreferences(ctx context.Context, req *connect.Request[v1.UpdateUserPreferencesRequest]) (*connect.Response[v1.AIPreferences], error) {
+	return c.updateUserPreferences.CallUnary(ctx, req)
+}
+
+// GetRoutingReport calls memos.api.v1.AIService.GetRoutingReport.
+func (c *aIServiceClient) GetRoutingReport(ctx context.Context, req *connect.Request[v1.GetRoutingReportRequest]) (*connect.Response[v1.RoutingReport], error) {
+	return c.getRoutingReport.CallUnary(ctx, req)
+}
+
+// ForgetEverything calls memos.api.v1.AIService.ForgetEverything.
+func (c *aIServiceClient) ForgetEverything(ctx context.Context, req *connect.Request[v1.ForgetEverythingRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.forgetEverything.CallUnary(ctx, req)
+}
+
 // AIServiceHandler is an implementation of the memos.api.v1.AIService service.
 type AIServiceHandler interface {
 	// SemanticSearch performs semantic search on memos.
@@ -459,6 +798,50 @@ type AIServiceHandler interface {
 	ListMessages(context.Context, *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error)
 	// ClearConversationMessages deletes all messages in a conversation.
 	ClearConversationMessages(context.Context, *connect.Request[v1.ClearConversationMessagesRequest]) (*connect.Response[emptypb.Empty], error)
+	// SaveConversationAsMemo renders a conversation (or selected messages) to markdown and saves it as a memo.
+	SaveConversationAsMemo(context.Context, *connect.Request[v1.SaveConversationAsMemoRequest]) (*connect.Response[v1.SaveAsMemoResponse], error)
+	// SaveMessageAsMemo saves a single assistant answer (with its question) as a memo.
+	SaveMessageAsMemo(context.Context, *connect.Request[v1.SaveMessageAsMemoRequest]) (*connect.Response[v1.SaveAsMemoResponse], error)
+	// CaptureURL fetches a web page and saves its article, summary and tags as a memo.
+	CaptureURL(context.Context, *connect.Request[v1.CaptureURLRequest]) (*connect.Response[v1.CaptureURLResponse], error)
+	// ListDigests returns the scheduled AI digests of the current user.
+	ListDigests(context.Context, *connect.Request[v1.ListDigestsRequest]) (*connect.Response[v1.ListDigestsResponse], error)
+	// UpdateDigests replaces the scheduled AI digests of the current user.
+	UpdateDigests(context.Context, *connect.Request[v1.UpdateDigestsRequest]) (*connect.Response[v1.ListDigestsResponse], error)
+	// RunDigest generates a digest immediately, regardless of its schedule.
+	RunDigest(context.Context, *connect.Request[v1.RunDigestRequest]) (*connect.Response[v1.RunDigestResponse], error)
+	// ListSearchSynonyms returns the search synonyms of the current user.
+	ListSearchSynonyms(context.Context, *connect.Request[v1.ListSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error)
+	// UpdateSearchSynonyms replaces the search synonyms of the current user.
+	UpdateSearchSynonyms(context.Context, *connect.Request[v1.UpdateSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error)
+	// RecordSearchClick records that a memo was opened from the results of a logged search.
+	RecordSearchClick(context.Context, *connect.Request[v1.RecordSearchClickRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetSearchInsights summarizes the logged searches of the current user, or of all users for an admin:
+	// top, zero-result and slow queries, and memos that keyword or semantic search cannot find well.
+	GetSearchInsights(context.Context, *connect.Request[v1.GetSearchInsightsRequest]) (*connect.Response[v1.SearchInsights], error)
+	// GetSuggestions returns ranked, explainable next actions for the current user.
+	GetSuggestions(context.Context, *connect.Request[v1.GetSuggestionsRequest]) (*connect.Response[v1.GetSuggestionsResponse], error)
+	// RecordSuggestionFeedback records that a suggestion was accepted or dismissed.
+	RecordSuggestionFeedback(context.Context, *connect.Request[v1.RecordSuggestionFeedbackRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserHabits returns the habits learned for the current user.
+	GetUserHabits(context.Context, *connect.Request[v1.GetUserHabitsRequest]) (*connect.Response[v1.UserHabits], error)
+	// UpdateUserHabits corrects learned habits. Corrected fields are no longer overwritten by learning.
+	UpdateUserHabits(context.Context, *connect.Request[v1.UpdateUserHabitsRequest]) (*connect.Response[v1.UserHabits], error)
+	// ResetUserHabits forgets learned habits and corrections of the current user.
+	ResetUserHabits(context.Context, *connect.Request[v1.ResetUserHabitsRequest]) (*connect.Response[v1.UserHabits], error)
+	// ListAIMemories lists what the assistant remembers about the current user.
+	ListAIMemories(context.Context, *connect.Request[v1.ListAIMemoriesRequest]) (*connect.Response[v1.ListAIMemoriesResponse], error)
+	// DeleteAIMemory forgets a single memory.
+	DeleteAIMemory(context.Context, *connect.Request[v1.DeleteAIMemoryRequest]) (*connect.Response[emptypb.Empty], error)
+	// UpdateUserPreferences updates the AI preferences of the current user, including incognito mode
+	// and search logging. Disabling search logging deletes the searches logged so far.
+	UpdateUserPreferences(context.Context, *connect.Request[v1.UpdateUserPreferencesRequest]) (*connect.Response[v1.AIPreferences], error)
+	// GetRoutingReport summarizes how users corrected assistant routing, by predicted intent.
+	// Requires an admin. Used to tune the routing keyword tables.
+	GetRoutingReport(context.Context, *connect.Request[v1.GetRoutingReportRequest]) (*connect.Response[v1.RoutingReport], error)
+	// ForgetEverything deletes all memories, preferences, habits, conversation summaries and search logs
+	// of the current user. Incognito mode and the search log setting are kept.
+	ForgetEverything(context.Context, *connect.Request[v1.ForgetEverythingRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewAIServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -594,6 +977,126 @@ func NewAIServiceHandler(svc AIServiceHandler, opts ...connect.HandlerOption) (s
 		connect.WithSchema(aIServiceMethods.ByName("ClearConversationMessages")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aIServiceSaveConversationAsMemoHandler := connect.NewUnaryHandler(
+		AIServiceSaveConversationAsMemoProcedure,
+		svc.SaveConversationAsMemo,
+		connect.WithSchema(aIServiceMethods.ByName("SaveConversationAsMemo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceSaveMessageAsMemoHandler := connect.NewUnaryHandler(
+		AIServiceSaveMessageAsMemoProcedure,
+		svc.SaveMessageAsMemo,
+		connect.WithSchema(aIServiceMethods.ByName("SaveMessageAsMemo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceCaptureURLHandler := connect.NewUnaryHandler(
+		AIServiceCaptureURLProcedure,
+		svc.CaptureURL,
+		connect.WithSchema(aIServiceMethods.ByName("CaptureURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceListDigestsHandler := connect.NewUnaryHandler(
+		AIServiceListDigestsProcedure,
+		svc.ListDigests,
+		connect.WithSchema(aIServiceMethods.ByName("ListDigests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceUpdateDigestsHandler := connect.NewUnaryHandler(
+		AIServiceUpdateDigestsProcedure,
+		svc.UpdateDigests,
+		connect.WithSchema(aIServiceMethods.ByName("UpdateDigests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceRunDigestHandler := connect.NewUnaryHandler(
+		AIServiceRunDigestProcedure,
+		svc.RunDigest,
+		connect.WithSchema(aIServiceMethods.ByName("RunDigest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceListSearchSynonymsHandler := connect.NewUnaryHandler(
+		AIServiceListSearchSynonymsProcedure,
+		svc.ListSearchSynonyms,
+		connect.WithSchema(aIServiceMethods.ByName("ListSearchSynonyms")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceUpdateSearchSynonymsHandler := connect.NewUnaryHandler(
+		AIServiceUpdateSearchSynonymsProcedure,
+		svc.UpdateSearchSynonyms,
+		connect.WithSchema(aIServiceMethods.ByName("UpdateSearchSynonyms")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceRecordSearchClickHandler := connect.NewUnaryHandler(
+		AIServiceRecordSearchClickProcedure,
+		svc.RecordSearchClick,
+		connect.WithSchema(aIServiceMethods.ByName("RecordSearchClick")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceGetSearchInsightsHandler := connect.NewUnaryHandler(
+		AIServiceGetSearchInsightsProcedure,
+		svc.GetSearchInsights,
+		connect.WithSchema(aIServiceMethods.ByName("GetSearchInsights")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceGetSuggestionsHandler := connect.NewUnaryHandler(
+		AIServiceGetSuggestionsProcedure,
+		svc.GetSuggestions,
+		connect.WithSchema(aIServiceMethods.ByName("GetSuggestions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceRecordSuggestionFeedbackHandler := connect.NewUnaryHandler(
+		AIServiceRecordSuggestionFeedbackProcedure,
+		svc.RecordSuggestionFeedback,
+		connect.WithSchema(aIServiceMethods.ByName("RecordSuggestionFeedback")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceGetUserHabitsHandler := connect.NewUnaryHandler(
+		AIServiceGetUserHabitsProcedure,
+		svc.GetUserHabits,
+		connect.WithSchema(aIServiceMethods.ByName("GetUserHabits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceUpdateUserHabitsHandler := connect.NewUnaryHandler(
+		AIServiceUpdateUserHabitsProcedure,
+		svc.UpdateUserHabits,
+		connect.WithSchema(aIServiceMethods.ByName("UpdateUserHabits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceResetUserHabitsHandler := connect.NewUnaryHandler(
+		AIServiceResetUserHabitsProcedure,
+		svc.ResetUserHabits,
+		connect.WithSchema(aIServiceMethods.ByName("ResetUserHabits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceListAIMemoriesHandler := connect.NewUnaryHandler(
+		AIServiceListAIMemoriesProcedure,
+		svc.ListAIMemories,
+		connect.WithSchema(aIServiceMethods.ByName("ListAIMemories")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceDeleteAIMemoryHandler := connect.NewUnaryHandler(
+		AIServiceDeleteAIMemoryProcedure,
+		svc.DeleteAIMemory,
+		connect.WithSchema(aIServiceMethods.ByName("DeleteAIMemory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceUpdateUserPreferencesHandler := connect.NewUnaryHandler(
+		AIServiceUpdateUserPreferencesProcedure,
+		svc.UpdateUserPreferences,
+		connect.WithSchema(aIServiceMethods.ByName("UpdateUserPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceGetRoutingReportHandler := connect.NewUnaryHandler(
+		AIServiceGetRoutingReportProcedure,
+		svc.GetRoutingReport,
+		connect.WithSchema(aIServiceMethods.ByName("GetRoutingReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIServiceForgetEverythingHandler := connect.NewUnaryHandler(
+		AIServiceForgetEverythingProcedure,
+		svc.ForgetEverything,
+		connect.WithSchema(aIServiceMethods.ByName("ForgetEverything")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.AIService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AIServiceSemanticSearchProcedure:
@@ -638,6 +1141,46 @@ func NewAIServiceHandler(svc AIServiceHandler, opts ...connect.HandlerOption) (s
 			aIServiceListMessagesHandler.ServeHTTP(w, r)
 		case AIServiceClearConversationMessagesProcedure:
 			aIServiceClearConversationMessagesHandler.ServeHTTP(w, r)
+		case AIServiceSaveConversationAsMemoProcedure:
+			aIServiceSaveConversationAsMemoHandler.ServeHTTP(w, r)
+		case AIServiceSaveMessageAsMemoProcedure:
+			aIServiceSaveMessageAsMemoHandler.ServeHTTP(w, r)
+		case AIServiceCaptureURLProcedure:
+			aIServiceCaptureURLHandler.ServeHTTP(w, r)
+		case AIServiceListDigestsProcedure:
+			aIServiceListDigestsHandler.ServeHTTP(w, r)
+		case AIServiceUpdateDigestsProcedure:
+			aIServiceUpdateDigestsHandler.ServeHTTP(w, r)
+		case AIServiceRunDigestProcedure:
+			aIServiceRunDigestHandler.ServeHTTP(w, r)
+		case AIServiceListSearchSynonymsProcedure:
+			aIServiceListSearchSynonymsHandler.ServeHTTP(w, r)
+		case AIServiceUpdateSearchSynonymsProcedure:
+			aIServiceUpdateSearchSynonymsHandler.ServeHTTP(w, r)
+		case AIServiceRecordSearchClickProcedure:
+			aIServiceRecordSearchClickHandler.ServeHTTP(w, r)
+		case AIServiceGetSearchInsightsProcedure:
+			aIServiceGetSearchInsightsHandler.ServeHTTP(w, r)
+		case AIServiceGetSuggestionsProcedure:
+			aIServiceGetSuggestionsHandler.ServeHTTP(w, r)
+		case AIServiceRecordSuggestionFeedbackProcedure:
+			aIServiceRecordSuggestionFeedbackHandler.ServeHTTP(w, r)
+		case AIServiceGetUserHabitsProcedure:
+			aIServiceGetUserHabitsHandler.ServeHTTP(w, r)
+		case AIServiceUpdateUserHabitsProcedure:
+			aIServiceUpdateUserHabitsHandler.ServeHTTP(w, r)
+		case AIServiceResetUserHabitsProcedure:
+			aIServiceResetUserHabitsHandler.ServeHTTP(w, r)
+		case AIServiceListAIMemoriesProcedure:
+			aIServiceListAIMemoriesHandler.ServeHTTP(w, r)
+		case AIServiceDeleteAIMemoryProcedure:
+			aIServiceDeleteAIMemoryHandler.ServeHTTP(w, r)
+		case AIServiceUpdateUserPreferencesProcedure:
+			aIServiceUpdateUserPreferencesHandler.ServeHTTP(w, r)
+		case AIServiceGetRoutingReportProcedure:
+			aIServiceGetRoutingReportHandler.ServeHTTP(w, r)
+		case AIServiceForgetEverythingProcedure:
+			aIServiceForgetEverythingHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -731,6 +1274,86 @@ func (UnimplementedAIServiceHandler) ClearConversationMessages(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.ClearConversationMessages is not implemented"))
 }
 
+func (UnimplementedAIServiceHandler) SaveConversationAsMemo(context.Context, *connect.Request[v1.SaveConversationAsMemoRequest]) (*connect.Response[v1.SaveAsMemoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.SaveConversationAsMemo is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) SaveMessageAsMemo(context.Context, *connect.Request[v1.SaveMessageAsMemoRequest]) (*connect.Response[v1.SaveAsMemoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.SaveMessageAsMemo is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) CaptureURL(context.Context, *connect.Request[v1.CaptureURLRequest]) (*connect.Response[v1.CaptureURLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.CaptureURL is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) ListDigests(context.Context, *connect.Request[v1.ListDigestsRequest]) (*connect.Response[v1.ListDigestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.ListDigests is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) UpdateDigests(context.Context, *connect.Request[v1.UpdateDigestsRequest]) (*connect.Response[v1.ListDigestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.UpdateDigests is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) RunDigest(context.Context, *connect.Request[v1.RunDigestRequest]) (*connect.Response[v1.RunDigestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.RunDigest is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) ListSearchSynonyms(context.Context, *connect.Request[v1.ListSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.ListSearchSynonyms is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) UpdateSearchSynonyms(context.Context, *connect.Request[v1.UpdateSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.UpdateSearchSynonyms is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) RecordSearchClick(context.Context, *connect.Request[v1.RecordSearchClickRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.RecordSearchClick is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) GetSearchInsights(context.Context, *connect.Request[v1.GetSearchInsightsRequest]) (*connect.Response[v1.SearchInsights], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.GetSearchInsights is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) GetSuggestions(context.Context, *connect.Request[v1.GetSuggestionsRequest]) (*connect.Response[v1.GetSuggestionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.GetSuggestions is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) RecordSuggestionFeedback(context.Context, *connect.Request[v1.RecordSuggestionFeedbackRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.RecordSuggestionFeedback is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) GetUserHabits(context.Context, *connect.Request[v1.GetUserHabitsRequest]) (*connect.Response[v1.UserHabits], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.GetUserHabits is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) UpdateUserHabits(context.Context, *connect.Request[v1.UpdateUserHabitsRequest]) (*connect.Response[v1.UserHabits], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.UpdateUserHabits is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) ResetUserHabits(context.Context, *connect.Request[v1.ResetUserHabitsRequest]) (*connect.Response[v1.UserHabits], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.ResetUserHabits is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) ListAIMemories(context.Context, *connect.Request[v1.ListAIMemoriesRequest]) (*connect.Response[v1.ListAIMemoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.ListAIMemories is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) DeleteAIMemory(context.Context, *connect.Request[v1.DeleteAIMemoryRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.DeleteAIMemory is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) UpdateUserPreferences(context.Context, *connect.Request[v1.UpdateUserPreferencesRequest]) (*connect.Response[v1.AIPreferences], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.UpdateUserPreferences is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) GetRoutingReport(context.Context, *connect.Request[v1.GetRoutingReportRequest]) (*connect.Response[v1.RoutingReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.GetRoutingReport is not implemented"))
+}
+
+func (UnimplementedAIServiceHandler) ForgetEverything(context.Context, *connect.Request[v1.ForgetEverythingRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AIService.ForgetEverything is not implemented"))
+}
+
 // ScheduleAgentServiceClient is a client for the memos.api.v1.ScheduleAgentService service.
 type ScheduleAgentServiceClient interface {
 	// Chat handles non-streaming schedule agent chat requests.
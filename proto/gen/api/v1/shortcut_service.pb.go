@@ -32,9 +32,20 @@ type Shortcut struct {
 	// The title of the shortcut.
 	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	// The filter expression for the shortcut.
-	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Whether to notify the user when new or updated memos match the filter,
+	// including other users' public and protected memos.
+	Notify bool `protobuf:"varint,4,opt,name=notify,proto3" json:"notify,omitempty"`
+	// The channels notifications are delivered to: "INBOX", "EMAIL" or "WEBHOOK".
+	// Defaults to the inbox.
+	NotifyChannels []string `protobuf:"bytes,5,rep,name=notify_channels,json=notifyChannels,proto3" json:"notify_channels,omitempty"`
+	// The minimum number of seconds between two notifications. Matches found
+	// during the quiet period are batched into the next notification.
+	QuietPeriodSeconds int64 `protobuf:"varint,6,opt,name=quiet_period_seconds,json=quietPeriodSeconds,proto3" json:"quiet_period_seconds,omitempty"`
+	// The timestamp of the last notification in seconds.
+	LastNotifiedTs int64 `protobuf:"varint,7,opt,name=last_notified_ts,json=lastNotifiedTs,proto3" json:"last_notified_ts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Shortcut) Reset() {
@@ -88,6 +99,34 @@ func (x *Shortcut) GetFilter() string {
 	return ""
 }
 
+func (x *Shortcut) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+func (x *Shortcut) GetNotifyChannels() []string {
+	if x != nil {
+		return x.NotifyChannels
+	}
+	return nil
+}
+
+func (x *Shortcut) GetQuietPeriodSeconds() int64 {
+	if x != nil {
+		return x.QuietPeriodSeconds
+	}
+	return 0
+}
+
+func (x *Shortcut) GetLastNotifiedTs() int64 {
+	if x != nil {
+		return x.LastNotifiedTs
+	}
+	return 0
+}
+
 type ListShortcutsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The parent resource where shortcuts are listed.
@@ -393,11 +432,15 @@ var File_api_v1_shortcut_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/shortcut_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xaf\x01\n" +
+	"\x1dapi/v1/shortcut_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xe0\x02\n" +
 	"\bShortcut\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12\x1b\n" +
-	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter:R\xeaAO\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12\x1b\n" +
+	"\x06notify\x18\x04 \x01(\bB\x03\xe0A\x01R\x06notify\x12,\n" +
+	"\x0fnotify_channels\x18\x05 \x03(\tB\x03\xe0A\x01R\x0enotifyChannels\x125\n" +
+	"\x14quiet_period_seconds\x18\x06 \x01(\x03B\x03\xe0A\x01R\x12quietPeriodSeconds\x12-\n" +
+	"\x10last_notified_ts\x18\a \x01(\x03B\x03\xe0A\x03R\x0elastNotifiedTs:R\xeaAO\n" +
 	"\x15memos.api.v1/Shortcut\x12!users/{user}/shortcuts/{shortcut}*\tshortcuts2\bshortcut\"M\n" +
 	"\x14ListShortcutsRequest\x125\n" +
 	"\x06parent\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\x12\x15memos.api.v1/ShortcutR\x06parent\"M\n" +
@@ -27,6 +27,10 @@ const (
 	InboxMessage_TYPE_UNSPECIFIED InboxMessage_Type = 0
 	// Memo comment notification.
 	InboxMessage_MEMO_COMMENT InboxMessage_Type = 1
+	// AI digest memo notification.
+	InboxMessage_AI_DIGEST InboxMessage_Type = 2
+	// New memos matching a shortcut with notifications enabled.
+	InboxMessage_SHORTCUT_ALERT InboxMessage_Type = 3
 )
 
 // Enum value maps for InboxMessage_Type.
@@ -34,10 +38,14 @@ var (
 	InboxMessage_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
+		2: "AI_DIGEST",
+		3: "SHORTCUT_ALERT",
 	}
 	InboxMessage_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"MEMO_COMMENT":     1,
+		"AI_DIGEST":        2,
+		"SHORTCUT_ALERT":   3,
 	}
 )
 
@@ -73,7 +81,13 @@ type InboxMessage struct {
 	// The type of the inbox message.
 	Type InboxMessage_Type `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
 	// The system-generated unique ID of related activity.
-	ActivityId    *int32 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	ActivityId *int32 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// The related memo ID, e.g. the memo created by an AI digest.
+	MemoId *int32 `protobuf:"varint,3,opt,name=memo_id,json=memoId,proto3,oneof" json:"memo_id,omitempty"`
+	// The memos matching a shortcut, for shortcut alerts.
+	MemoIds []int32 `protobuf:"varint,4,rep,packed,name=memo_ids,json=memoIds,proto3" json:"memo_ids,omitempty"`
+	// The ID of the matched shortcut, for shortcut alerts.
+	ShortcutId    string `protobuf:"bytes,5,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,19 +136,48 @@ func (x *InboxMessage) GetActivityId() int32 {
 	return 0
 }
 
+func (x *InboxMessage) GetMemoId() int32 {
+	if x != nil && x.MemoId != nil {
+		return *x.MemoId
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetMemoIds() []int32 {
+	if x != nil {
+		return x.MemoIds
+	}
+	return nil
+}
+
+func (x *InboxMessage) GetShortcutId() string {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return ""
+}
+
 var File_store_inbox_proto protoreflect.FileDescriptor
 
 const file_store_inbox_proto_rawDesc = "" +
 	"\n" +
-	"\x11store/inbox.proto\x12\vmemos.store\"\xa8\x01\n" +
+	"\x11store/inbox.proto\x12\vmemos.store\"\xb1\x02\n" +
 	"\fInboxMessage\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12$\n" +
 	"\vactivity_id\x18\x02 \x01(\x05H\x00R\n" +
-	"activityId\x88\x01\x01\".\n" +
+	"activityId\x88\x01\x01\x12\x1c\n" +
+	"\amemo_id\x18\x03 \x01(\x05H\x01R\x06memoId\x88\x01\x01\x12\x19\n" +
+	"\bmemo_ids\x18\x04 \x03(\x05R\amemoIds\x12\x1f\n" +
+	"\vshortcut_id\x18\x05 \x01(\tR\n" +
+	"shortcutId\"Q\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
-	"\fMEMO_COMMENT\x10\x01B\x0e\n" +
-	"\f_activity_idB\x98\x01\n" +
+	"\fMEMO_COMMENT\x10\x01\x12\r\n" +
+	"\tAI_DIGEST\x10\x02\x12\x12\n" +
+	"\x0eSHORTCUT_ALERT\x10\x03B\x0e\n" +
+	"\f_activity_idB\n" +
+	"\n" +
+	"\b_memo_idB\x98\x01\n" +
 	"\x0fcom.memos.storeB\n" +
 	"InboxProtoP\x01Z,github.com/hrygo/divinesense/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
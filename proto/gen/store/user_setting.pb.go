@@ -38,18 +38,24 @@ const (
 	UserSetting_PERSONAL_ACCESS_TOKENS UserSetting_Key = 7
 	// Review states for spaced repetition (P3-C002).
 	UserSetting_REVIEW_STATES UserSetting_Key = 8
+	// Scheduled AI digests of the user.
+	UserSetting_DIGESTS UserSetting_Key = 9
+	// Search synonyms of the user, used to expand search queries.
+	UserSetting_SEARCH_SYNONYMS UserSetting_Key = 10
 )
 
 // Enum value maps for UserSetting_Key.
 var (
 	UserSetting_Key_name = map[int32]string{
-		0: "KEY_UNSPECIFIED",
-		1: "GENERAL",
-		4: "SHORTCUTS",
-		5: "WEBHOOKS",
-		6: "REFRESH_TOKENS",
-		7: "PERSONAL_ACCESS_TOKENS",
-		8: "REVIEW_STATES",
+		0:  "KEY_UNSPECIFIED",
+		1:  "GENERAL",
+		4:  "SHORTCUTS",
+		5:  "WEBHOOKS",
+		6:  "REFRESH_TOKENS",
+		7:  "PERSONAL_ACCESS_TOKENS",
+		8:  "REVIEW_STATES",
+		9:  "DIGESTS",
+		10: "SEARCH_SYNONYMS",
 	}
 	UserSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED":        0,
@@ -59,6 +65,8 @@ var (
 		"REFRESH_TOKENS":         6,
 		"PERSONAL_ACCESS_TOKENS": 7,
 		"REVIEW_STATES":          8,
+		"DIGESTS":                9,
+		"SEARCH_SYNONYMS":        10,
 	}
 )
 
@@ -89,6 +97,167 @@ func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
 	return file_store_user_setting_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type ShortcutsUserSetting_Shortcut_Channel int32
+
+const (
+	ShortcutsUserSetting_Shortcut_CHANNEL_UNSPECIFIED ShortcutsUserSetting_Shortcut_Channel = 0
+	// Notify the user's inbox.
+	ShortcutsUserSetting_Shortcut_INBOX ShortcutsUserSetting_Shortcut_Channel = 1
+	// Send the matches to the user's email address.
+	ShortcutsUserSetting_Shortcut_EMAIL ShortcutsUserSetting_Shortcut_Channel = 2
+	// Post the matches to the user's webhooks.
+	ShortcutsUserSetting_Shortcut_WEBHOOK ShortcutsUserSetting_Shortcut_Channel = 3
+)
+
+// Enum value maps for ShortcutsUserSetting_Shortcut_Channel.
+var (
+	ShortcutsUserSetting_Shortcut_Channel_name = map[int32]string{
+		0: "CHANNEL_UNSPECIFIED",
+		1: "INBOX",
+		2: "EMAIL",
+		3: "WEBHOOK",
+	}
+	ShortcutsUserSetting_Shortcut_Channel_value = map[string]int32{
+		"CHANNEL_UNSPECIFIED": 0,
+		"INBOX":               1,
+		"EMAIL":               2,
+		"WEBHOOK":             3,
+	}
+)
+
+func (x ShortcutsUserSetting_Shortcut_Channel) Enum() *ShortcutsUserSetting_Shortcut_Channel {
+	p := new(ShortcutsUserSetting_Shortcut_Channel)
+	*p = x
+	return p
+}
+
+func (x ShortcutsUserSetting_Shortcut_Channel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShortcutsUserSetting_Shortcut_Channel) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (ShortcutsUserSetting_Shortcut_Channel) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[1]
+}
+
+func (x ShortcutsUserSetting_Shortcut_Channel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShortcutsUserSetting_Shortcut_Channel.Descriptor instead.
+func (ShortcutsUserSetting_Shortcut_Channel) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4, 0, 0}
+}
+
+type DigestsUserSetting_Digest_Kind int32
+
+const (
+	DigestsUserSetting_Digest_KIND_UNSPECIFIED DigestsUserSetting_Digest_Kind = 0
+	// Brief of the last day: new memos, today's schedules and due reviews.
+	DigestsUserSetting_Digest_DAILY_BRIEF DigestsUserSetting_Digest_Kind = 1
+	// Review of the last week: memos, completed and upcoming schedules, due reviews.
+	DigestsUserSetting_Digest_WEEKLY_REVIEW DigestsUserSetting_Digest_Kind = 2
+)
+
+// Enum value maps for DigestsUserSetting_Digest_Kind.
+var (
+	DigestsUserSetting_Digest_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "DAILY_BRIEF",
+		2: "WEEKLY_REVIEW",
+	}
+	DigestsUserSetting_Digest_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"DAILY_BRIEF":      1,
+		"WEEKLY_REVIEW":    2,
+	}
+)
+
+func (x DigestsUserSetting_Digest_Kind) Enum() *DigestsUserSetting_Digest_Kind {
+	p := new(DigestsUserSetting_Digest_Kind)
+	*p = x
+	return p
+}
+
+func (x DigestsUserSetting_Digest_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DigestsUserSetting_Digest_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (DigestsUserSetting_Digest_Kind) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[2]
+}
+
+func (x DigestsUserSetting_Digest_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DigestsUserSetting_Digest_Kind.Descriptor instead.
+func (DigestsUserSetting_Digest_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7, 0, 0}
+}
+
+type DigestsUserSetting_Digest_Channel int32
+
+const (
+	DigestsUserSetting_Digest_CHANNEL_UNSPECIFIED DigestsUserSetting_Digest_Channel = 0
+	// Notify the user's inbox.
+	DigestsUserSetting_Digest_INBOX DigestsUserSetting_Digest_Channel = 1
+	// Send the digest to the user's email address.
+	DigestsUserSetting_Digest_EMAIL DigestsUserSetting_Digest_Channel = 2
+	// Post the digest to the user's webhooks.
+	DigestsUserSetting_Digest_WEBHOOK DigestsUserSetting_Digest_Channel = 3
+)
+
+// Enum value maps for DigestsUserSetting_Digest_Channel.
+var (
+	DigestsUserSetting_Digest_Channel_name = map[int32]string{
+		0: "CHANNEL_UNSPECIFIED",
+		1: "INBOX",
+		2: "EMAIL",
+		3: "WEBHOOK",
+	}
+	DigestsUserSetting_Digest_Channel_value = map[string]int32{
+		"CHANNEL_UNSPECIFIED": 0,
+		"INBOX":               1,
+		"EMAIL":               2,
+		"WEBHOOK":             3,
+	}
+)
+
+func (x DigestsUserSetting_Digest_Channel) Enum() *DigestsUserSetting_Digest_Channel {
+	p := new(DigestsUserSetting_Digest_Channel)
+	*p = x
+	return p
+}
+
+func (x DigestsUserSetting_Digest_Channel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DigestsUserSetting_Digest_Channel) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[3].Descriptor()
+}
+
+func (DigestsUserSetting_Digest_Channel) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[3]
+}
+
+func (x DigestsUserSetting_Digest_Channel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DigestsUserSetting_Digest_Channel.Descriptor instead.
+func (DigestsUserSetting_Digest_Channel) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7, 0, 1}
+}
+
 type UserSetting struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -101,6 +270,8 @@ type UserSetting struct {
 	//	*UserSetting_RefreshTokens
 	//	*UserSetting_PersonalAccessTokens
 	//	*UserSetting_ReviewStates
+	//	*UserSetting_Digests
+	//	*UserSetting_SearchSynonyms
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -211,6 +382,24 @@ func (x *UserSetting) GetReviewStates() *ReviewStatesUserSetting {
 	return nil
 }
 
+func (x *UserSetting) GetDigests() *DigestsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Digests); ok {
+			return x.Digests
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetSearchSynonyms() *SearchSynonymsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_SearchSynonyms); ok {
+			return x.SearchSynonyms
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -239,6 +428,14 @@ type UserSetting_ReviewStates struct {
 	ReviewStates *ReviewStatesUserSetting `protobuf:"bytes,10,opt,name=review_states,json=reviewStates,proto3,oneof"`
 }
 
+type UserSetting_Digests struct {
+	Digests *DigestsUserSetting `protobuf:"bytes,11,opt,name=digests,proto3,oneof"`
+}
+
+type UserSetting_SearchSynonyms struct {
+	SearchSynonyms *SearchSynonymsUserSetting `protobuf:"bytes,12,opt,name=search_synonyms,json=searchSynonyms,proto3,oneof"`
+}
+
 func (*UserSetting_General) isUserSetting_Value() {}
 
 func (*UserSetting_Shortcuts) isUserSetting_Value() {}
@@ -251,6 +448,10 @@ func (*UserSetting_PersonalAccessTokens) isUserSetting_Value() {}
 
 func (*UserSetting_ReviewStates) isUserSetting_Value() {}
 
+func (*UserSetting_Digests) isUserSetting_Value() {}
+
+func (*UserSetting_SearchSynonyms) isUserSetting_Value() {}
+
 type GeneralUserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The user's locale.
@@ -537,6 +738,97 @@ func (x *ReviewStatesUserSetting) GetStates() []*ReviewStatesUserSetting_ReviewS
 	return nil
 }
 
+// DigestsUserSetting stores the scheduled AI digests of a user.
+type DigestsUserSetting struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Digests       []*DigestsUserSetting_Digest `protobuf:"bytes,1,rep,name=digests,proto3" json:"digests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestsUserSetting) Reset() {
+	*x = DigestsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestsUserSetting) ProtoMessage() {}
+
+func (x *DigestsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestsUserSetting.ProtoReflect.Descriptor instead.
+func (*DigestsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DigestsUserSetting) GetDigests() []*DigestsUserSetting_Digest {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+// SearchSynonymsUserSetting stores the user's own synonyms for search query expansion,
+// e.g. "k8s" and "kubernetes".
+type SearchSynonymsUserSetting struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Groups        []*SearchSynonymsUserSetting_Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSynonymsUserSetting) Reset() {
+	*x = SearchSynonymsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSynonymsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSynonymsUserSetting) ProtoMessage() {}
+
+func (x *SearchSynonymsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSynonymsUserSetting.ProtoReflect.Descriptor instead.
+func (*SearchSynonymsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchSynonymsUserSetting) GetGroups() []*SearchSynonymsUserSetting_Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 type RefreshTokensUserSetting_RefreshToken struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique identifier (matches 'tid' claim in JWT)
@@ -555,7 +847,7 @@ type RefreshTokensUserSetting_RefreshToken struct {
 
 func (x *RefreshTokensUserSetting_RefreshToken) Reset() {
 	*x = RefreshTokensUserSetting_RefreshToken{}
-	mi := &file_store_user_setting_proto_msgTypes[7]
+	mi := &file_store_user_setting_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +859,7 @@ func (x *RefreshTokensUserSetting_RefreshToken) String() string {
 func (*RefreshTokensUserSetting_RefreshToken) ProtoMessage() {}
 
 func (x *RefreshTokensUserSetting_RefreshToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[7]
+	mi := &file_store_user_setting_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +928,7 @@ type RefreshTokensUserSetting_ClientInfo struct {
 
 func (x *RefreshTokensUserSetting_ClientInfo) Reset() {
 	*x = RefreshTokensUserSetting_ClientInfo{}
-	mi := &file_store_user_setting_proto_msgTypes[8]
+	mi := &file_store_user_setting_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -648,7 +940,7 @@ func (x *RefreshTokensUserSetting_ClientInfo) String() string {
 func (*RefreshTokensUserSetting_ClientInfo) ProtoMessage() {}
 
 func (x *RefreshTokensUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[8]
+	mi := &file_store_user_setting_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,7 +1011,7 @@ type PersonalAccessTokensUserSetting_PersonalAccessToken struct {
 
 func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) Reset() {
 	*x = PersonalAccessTokensUserSetting_PersonalAccessToken{}
-	mi := &file_store_user_setting_proto_msgTypes[9]
+	mi := &file_store_user_setting_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -731,7 +1023,7 @@ func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) String() string {
 func (*PersonalAccessTokensUserSetting_PersonalAccessToken) ProtoMessage() {}
 
 func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[9]
+	mi := &file_store_user_setting_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,17 +1082,31 @@ func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) GetLastUsedAt() *t
 }
 
 type ShortcutsUserSetting_Shortcut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Filter string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Notify the user when new or updated memos match the filter.
+	Notify bool `protobuf:"varint,4,opt,name=notify,proto3" json:"notify,omitempty"`
+	// Channels match notifications are delivered to (empty: inbox).
+	NotifyChannels []ShortcutsUserSetting_Shortcut_Channel `protobuf:"varint,5,rep,packed,name=notify_channels,json=notifyChannels,proto3,enum=memos.store.ShortcutsUserSetting_Shortcut_Channel" json:"notify_channels,omitempty"`
+	// Minimum seconds between two notifications; matches in between are batched.
+	QuietPeriodSeconds int64 `protobuf:"varint,6,opt,name=quiet_period_seconds,json=quietPeriodSeconds,proto3" json:"quiet_period_seconds,omitempty"`
+	// Memos updated at or before this timestamp (Unix seconds) have been evaluated.
+	LastCheckedTs int64 `protobuf:"varint,7,opt,name=last_checked_ts,json=lastCheckedTs,proto3" json:"last_checked_ts,omitempty"`
+	// Last notification timestamp (Unix seconds).
+	LastNotifiedTs int64 `protobuf:"varint,8,opt,name=last_notified_ts,json=lastNotifiedTs,proto3" json:"last_notified_ts,omitempty"`
+	// Matched memos waiting for the quiet period to end.
+	PendingMemoIds []int32 `protobuf:"varint,9,rep,packed,name=pending_memo_ids,json=pendingMemoIds,proto3" json:"pending_memo_ids,omitempty"`
+	// Recently notified memos, so later edits do not notify again.
+	NotifiedMemoIds []int32 `protobuf:"varint,10,rep,packed,name=notified_memo_ids,json=notifiedMemoIds,proto3" json:"notified_memo_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ShortcutsUserSetting_Shortcut) Reset() {
 	*x = ShortcutsUserSetting_Shortcut{}
-	mi := &file_store_user_setting_proto_msgTypes[10]
+	mi := &file_store_user_setting_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -812,7 +1118,7 @@ func (x *ShortcutsUserSetting_Shortcut) String() string {
 func (*ShortcutsUserSetting_Shortcut) ProtoMessage() {}
 
 func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[10]
+	mi := &file_store_user_setting_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,6 +1155,55 @@ func (x *ShortcutsUserSetting_Shortcut) GetFilter() string {
 	return ""
 }
 
+func (x *ShortcutsUserSetting_Shortcut) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetNotifyChannels() []ShortcutsUserSetting_Shortcut_Channel {
+	if x != nil {
+		return x.NotifyChannels
+	}
+	return nil
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetQuietPeriodSeconds() int64 {
+	if x != nil {
+		return x.QuietPeriodSeconds
+	}
+	return 0
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetLastCheckedTs() int64 {
+	if x != nil {
+		return x.LastCheckedTs
+	}
+	return 0
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetLastNotifiedTs() int64 {
+	if x != nil {
+		return x.LastNotifiedTs
+	}
+	return 0
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetPendingMemoIds() []int32 {
+	if x != nil {
+		return x.PendingMemoIds
+	}
+	return nil
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetNotifiedMemoIds() []int32 {
+	if x != nil {
+		return x.NotifiedMemoIds
+	}
+	return nil
+}
+
 type WebhooksUserSetting_Webhook struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique identifier for the webhook
@@ -863,7 +1218,7 @@ type WebhooksUserSetting_Webhook struct {
 
 func (x *WebhooksUserSetting_Webhook) Reset() {
 	*x = WebhooksUserSetting_Webhook{}
-	mi := &file_store_user_setting_proto_msgTypes[11]
+	mi := &file_store_user_setting_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -875,7 +1230,7 @@ func (x *WebhooksUserSetting_Webhook) String() string {
 func (*WebhooksUserSetting_Webhook) ProtoMessage() {}
 
 func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[11]
+	mi := &file_store_user_setting_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,7 +1287,7 @@ type ReviewStatesUserSetting_ReviewState struct {
 
 func (x *ReviewStatesUserSetting_ReviewState) Reset() {
 	*x = ReviewStatesUserSetting_ReviewState{}
-	mi := &file_store_user_setting_proto_msgTypes[12]
+	mi := &file_store_user_setting_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -944,7 +1299,7 @@ func (x *ReviewStatesUserSetting_ReviewState) String() string {
 func (*ReviewStatesUserSetting_ReviewState) ProtoMessage() {}
 
 func (x *ReviewStatesUserSetting_ReviewState) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[12]
+	mi := &file_store_user_setting_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,11 +1357,186 @@ func (x *ReviewStatesUserSetting_ReviewState) GetIntervalDays() int32 {
 	return 0
 }
 
+type DigestsUserSetting_Digest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the digest
+	Id   string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind DigestsUserSetting_Digest_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=memos.store.DigestsUserSetting_Digest_Kind" json:"kind,omitempty"`
+	// Cron expression (5-field) evaluated in the digest timezone.
+	Cron string `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	// IANA timezone name, e.g. "Asia/Shanghai".
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Enabled  bool   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Only include memos with any of these tags (empty: all memos).
+	Tags             []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	IncludeMemos     bool     `protobuf:"varint,7,opt,name=include_memos,json=includeMemos,proto3" json:"include_memos,omitempty"`
+	IncludeSchedules bool     `protobuf:"varint,8,opt,name=include_schedules,json=includeSchedules,proto3" json:"include_schedules,omitempty"`
+	IncludeReviews   bool     `protobuf:"varint,9,opt,name=include_reviews,json=includeReviews,proto3" json:"include_reviews,omitempty"`
+	// Channels the digest is delivered to besides being saved as a memo.
+	Channels []DigestsUserSetting_Digest_Channel `protobuf:"varint,10,rep,packed,name=channels,proto3,enum=memos.store.DigestsUserSetting_Digest_Channel" json:"channels,omitempty"`
+	// Last run timestamp (Unix seconds)
+	LastRunTs     int64 `protobuf:"varint,11,opt,name=last_run_ts,json=lastRunTs,proto3" json:"last_run_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestsUserSetting_Digest) Reset() {
+	*x = DigestsUserSetting_Digest{}
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestsUserSetting_Digest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestsUserSetting_Digest) ProtoMessage() {}
+
+func (x *DigestsUserSetting_Digest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestsUserSetting_Digest.ProtoReflect.Descriptor instead.
+func (*DigestsUserSetting_Digest) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *DigestsUserSetting_Digest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DigestsUserSetting_Digest) GetKind() DigestsUserSetting_Digest_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return DigestsUserSetting_Digest_KIND_UNSPECIFIED
+}
+
+func (x *DigestsUserSetting_Digest) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *DigestsUserSetting_Digest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *DigestsUserSetting_Digest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DigestsUserSetting_Digest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *DigestsUserSetting_Digest) GetIncludeMemos() bool {
+	if x != nil {
+		return x.IncludeMemos
+	}
+	return false
+}
+
+func (x *DigestsUserSetting_Digest) GetIncludeSchedules() bool {
+	if x != nil {
+		return x.IncludeSchedules
+	}
+	return false
+}
+
+func (x *DigestsUserSetting_Digest) GetIncludeReviews() bool {
+	if x != nil {
+		return x.IncludeReviews
+	}
+	return false
+}
+
+func (x *DigestsUserSetting_Digest) GetChannels() []DigestsUserSetting_Digest_Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *DigestsUserSetting_Digest) GetLastRunTs() int64 {
+	if x != nil {
+		return x.LastRunTs
+	}
+	return 0
+}
+
+type SearchSynonymsUserSetting_Group struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Terms that mean the same to the user, matched case-insensitively.
+	Terms         []string `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSynonymsUserSetting_Group) Reset() {
+	*x = SearchSynonymsUserSetting_Group{}
+	mi := &file_store_user_setting_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSynonymsUserSetting_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSynonymsUserSetting_Group) ProtoMessage() {}
+
+func (x *SearchSynonymsUserSetting_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSynonymsUserSetting_Group.ProtoReflect.Descriptor instead.
+func (*SearchSynonymsUserSetting_Group) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *SearchSynonymsUserSetting_Group) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
 var File_store_user_setting_proto protoreflect.FileDescriptor
 
 const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x05\n" +
+	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xde\x06\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12.\n" +
 	"\x03key\x18\x02 \x01(\x0e2\x1c.memos.store.UserSetting.KeyR\x03key\x12;\n" +
@@ -1016,7 +1546,9 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\x0erefresh_tokens\x18\b \x01(\v2%.memos.store.RefreshTokensUserSettingH\x00R\rrefreshTokens\x12d\n" +
 	"\x16personal_access_tokens\x18\t \x01(\v2,.memos.store.PersonalAccessTokensUserSettingH\x00R\x14personalAccessTokens\x12K\n" +
 	"\rreview_states\x18\n" +
-	" \x01(\v2$.memos.store.ReviewStatesUserSettingH\x00R\freviewStates\"\x87\x01\n" +
+	" \x01(\v2$.memos.store.ReviewStatesUserSettingH\x00R\freviewStates\x12;\n" +
+	"\adigests\x18\v \x01(\v2\x1f.memos.store.DigestsUserSettingH\x00R\adigests\x12Q\n" +
+	"\x0fsearch_synonyms\x18\f \x01(\v2&.memos.store.SearchSynonymsUserSettingH\x00R\x0esearchSynonyms\"\xa9\x01\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\r\n" +
@@ -1024,7 +1556,10 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\bWEBHOOKS\x10\x05\x12\x12\n" +
 	"\x0eREFRESH_TOKENS\x10\x06\x12\x1a\n" +
 	"\x16PERSONAL_ACCESS_TOKENS\x10\a\x12\x11\n" +
-	"\rREVIEW_STATES\x10\bB\a\n" +
+	"\rREVIEW_STATES\x10\b\x12\v\n" +
+	"\aDIGESTS\x10\t\x12\x13\n" +
+	"\x0fSEARCH_SYNONYMS\x10\n" +
+	"B\a\n" +
 	"\x05value\"k\n" +
 	"\x12GeneralUserSetting\x12\x16\n" +
 	"\x06locale\x18\x01 \x01(\tR\x06locale\x12'\n" +
@@ -1063,13 +1598,26 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
 	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastUsedAt\"\xaa\x01\n" +
+	"lastUsedAt\"\xc1\x04\n" +
 	"\x14ShortcutsUserSetting\x12H\n" +
-	"\tshortcuts\x18\x01 \x03(\v2*.memos.store.ShortcutsUserSetting.ShortcutR\tshortcuts\x1aH\n" +
+	"\tshortcuts\x18\x01 \x03(\v2*.memos.store.ShortcutsUserSetting.ShortcutR\tshortcuts\x1a\xde\x03\n" +
 	"\bShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
-	"\x06filter\x18\x03 \x01(\tR\x06filter\"\x9e\x01\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x16\n" +
+	"\x06notify\x18\x04 \x01(\bR\x06notify\x12[\n" +
+	"\x0fnotify_channels\x18\x05 \x03(\x0e22.memos.store.ShortcutsUserSetting.Shortcut.ChannelR\x0enotifyChannels\x120\n" +
+	"\x14quiet_period_seconds\x18\x06 \x01(\x03R\x12quietPeriodSeconds\x12&\n" +
+	"\x0flast_checked_ts\x18\a \x01(\x03R\rlastCheckedTs\x12(\n" +
+	"\x10last_notified_ts\x18\b \x01(\x03R\x0elastNotifiedTs\x12(\n" +
+	"\x10pending_memo_ids\x18\t \x03(\x05R\x0ependingMemoIds\x12*\n" +
+	"\x11notified_memo_ids\x18\n" +
+	" \x03(\x05R\x0fnotifiedMemoIds\"E\n" +
+	"\aChannel\x12\x17\n" +
+	"\x13CHANNEL_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05INBOX\x10\x01\x12\t\n" +
+	"\x05EMAIL\x10\x02\x12\v\n" +
+	"\aWEBHOOK\x10\x03\"\x9e\x01\n" +
 	"\x13WebhooksUserSetting\x12D\n" +
 	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\x1aA\n" +
 	"\aWebhook\x12\x0e\n" +
@@ -1085,7 +1633,35 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\x0enext_review_ts\x18\x04 \x01(\x03R\fnextReviewTs\x12\x1f\n" +
 	"\vease_factor\x18\x05 \x01(\x01R\n" +
 	"easeFactor\x12#\n" +
-	"\rinterval_days\x18\x06 \x01(\x05R\fintervalDaysB\x9e\x01\n" +
+	"\rinterval_days\x18\x06 \x01(\x05R\fintervalDays\"\x80\x05\n" +
+	"\x12DigestsUserSetting\x12@\n" +
+	"\adigests\x18\x01 \x03(\v2&.memos.store.DigestsUserSetting.DigestR\adigests\x1a\xa7\x04\n" +
+	"\x06Digest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12?\n" +
+	"\x04kind\x18\x02 \x01(\x0e2+.memos.store.DigestsUserSetting.Digest.KindR\x04kind\x12\x12\n" +
+	"\x04cron\x18\x03 \x01(\tR\x04cron\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12#\n" +
+	"\rinclude_memos\x18\a \x01(\bR\fincludeMemos\x12+\n" +
+	"\x11include_schedules\x18\b \x01(\bR\x10includeSchedules\x12'\n" +
+	"\x0finclude_reviews\x18\t \x01(\bR\x0eincludeReviews\x12J\n" +
+	"\bchannels\x18\n" +
+	" \x03(\x0e2..memos.store.DigestsUserSetting.Digest.ChannelR\bchannels\x12\x1e\n" +
+	"\vlast_run_ts\x18\v \x01(\x03R\tlastRunTs\"@\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vDAILY_BRIEF\x10\x01\x12\x11\n" +
+	"\rWEEKLY_REVIEW\x10\x02\"E\n" +
+	"\aChannel\x12\x17\n" +
+	"\x13CHANNEL_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05INBOX\x10\x01\x12\t\n" +
+	"\x05EMAIL\x10\x02\x12\v\n" +
+	"\aWEBHOOK\x10\x03\"\x80\x01\n" +
+	"\x19SearchSynonymsUserSetting\x12D\n" +
+	"\x06groups\x18\x01 \x03(\v2,.memos.store.SearchSynonymsUserSetting.GroupR\x06groups\x1a\x1d\n" +
+	"\x05Group\x12\x14\n" +
+	"\x05terms\x18\x01 \x03(\tR\x05termsB\x9e\x01\n" +
 	"\x0fcom.memos.storeB\x10UserSettingProtoP\x01Z,github.com/hrygo/divinesense/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -1100,49 +1676,63 @@ func file_store_user_setting_proto_rawDescGZIP() []byte {
 	return file_store_user_setting_proto_rawDescData
 }
 
-var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_store_user_setting_proto_goTypes = []any{
 	(UserSetting_Key)(0),                                        // 0: memos.store.UserSetting.Key
-	(*UserSetting)(nil),                                         // 1: memos.store.UserSetting
-	(*GeneralUserSetting)(nil),                                  // 2: memos.store.GeneralUserSetting
-	(*RefreshTokensUserSetting)(nil),                            // 3: memos.store.RefreshTokensUserSetting
-	(*PersonalAccessTokensUserSetting)(nil),                     // 4: memos.store.PersonalAccessTokensUserSetting
-	(*ShortcutsUserSetting)(nil),                                // 5: memos.store.ShortcutsUserSetting
-	(*WebhooksUserSetting)(nil),                                 // 6: memos.store.WebhooksUserSetting
-	(*ReviewStatesUserSetting)(nil),                             // 7: memos.store.ReviewStatesUserSetting
-	(*RefreshTokensUserSetting_RefreshToken)(nil),               // 8: memos.store.RefreshTokensUserSetting.RefreshToken
-	(*RefreshTokensUserSetting_ClientInfo)(nil),                 // 9: memos.store.RefreshTokensUserSetting.ClientInfo
-	(*PersonalAccessTokensUserSetting_PersonalAccessToken)(nil), // 10: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
-	(*ShortcutsUserSetting_Shortcut)(nil),                       // 11: memos.store.ShortcutsUserSetting.Shortcut
-	(*WebhooksUserSetting_Webhook)(nil),                         // 12: memos.store.WebhooksUserSetting.Webhook
-	(*ReviewStatesUserSetting_ReviewState)(nil),                 // 13: memos.store.ReviewStatesUserSetting.ReviewState
-	(*timestamppb.Timestamp)(nil),                               // 14: google.protobuf.Timestamp
+	(ShortcutsUserSetting_Shortcut_Channel)(0),                  // 1: memos.store.ShortcutsUserSetting.Shortcut.Channel
+	(DigestsUserSetting_Digest_Kind)(0),                         // 2: memos.store.DigestsUserSetting.Digest.Kind
+	(DigestsUserSetting_Digest_Channel)(0),                      // 3: memos.store.DigestsUserSetting.Digest.Channel
+	(*UserSetting)(nil),                                         // 4: memos.store.UserSetting
+	(*GeneralUserSetting)(nil),                                  // 5: memos.store.GeneralUserSetting
+	(*RefreshTokensUserSetting)(nil),                            // 6: memos.store.RefreshTokensUserSetting
+	(*PersonalAccessTokensUserSetting)(nil),                     // 7: memos.store.PersonalAccessTokensUserSetting
+	(*ShortcutsUserSetting)(nil),                                // 8: memos.store.ShortcutsUserSetting
+	(*WebhooksUserSetting)(nil),                                 // 9: memos.store.WebhooksUserSetting
+	(*ReviewStatesUserSetting)(nil),                             // 10: memos.store.ReviewStatesUserSetting
+	(*DigestsUserSetting)(nil),                                  // 11: memos.store.DigestsUserSetting
+	(*SearchSynonymsUserSetting)(nil),                           // 12: memos.store.SearchSynonymsUserSetting
+	(*RefreshTokensUserSetting_RefreshToken)(nil),               // 13: memos.store.RefreshTokensUserSetting.RefreshToken
+	(*RefreshTokensUserSetting_ClientInfo)(nil),                 // 14: memos.store.RefreshTokensUserSetting.ClientInfo
+	(*PersonalAccessTokensUserSetting_PersonalAccessToken)(nil), // 15: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
+	(*ShortcutsUserSetting_Shortcut)(nil),                       // 16: memos.store.ShortcutsUserSetting.Shortcut
+	(*WebhooksUserSetting_Webhook)(nil),                         // 17: memos.store.WebhooksUserSetting.Webhook
+	(*ReviewStatesUserSetting_ReviewState)(nil),                 // 18: memos.store.ReviewStatesUserSetting.ReviewState
+	(*DigestsUserSetting_Digest)(nil),                           // 19: memos.store.DigestsUserSetting.Digest
+	(*SearchSynonymsUserSetting_Group)(nil),                     // 20: memos.store.SearchSynonymsUserSetting.Group
+	(*timestamppb.Timestamp)(nil),                               // 21: google.protobuf.Timestamp
 }
 var file_store_user_setting_proto_depIdxs = []int32{
 	0,  // 0: memos.store.UserSetting.key:type_name -> memos.store.UserSetting.Key
-	2,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
-	5,  // 2: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
-	6,  // 3: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
-	3,  // 4: memos.store.UserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting
-	4,  // 5: memos.store.UserSetting.personal_access_tokens:type_name -> memos.store.PersonalAccessTokensUserSetting
-	7,  // 6: memos.store.UserSetting.review_states:type_name -> memos.store.ReviewStatesUserSetting
-	8,  // 7: memos.store.RefreshTokensUserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting.RefreshToken
-	10, // 8: memos.store.PersonalAccessTokensUserSetting.tokens:type_name -> memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
-	11, // 9: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
-	12, // 10: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
-	13, // 11: memos.store.ReviewStatesUserSetting.states:type_name -> memos.store.ReviewStatesUserSetting.ReviewState
-	14, // 12: memos.store.RefreshTokensUserSetting.RefreshToken.expires_at:type_name -> google.protobuf.Timestamp
-	14, // 13: memos.store.RefreshTokensUserSetting.RefreshToken.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 14: memos.store.RefreshTokensUserSetting.RefreshToken.client_info:type_name -> memos.store.RefreshTokensUserSetting.ClientInfo
-	14, // 15: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	14, // 16: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
-	14, // 17: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	5,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
+	8,  // 2: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
+	9,  // 3: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
+	6,  // 4: memos.store.UserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting
+	7,  // 5: memos.store.UserSetting.personal_access_tokens:type_name -> memos.store.PersonalAccessTokensUserSetting
+	10, // 6: memos.store.UserSetting.review_states:type_name -> memos.store.ReviewStatesUserSetting
+	11, // 7: memos.store.UserSetting.digests:type_name -> memos.store.DigestsUserSetting
+	12, // 8: memos.store.UserSetting.search_synonyms:type_name -> memos.store.SearchSynonymsUserSetting
+	13, // 9: memos.store.RefreshTokensUserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting.RefreshToken
+	15, // 10: memos.store.PersonalAccessTokensUserSetting.tokens:type_name -> memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
+	16, // 11: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
+	17, // 12: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
+	18, // 13: memos.store.ReviewStatesUserSetting.states:type_name -> memos.store.ReviewStatesUserSetting.ReviewState
+	19, // 14: memos.store.DigestsUserSetting.digests:type_name -> memos.store.DigestsUserSetting.Digest
+	20, // 15: memos.store.SearchSynonymsUserSetting.groups:type_name -> memos.store.SearchSynonymsUserSetting.Group
+	21, // 16: memos.store.RefreshTokensUserSetting.RefreshToken.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 17: memos.store.RefreshTokensUserSetting.RefreshToken.created_at:type_name -> google.protobuf.Timestamp
+	14, // 18: memos.store.RefreshTokensUserSetting.RefreshToken.client_info:type_name -> memos.store.RefreshTokensUserSetting.ClientInfo
+	21, // 19: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 20: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
+	21, // 21: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
+	1,  // 22: memos.store.ShortcutsUserSetting.Shortcut.notify_channels:type_name -> memos.store.ShortcutsUserSetting.Shortcut.Channel
+	2,  // 23: memos.store.DigestsUserSetting.Digest.kind:type_name -> memos.store.DigestsUserSetting.Digest.Kind
+	3,  // 24: memos.store.DigestsUserSetting.Digest.channels:type_name -> memos.store.DigestsUserSetting.Digest.Channel
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_store_user_setting_proto_init() }
@@ -1157,14 +1747,16 @@ func file_store_user_setting_proto_init() {
 		(*UserSetting_RefreshTokens)(nil),
 		(*UserSetting_PersonalAccessTokens)(nil),
 		(*UserSetting_ReviewStates)(nil),
+		(*UserSetting_Digests)(nil),
+		(*UserSetting_SearchSynonyms)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_setting_proto_rawDesc), len(file_store_user_setting_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   13,
+			NumEnums:      4,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,244 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// AILimits configures AILimiter. Zero or negative values disable a limit.
+type AILimits struct {
+	UserRequestsPerMinute     int
+	UserConcurrentStreams     int
+	UserDailyLLMCalls         int
+	InstanceRequestsPerMinute int
+	InstanceConcurrentStreams int
+	InstanceDailyLLMCalls     int
+}
+
+// Limit names reported in AILimitError.
+const (
+	LimitRequestsPerMinute = "requests_per_minute"
+	LimitConcurrentStreams = "concurrent_streams"
+	LimitDailyLLMCalls     = "daily_llm_calls"
+)
+
+// Limit scopes reported in AILimitError.
+const (
+	ScopeUser     = "user"
+	ScopeInstance = "instance"
+)
+
+// idleRateSweepInterval is how often per-user rate limiters with a full bucket are dropped.
+const idleRateSweepInterval = time.Minute
+
+// AILimitError reports an exceeded AI limit.
+type AILimitError struct {
+	Limit      string        // One of the Limit* names
+	Scope      string        // ScopeUser or ScopeInstance
+	Quota      int           // The configured limit
+	RetryAfter time.Duration // When a retry may succeed
+}
+
+func (e *AILimitError) Error() string {
+	return fmt.Sprintf("AI %s limit exceeded (%s quota %d), retry after %s",
+		e.Scope, e.Limit, e.Quota, e.RetryAfter.Round(time.Second))
+}
+
+// AILimiter enforces per-user and per-instance limits on AI usage:
+// requests per minute, concurrently open streams and LLM calls per UTC day.
+// Limits are passed on every call so they can be tuned at runtime.
+type AILimiter struct {
+	mu sync.Mutex
+
+	userRate     map[int32]*rate.Limiter
+	instanceRate *rate.Limiter
+	lastSweep    time.Time // Last removal of idle user rate limiters
+
+	userStreams     map[int32]int
+	instanceStreams int
+
+	day           string // UTC date the LLM call counts belong to
+	userCalls     map[int32]int
+	instanceCalls int
+
+	now func() time.Time
+}
+
+// NewAILimiter creates a new AI limiter.
+func NewAILimiter() *AILimiter {
+	return &AILimiter{
+		userRate:    make(map[int32]*rate.Limiter),
+		userStreams: make(map[int32]int),
+		userCalls:   make(map[int32]int),
+		now:         time.Now,
+	}
+}
+
+// Allow admits a unary AI request of the user.
+// It checks the request rate and the remaining daily LLM calls.
+func (l *AILimiter) Allow(limits AILimits, userID int32) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if err := l.checkDailyLocked(limits, userID, now); err != nil {
+		return err
+	}
+	return l.reserveRateLocked(limits, userID, now)
+}
+
+// AcquireStream admits a streaming AI request of the user, additionally holding one
+// of the user's concurrent stream slots. release must be called when the stream ends.
+func (l *AILimiter) AcquireStream(limits AILimits, userID int32) (release func(), err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if err := l.checkDailyLocked(limits, userID, now); err != nil {
+		return nil, err
+	}
+	// Streams end at an unknown time; a short retry-after suits chat clients
+	if limits.UserConcurrentStreams > 0 && l.userStreams[userID] >= limits.UserConcurrentStreams {
+		return nil, &AILimitError{Limit: LimitConcurrentStreams, Scope: ScopeUser, Quota: limits.UserConcurrentStreams, RetryAfter: 5 * time.Second}
+	}
+	if limits.InstanceConcurrentStreams > 0 && l.instanceStreams >= limits.InstanceConcurrentStreams {
+		return nil, &AILimitError{Limit: LimitConcurrentStreams, Scope: ScopeInstance, Quota: limits.InstanceConcurrentStreams, RetryAfter: 5 * time.Second}
+	}
+	if err := l.reserveRateLocked(limits, userID, now); err != nil {
+		return nil, err
+	}
+
+	l.userStreams[userID]++
+	l.instanceStreams++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			if l.userStreams[userID]--; l.userStreams[userID] <= 0 {
+				delete(l.userStreams, userID)
+			}
+			l.instanceStreams--
+		})
+	}, nil
+}
+
+// RecordLLMCall counts one LLM call against the daily quotas.
+// userID is 0 for calls not made on behalf of a user (background jobs).
+func (l *AILimiter) RecordLLMCall(userID int32) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rollDayLocked(l.now())
+	if userID != 0 {
+		l.userCalls[userID]++
+	}
+	l.instanceCalls++
+}
+
+// reserveRateLocked takes one token from the user and instance rate limiters,
+// or none if either has no token left.
+func (l *AILimiter) reserveRateLocked(limits AILimits, userID int32, now time.Time) error {
+	l.sweepIdleRateLocked(now)
+
+	var userLimiter *rate.Limiter
+	if limits.UserRequestsPerMinute > 0 {
+		userLimiter = l.userRate[userID]
+		if userLimiter == nil {
+			userLimiter = newPerMinuteLimiter(limits.UserRequestsPerMinute)
+			l.userRate[userID] = userLimiter
+		} else {
+			setPerMinute(userLimiter, limits.UserRequestsPerMinute, now)
+		}
+		if delay := tokenDelay(userLimiter, now); delay > 0 {
+			return &AILimitError{Limit: LimitRequestsPerMinute, Scope: ScopeUser, Quota: limits.UserRequestsPerMinute, RetryAfter: delay}
+		}
+	}
+
+	var instanceLimiter *rate.Limiter
+	if limits.InstanceRequestsPerMinute > 0 {
+		if l.instanceRate == nil {
+			l.instanceRate = newPerMinuteLimiter(limits.InstanceRequestsPerMinute)
+		} else {
+			setPerMinute(l.instanceRate, limits.InstanceRequestsPerMinute, now)
+		}
+		instanceLimiter = l.instanceRate
+		if delay := tokenDelay(instanceLimiter, now); delay > 0 {
+			return &AILimitError{Limit: LimitRequestsPerMinute, Scope: ScopeInstance, Quota: limits.InstanceRequestsPerMinute, RetryAfter: delay}
+		}
+	}
+
+	if userLimiter != nil {
+		userLimiter.AllowN(now, 1)
+	}
+	if instanceLimiter != nil {
+		instanceLimiter.AllowN(now, 1)
+	}
+	return nil
+}
+
+// sweepIdleRateLocked drops the rate limiters of users whose bucket has refilled.
+// A full limiter behaves like a new one, so the map only holds recently active users.
+func (l *AILimiter) sweepIdleRateLocked(now time.Time) {
+	if now.Sub(l.lastSweep) < idleRateSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for userID, limiter := range l.userRate {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(l.userRate, userID)
+		}
+	}
+}
+
+// checkDailyLocked rejects requests once the daily LLM calls are used up.
+func (l *AILimiter) checkDailyLocked(limits AILimits, userID int32, now time.Time) error {
+	l.rollDayLocked(now)
+
+	year, month, day := now.UTC().Date()
+	untilTomorrow := time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC).Sub(now)
+	if limits.UserDailyLLMCalls > 0 && l.userCalls[userID] >= limits.UserDailyLLMCalls {
+		return &AILimitError{Limit: LimitDailyLLMCalls, Scope: ScopeUser, Quota: limits.UserDailyLLMCalls, RetryAfter: untilTomorrow}
+	}
+	if limits.InstanceDailyLLMCalls > 0 && l.instanceCalls >= limits.InstanceDailyLLMCalls {
+		return &AILimitError{Limit: LimitDailyLLMCalls, Scope: ScopeInstance, Quota: limits.InstanceDailyLLMCalls, RetryAfter: untilTomorrow}
+	}
+	return nil
+}
+
+// rollDayLocked resets the LLM call counts at the start of a new UTC day.
+func (l *AILimiter) rollDayLocked(now time.Time) {
+	day := now.UTC().Format(time.DateOnly)
+	if day != l.day {
+		l.day = day
+		l.userCalls = make(map[int32]int)
+		l.instanceCalls = 0
+	}
+}
+
+// newPerMinuteLimiter allows perMinute requests per minute, all of them in a burst.
+func newPerMinuteLimiter(perMinute int) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(float64(perMinute)/60), perMinute)
+}
+
+// setPerMinute updates a limiter after the limit was changed in the settings.
+func setPerMinute(limiter *rate.Limiter, perMinute int, now time.Time) {
+	if limiter.Burst() != perMinute {
+		limiter.SetLimitAt(now, rate.Limit(float64(perMinute)/60))
+		limiter.SetBurstAt(now, perMinute)
+	}
+}
+
+// tokenDelay returns how long until the limiter has a token, 0 if it has one now.
+func tokenDelay(limiter *rate.Limiter, now time.Time) time.Duration {
+	missing := 1 - limiter.TokensAt(now)
+	if missing <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(missing / float64(limiter.Limit()) * float64(time.Second)))
+}
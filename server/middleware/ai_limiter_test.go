@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAILimiter returns a limiter whose clock is controlled by the returned pointer.
+func newTestAILimiter() (*AILimiter, *time.Time) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	l := NewAILimiter()
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func requireLimitError(t *testing.T, err error, limit, scope string) *AILimitError {
+	t.Helper()
+	var limitErr *AILimitError
+	require.True(t, errors.As(err, &limitErr), "expected AILimitError, got %v", err)
+	assert.Equal(t, limit, limitErr.Limit)
+	assert.Equal(t, scope, limitErr.Scope)
+	assert.Positive(t, limitErr.RetryAfter)
+	return limitErr
+}
+
+func TestAILimiter_Allow_UserRate(t *testing.T) {
+	l, now := newTestAILimiter()
+	limits := AILimits{UserRequestsPerMinute: 2}
+
+	require.NoError(t, l.Allow(limits, 1))
+	require.NoError(t, l.Allow(limits, 1))
+	limitErr := requireLimitError(t, l.Allow(limits, 1), LimitRequestsPerMinute, ScopeUser)
+	assert.Equal(t, 2, limitErr.Quota)
+	assert.Equal(t, 30*time.Second, limitErr.RetryAfter)
+
+	// Other users have their own budget
+	require.NoError(t, l.Allow(limits, 2))
+
+	*now = now.Add(30 * time.Second)
+	require.NoError(t, l.Allow(limits, 1))
+}
+
+func TestAILimiter_Allow_InstanceRateTakesNoUserToken(t *testing.T) {
+	l, _ := newTestAILimiter()
+	limits := AILimits{UserRequestsPerMinute: 2, InstanceRequestsPerMinute: 1}
+
+	require.NoError(t, l.Allow(limits, 1))
+	requireLimitError(t, l.Allow(limits, 1), LimitRequestsPerMinute, ScopeInstance)
+
+	// The rejected request did not use the user's second token
+	require.NoError(t, l.Allow(AILimits{UserRequestsPerMinute: 2}, 1))
+}
+
+func TestAILimiter_Allow_DailyLLMCalls(t *testing.T) {
+	l, now := newTestAILimiter()
+	limits := AILimits{UserDailyLLMCalls: 2, InstanceDailyLLMCalls: 3}
+
+	l.RecordLLMCall(1)
+	l.RecordLLMCall(1)
+	limitErr := requireLimitError(t, l.Allow(limits, 1), LimitDailyLLMCalls, ScopeUser)
+	assert.Equal(t, 12*time.Hour, limitErr.RetryAfter)
+
+	// Background calls count against the instance only
+	require.NoError(t, l.Allow(limits, 2))
+	l.RecordLLMCall(0)
+	requireLimitError(t, l.Allow(limits, 2), LimitDailyLLMCalls, ScopeInstance)
+
+	// Counts reset at the start of the next UTC day
+	*now = now.Add(12 * time.Hour)
+	require.NoError(t, l.Allow(limits, 1))
+	require.NoError(t, l.Allow(limits, 2))
+}
+
+func TestAILimiter_AcquireStream(t *testing.T) {
+	l, _ := newTestAILimiter()
+	limits := AILimits{UserConcurrentStreams: 1, InstanceConcurrentStreams: 2}
+
+	release1, err := l.AcquireStream(limits, 1)
+	require.NoError(t, err)
+	_, err = l.AcquireStream(limits, 1)
+	requireLimitError(t, err, LimitConcurrentStreams, ScopeUser)
+
+	release2, err := l.AcquireStream(limits, 2)
+	require.NoError(t, err)
+	_, err = l.AcquireStream(limits, 3)
+	requireLimitError(t, err, LimitConcurrentStreams, ScopeInstance)
+
+	// Releasing twice frees only one slot
+	release1()
+	release1()
+	release3, err := l.AcquireStream(limits, 3)
+	require.NoError(t, err)
+	_, err = l.AcquireStream(limits, 1)
+	requireLimitError(t, err, LimitConcurrentStreams, ScopeInstance)
+
+	release2()
+	release3()
+	assert.Empty(t, l.userStreams)
+	assert.Zero(t, l.instanceStreams)
+}
+
+func TestAILimiter_SweepsIdleUserRateLimiters(t *testing.T) {
+	l, now := newTestAILimiter()
+	limits := AILimits{UserRequestsPerMinute: 60}
+
+	for userID := int32(1); userID <= 100; userID++ {
+		require.NoError(t, l.Allow(limits, userID))
+	}
+	assert.Len(t, l.userRate, 100)
+
+	// Users 1-100 have refilled their bucket after a second; user 101 is still active
+	*now = now.Add(idleRateSweepInterval)
+	require.NoError(t, l.Allow(limits, 101))
+	assert.Len(t, l.userRate, 1)
+	assert.Contains(t, l.userRate, int32(101))
+
+	// A swept user starts with a full bucket again
+	for i := 0; i < 60; i++ {
+		require.NoError(t, l.Allow(limits, 1))
+	}
+	requireLimitError(t, l.Allow(limits, 1), LimitRequestsPerMinute, ScopeUser)
+}
@@ -0,0 +1,172 @@
+package queryengine
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// recentMemoDays 笔记检索中“最近”回溯的天数
+const recentMemoDays = 7
+
+// memoChineseVerbs 中文查询中描述记笔记动作的词，不参与内容检索（长词在前）
+var memoChineseVerbs = []string{"写了什么", "记了什么", "写了", "记了", "写过", "记过", "写的", "记的"}
+
+// recentLabels 模糊近期时间的标签；日程检索中指今后几天，笔记检索中指过去几天
+var recentLabels = map[string]bool{
+	"近期":         true,
+	"recently":   true,
+	"lately":     true,
+	"these days": true,
+}
+
+var (
+	// memoDateRegexes 查询中的具体日期，检索笔记内容前移除
+	memoDateRegexes = []*regexp.Regexp{
+		regexp.MustCompile(`\d{4}年\d{1,2}月\d{1,2}[日号]`),
+		regexp.MustCompile(`\d{4}[-/]\d{1,2}[-/]\d{1,2}`),
+		regexp.MustCompile(`\d{1,2}月\d{1,2}[日号]`),
+		regexp.MustCompile(`\b\d{1,2}[-/]\d{1,2}\b`),
+	}
+
+	// chineseMonthRegex 匹配 "3月"、"三月份"、"2025年3月"
+	chineseMonthRegex = regexp.MustCompile(`(?:(\d{4})年)?(1[0-2]|0?[1-9]|十[一二]?|[一二三四五六七八九])月份?`)
+
+	// englishMonthRegex 匹配 "in march"、"during may 2025"，要求介词以避免 "may"、"march" 的歧义
+	englishMonthRegex = regexp.MustCompile(`(?i)\b(?:in|during|from|since|of)\s+(january|february|march|april|may|june|july|august|september|october|november|december)(?:\s+(\d{4}))?\b`)
+
+	// monthDayLabelRegex 未写年份的日期标签，见 detectTimeRangeWithTimezone
+	monthDayLabelRegex = regexp.MustCompile(`^\d{1,2}月\d{1,2}日$`)
+
+	// memoVerbRegex 英文查询中描述记笔记动作的词，不参与内容检索
+	memoVerbRegex = regexp.MustCompile(`(?i)\b(?:write|wrote|written|writing|jot|jotted|noted|saved|record|recorded)\b`)
+
+	chineseMonthNumbers = map[string]int{
+		"一": 1, "二": 2, "三": 3, "四": 4, "五": 5, "六": 6,
+		"七": 7, "八": 8, "九": 9, "十": 10, "十一": 11, "十二": 12,
+	}
+)
+
+// DetectMemoTimeRange 检测笔记检索查询中的时间范围
+// 返回时间范围和去掉时间词、停用词后的检索内容；未检测到时间时返回 nil 和原查询。
+// 与日程不同，笔记记录的是已经发生的事：
+// - "最近"、"recently" 指过去 7 天
+// - 未写年份的月份（"3月"、"in March"）取最近一次，包括本月
+// - 未写年份的具体日期如果落在将来，取去年的同一天
+func (r *QueryRouter) DetectMemoTimeRange(query string, userTimezone *time.Location) (*TimeRange, string) {
+	if userTimezone == nil {
+		userTimezone = utcLocation
+	}
+	now := time.Now().In(userTimezone)
+	queryLower := strings.ToLower(strings.TrimSpace(query))
+
+	timeRange := r.detectKeywordRange(queryLower, now)
+	if timeRange == nil {
+		timeRange = r.detectTimeRangeWithTimezone(queryLower, userTimezone)
+	}
+	if timeRange == nil {
+		timeRange = detectMonth(queryLower, now)
+	}
+	if timeRange == nil {
+		return nil, query
+	}
+
+	switch {
+	case recentLabels[timeRange.Label]:
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, userTimezone)
+		timeRange = &TimeRange{Start: today.AddDate(0, 0, 1-recentMemoDays), End: today.AddDate(0, 0, 1), Label: timeRange.Label}
+	case timeRange.Start.After(now) && isMonthDayLabel(timeRange.Label):
+		timeRange = &TimeRange{Start: timeRange.Start.AddDate(-1, 0, 0), End: timeRange.End.AddDate(-1, 0, 0), Label: timeRange.Label}
+	}
+
+	// 移除日期、月份和记笔记的动作后再提取内容，extractContentQuery 只移除相对时间词和停用词
+	content := query
+	for _, re := range memoDateRegexes {
+		content = re.ReplaceAllString(content, " ")
+	}
+	content = chineseMonthRegex.ReplaceAllString(content, " ")
+	content = englishMonthRegex.ReplaceAllString(content, " ")
+	content = memoVerbRegex.ReplaceAllString(content, " ")
+	for _, word := range memoChineseVerbs {
+		content = strings.ReplaceAll(content, word, " ")
+	}
+	return timeRange, r.extractContentQuery(content)
+}
+
+// detectKeywordRange 按 now 所在时区的日期计算相对时间词（"昨天"、"last week"）
+// timeKeywords 按 UTC 日期计算，因此把用户的墙上时间当作 UTC 传入，再把结果换回用户时区
+func (r *QueryRouter) detectKeywordRange(query string, now time.Time) *TimeRange {
+	calculator := r.matchTimeKeyword(query)
+	if calculator == nil {
+		return nil
+	}
+	timeRange := calculator(wallClockIn(now, utcLocation))
+	if timeRange == nil {
+		return nil
+	}
+	return &TimeRange{
+		Start: wallClockIn(timeRange.Start, now.Location()),
+		End:   wallClockIn(timeRange.End, now.Location()),
+		Label: timeRange.Label,
+	}
+}
+
+// wallClockIn 返回 loc 中与 t 墙上时间相同的时刻
+func wallClockIn(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// detectMonth 检测月份，未写年份时取最近一次（包括本月）
+func detectMonth(query string, now time.Time) *TimeRange {
+	var yearText, monthText string
+	english := false
+	if matches := chineseMonthRegex.FindStringSubmatchIndex(query); matches != nil {
+		// "3月1" 等具体日期的一部分不算月份
+		if end := matches[1]; end < len(query) && query[end] >= '0' && query[end] <= '9' {
+			return nil
+		}
+		yearText, monthText = submatch(query, matches, 1), submatch(query, matches, 2)
+	} else if matches := englishMonthRegex.FindStringSubmatch(query); matches != nil {
+		monthTime, err := time.Parse("January", matches[1])
+		if err != nil {
+			return nil
+		}
+		yearText, monthText = matches[2], strconv.Itoa(int(monthTime.Month()))
+		english = true
+	} else {
+		return nil
+	}
+
+	month, ok := chineseMonthNumbers[monthText]
+	if !ok {
+		month, _ = strconv.Atoi(monthText)
+	}
+	year := now.Year()
+	if yearText != "" {
+		year, _ = strconv.Atoi(yearText)
+	} else if month > int(now.Month()) {
+		year--
+	}
+
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, now.Location())
+	label := fmt.Sprintf("%d年%d月", year, month)
+	if english {
+		label = start.Format("January 2006")
+	}
+	return &TimeRange{Start: start, End: start.AddDate(0, 1, 0), Label: label}
+}
+
+// submatch 返回第 n 个子匹配，未匹配时为空
+func submatch(s string, matches []int, n int) string {
+	if matches[2*n] < 0 {
+		return ""
+	}
+	return s[matches[2*n]:matches[2*n+1]]
+}
+
+// isMonthDayLabel 判断标签是否为未写年份的日期（如 "1月21日"）
+func isMonthDayLabel(label string) bool {
+	return monthDayLabelRegex.MatchString(label)
+}
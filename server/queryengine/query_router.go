@@ -8,6 +8,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/hrygo/divinesense/plugin/ai/locale"
 )
 
 // UTC 时区常量，统一使用 UTC 避免时区混淆
@@ -442,6 +444,11 @@ func (r *QueryRouter) initTimeKeywords() {
 	}
 	r.timeKeywords["第四季度"] = r.timeKeywords["四季度"]
 
+	// ============================================================
+	// 8. 英文关键词（复用上面的计算函数）
+	// ============================================================
+	r.initEnglishTimeKeywords()
+
 	// 初始化时调用一次，避免 now 变量未使用警告
 	_ = r.timeKeywords["今天"](now)
 }
@@ -475,7 +482,7 @@ func (r *QueryRouter) quickMatchWithTimezone(query string, userTimezone *time.Lo
 		contentQuery := r.extractContentQuery(queryTrimmed) // 使用原始查询保留大小写
 
 		// 检查是否是纯时间查询（内容查询为空或只包含停用词）
-		scheduleStopWords := []string{"日程", "安排", "事", "计划"}
+		scheduleStopWords := []string{"日程", "安排", "事", "计划", "schedule", "calendar", "agenda", "plans", "events"}
 		isScheduleOnly := true
 		for _, word := range strings.Fields(contentQuery) {
 			isStopWord := false
@@ -551,6 +558,20 @@ func (r *QueryRouter) quickMatchWithTimezone(query string, userTimezone *time.Lo
 	return nil
 }
 
+// matchTimeKeyword 返回查询中最长的相对时间关键词对应的计算函数，没有匹配时返回 nil
+// 优先匹配最长关键词，避免"大后天"匹配到"后天"
+func (r *QueryRouter) matchTimeKeyword(query string) timeRangeCalculator {
+	var matchedKeyword string
+	var matchedCalculator timeRangeCalculator
+	for keyword, calculator := range r.timeKeywords {
+		if containsTimeKeyword(query, keyword) && len(keyword) > len(matchedKeyword) {
+			matchedKeyword = keyword
+			matchedCalculator = calculator
+		}
+	}
+	return matchedCalculator
+}
+
 // detectTimeRange 检测时间范围
 // P1 改进：统一使用 UTC 时区
 // P2 改进：支持具体日期解析（"1月21日"、"1-21"等）
@@ -561,19 +582,7 @@ func (r *QueryRouter) detectTimeRange(query string) *TimeRange {
 	// ============================================================
 	// 1. 精确匹配时间关键词（相对时间）
 	// ============================================================
-	// 修复：优先匹配最长关键词，避免"大后天"匹配到"后天"
-	var matchedKeyword string
-	var matchedCalculator timeRangeCalculator
-	for keyword, calculator := range r.timeKeywords {
-		if strings.Contains(query, keyword) {
-			// 选择最长的匹配关键词
-			if len(keyword) > len(matchedKeyword) {
-				matchedKeyword = keyword
-				matchedCalculator = calculator
-			}
-		}
-	}
-	if matchedCalculator != nil {
+	if matchedCalculator := r.matchTimeKeyword(query); matchedCalculator != nil {
 		return matchedCalculator(now)
 	}
 
@@ -664,6 +673,9 @@ func (r *QueryRouter) determineScheduleQueryMode(query string, timeRange *TimeRa
 	}
 
 	label := timeRange.Label
+	if isEnglishRelativeLabel(label) {
+		return StandardQueryMode
+	}
 	for _, keyword := range relativeTimeKeywords {
 		if strings.Contains(label, keyword) {
 			return StandardQueryMode // 相对时间用标准模式
@@ -686,19 +698,7 @@ func (r *QueryRouter) detectTimeRangeWithTimezone(query string, userTimezone *ti
 	// ============================================================
 	// 1. 精确匹配时间关键词（相对时间）
 	// ============================================================
-	// 修复：优先匹配最长关键词，避免"大后天"匹配到"后天"
-	var matchedKeyword string
-	var matchedCalculator timeRangeCalculator
-	for keyword, calculator := range r.timeKeywords {
-		if strings.Contains(query, keyword) {
-			// 选择最长的匹配关键词
-			if len(keyword) > len(matchedKeyword) {
-				matchedKeyword = keyword
-				matchedCalculator = calculator
-			}
-		}
-	}
-	if matchedCalculator != nil {
+	if matchedCalculator := r.matchTimeKeyword(query); matchedCalculator != nil {
 		// calculator 仍然使用 UTC（因为 timeKeywords 是用 UTC 初始化的）
 		// 但我们在调用时会传入用户时区的 "now"
 		userNow := time.Now().In(userTimezone)
@@ -892,6 +892,11 @@ func (r *QueryRouter) extractContentQuery(query string) string {
 		contentQuery = strings.ReplaceAll(contentQuery, word, " ")
 	}
 
+	// 英文查询：按整词移除英文时间词和停用词
+	if locale.Detect(query) == locale.English {
+		contentQuery = removeEnglishWords(contentQuery)
+	}
+
 	// 清理多余空格
 	words := strings.Fields(contentQuery)
 	contentQuery = strings.Join(words, " ")
@@ -0,0 +1,85 @@
+package queryengine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQueryRouter_DetectMemoTimeRange 测试笔记检索的时间范围检测
+func TestQueryRouter_DetectMemoTimeRange(t *testing.T) {
+	router := NewQueryRouter()
+	now := time.Now().In(utcLocation)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, utcLocation)
+
+	t.Run("最近指过去 7 天", func(t *testing.T) {
+		tr, content := router.DetectMemoTimeRange("最近关于迁移的想法", time.UTC)
+		require.NotNil(t, tr)
+		assert.Equal(t, today.AddDate(0, 0, -6), tr.Start)
+		assert.Equal(t, today.AddDate(0, 0, 1), tr.End)
+		assert.Equal(t, "迁移 想法", content)
+	})
+
+	t.Run("未写年份的月份取最近一次", func(t *testing.T) {
+		tr, content := router.DetectMemoTimeRange("notes from my Tokyo trip in March", time.UTC)
+		require.NotNil(t, tr)
+		year := now.Year()
+		if now.Month() < time.March {
+			year--
+		}
+		assert.Equal(t, time.Date(year, time.March, 1, 0, 0, 0, 0, utcLocation), tr.Start)
+		assert.Equal(t, time.Date(year, time.April, 1, 0, 0, 0, 0, utcLocation), tr.End)
+		assert.Equal(t, "Tokyo trip", content)
+	})
+
+	t.Run("写明年份的月份", func(t *testing.T) {
+		tr, content := router.DetectMemoTimeRange("2025年3月的旅行笔记", time.UTC)
+		require.NotNil(t, tr)
+		assert.Equal(t, time.Date(2025, time.March, 1, 0, 0, 0, 0, utcLocation), tr.Start)
+		assert.Equal(t, "2025年3月", tr.Label)
+		assert.Equal(t, "旅行", content)
+	})
+
+	t.Run("未写年份的日期不在将来", func(t *testing.T) {
+		tr, _ := router.DetectMemoTimeRange("1月1日的会议记录", time.UTC)
+		require.NotNil(t, tr)
+		assert.False(t, tr.Start.After(now))
+		assert.Equal(t, time.January, tr.Start.Month())
+	})
+
+	t.Run("移除记笔记的动作", func(t *testing.T) {
+		tr, content := router.DetectMemoTimeRange("what did I write about the migration last week", time.UTC)
+		require.NotNil(t, tr)
+		assert.Equal(t, "last week", tr.Label)
+		assert.Equal(t, "migration", content)
+	})
+
+	t.Run("相对时间按用户时区的日期计算", func(t *testing.T) {
+		for _, name := range []string{"Asia/Shanghai", "America/Los_Angeles"} {
+			loc, err := time.LoadLocation(name)
+			require.NoError(t, err)
+			localNow := time.Now().In(loc)
+			localToday := time.Date(localNow.Year(), localNow.Month(), localNow.Day(), 0, 0, 0, 0, loc)
+
+			tr, _ := router.DetectMemoTimeRange("昨天的会议记录", loc)
+			require.NotNil(t, tr, name)
+			assert.True(t, localToday.AddDate(0, 0, -1).Equal(tr.Start), name)
+			assert.True(t, localToday.Equal(tr.End), name)
+
+			tr, _ = router.DetectMemoTimeRange("what did I write last week", loc)
+			require.NotNil(t, tr, name)
+			assert.Equal(t, time.Monday, tr.Start.In(loc).Weekday(), name)
+			assert.Zero(t, tr.Start.In(loc).Hour(), name)
+			assert.True(t, tr.End.Sub(tr.Start) >= 7*24*time.Hour-time.Hour, name)
+			assert.True(t, tr.Start.Before(localToday), name)
+		}
+	})
+
+	t.Run("无时间", func(t *testing.T) {
+		tr, content := router.DetectMemoTimeRange("may the force be with you", time.UTC)
+		assert.Nil(t, tr)
+		assert.Equal(t, "may the force be with you", content)
+	})
+}
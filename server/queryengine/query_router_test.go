@@ -65,6 +65,30 @@ func TestQueryRouter_Route(t *testing.T) {
 			expectedStrategy: "hybrid_standard",
 			minConfidence: 0.70,
 		},
+		{
+			name:          "English schedule - tomorrow",
+			query:         "What's on my calendar tomorrow?",
+			expectedStrategy: "schedule_bm25_only",
+			minConfidence: 0.90,
+		},
+		{
+			name:          "English hybrid - next week meetings",
+			query:         "meetings about the AI project next week",
+			expectedStrategy: "hybrid_with_time_filter",
+			minConfidence: 0.85,
+		},
+		{
+			name:          "English memo search",
+			query:         "find notes about distributed tracing",
+			expectedStrategy: "memo_semantic_only",
+			minConfidence: 0.85,
+		},
+		{
+			name:          "English general question",
+			query:         "summarize my work plan",
+			expectedStrategy: "full_pipeline_with_reranker",
+			minConfidence: 0.60,
+		},
 	}
 
 	for _, tt := range tests {
@@ -162,6 +186,36 @@ func TestQueryRouter_DetectTimeRange(t *testing.T) {
 			expectLabel: "",
 			expectRange: false,
 		},
+		{
+			name:        "English - tomorrow",
+			query:       "tomorrow's schedule",
+			expectLabel: "tomorrow",
+			expectRange: true,
+		},
+		{
+			name:        "English - next week",
+			query:       "plans for next week",
+			expectLabel: "next week",
+			expectRange: true,
+		},
+		{
+			name:        "English - end of month",
+			query:       "deadlines at the end of the month",
+			expectLabel: "end of month",
+			expectRange: true,
+		},
+		{
+			name:        "English - weekday",
+			query:       "Friday standup",
+			expectLabel: "friday",
+			expectRange: true,
+		},
+		{
+			name:        "English - keyword inside word",
+			query:       "notes on the Todayville project",
+			expectLabel: "",
+			expectRange: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -243,6 +297,16 @@ func TestQueryRouter_ExtractContentQuery(t *testing.T) {
 			query:    "今天有什么安排",
 			expected: "",
 		},
+		{
+			name:     "English pure time query",
+			query:    "What's on my calendar tomorrow?",
+			expected: "",
+		},
+		{
+			name:     "English time and stop words",
+			query:    "notes about Docker and Kubernetes from last week",
+			expected: "Docker and Kubernetes",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,169 @@
+package queryengine
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// englishTimeAliases 英文时间关键词 -> 复用的中文关键词计算函数
+// 英文关键词按整词匹配（见 containsTimeKeyword），避免 "q1" 等短词命中单词内部片段
+var englishTimeAliases = map[string]string{
+	// 精确日期
+	"today":                "今天",
+	"tomorrow":             "明天",
+	"day after tomorrow":   "后天",
+	"yesterday":            "昨天",
+	"day before yesterday": "前天",
+	// 周相关
+	"this week": "本周",
+	"next week": "下周",
+	"last week": "上周",
+	// 星期
+	"monday":    "周一",
+	"tuesday":   "周二",
+	"wednesday": "周三",
+	"thursday":  "周四",
+	"friday":    "周五",
+	"saturday":  "周六",
+	"sunday":    "周日",
+	// 时段
+	"this morning":   "上午",
+	"this afternoon": "下午",
+	"this evening":   "晚上",
+	"tonight":        "晚上",
+	// 模糊时间
+	"recently":   "最近",
+	"lately":     "最近",
+	"these days": "这几天",
+	"this month": "这个月",
+	"next month": "下个月",
+	"last month": "上个月",
+	// 年份
+	"this year": "今年",
+	"next year": "明年",
+	"last year": "去年",
+	// 季度
+	"q1": "一季度",
+	"q2": "二季度",
+	"q3": "三季度",
+	"q4": "四季度",
+}
+
+// englishStopWords 英文停用词（整词匹配）
+var englishStopWords = []string{
+	"what's", "whats", "what", "is", "are", "was", "were", "do", "does", "did", "i", "have", "has",
+	"on", "in", "at", "for", "of", "to", "from", "my", "me", "the", "a", "an", "any", "about",
+	"show", "list", "search", "find", "notes", "note", "memos", "memo", "content",
+}
+
+var (
+	// englishTimeWordsRegex 匹配所有英文时间关键词（最长优先）
+	englishTimeWordsRegex *regexp.Regexp
+	// englishStopWordsRegex 匹配英文停用词
+	englishStopWordsRegex = regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoteAll(englishStopWords), "|") + `)\b`)
+)
+
+func init() {
+	words := make([]string, 0, len(englishTimeAliases)+4)
+	for word := range englishTimeAliases {
+		words = append(words, word)
+	}
+	words = append(words, "end of the month", "end of month", "end of the week", "end of week")
+	sort.Slice(words, func(i, j int) bool {
+		if len(words[i]) != len(words[j]) {
+			return len(words[i]) > len(words[j])
+		}
+		return words[i] < words[j]
+	})
+	englishTimeWordsRegex = regexp.MustCompile(`(?i)\b(?:the\s+)?(?:` + strings.Join(quoteAll(words), "|") + `)(?:'s)?\b`)
+}
+
+func quoteAll(words []string) []string {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = regexp.QuoteMeta(w)
+	}
+	return quoted
+}
+
+// initEnglishTimeKeywords 初始化英文时间关键词
+// 复用中文关键词的计算函数，仅替换标签
+func (r *QueryRouter) initEnglishTimeKeywords() {
+	for word, zh := range englishTimeAliases {
+		calculator := r.timeKeywords[zh]
+		label := word
+		r.timeKeywords[word] = func(t time.Time) *TimeRange {
+			tr := calculator(t)
+			tr.Label = label
+			return tr
+		}
+	}
+
+	// 月末 / 周末：当月最后一天、本周日
+	endOfMonth := func(t time.Time) *TimeRange {
+		utcTime := t.In(utcLocation)
+		start := time.Date(utcTime.Year(), utcTime.Month()+1, 0, 0, 0, 0, 0, utcLocation)
+		return &TimeRange{Start: start, End: start.Add(24 * time.Hour), Label: "end of month"}
+	}
+	endOfWeek := func(t time.Time) *TimeRange {
+		utcTime := t.In(utcLocation)
+		weekday := int(utcTime.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		start := time.Date(utcTime.Year(), utcTime.Month(), utcTime.Day()+7-weekday, 0, 0, 0, 0, utcLocation)
+		return &TimeRange{Start: start, End: start.Add(24 * time.Hour), Label: "end of week"}
+	}
+	r.timeKeywords["end of month"] = endOfMonth
+	r.timeKeywords["end of the month"] = endOfMonth
+	r.timeKeywords["end of week"] = endOfWeek
+	r.timeKeywords["end of the week"] = endOfWeek
+}
+
+// isEnglishRelativeLabel 判断标签是否为英文相对时间
+func isEnglishRelativeLabel(label string) bool {
+	if _, ok := englishTimeAliases[label]; ok {
+		return true
+	}
+	return strings.HasPrefix(label, "end of ")
+}
+
+// containsTimeKeyword 检测查询是否包含时间关键词
+// 英文关键词按整词匹配，中文关键词按子串匹配
+func containsTimeKeyword(query, keyword string) bool {
+	if keyword == "" || keyword[0] >= 0x80 {
+		return strings.Contains(query, keyword)
+	}
+	for i := 0; ; {
+		idx := strings.Index(query[i:], keyword)
+		if idx < 0 {
+			return false
+		}
+		start, end := i+idx, i+idx+len(keyword)
+		if (start == 0 || !isASCIIWordByte(query[start-1])) && (end == len(query) || !isASCIIWordByte(query[end])) {
+			return true
+		}
+		i = start + 1
+	}
+}
+
+func isASCIIWordByte(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
+
+// removeEnglishWords 移除英文时间词和停用词，并清理只剩标点的残片
+func removeEnglishWords(query string) string {
+	query = englishTimeWordsRegex.ReplaceAllString(query, " ")
+	query = englishStopWordsRegex.ReplaceAllString(query, " ")
+
+	words := strings.Fields(query)
+	kept := words[:0]
+	for _, word := range words {
+		if strings.Trim(word, "?!.,;:'\"") != "" {
+			kept = append(kept, word)
+		}
+	}
+	return strings.Join(kept, " ")
+}
@@ -7,9 +7,11 @@ import (
 	"log/slog"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/plugin/ai/vector"
 	"github.com/hrygo/divinesense/server/queryengine"
 	"github.com/hrygo/divinesense/store"
 )
@@ -25,8 +27,15 @@ const (
 // 根据查询复杂度和结果质量动态调整检索策略
 type AdaptiveRetriever struct {
 	store            *store.Store
+	vectorService    vector.VectorService
 	embeddingService ai.EmbeddingService
 	rerankerService  ai.RerankerService
+	keywordSuggester KeywordSuggester // 查询扩展的常用关键词来源，可选
+	searchRecorder   SearchRecorder   // 检索日志记录器，可选
+	mmrEnabled       bool             // 是否启用多样性重排，见 SetMMREnabled
+
+	tagGraphMu sync.Mutex
+	tagGraphs  map[int32]*cachedTagGraph // 用户 ID -> 标签共现图，见 ExpandQuery
 }
 
 // SearchResult 检索结果
@@ -37,6 +46,7 @@ type SearchResult struct {
 	Content  string
 	Memo     *store.Memo
 	Schedule *store.Schedule
+	Similar  []*SearchResult // 折叠到该结果的近似重复笔记，按相关度排序
 }
 
 // RetrievalOptions 检索选项
@@ -44,22 +54,27 @@ type RetrievalOptions struct {
 	Query            string
 	UserID           int32
 	Strategy         string
-	TimeRange        *queryengine.TimeRange
+	TimeRange        *queryengine.TimeRange // 日程按时间过滤；笔记按显示时间过滤，范围内没有匹配时按时间距离衰减分数
 	MinScore         float32
 	Limit            int
 	RequestID        string // 请求追踪 ID
 	Logger           *slog.Logger // 结构化日志记录器
 	ScheduleQueryMode queryengine.ScheduleQueryMode // P1: 日程查询模式
+	Filter           string // 结构化查询编译出的 CEL 笔记过滤条件（见 plugin/search），只作用于笔记
+	Expansions       []ExpandedTerm // 查询扩展词（见 ExpandQuery），只作用于笔记的 BM25 检索并降权
+	Location         *time.Location // 用户时区，分面按该时区统计创建月份；为空时使用 UTC
 }
 
 // NewAdaptiveRetriever 创建自适应检索器
 func NewAdaptiveRetriever(
 	st *store.Store,
+	vectorService vector.VectorService,
 	embeddingService ai.EmbeddingService,
 	rerankerService ai.RerankerService,
 ) *AdaptiveRetriever {
 	return &AdaptiveRetriever{
 		store:            st,
+		vectorService:    vectorService,
 		embeddingService: embeddingService,
 		rerankerService:  rerankerService,
 	}
@@ -88,30 +103,64 @@ func (r *AdaptiveRetriever) Retrieve(ctx context.Context, opts *RetrievalOptions
 		opts.RequestID = generateRequestID()
 	}
 
+	// 时间范围同时约束笔记（日程检索自行按时间过滤）
+	if opts.TimeRange != nil && opts.Strategy != "schedule_bm25_only" {
+		return r.retrieveInTimeRange(ctx, opts)
+	}
+	return r.retrieve(ctx, opts)
+}
+
+// retrieve 按策略检索并做多样性重排
+func (r *AdaptiveRetriever) retrieve(ctx context.Context, opts *RetrievalOptions) ([]*SearchResult, error) {
+	// 只有过滤条件没有检索文本时（如 "tag:work is:pinned"），直接按条件列出笔记
+	if strings.TrimSpace(opts.Query) == "" && opts.Filter != "" {
+		return r.listFilteredMemos(ctx, opts)
+	}
+
+	// 多样性重排会折叠近似重复结果，因此先多取候选，重排后再截断到 Limit
+	fetchOpts := opts
+	diversifying := r.diversifies(opts)
+	if diversifying && opts.Limit > 0 {
+		overfetched := *opts
+		overfetched.Limit = opts.Limit * mmrOverfetch
+		fetchOpts = &overfetched
+	}
+
 	// 根据路由策略选择检索路径
+	var results []*SearchResult
+	var err error
 	switch opts.Strategy {
 	case "schedule_bm25_only":
-		return r.scheduleBM25Only(ctx, opts)
+		results, err = r.scheduleBM25Only(ctx, fetchOpts)
 
 	case "memo_semantic_only":
-		return r.memoSemanticOnly(ctx, opts)
+		results, err = r.memoSemanticOnly(ctx, fetchOpts)
 
 	case "hybrid_bm25_weighted":
-		return r.hybridBM25Weighted(ctx, opts)
+		results, err = r.hybridBM25Weighted(ctx, fetchOpts)
 
 	case "hybrid_with_time_filter":
-		return r.hybridWithTimeFilter(ctx, opts)
+		results, err = r.hybridWithTimeFilter(ctx, fetchOpts)
 
 	case "hybrid_standard":
-		return r.hybridStandard(ctx, opts)
+		results, err = r.hybridStandard(ctx, fetchOpts)
 
 	case "full_pipeline_with_reranker":
-		return r.fullPipelineWithReranker(ctx, opts)
+		results, err = r.fullPipelineWithReranker(ctx, fetchOpts)
 
 	default:
 		// 默认使用标准混合检索
-		return r.hybridStandard(ctx, opts)
+		results, err = r.hybridStandard(ctx, fetchOpts)
+	}
+	if err != nil {
+		return nil, err
 	}
+	if !diversifying {
+		return results, nil
+	}
+
+	// 折叠近似重复结果并按 MMR 提升多样性
+	return r.truncateResults(r.diversify(ctx, opts, results), opts.Limit), nil
 }
 
 // scheduleBM25Only 纯日程查询（BM25 + 时间过滤）
@@ -216,11 +265,7 @@ func (r *AdaptiveRetriever) memoSemanticOnly(ctx context.Context, opts *Retrieva
 		limit = opts.Limit
 	}
 
-	vectorResults, err := r.store.VectorSearch(ctx, &store.VectorSearchOptions{
-		UserID: opts.UserID,
-		Vector: queryVector,
-		Limit:  limit,
-	})
+	vectorResults, err := r.searchMemoVectors(ctx, opts, queryVector, limit)
 	if err != nil {
 		opts.Logger.ErrorContext(ctx, "Vector search failed",
 			"request_id", opts.RequestID,
@@ -242,11 +287,7 @@ func (r *AdaptiveRetriever) memoSemanticOnly(ctx context.Context, opts *Retrieva
 	// 根据质量决定是否扩展
 	if quality == MediumQuality && opts.Limit > 5 {
 		// 扩展到 Top 20
-		moreResults, err := r.store.VectorSearch(ctx, &store.VectorSearchOptions{
-			UserID: opts.UserID,
-			Vector: queryVector,
-			Limit:  20,
-		})
+		moreResults, err := r.searchMemoVectors(ctx, opts, queryVector, 20)
 		if err == nil {
 			// 合并结果
 			results = r.mergeResults(results, r.convertVectorResults(moreResults), opts.Limit)
@@ -434,11 +475,7 @@ func (r *AdaptiveRetriever) hybridSearch(ctx context.Context, opts *RetrievalOpt
 			return
 		}
 
-		results, err := r.store.VectorSearch(ctx, &store.VectorSearchOptions{
-			UserID: opts.UserID,
-			Vector: queryVector,
-			Limit:  20,
-		})
+		results, err := r.searchMemoVectors(ctx, opts, queryVector, 20)
 		select {
 		case <-ctx.Done():
 		case vectorCh <- vectorResult{results, err}:
@@ -447,12 +484,7 @@ func (r *AdaptiveRetriever) hybridSearch(ctx context.Context, opts *RetrievalOpt
 
 	// 并行执行 BM25 检索
 	go func() {
-		results, err := r.store.BM25Search(ctx, &store.BM25SearchOptions{
-			UserID:   opts.UserID,
-			Query:    opts.Query,
-			Limit:    20,
-			MinScore: 0.1,
-		})
+		results, err := r.memoBM25Search(ctx, opts)
 		select {
 		case <-ctx.Done():
 		case bm25Ch <- bm25Result{results, err}:
@@ -490,6 +522,105 @@ func (r *AdaptiveRetriever) hybridSearch(ctx context.Context, opts *RetrievalOpt
 	return results, nil
 }
 
+// searchMemoVectors 通过 VectorService 检索用户 memo 的向量相似结果
+func (r *AdaptiveRetriever) searchMemoVectors(ctx context.Context, opts *RetrievalOptions, queryVector []float32, limit int) ([]*store.MemoWithScore, error) {
+	if r.vectorService == nil {
+		return nil, fmt.Errorf("vector service is not configured")
+	}
+
+	filter := map[string]any{
+		vector.KeyUserID:  opts.UserID,
+		vector.KeyDocType: vector.DocTypeMemo,
+	}
+	if opts.Filter != "" {
+		filter[vector.KeyMemoFilter] = opts.Filter
+	}
+	results, err := r.vectorService.SearchSimilar(ctx, queryVector, limit, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	memos := make([]*store.MemoWithScore, 0, len(results))
+	for _, result := range results {
+		memo, ok := result.Metadata[vector.KeyMemo].(*store.Memo)
+		if !ok {
+			continue
+		}
+		memos = append(memos, &store.MemoWithScore{Memo: memo, Score: result.Score})
+	}
+	return memos, nil
+}
+
+// listFilteredMemos 按过滤条件列出用户笔记（无检索文本时使用），按时间倒序，分数均为 1
+func (r *AdaptiveRetriever) listFilteredMemos(ctx context.Context, opts *RetrievalOptions) ([]*SearchResult, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	rowStatus := store.Normal
+	memos, err := r.store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &opts.UserID,
+		RowStatus: &rowStatus,
+		Filters:   []string{opts.Filter},
+		Limit:     &limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memos: %w", err)
+	}
+
+	results := make([]*SearchResult, len(memos))
+	for i, memo := range memos {
+		results[i] = &SearchResult{
+			ID:      int64(memo.ID),
+			Type:    "memo",
+			Score:   1,
+			Content: memo.Content,
+			Memo:    memo,
+		}
+	}
+	return results, nil
+}
+
+// Facets 统计与检索条件匹配的全部笔记的分面（标签、创建月份、可见性等）。
+// 按全文匹配、过滤条件和时间范围计算，仅语义相似的笔记不计入。
+func (r *AdaptiveRetriever) Facets(ctx context.Context, opts *RetrievalOptions) (*store.MemoFacets, error) {
+	filter := opts.Filter
+	if opts.TimeRange != nil {
+		filter = joinFilters(filter, timeRangeFilter(r.memoTimeField(ctx), opts.TimeRange))
+	}
+	rowStatus := store.Normal
+	facets, err := r.store.GetMemoFacets(ctx, &store.FindMemoFacets{
+		FindMemo: store.FindMemo{
+			CreatorID: &opts.UserID,
+			RowStatus: &rowStatus,
+			Filters:   memoFilters(filter),
+		},
+		TextQuery:      opts.Query,
+		TimezoneOffset: timezoneOffset(opts.Location),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memo facets: %w", err)
+	}
+	return facets, nil
+}
+
+// timezoneOffset 返回时区当前相对 UTC 的偏移秒数，为空时为 0
+func timezoneOffset(loc *time.Location) int {
+	if loc == nil {
+		return 0
+	}
+	_, offset := time.Now().In(loc).Zone()
+	return offset
+}
+
+// memoFilters 将单个过滤条件转换为 store 过滤条件列表
+func memoFilters(filter string) []string {
+	if filter == "" {
+		return nil
+	}
+	return []string{filter}
+}
+
 // convertVectorResults 转换向量检索结果
 func (r *AdaptiveRetriever) convertVectorResults(results []*store.MemoWithScore) []*SearchResult {
 	searchResults := make([]*SearchResult, len(results))
@@ -2,12 +2,15 @@ package retrieval
 
 import (
 	"context"
+	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/plugin/ai/vector"
 	storepb "github.com/hrygo/divinesense/proto/gen/store"
 	"github.com/hrygo/divinesense/server/queryengine"
 	"github.com/hrygo/divinesense/store"
@@ -407,7 +410,7 @@ func TestAdaptiveRetriever_Retrieve_ScheduleBM25Only(t *testing.T) {
 	mockEmbedding := &MockEmbeddingService{}
 	mockReranker := &MockRerankerService{}
 
-	retriever := NewAdaptiveRetriever(nil, mockEmbedding, mockReranker)
+	retriever := NewAdaptiveRetriever(nil, nil, mockEmbedding, mockReranker)
 
 	// Test that the strategy field is correctly used
 	opts := &RetrievalOptions{
@@ -429,6 +432,100 @@ func TestAdaptiveRetriever_Retrieve_ScheduleBM25Only(t *testing.T) {
 	_ = mockStore
 }
 
+// TestAdaptiveRetriever_Diversify 测试近似重复折叠与 MMR 重排
+func TestAdaptiveRetriever_Diversify(t *testing.T) {
+	ctx := context.Background()
+	vectorService := vector.NewMockVectorService()
+	for id, embedding := range map[int64][]float32{
+		1: {1, 0, 0},
+		2: {0.99, 0.01, 0}, // 与笔记 1 近似重复
+		3: {0, 1, 0},
+	} {
+		assert.NoError(t, vectorService.StoreEmbedding(ctx, vector.FormatDocID(vector.DocTypeMemo, id), embedding, nil))
+	}
+	retriever := NewAdaptiveRetriever(nil, vectorService, &MockEmbeddingService{}, &MockRerankerService{})
+
+	newResults := func() []*SearchResult {
+		return []*SearchResult{
+			{ID: 1, Type: "memo", Score: 0.9},
+			{ID: 2, Type: "memo", Score: 0.8},
+			{ID: 3, Type: "memo", Score: 0.7},
+			{ID: 4, Type: "schedule", Score: 0.6},
+		}
+	}
+
+	opts := &RetrievalOptions{Strategy: "hybrid_standard", Logger: slog.Default()}
+	results := retriever.diversify(ctx, opts, newResults())
+	assert.Len(t, results, 3)
+	assert.Equal(t, int64(1), results[0].ID)
+	assert.Len(t, results[0].Similar, 1)
+	assert.Equal(t, int64(2), results[0].Similar[0].ID)
+
+	// BM25 策略不做多样性重排
+	opts.Strategy = "schedule_bm25_only"
+	assert.Len(t, retriever.diversify(ctx, opts, newResults()), 4)
+}
+
+// TestAdaptiveRetriever_RetrieveMMR 测试多样性重排默认关闭，启用后多取候选以返回 Limit 条结果
+func TestAdaptiveRetriever_RetrieveMMR(t *testing.T) {
+	ctx := context.Background()
+	vectorService := vector.NewMockVectorService()
+	for id, embedding := range map[int32][]float32{
+		1: {1, 0, 0},
+		2: {0.99, 0.01, 0}, // 与笔记 1 近似重复
+		3: {0.6, 0.8, 0},
+		4: {0.5, 0, 0.8},
+	} {
+		metadata := map[string]any{
+			vector.KeyUserID:  int32(9),
+			vector.KeyDocType: vector.DocTypeMemo,
+			vector.KeyMemo:    &store.Memo{ID: id, CreatorID: 9},
+		}
+		assert.NoError(t, vectorService.StoreEmbedding(ctx, vector.FormatDocID(vector.DocTypeMemo, int64(id)), embedding, metadata))
+	}
+	embeddingService := &MockEmbeddingService{}
+	embeddingService.On("Embed", mock.Anything, "query").Return([]float32{1, 0, 0}, nil)
+	retriever := NewAdaptiveRetriever(nil, vectorService, embeddingService, &MockRerankerService{})
+
+	retrieve := func() []*SearchResult {
+		results, err := retriever.Retrieve(ctx, &RetrievalOptions{Query: "query", UserID: 9, Strategy: "memo_semantic_only", Limit: 3})
+		assert.NoError(t, err)
+		return results
+	}
+	ids := func(results []*SearchResult) []int64 {
+		var ids []int64
+		for _, result := range results {
+			ids = append(ids, result.ID)
+		}
+		return ids
+	}
+
+	// 默认关闭：保持相关度顺序，不折叠
+	assert.Equal(t, []int64{1, 2, 3}, ids(retrieve()))
+
+	// 启用后折叠近似重复，仍返回 Limit 条结果
+	retriever.SetMMREnabled(true)
+	results := retrieve()
+	assert.Len(t, results, 3)
+	assert.Equal(t, int64(1), results[0].ID)
+	assert.Equal(t, []int64{2}, ids(results[0].Similar))
+	assert.ElementsMatch(t, []int64{1, 3, 4}, ids(results))
+}
+
+// TestTimeDecay 测试按与时间范围的距离衰减分数
+func TestTimeDecay(t *testing.T) {
+	start := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	tr := &queryengine.TimeRange{Start: start, End: start.AddDate(0, 1, 0)}
+	scale := tr.Duration()
+
+	assert.Equal(t, float32(1), timeDecay(start, tr, scale))
+	assert.Equal(t, float32(1), timeDecay(start.AddDate(0, 0, 15), tr, scale))
+	assert.InDelta(t, 0.5, timeDecay(start.Add(-scale), tr, scale), 0.001)
+	assert.InDelta(t, 1.0/3, timeDecay(tr.End.Add(2*scale), tr, scale), 0.001)
+	assert.Equal(t, "created_ts >= 1772323200 && created_ts < 1775001600", timeRangeFilter("created_ts", tr))
+	assert.Equal(t, "(tag in [\"work\"]) && (pinned)", joinFilters(`tag in ["work"]`, "pinned"))
+}
+
 // TestQualityLevel_String 测试质量级别字符串表示
 func TestQualityLevel_String(t *testing.T) {
 	tests := []struct {
@@ -620,6 +717,15 @@ func TestRRFUniqueResults(t *testing.T) {
 
 // Helper functions for RRF tests
 
+// TestReplaceTerm 测试查询扩展的整词替换
+func TestReplaceTerm(t *testing.T) {
+	assert.Equal(t, "kubernetes deployment", replaceTerm("k8s deployment", "k8s", "kubernetes"))
+	assert.Equal(t, "ask8s deployment", replaceTerm("ask8s deployment", "k8s", "kubernetes"), "英文须整词匹配")
+	assert.Equal(t, "本周weekly sync纪要", replaceTerm("本周周会纪要", "周会", "weekly sync"))
+	assert.Equal(t, "周会", replaceTerm("k8s", "k8s", "周会"))
+	assert.Equal(t, -1, indexTerm("go to", "got"))
+}
+
 func createMockMemo(id int64) *store.Memo {
 	return &store.Memo{
 		ID:      int32(id),
@@ -0,0 +1,305 @@
+package bench
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+
+	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/plugin/ai/vector"
+	"github.com/hrygo/divinesense/plugin/markdown"
+	"github.com/hrygo/divinesense/server/queryengine"
+	"github.com/hrygo/divinesense/server/retrieval"
+	"github.com/hrygo/divinesense/server/runner/memopayload"
+	"github.com/hrygo/divinesense/store"
+)
+
+// RouterStrategy 表示按 QueryRouter 对每个查询的路由决策选择策略，即线上的实际行为
+const RouterStrategy = "router"
+
+// embedBatchSize 每批生成向量的笔记数
+const embedBatchSize = 32
+
+// Strategies 默认评测的全部策略
+var Strategies = []string{
+	RouterStrategy,
+	"memo_semantic_only",
+	"hybrid_bm25_weighted",
+	"hybrid_standard",
+	"hybrid_with_time_filter",
+	"full_pipeline_with_reranker",
+	"schedule_bm25_only",
+}
+
+// Bench 检索评测器
+type Bench struct {
+	store     *store.Store
+	vector    vector.VectorService
+	embedding ai.EmbeddingService // 可选；未配置时语义检索不可用，混合检索退化为 BM25
+	retriever *retrieval.AdaptiveRetriever
+	router    *queryengine.QueryRouter
+	markdown  markdown.Service
+	logger    *slog.Logger // 丢弃检索器的逐查询日志
+}
+
+// Corpus 已导入临时存储的数据集
+type Corpus struct {
+	UserID  int32
+	memoIDs map[int32]string // 存储 ID -> 数据集 ID
+}
+
+// Options 评测选项
+type Options struct {
+	K          int      // 评测的结果数，默认 10
+	Strategies []string // 默认 Strategies
+}
+
+// StrategyReport 单个策略的评测结果
+type StrategyReport struct {
+	Strategy    string        `json:"strategy"`
+	Queries     int           `json:"queries"`
+	Errors      int           `json:"errors"`                // 失败的查询按零分计入指标
+	FirstError  string        `json:"first_error,omitempty"` // 第一个失败查询的错误
+	Recall      float64       `json:"recall"`
+	MRR         float64       `json:"mrr"`
+	NDCG        float64       `json:"ndcg"`
+	MeanLatency time.Duration `json:"mean_latency"`
+	P95Latency  time.Duration `json:"p95_latency"`
+}
+
+// Report 评测报告
+type Report struct {
+	K          int               `json:"k"`
+	Memos      int               `json:"memos"`
+	Strategies []*StrategyReport `json:"strategies"`
+}
+
+// New 创建评测器。embedding 和 reranker 可为 nil。
+func New(st *store.Store, vectorService vector.VectorService, embedding ai.EmbeddingService, reranker ai.RerankerService) *Bench {
+	retrieverEmbedding := embedding
+	if retrieverEmbedding == nil {
+		retrieverEmbedding = unavailableEmbedding{}
+	}
+	return &Bench{
+		store:     st,
+		vector:    vectorService,
+		embedding: embedding,
+		retriever: retrieval.NewAdaptiveRetriever(st, vectorService, retrieverEmbedding, reranker),
+		router:    queryengine.NewQueryRouter(),
+		markdown:  markdown.NewService(markdown.WithTagExtension()),
+		logger:    slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+// Load 以临时用户导入数据集的笔记，并在配置了向量模型时生成笔记向量
+func (b *Bench) Load(ctx context.Context, ds *Dataset) (*Corpus, error) {
+	user, err := b.store.CreateUser(ctx, &store.User{
+		Username: "retrieval-bench-" + shortuuid.New()[:8],
+		Role:     store.RoleUser,
+		Nickname: "Retrieval Bench",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bench user: %w", err)
+	}
+
+	corpus := &Corpus{UserID: user.ID, memoIDs: make(map[int32]string, len(ds.Memos))}
+	memos := make([]*store.Memo, 0, len(ds.Memos))
+	for _, m := range ds.Memos {
+		createdTs, _ := m.createdTs()
+		memo := &store.Memo{
+			UID:        shortuuid.New(),
+			CreatorID:  user.ID,
+			Content:    m.Content,
+			Visibility: store.Private,
+			CreatedTs:  createdTs,
+			UpdatedTs:  createdTs,
+		}
+		if err := memopayload.RebuildMemoPayload(memo, b.markdown); err != nil {
+			return corpus, fmt.Errorf("failed to build payload of memo %q: %w", m.ID, err)
+		}
+		memo, err := b.store.CreateMemo(ctx, memo)
+		if err != nil {
+			return corpus, fmt.Errorf("failed to create memo %q: %w", m.ID, err)
+		}
+		corpus.memoIDs[memo.ID] = m.ID
+		memos = append(memos, memo)
+	}
+
+	if b.embedding == nil || b.vector == nil {
+		return corpus, nil
+	}
+	for start := 0; start < len(memos); start += embedBatchSize {
+		batch := memos[start:min(start+embedBatchSize, len(memos))]
+		texts := make([]string, len(batch))
+		for i, memo := range batch {
+			texts[i] = memo.Content
+		}
+		vectors, err := b.embedding.EmbedBatch(ctx, texts)
+		if err != nil {
+			return corpus, fmt.Errorf("failed to embed memos: %w", err)
+		}
+		for i, memo := range batch {
+			if err := b.vector.StoreEmbedding(ctx, vector.FormatDocID(vector.DocTypeMemo, int64(memo.ID)), vectors[i], nil); err != nil {
+				return corpus, fmt.Errorf("failed to store embedding of memo %q: %w", corpus.memoIDs[memo.ID], err)
+			}
+		}
+	}
+	return corpus, nil
+}
+
+// Cleanup 删除导入的笔记和临时用户
+func (b *Bench) Cleanup(ctx context.Context, corpus *Corpus) error {
+	if corpus == nil {
+		return nil
+	}
+	for id := range corpus.memoIDs {
+		if err := b.store.DeleteMemo(ctx, &store.DeleteMemo{ID: id}); err != nil {
+			return fmt.Errorf("failed to delete bench memo: %w", err)
+		}
+	}
+	return b.store.DeleteUser(ctx, &store.DeleteUser{ID: corpus.UserID})
+}
+
+// Run 对每个策略执行全部查询并汇总指标
+func (b *Bench) Run(ctx context.Context, corpus *Corpus, ds *Dataset, opts Options) (*Report, error) {
+	if opts.K <= 0 {
+		opts.K = 10
+	}
+	if len(opts.Strategies) == 0 {
+		opts.Strategies = Strategies
+	}
+
+	report := &Report{K: opts.K, Memos: len(ds.Memos)}
+	for _, strategy := range opts.Strategies {
+		sr := &StrategyReport{Strategy: strategy, Queries: len(ds.Queries)}
+		latencies := make([]time.Duration, 0, len(ds.Queries))
+		for _, query := range ds.Queries {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			start := time.Now()
+			ranked, err := b.retrieve(ctx, corpus, strategy, query.Query, opts.K)
+			latencies = append(latencies, time.Since(start))
+			if err != nil {
+				if sr.Errors == 0 {
+					sr.FirstError = err.Error()
+				}
+				sr.Errors++
+			}
+
+			relevant := make(map[string]bool, len(query.Relevant))
+			for _, id := range query.Relevant {
+				relevant[id] = true
+			}
+			sr.Recall += RecallAtK(ranked, relevant, opts.K)
+			sr.MRR += ReciprocalRank(ranked, relevant, opts.K)
+			sr.NDCG += NDCGAtK(ranked, relevant, opts.K)
+		}
+
+		n := float64(len(ds.Queries))
+		sr.Recall, sr.MRR, sr.NDCG = sr.Recall/n, sr.MRR/n, sr.NDCG/n
+		sr.MeanLatency, sr.P95Latency = latencyStats(latencies)
+		report.Strategies = append(report.Strategies, sr)
+	}
+	return report, nil
+}
+
+// retrieve 执行一次检索，返回排序后的数据集笔记 ID
+func (b *Bench) retrieve(ctx context.Context, corpus *Corpus, strategy, query string, k int) ([]string, error) {
+	opts := &retrieval.RetrievalOptions{
+		Query:    query,
+		UserID:   corpus.UserID,
+		Strategy: strategy,
+		Limit:    k,
+		Logger:   b.logger,
+	}
+	if strategy == RouterStrategy {
+		decision := b.router.Route(ctx, query, time.UTC)
+		opts.Strategy = decision.Strategy
+		opts.TimeRange = decision.TimeRange
+		opts.ScheduleQueryMode = decision.ScheduleQueryMode
+	}
+
+	results, err := b.retriever.Retrieve(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	ranked := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.Type != "memo" {
+			continue
+		}
+		if id, ok := corpus.memoIDs[int32(result.ID)]; ok {
+			ranked = append(ranked, id)
+		}
+	}
+	return ranked, nil
+}
+
+// WriteText 以表格输出报告
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "STRATEGY\tQUERIES\tERRORS\tRECALL@%d\tMRR@%d\tNDCG@%d\tMEAN\tP95\n", r.K, r.K, r.K)
+	for _, s := range r.Strategies {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.3f\t%.3f\t%.3f\t%s\t%s\n",
+			s.Strategy, s.Queries, s.Errors, s.Recall, s.MRR, s.NDCG,
+			s.MeanLatency.Round(time.Millisecond), s.P95Latency.Round(time.Millisecond))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, s := range r.Strategies {
+		if s.FirstError != "" {
+			fmt.Fprintf(w, "\n%s: %d error(s), first: %s", s.Strategy, s.Errors, s.FirstError)
+		}
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// WriteJSON 以 JSON 输出报告，延迟单位为纳秒
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// latencyStats 返回平均延迟和 P95 延迟
+func latencyStats(latencies []time.Duration) (time.Duration, time.Duration) {
+	if len(latencies) == 0 {
+		return 0, 0
+	}
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, latency := range sorted {
+		total += latency
+	}
+	p95 := sorted[(len(sorted)*95+99)/100-1]
+	return total / time.Duration(len(sorted)), p95
+}
+
+// unavailableEmbedding 在未配置向量模型时代替 EmbeddingService，
+// 使语义检索返回错误、混合检索退化为 BM25
+type unavailableEmbedding struct{}
+
+func (unavailableEmbedding) Embed(context.Context, string) ([]float32, error) {
+	return nil, fmt.Errorf("embedding service is not configured")
+}
+
+func (unavailableEmbedding) EmbedBatch(context.Context, []string) ([][]float32, error) {
+	return nil, fmt.Errorf("embedding service is not configured")
+}
+
+func (unavailableEmbedding) Dimensions() int {
+	return 0
+}
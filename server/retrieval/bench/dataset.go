@@ -0,0 +1,100 @@
+// Package bench 基于标注数据集评测 AdaptiveRetriever 各检索策略的质量与延迟。
+//
+// 数据集包含笔记和查询，每个查询标注相关笔记。评测时笔记写入临时存储，
+// 每个策略执行全部查询，报告 recall@k、MRR、nDCG@k 和延迟，
+// 用于以数据驱动权重、阈值和 Reranker 开关的调整。
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Dataset 标注数据集
+//
+//	{
+//	  "memos": [{"id": "okr", "content": "Q3 OKR: ...", "created_at": "2026-03-01"}],
+//	  "queries": [{"query": "第三季度目标", "relevant": ["okr"]}]
+//	}
+type Dataset struct {
+	Memos   []*DatasetMemo  `json:"memos"`
+	Queries []*DatasetQuery `json:"queries"`
+}
+
+// DatasetMemo 数据集中的笔记
+type DatasetMemo struct {
+	ID        string `json:"id"`                   // 数据集内唯一标识，供查询标注引用
+	Content   string `json:"content"`              // 笔记内容（Markdown）
+	CreatedAt string `json:"created_at,omitempty"` // 创建时间，YYYY-MM-DD 或 RFC 3339，默认为导入时间
+}
+
+// DatasetQuery 带相关笔记标注的查询
+type DatasetQuery struct {
+	Query    string   `json:"query"`
+	Relevant []string `json:"relevant"` // 相关笔记的 ID
+}
+
+// LoadDataset 读取并校验 JSON 数据集
+func LoadDataset(path string) (*Dataset, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dataset: %w", err)
+	}
+	var ds Dataset
+	if err := json.Unmarshal(data, &ds); err != nil {
+		return nil, fmt.Errorf("failed to parse dataset: %w", err)
+	}
+	if err := ds.Validate(); err != nil {
+		return nil, err
+	}
+	return &ds, nil
+}
+
+// Validate 校验笔记 ID 唯一、时间可解析、查询标注引用的笔记存在
+func (ds *Dataset) Validate() error {
+	if len(ds.Memos) == 0 || len(ds.Queries) == 0 {
+		return fmt.Errorf("dataset needs at least one memo and one query")
+	}
+	ids := make(map[string]bool, len(ds.Memos))
+	for i, memo := range ds.Memos {
+		if memo.ID == "" || strings.TrimSpace(memo.Content) == "" {
+			return fmt.Errorf("memo %d: id and content are required", i)
+		}
+		if ids[memo.ID] {
+			return fmt.Errorf("memo %q: duplicate id", memo.ID)
+		}
+		if _, err := memo.createdTs(); err != nil {
+			return fmt.Errorf("memo %q: %w", memo.ID, err)
+		}
+		ids[memo.ID] = true
+	}
+	for i, query := range ds.Queries {
+		if strings.TrimSpace(query.Query) == "" || len(query.Relevant) == 0 {
+			return fmt.Errorf("query %d: query and relevant are required", i)
+		}
+		for _, id := range query.Relevant {
+			if !ids[id] {
+				return fmt.Errorf("query %q: unknown relevant memo %q", query.Query, id)
+			}
+		}
+	}
+	return nil
+}
+
+// createdTs 解析创建时间，未设置时返回 0
+func (m *DatasetMemo) createdTs() (int64, error) {
+	if m.CreatedAt == "" {
+		return 0, nil
+	}
+	if t, err := time.Parse("2006-01-02", m.CreatedAt); err == nil {
+		return t.Unix(), nil
+	}
+	t, err := time.Parse(time.RFC3339, m.CreatedAt)
+	if err != nil {
+		return 0, fmt.Errorf("invalid created_at %q, expected YYYY-MM-DD or RFC 3339", m.CreatedAt)
+	}
+	return t.Unix(), nil
+}
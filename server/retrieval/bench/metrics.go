@@ -0,0 +1,53 @@
+package bench
+
+import "math"
+
+// RecallAtK 返回前 k 个结果覆盖的相关笔记比例
+func RecallAtK(ranked []string, relevant map[string]bool, k int) float64 {
+	if len(relevant) == 0 {
+		return 0
+	}
+	hits := 0
+	for i, id := range ranked {
+		if i >= k {
+			break
+		}
+		if relevant[id] {
+			hits++
+		}
+	}
+	return float64(hits) / float64(len(relevant))
+}
+
+// ReciprocalRank 返回前 k 个结果中第一个相关笔记排名的倒数，没有命中时为 0
+func ReciprocalRank(ranked []string, relevant map[string]bool, k int) float64 {
+	for i, id := range ranked {
+		if i >= k {
+			break
+		}
+		if relevant[id] {
+			return 1 / float64(i+1)
+		}
+	}
+	return 0
+}
+
+// NDCGAtK 返回二元相关度下前 k 个结果的归一化折损累计增益
+func NDCGAtK(ranked []string, relevant map[string]bool, k int) float64 {
+	var dcg, idcg float64
+	for i, id := range ranked {
+		if i >= k {
+			break
+		}
+		if relevant[id] {
+			dcg += 1 / math.Log2(float64(i+2))
+		}
+	}
+	for i := 0; i < len(relevant) && i < k; i++ {
+		idcg += 1 / math.Log2(float64(i+2))
+	}
+	if idcg == 0 {
+		return 0
+	}
+	return dcg / idcg
+}
@@ -0,0 +1,23 @@
+package bench
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	relevant := map[string]bool{"a": true, "b": true}
+	ranked := []string{"x", "a", "y", "b"}
+
+	assert.Equal(t, 0.5, RecallAtK(ranked, relevant, 2))
+	assert.Equal(t, 1.0, RecallAtK(ranked, relevant, 10))
+	assert.Equal(t, 0.5, ReciprocalRank(ranked, relevant, 10))
+	assert.Equal(t, 0.0, ReciprocalRank(ranked, relevant, 1))
+
+	want := (1/math.Log2(3) + 1/math.Log2(5)) / (1 + 1/math.Log2(3))
+	assert.InDelta(t, want, NDCGAtK(ranked, relevant, 10), 1e-9)
+	assert.Equal(t, 1.0, NDCGAtK([]string{"b", "a"}, relevant, 10))
+	assert.Equal(t, 0.0, NDCGAtK(nil, relevant, 10))
+}
@@ -0,0 +1,101 @@
+package retrieval
+
+import (
+	"context"
+
+	"github.com/hrygo/divinesense/plugin/ai/rag"
+	"github.com/hrygo/divinesense/plugin/ai/vector"
+)
+
+// ragStrategies 检索策略对应的 rag 策略，决定多样性重排配置
+var ragStrategies = map[string]rag.SearchStrategy{
+	"schedule_bm25_only":          rag.StrategyBM25Only,
+	"memo_semantic_only":          rag.StrategySemanticOnly,
+	"hybrid_bm25_weighted":        rag.StrategyHybridBM25Heavy,
+	"hybrid_with_time_filter":     rag.StrategyHybridStandard,
+	"hybrid_standard":             rag.StrategyHybridStandard,
+	"full_pipeline_with_reranker": rag.StrategyFullPipeline,
+}
+
+// mmrOverfetch 多样性重排时候选结果数量相对 Limit 的倍数，用于弥补折叠掉的近似重复结果
+const mmrOverfetch = 2
+
+// SetMMREnabled 设置是否启用多样性重排（默认关闭，见 profile.AIMMREnabled）。
+// 启用后，配置了 UseMMR 的检索策略会折叠近似重复笔记并按 MMR 重新排序。
+func (r *AdaptiveRetriever) SetMMREnabled(enabled bool) {
+	r.mmrEnabled = enabled
+}
+
+// diversifies 判断本次检索是否做多样性重排
+func (r *AdaptiveRetriever) diversifies(opts *RetrievalOptions) bool {
+	return r.mmrEnabled && strategyConfig(opts.Strategy).UseMMR
+}
+
+// strategyConfig 返回检索策略对应的 rag 策略配置
+func strategyConfig(strategy string) rag.StrategyConfig {
+	ragStrategy, ok := ragStrategies[strategy]
+	if !ok {
+		ragStrategy = rag.StrategyHybridStandard
+	}
+	return rag.GetStrategyConfig(ragStrategy)
+}
+
+// diversify 多样性重排：使用已存储的笔记向量，将相似度超过阈值的近似重复笔记
+// 折叠到排名最高的一条（见 SearchResult.Similar），再按 MMR 重新排序。
+// 配置见 rag.StrategyConfig；向量服务无法读取向量（如 SQLite）时保持原顺序。
+func (r *AdaptiveRetriever) diversify(ctx context.Context, opts *RetrievalOptions, results []*SearchResult) []*SearchResult {
+	config := strategyConfig(opts.Strategy)
+	if !config.UseMMR || len(results) < 2 {
+		return results
+	}
+	reader, ok := r.vectorService.(vector.EmbeddingReader)
+	if !ok {
+		return results
+	}
+
+	docIDs := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.Type == "memo" {
+			docIDs = append(docIDs, vector.FormatDocID(vector.DocTypeMemo, result.ID))
+		}
+	}
+	embeddings, err := reader.GetEmbeddings(ctx, docIDs)
+	if err != nil {
+		opts.Logger.DebugContext(ctx, "Skipping diversity re-ranking",
+			"request_id", opts.RequestID,
+			"error", err,
+		)
+		return results
+	}
+	if len(embeddings) == 0 {
+		return results
+	}
+
+	// 日程等没有向量的结果不会被折叠
+	candidates := make([]rag.MMRCandidate, len(results))
+	for i, result := range results {
+		candidates[i] = rag.MMRCandidate{Score: result.Score}
+		if result.Type == "memo" {
+			candidates[i].Embedding = embeddings[vector.FormatDocID(vector.DocTypeMemo, result.ID)]
+		}
+	}
+
+	selections := rag.Diversify(candidates, config)
+	diversified := make([]*SearchResult, 0, len(selections))
+	collapsed := 0
+	for _, selection := range selections {
+		result := results[selection.Index]
+		for _, index := range selection.Duplicates {
+			result.Similar = append(result.Similar, results[index])
+		}
+		collapsed += len(selection.Duplicates)
+		diversified = append(diversified, result)
+	}
+
+	opts.Logger.DebugContext(ctx, "Diversity re-ranking completed",
+		"request_id", opts.RequestID,
+		"result_count", len(diversified),
+		"collapsed_count", collapsed,
+	)
+	return diversified
+}
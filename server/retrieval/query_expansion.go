@@ -0,0 +1,274 @@
+package retrieval
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hrygo/divinesense/plugin/ai/graph"
+	"github.com/hrygo/divinesense/store"
+)
+
+// 查询扩展词来源
+const (
+	ExpansionSourceSynonym = "synonym" // 用户设置的检索同义词
+	ExpansionSourceTag     = "tag"     // 与查询中的标签共现的标签
+	ExpansionSourceKeyword = "keyword" // 用户常用的检索关键词
+)
+
+const (
+	maxExpansions  = 6                // 每个查询最多的扩展词
+	maxRelatedTags = 2                // 每个标签最多扩展的共现标签
+	minSharedMemos = 2                // 共现标签至少共同出现的笔记数
+	tagGraphTTL    = 10 * time.Minute // 标签共现图缓存时间
+	bm25Limit      = 20               // 混合检索中 BM25 的候选数
+	bm25MinScore   = 0.1              // 混合检索中 BM25 的最低分数
+)
+
+// expansionWeights 扩展检索的 BM25 分数按来源降权，直接匹配的笔记排在前面
+var expansionWeights = map[string]float32{
+	ExpansionSourceSynonym: 0.8,
+	ExpansionSourceTag:     0.5,
+	ExpansionSourceKeyword: 0.3,
+}
+
+// ExpandedTerm 查询扩展词
+type ExpandedTerm struct {
+	Term     string // 扩展词（小写）
+	Original string // 查询中被扩展词替换的部分（小写）
+	Source   string // 来源，见 ExpansionSource*
+}
+
+// KeywordSuggester 根据用户习惯推荐与查询相关的关键词，见 habit.HabitApplier.EnhanceSearchQuery
+type KeywordSuggester interface {
+	EnhanceSearchQuery(ctx context.Context, userID int32, query string) []string
+}
+
+// cachedTagGraph 缓存的用户标签共现图
+type cachedTagGraph struct {
+	graph   *graph.TagGraph
+	builtAt time.Time
+}
+
+// SetKeywordSuggester 设置常用关键词来源，未设置时查询扩展不使用常用关键词
+func (r *AdaptiveRetriever) SetKeywordSuggester(suggester KeywordSuggester) {
+	r.keywordSuggester = suggester
+}
+
+// UsesExpansions 判断检索策略是否使用查询扩展词，即是否包含笔记的 BM25 检索
+func UsesExpansions(strategy string) bool {
+	switch strategy {
+	case "memo_semantic_only", "schedule_bm25_only":
+		return false
+	default:
+		return true
+	}
+}
+
+// ExpandQuery 用用户自己的词汇扩展查询，按优先级依次取：
+// 1. 用户设置的检索同义词（"k8s" ↔ "kubernetes"）
+// 2. 与查询中的标签经常一起使用的标签
+// 3. 与查询相关的常用检索关键词，替换整个查询
+// 扩展词只作用于 BM25（见 RetrievalOptions.Expansions）；读取失败的来源被跳过，不影响检索。
+func (r *AdaptiveRetriever) ExpandQuery(ctx context.Context, userID int32, query string) []ExpandedTerm {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" || r.store == nil {
+		return nil
+	}
+
+	var expansions []ExpandedTerm
+	seen := make(map[string]bool)
+	add := func(term, original, source string) {
+		term = strings.ToLower(strings.TrimSpace(term))
+		if term == "" || seen[term] || len(expansions) >= maxExpansions || indexTerm(query, term) >= 0 {
+			return
+		}
+		seen[term] = true
+		expansions = append(expansions, ExpandedTerm{Term: term, Original: original, Source: source})
+	}
+
+	groups, err := r.store.GetUserSearchSynonyms(ctx, userID)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get search synonyms", "user_id", userID, "error", err)
+	}
+	for _, group := range groups {
+		for _, original := range group.Terms {
+			original = strings.ToLower(strings.TrimSpace(original))
+			if original == "" || indexTerm(query, original) < 0 {
+				continue
+			}
+			for _, term := range group.Terms {
+				add(term, original, ExpansionSourceSynonym)
+			}
+		}
+	}
+
+	tagGraph, err := r.tagGraph(ctx, userID)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to build tag co-occurrence graph", "user_id", userID, "error", err)
+	} else {
+		for _, tag := range tagGraph.Tags() {
+			// 单字标签容易误匹配
+			if utf8.RuneCountInString(tag) < 2 || indexTerm(query, tag) < 0 {
+				continue
+			}
+			for _, related := range tagGraph.Related(tag, minSharedMemos, maxRelatedTags) {
+				add(related.Tag, tag, ExpansionSourceTag)
+			}
+		}
+	}
+
+	if r.keywordSuggester != nil {
+		for _, keyword := range r.keywordSuggester.EnhanceSearchQuery(ctx, userID, query) {
+			add(keyword, query, ExpansionSourceKeyword)
+		}
+	}
+	return expansions
+}
+
+// tagGraph 返回用户的标签共现图，缓存 tagGraphTTL
+func (r *AdaptiveRetriever) tagGraph(ctx context.Context, userID int32) (*graph.TagGraph, error) {
+	r.tagGraphMu.Lock()
+	cached, ok := r.tagGraphs[userID]
+	r.tagGraphMu.Unlock()
+	if ok && time.Since(cached.builtAt) < tagGraphTTL {
+		return cached.graph, nil
+	}
+
+	rowStatus := store.Normal
+	memos, err := r.store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:      &userID,
+		RowStatus:      &rowStatus,
+		ExcludeContent: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tagGraph := graph.BuildTagGraph(memos)
+
+	r.tagGraphMu.Lock()
+	if r.tagGraphs == nil {
+		r.tagGraphs = make(map[int32]*cachedTagGraph)
+	}
+	r.tagGraphs[userID] = &cachedTagGraph{graph: tagGraph, builtAt: time.Now()}
+	r.tagGraphMu.Unlock()
+	return tagGraph, nil
+}
+
+// memoBM25Search BM25 检索笔记。有扩展词时，将查询中的原词替换为扩展词再检索一次，
+// 分数按来源降权（见 expansionWeights）后与原查询的结果合并，同一笔记取最高分。
+// 不同查询的 BM25 分数不可直接比较，扩展检索的分数先按原查询的最高分缩放，
+// 使扩展检索的最佳结果为原查询最佳结果的 weight 倍。
+func (r *AdaptiveRetriever) memoBM25Search(ctx context.Context, opts *RetrievalOptions) ([]*store.BM25Result, error) {
+	search := func(query string) ([]*store.BM25Result, error) {
+		return r.store.BM25Search(ctx, &store.BM25SearchOptions{
+			UserID:   opts.UserID,
+			Query:    query,
+			Limit:    bm25Limit,
+			MinScore: bm25MinScore,
+			Filters:  memoFilters(opts.Filter),
+		})
+	}
+	results, err := search(opts.Query)
+	if err != nil || len(opts.Expansions) == 0 {
+		return results, err
+	}
+
+	best := make(map[int32]*store.BM25Result, len(results))
+	var topScore float32
+	for _, result := range results {
+		best[result.Memo.ID] = result
+		topScore = max(topScore, result.Score)
+	}
+	query := strings.ToLower(opts.Query)
+	for _, expansion := range opts.Expansions {
+		expanded := replaceTerm(query, expansion.Original, expansion.Term)
+		if expanded == query {
+			continue
+		}
+		expandedResults, err := search(expanded)
+		if err != nil {
+			opts.Logger.WarnContext(ctx, "Expanded BM25 search failed",
+				"request_id", opts.RequestID,
+				"term", expansion.Term,
+				"error", err,
+			)
+			continue
+		}
+		var expandedTop float32
+		for _, result := range expandedResults {
+			expandedTop = max(expandedTop, result.Score)
+		}
+		weight := expansionWeights[expansion.Source]
+		if topScore > 0 && expandedTop > 0 {
+			weight *= topScore / expandedTop
+		}
+		for _, result := range expandedResults {
+			score := result.Score * weight
+			if existing, ok := best[result.Memo.ID]; ok && existing.Score >= score {
+				continue
+			}
+			best[result.Memo.ID] = &store.BM25Result{Memo: result.Memo, Score: score}
+		}
+	}
+
+	merged := make([]*store.BM25Result, 0, len(best))
+	for _, result := range best {
+		merged = append(merged, result)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		if merged[i].Score != merged[j].Score {
+			return merged[i].Score > merged[j].Score
+		}
+		return merged[i].Memo.UpdatedTs > merged[j].Memo.UpdatedTs
+	})
+	if len(merged) > bm25Limit {
+		merged = merged[:bm25Limit]
+	}
+
+	opts.Logger.DebugContext(ctx, "Expanded BM25 search completed",
+		"request_id", opts.RequestID,
+		"expansion_count", len(opts.Expansions),
+		"direct_count", len(results),
+		"merged_count", len(merged),
+	)
+	return merged, nil
+}
+
+// indexTerm 返回 term 在 text 中的位置，未找到时为 -1。
+// 以字母或数字开头、结尾的英文词须整词匹配，中文不要求词边界。
+func indexTerm(text, term string) int {
+	if term == "" {
+		return -1
+	}
+	for offset := 0; offset < len(text); {
+		i := strings.Index(text[offset:], term)
+		if i < 0 {
+			return -1
+		}
+		start, end := offset+i, offset+i+len(term)
+		if !(isASCIIWordByte(term[0]) && start > 0 && isASCIIWordByte(text[start-1])) &&
+			!(isASCIIWordByte(term[len(term)-1]) && end < len(text) && isASCIIWordByte(text[end])) {
+			return start
+		}
+		offset = start + 1
+	}
+	return -1
+}
+
+// replaceTerm 将 text 中第一处整词匹配的 original 替换为 term
+func replaceTerm(text, original, term string) string {
+	i := indexTerm(text, original)
+	if i < 0 {
+		return text
+	}
+	return text[:i] + term + text[i+len(original):]
+}
+
+// isASCIIWordByte 判断是否为英文字母或数字
+func isASCIIWordByte(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
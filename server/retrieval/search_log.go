@@ -0,0 +1,27 @@
+package retrieval
+
+import (
+	"context"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+// SearchRecorder 记录用户的检索，用于检索洞察，见 searchlog.Service
+type SearchRecorder interface {
+	// Record 保存检索日志，返回日志 ID；用户关闭了检索日志或保存失败时返回 0
+	Record(ctx context.Context, log *store.SearchLog) int64
+}
+
+// SetSearchRecorder 设置检索日志记录器，未设置时不记录检索
+func (r *AdaptiveRetriever) SetSearchRecorder(recorder SearchRecorder) {
+	r.searchRecorder = recorder
+}
+
+// RecordSearch 记录一次检索，返回日志 ID，未记录时为 0。
+// 由调用方在检索完成后调用，记录失败不影响检索。
+func (r *AdaptiveRetriever) RecordSearch(ctx context.Context, log *store.SearchLog) int64 {
+	if r.searchRecorder == nil {
+		return 0
+	}
+	return r.searchRecorder.Record(ctx, log)
+}
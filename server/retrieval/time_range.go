@@ -0,0 +1,115 @@
+package retrieval
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hrygo/divinesense/server/queryengine"
+	"github.com/hrygo/divinesense/store"
+)
+
+// minTimeDecayScale 时间衰减的最小尺度，避免“昨天”等短范围外的笔记分数骤降
+const minTimeDecayScale = 7 * 24 * time.Hour
+
+// retrieveInTimeRange 在时间范围内检索笔记
+// 范围内没有匹配的笔记时，去掉时间过滤重新检索，按笔记与时间范围的距离衰减分数：
+// 超出一个尺度（时间范围长度，至少 7 天）分数减半，超出两个尺度剩三分之一，以此类推。
+func (r *AdaptiveRetriever) retrieveInTimeRange(ctx context.Context, opts *RetrievalOptions) ([]*SearchResult, error) {
+	if !opts.TimeRange.End.After(opts.TimeRange.Start) {
+		return nil, fmt.Errorf("invalid time range: start=%v, end=%v", opts.TimeRange.Start, opts.TimeRange.End)
+	}
+
+	field := r.memoTimeField(ctx)
+	ranged := *opts
+	ranged.Filter = joinFilters(opts.Filter, timeRangeFilter(field, opts.TimeRange))
+	results, err := r.retrieve(ctx, &ranged)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(opts.Query) == "" || containsMemo(results) {
+		return results, nil
+	}
+
+	opts.Logger.InfoContext(ctx, "No memos in time range, decaying scores by time distance",
+		"request_id", opts.RequestID,
+		"time_range", opts.TimeRange.Label,
+	)
+	results, err = r.retrieve(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	scale := max(opts.TimeRange.Duration(), minTimeDecayScale)
+	for _, result := range results {
+		if result.Memo != nil {
+			result.Score *= timeDecay(memoTime(result.Memo, field), opts.TimeRange, scale)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	return results, nil
+}
+
+// memoTimeField 返回笔记显示时间对应的过滤字段：实例设置为按更新时间显示时为 updated_ts，否则为 created_ts
+func (r *AdaptiveRetriever) memoTimeField(ctx context.Context) string {
+	if r.store == nil {
+		return "created_ts"
+	}
+	setting, err := r.store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil || !setting.DisplayWithUpdateTime {
+		return "created_ts"
+	}
+	return "updated_ts"
+}
+
+// timeRangeFilter 将时间范围转换为 CEL 笔记过滤条件
+func timeRangeFilter(field string, tr *queryengine.TimeRange) string {
+	return fmt.Sprintf("%s >= %d && %s < %d", field, tr.Start.Unix(), field, tr.End.Unix())
+}
+
+// joinFilters 合并两个 CEL 过滤条件
+func joinFilters(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	default:
+		return "(" + a + ") && (" + b + ")"
+	}
+}
+
+// memoTime 返回笔记在 field 字段上的时间
+func memoTime(memo *store.Memo, field string) time.Time {
+	if field == "updated_ts" {
+		return time.Unix(memo.UpdatedTs, 0)
+	}
+	return time.Unix(memo.CreatedTs, 0)
+}
+
+// timeDecay 返回时间衰减系数：范围内为 1，范围外为 1 / (1 + 距离/尺度)
+func timeDecay(t time.Time, tr *queryengine.TimeRange, scale time.Duration) float32 {
+	var distance time.Duration
+	switch {
+	case t.Before(tr.Start):
+		distance = tr.Start.Sub(t)
+	case !t.Before(tr.End):
+		distance = t.Sub(tr.End)
+	default:
+		return 1
+	}
+	return float32(1 / (1 + float64(distance)/float64(scale)))
+}
+
+// containsMemo 判断结果中是否有笔记
+func containsMemo(results []*SearchResult) bool {
+	for _, result := range results {
+		if result.Type == "memo" {
+			return true
+		}
+	}
+	return false
+}
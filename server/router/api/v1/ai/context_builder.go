@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"sync"
 
+	"github.com/hrygo/divinesense/plugin/ai/tokenizer"
 	"github.com/hrygo/divinesense/store"
 )
 
@@ -22,12 +23,14 @@ type MessageStore interface {
 }
 
 // TokenCounter estimates token count for a string.
+// tokenizer.Tokenizer implementations satisfy it.
 type TokenCounter interface {
 	CountTokens(text string) int
 }
 
 // SimpleTokenCounter provides a rough token estimation.
-// Approximately 4 characters per token for English text.
+// Approximately 4 characters per token for English text; it undercounts Chinese.
+// Prefer tokenizer.ForModel.
 type SimpleTokenCounter struct{}
 
 func (s *SimpleTokenCounter) CountTokens(text string) int {
@@ -70,21 +73,48 @@ type BuiltContext struct {
 // a race condition: the next message may be sent before the previous
 // message is written to the database.
 type ContextBuilder struct {
-	store       MessageStore
+	store        MessageStore
 	tokenCounter TokenCounter
-	maxTokens   int // Default max tokens (approx 8000 for most models)
-	mu          sync.RWMutex
+	maxTokens    int // Default max tokens (approx 8000 for most models)
+	mu           sync.RWMutex
 }
 
+// historyBudgetRatio is the share of a model's input budget given to conversation history.
+// The rest is left for the system prompt, retrieval results and tool output.
+const historyBudgetRatio = 0.5
+
 // NewContextBuilder creates a new ContextBuilder.
 func NewContextBuilder(store MessageStore) *ContextBuilder {
 	return &ContextBuilder{
-		store:       store,
-		tokenCounter: &SimpleTokenCounter{},
-		maxTokens:   8000, // Default context window
+		store:        store,
+		tokenCounter: tokenizer.Default(),
+		maxTokens:    8000, // Default context window
 	}
 }
 
+// SetModel counts tokens with the model's tokenizer and sizes the history
+// budget from its context window.
+func (b *ContextBuilder) SetModel(model string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokenCounter = tokenizer.ForModel(model)
+	b.maxTokens = int(float64(tokenizer.InputBudget(model)) * historyBudgetRatio)
+}
+
+// MaxTokens returns the default maximum token count.
+func (b *ContextBuilder) MaxTokens() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.maxTokens
+}
+
+// TokenCounter returns the token counter in use.
+func (b *ContextBuilder) TokenCounter() TokenCounter {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.tokenCounter
+}
+
 // SetMaxTokens sets the default maximum token count.
 func (b *ContextBuilder) SetMaxTokens(max int) {
 	b.mu.Lock()
@@ -105,6 +135,7 @@ func (b *ContextBuilder) BuildContext(
 ) (*BuiltContext, error) {
 	b.mu.RLock()
 	maxTokens := b.maxTokens
+	tokenCounter := b.tokenCounter
 	b.mu.RUnlock()
 
 	// Apply control overrides
@@ -185,7 +216,7 @@ func (b *ContextBuilder) BuildContext(
 	}
 
 	// 7. Apply token limit (truncate from oldest)
-	truncated := b.truncateByTokens(contents, maxTokens)
+	truncated := truncateByTokens(tokenCounter, contents, maxTokens)
 	wasTruncated := len(truncated) < len(contents)
 
 	// 8. Apply message count limit
@@ -197,7 +228,7 @@ func (b *ContextBuilder) BuildContext(
 	// 9. Calculate token count
 	tokenCount := 0
 	for _, msg := range truncated {
-		tokenCount += b.tokenCounter.CountTokens(msg)
+		tokenCount += tokenCounter.CountTokens(msg)
 	}
 
 	result := &BuiltContext{
@@ -249,7 +280,7 @@ func (b *ContextBuilder) findLastSeparator(messages []Message) int {
 
 // truncateByTokens truncates messages to fit within maxTokens.
 // Removes oldest messages first (from the beginning).
-func (b *ContextBuilder) truncateByTokens(messages []string, maxTokens int) []string {
+func truncateByTokens(counter TokenCounter, messages []string, maxTokens int) []string {
 	if maxTokens <= 0 {
 		return messages
 	}
@@ -257,7 +288,7 @@ func (b *ContextBuilder) truncateByTokens(messages []string, maxTokens int) []st
 	// Count from newest (end) to oldest (beginning)
 	totalTokens := 0
 	for i := len(messages) - 1; i >= 0; i-- {
-		totalTokens += b.tokenCounter.CountTokens(messages[i])
+		totalTokens += counter.CountTokens(messages[i])
 		if totalTokens > maxTokens {
 			// Return messages after this point
 			if i+1 < len(messages) {
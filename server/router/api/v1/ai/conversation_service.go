@@ -27,6 +27,7 @@ type ChatEvent struct {
 	UserMessage string
 	// For AssistantResponse event
 	AssistantResponse string
+	AssistantMetadata *MessageMetadata // Citations and schedule cards shown with the answer
 	// For Separator event
 	SeparatorContent string
 	// Context information
@@ -297,7 +298,7 @@ func (s *ConversationService) handleAssistantResponse(ctx context.Context, event
 		Type:           store.AIMessageTypeMessage,
 		Role:           store.AIMessageRoleAssistant,
 		Content:        event.AssistantResponse,
-		Metadata:       emptyMetadata,
+		Metadata:       event.AssistantMetadata.String(),
 		CreatedTs:      event.Timestamp,
 	})
 	if err != nil {
@@ -30,6 +30,10 @@ type ConversationSummarizer struct {
 	writer           MessageWriter
 	llm              ai.LLMService
 	messageThreshold int // Trigger summarization after this many MESSAGE types
+
+	// Trigger summarization once MESSAGE content reaches this many tokens (0 = disabled)
+	tokenThreshold int
+	tokenCounter   TokenCounter
 }
 
 // NewConversationSummarizer creates a new conversation summarizer.
@@ -59,6 +63,14 @@ func NewConversationSummarizerWithStore(store interface{ MessageReader; MessageW
 	}
 }
 
+// SetTokenThreshold also triggers summarization when the messages after the
+// last SEPARATOR reach threshold tokens, so long messages are summarized
+// before the context builder starts dropping them.
+func (s *ConversationSummarizer) SetTokenThreshold(counter TokenCounter, threshold int) {
+	s.tokenCounter = counter
+	s.tokenThreshold = threshold
+}
+
 // ShouldSummarize checks if a conversation needs summarization.
 // Returns (shouldSummarize, messageCountAfterLastSeparator).
 func (s *ConversationSummarizer) ShouldSummarize(ctx context.Context, conversationID int32) (bool, int) {
@@ -69,17 +81,23 @@ func (s *ConversationSummarizer) ShouldSummarize(ctx context.Context, conversati
 		return false, 0
 	}
 
-	// Count MESSAGE types after the last SEPARATOR
-	messageCount := 0
+	// Count MESSAGE types and their tokens after the last SEPARATOR
+	messageCount, tokenCount := 0, 0
 	for i := len(messages) - 1; i >= 0; i-- {
 		if messages[i].Type == store.AIMessageTypeSeparator {
 			break
 		}
 		if messages[i].Type == store.AIMessageTypeMessage {
 			messageCount++
+			if s.tokenCounter != nil {
+				tokenCount += s.tokenCounter.CountTokens(messages[i].Content)
+			}
 		}
 	}
 
+	if s.tokenThreshold > 0 && tokenCount >= s.tokenThreshold && messageCount > 1 {
+		return true, messageCount
+	}
 	return messageCount >= s.messageThreshold, messageCount
 }
 
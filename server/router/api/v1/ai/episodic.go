@@ -0,0 +1,103 @@
+package ai
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	aicontext "github.com/hrygo/divinesense/plugin/ai/context"
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+)
+
+const (
+	// maxRecalledEpisodes is the number of past episodes injected into a chat.
+	maxRecalledEpisodes = 3
+
+	// maxEpisodeSummaryRunes bounds the stored answer summary of an episode.
+	maxEpisodeSummaryRunes = 200
+
+	// episodeSaveTimeout bounds embedding and storing an episode after a chat.
+	episodeSaveTimeout = 10 * time.Second
+
+	// Importance of a chat episode, raised when the user asks to remember something.
+	baseEpisodeImportance    = 0.5
+	explicitMemoryImportance = 0.8
+)
+
+// recallAcknowledgement pairs the recalled memory in history, which is read as user/assistant turns.
+const recallAcknowledgement = "好的，我会参考这些历史信息。"
+
+// explicitMemoryCues mark messages the user wants remembered.
+var explicitMemoryCues = []string{
+	"记住", "记得", "别忘了", "我喜欢", "我不喜欢", "我习惯", "我偏好", "以后",
+	"remember", "don't forget", "i prefer", "i like", "i don't like", "from now on",
+}
+
+// episodicRecall recalls relevant episodes before a chat and records the chat afterwards.
+type episodicRecall struct {
+	memory    *memory.Service
+	extractor *aicontext.LongTermExtractor
+}
+
+func newEpisodicRecall(mem *memory.Service) *episodicRecall {
+	return &episodicRecall{
+		memory:    mem,
+		extractor: aicontext.NewLongTermExtractor(maxRecalledEpisodes),
+	}
+}
+
+// withRecalledHistory prepends recalled episodes to history as a user/assistant pair.
+// Returns history unchanged when nothing relevant is recalled.
+func (r *episodicRecall) withRecalledHistory(ctx context.Context, userID int32, query string, history []string) []string {
+	provider := aicontext.NewMemoryEpisodicProvider(r.memory)
+	longTerm, err := r.extractor.Extract(ctx, provider, nil, userID, query)
+	if err != nil || longTerm == nil || len(longTerm.Episodes) == 0 {
+		return history
+	}
+
+	result := make([]string, 0, len(history)+2)
+	result = append(result, aicontext.FormatEpisodes(longTerm.Episodes), recallAcknowledgement)
+	return append(result, history...)
+}
+
+// save records a completed chat as an episode in the background.
+func (r *episodicRecall) save(userID int32, agentType AgentType, message, answer string) {
+	episode := memory.EpisodicMemory{
+		UserID:     userID,
+		Timestamp:  time.Now(),
+		AgentType:  strings.ToLower(agentType.String()),
+		UserInput:  message,
+		Outcome:    "success",
+		Summary:    truncateRunes(strings.TrimSpace(answer), maxEpisodeSummaryRunes),
+		Importance: episodeImportance(message),
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), episodeSaveTimeout)
+		defer cancel()
+		if err := r.memory.SaveEpisode(ctx, episode); err != nil {
+			slog.Debug("failed to save chat episode", "user_id", userID, "error", err)
+		}
+	}()
+}
+
+// episodeImportance scores how important a message is to remember.
+func episodeImportance(message string) float32 {
+	lower := strings.ToLower(message)
+	for _, cue := range explicitMemoryCues {
+		if strings.Contains(lower, cue) {
+			return explicitMemoryImportance
+		}
+	}
+	return baseEpisodeImportance
+}
+
+func truncateRunes(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:maxRunes]) + "..."
+}
@@ -6,8 +6,13 @@ import (
 
 	"github.com/hrygo/divinesense/plugin/ai"
 	agentpkg "github.com/hrygo/divinesense/plugin/ai/agent"
+	"github.com/hrygo/divinesense/plugin/ai/agent/tools"
+	"github.com/hrygo/divinesense/plugin/ai/habit"
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+	"github.com/hrygo/divinesense/plugin/ai/tokenizer"
 	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
 	"github.com/hrygo/divinesense/server/retrieval"
+	"github.com/hrygo/divinesense/server/service/capture"
 	"github.com/hrygo/divinesense/server/service/schedule"
 	"github.com/hrygo/divinesense/store"
 )
@@ -60,6 +65,8 @@ type CreateConfig struct {
 	Type     AgentType
 	UserID   int32
 	Timezone string
+	// SessionID identifies the persisted conversation context; empty for none
+	SessionID string
 }
 
 // AgentFactory creates parrot agents based on type.
@@ -67,6 +74,9 @@ type AgentFactory struct {
 	llm       ai.LLMService
 	retriever *retrieval.AdaptiveRetriever
 	store     *store.Store
+	memory    memory.MemoryService
+	contexts  *agentpkg.ContextStore
+	tokenizer tokenizer.Tokenizer
 }
 
 // NewAgentFactory creates a new agent factory.
@@ -82,6 +92,25 @@ func NewAgentFactory(
 	}
 }
 
+// WithMemoryService enables habit-based defaults for agents that create schedules.
+func (f *AgentFactory) WithMemoryService(mem memory.MemoryService) *AgentFactory {
+	f.memory = mem
+	return f
+}
+
+// WithContextStore keeps the schedule agent's conversation context (working state,
+// schedule drafts) in the given store for configs with a session ID.
+func (f *AgentFactory) WithContextStore(contexts *agentpkg.ContextStore) *AgentFactory {
+	f.contexts = contexts
+	return f
+}
+
+// WithTokenizer fits memo search results into the prompt with the LLM's tokenizer.
+func (f *AgentFactory) WithTokenizer(tok tokenizer.Tokenizer) *AgentFactory {
+	f.tokenizer = tok
+	return f
+}
+
 // Create creates an agent based on the configuration.
 func (f *AgentFactory) Create(ctx context.Context, cfg *CreateConfig) (agentpkg.ParrotAgent, error) {
 	if f.llm == nil {
@@ -115,6 +144,10 @@ func (f *AgentFactory) createMemoParrot(cfg *CreateConfig) (agentpkg.ParrotAgent
 	if err != nil {
 		return nil, fmt.Errorf("failed to create memo parrot: %w", err)
 	}
+	agent.SetTimezone(NormalizeTimezone(cfg.Timezone))
+	if f.tokenizer != nil {
+		agent.SetTokenizer(f.tokenizer)
+	}
 
 	return agent, nil
 }
@@ -142,12 +175,18 @@ func (f *AgentFactory) createScheduleParrot(_ context.Context, cfg *CreateConfig
 	if err != nil {
 		return nil, fmt.Errorf("failed to create scheduler agent v2: %w", err)
 	}
+	if f.memory != nil {
+		schedulerAgent.SetHabitApplier(habit.NewHabitApplier(f.memory))
+	}
 
 	// Wrap in schedule parrot V2
 	parrot, err := agentpkg.NewScheduleParrotV2(schedulerAgent)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create schedule parrot v2: %w", err)
 	}
+	if f.contexts != nil && cfg.SessionID != "" {
+		parrot.SetContextStore(f.contexts, cfg.SessionID)
+	}
 
 	return parrot, nil
 }
@@ -173,6 +212,32 @@ func (f *AgentFactory) createAmazingParrot(_ context.Context, cfg *CreateConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create amazing parrot: %w", err)
 	}
+	agent.SetTimezone(NormalizeTimezone(cfg.Timezone))
+	if f.tokenizer != nil {
+		agent.SetTokenizer(f.tokenizer)
+	}
+
+	// Enable web capture for links in user input
+	webCaptureTool, err := tools.NewWebCaptureTool(
+		capture.NewService(f.store, f.llm),
+		func(context.Context) int32 { return cfg.UserID },
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create web capture tool: %w", err)
+	}
+	agent.SetWebCaptureTool(webCaptureTool)
+
+	// Enable reading the extracted text of attachments
+	attachmentReadTool, err := tools.NewAttachmentReadTool(f.store, func(context.Context) int32 { return cfg.UserID })
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attachment read tool: %w", err)
+	}
+	agent.SetAttachmentReadTool(attachmentReadTool)
+
+	// Fill in the user's usual schedule time and duration
+	if f.memory != nil {
+		agent.SetHabitApplier(habit.NewHabitApplier(f.memory))
+	}
 
 	return agent, nil
 }
@@ -2,8 +2,10 @@ package ai
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 
 	"google.golang.org/grpc/codes"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/hrygo/divinesense/plugin/ai"
 	agentpkg "github.com/hrygo/divinesense/plugin/ai/agent"
+	"github.com/hrygo/divinesense/plugin/ai/memory"
 	"github.com/hrygo/divinesense/plugin/ai/router"
 	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
 	"github.com/hrygo/divinesense/server/internal/errors"
@@ -28,6 +31,7 @@ type ParrotHandler struct {
 	factory    *AgentFactory
 	llm        ai.LLMService
 	chatRouter *agentpkg.ChatRouter
+	episodic   *episodicRecall
 }
 
 // NewParrotHandler creates a new parrot handler.
@@ -43,38 +47,57 @@ func (h *ParrotHandler) SetChatRouter(router *agentpkg.ChatRouter) {
 	h.chatRouter = router
 }
 
+// SetEpisodicMemory enables recalling past episodes into chats and recording new ones.
+func (h *ParrotHandler) SetEpisodicMemory(mem *memory.Service) {
+	if mem == nil {
+		h.episodic = nil
+		return
+	}
+	h.episodic = newEpisodicRecall(mem)
+}
+
 // Handle implements Handler interface for parrot agent requests.
 func (h *ParrotHandler) Handle(ctx context.Context, req *ChatRequest, stream ChatStream) error {
 	if h.llm == nil {
 		return status.Error(codes.Unavailable, "LLM service is not available")
 	}
 
+	// In incognito mode episodic memory is neither read nor written
+	incognito := h.episodic != nil && h.episodic.memory.IsIncognito(ctx, req.UserID)
+
+	// Learn from corrections of the previous routing of this message
+	if h.chatRouter != nil && !incognito {
+		h.recordRoutingCorrection(ctx, req)
+	}
+
 	// Auto-route if AgentType is AUTO
 	agentType := req.AgentType
 	if agentType == AgentTypeAuto && h.chatRouter != nil {
-		// Add user ID to context for history matching.
+		// Add user ID to context for history matching, which reads and records episodes.
 		// Note: req.UserID is already authenticated by the gRPC interceptor middleware.
-		ctx = router.WithUserID(ctx, req.UserID)
-		routeResult, err := h.chatRouter.Route(ctx, req.Message)
+		routeCtx := ctx
+		if !incognito {
+			routeCtx = router.WithUserID(ctx, req.UserID)
+		}
+		routeResult, err := h.chatRouter.RouteForUser(routeCtx, req.UserID, req.Message)
 		if err != nil {
 			slog.Warn("chat router failed, defaulting to amazing",
 				"error", err,
 				"message", req.Message[:min(len(req.Message), 30)])
 			agentType = AgentTypeAmazing
 		} else {
-			// Map ChatRouteType to AgentType
-			switch routeResult.Route {
-			case agentpkg.RouteTypeMemo:
-				agentType = AgentTypeMemo
-			case agentpkg.RouteTypeSchedule:
-				agentType = AgentTypeSchedule
-			default:
-				agentType = AgentTypeAmazing
-			}
+			agentType = routeToAgentType(routeResult.Route)
 			slog.Info("chat auto-routed",
 				"route", routeResult.Route,
 				"method", routeResult.Method,
 				"confidence", routeResult.Confidence)
+
+			// Tell the client which assistant answers, so users can flag a wrong one
+			if data, err := json.Marshal(routeResult); err == nil {
+				if err := stream.Send(&v1pb.ChatResponse{EventType: "routing", EventData: string(data)}); err != nil {
+					return err
+				}
+			}
 		}
 	} else if agentType == AgentTypeAuto {
 		// No router configured, fallback to amazing
@@ -90,9 +113,10 @@ func (h *ParrotHandler) Handle(ctx context.Context, req *ChatRequest, stream Cha
 
 	// Create agent using factory
 	agent, err := h.factory.Create(ctx, &CreateConfig{
-		Type:     req.AgentType,
-		UserID:   req.UserID,
-		Timezone: req.Timezone,
+		Type:      agentType,
+		UserID:    req.UserID,
+		Timezone:  req.Timezone,
+		SessionID: conversationSessionID(req),
 	})
 	if err != nil {
 		logger.Error("Failed to create agent", err)
@@ -103,12 +127,27 @@ func (h *ParrotHandler) Handle(ctx context.Context, req *ChatRequest, stream Cha
 		slog.String("agent_name", agent.Name()),
 	)
 
+	// Recall relevant past episodes into history
+	if h.episodic != nil && !incognito {
+		history := h.episodic.withRecalledHistory(ctx, req.UserID, req.Message, req.History)
+		if len(history) != len(req.History) {
+			recalled := *req
+			recalled.History = history
+			req = &recalled
+		}
+	}
+
 	// Execute agent with streaming
-	if err := h.executeAgent(ctx, agent, req, stream, logger); err != nil {
+	answer, err := h.executeAgent(ctx, agent, req, stream, logger)
+	if err != nil {
 		logger.Error("AI chat failed", err)
 		return status.Error(codes.Internal, fmt.Sprintf("agent execution failed: %v", err))
 	}
 
+	if h.episodic != nil && !incognito {
+		h.episodic.save(req.UserID, agentType, req.Message, answer)
+	}
+
 	logger.Info("AI chat completed",
 		slog.Int64(observability.LogFieldDuration, logger.DurationMs()),
 	)
@@ -116,18 +155,86 @@ func (h *ParrotHandler) Handle(ctx context.Context, req *ChatRequest, stream Cha
 	return nil
 }
 
-// executeAgent executes the agent and streams responses.
+// recordRoutingCorrection stores a correction when the user re-sends an auto-routed
+// message to another assistant or flags the previous assistant as wrong.
+func (h *ParrotHandler) recordRoutingCorrection(ctx context.Context, req *ChatRequest) {
+	routed, ok := h.chatRouter.LastRoute(req.UserID, req.Message)
+	if !ok {
+		if req.WrongAgent {
+			slog.Debug("wrong agent reported without a previous routing of the message", "user_id", req.UserID)
+		}
+		return
+	}
+
+	var corrected agentpkg.ChatRouteType
+	switch {
+	case req.AgentType != AgentTypeAuto:
+		corrected = agentTypeToRoute(req.AgentType)
+		if corrected == routed.Route {
+			return
+		}
+	case req.WrongAgent:
+		// Flagged without picking another assistant
+	default:
+		return
+	}
+
+	if err := h.chatRouter.RecordCorrection(ctx, req.UserID, req.Message, routed, corrected); err != nil {
+		slog.Warn("failed to record routing correction", "user_id", req.UserID, "error", err)
+		return
+	}
+	slog.Info("routing correction recorded",
+		"routed", routed.Route,
+		"intent", routed.Intent,
+		"corrected", corrected)
+}
+
+// conversationSessionID returns the session ID under which the agent context of a
+// saved conversation is persisted, or "" for temporary conversations.
+func conversationSessionID(req *ChatRequest) string {
+	if req.ConversationID == 0 || req.IsTempConversation {
+		return ""
+	}
+	return fmt.Sprintf("conversation-%d", req.ConversationID)
+}
+
+// routeToAgentType maps a chat route to the agent type.
+func routeToAgentType(route agentpkg.ChatRouteType) AgentType {
+	switch route {
+	case agentpkg.RouteTypeMemo:
+		return AgentTypeMemo
+	case agentpkg.RouteTypeSchedule:
+		return AgentTypeSchedule
+	default:
+		return AgentTypeAmazing
+	}
+}
+
+// agentTypeToRoute maps an explicit agent type to the chat route.
+func agentTypeToRoute(agentType AgentType) agentpkg.ChatRouteType {
+	switch agentType {
+	case AgentTypeMemo:
+		return agentpkg.RouteTypeMemo
+	case AgentTypeSchedule:
+		return agentpkg.RouteTypeSchedule
+	default:
+		return agentpkg.RouteTypeAmazing
+	}
+}
+
+// executeAgent executes the agent, streams responses and returns the answer text.
 func (h *ParrotHandler) executeAgent(
 	ctx context.Context,
 	agent agentpkg.ParrotAgent,
 	req *ChatRequest,
 	stream ChatStream,
 	logger *observability.RequestContext,
-) error {
+) (string, error) {
 	// Track events for logging
 	eventCount := make(map[string]int)
 	var totalChunks int
 	var streamMu sync.Mutex
+	var answer strings.Builder
 
 	// Create stream adapter
 	streamAdapter := agentpkg.NewParrotStreamAdapter(func(eventType string, eventData any) error {
@@ -159,6 +266,10 @@ func (h *ParrotHandler) executeAgent(
 		streamMu.Lock()
 		defer streamMu.Unlock()
 
+		if eventType == "answer" || eventType == "content" {
+			answer.WriteString(dataStr)
+		}
+
 		return stream.Send(&v1pb.ChatResponse{
 			EventType: eventType,
 			EventData: dataStr,
@@ -172,7 +283,7 @@ func (h *ParrotHandler) executeAgent(
 
 	// Execute agent
 	if err := agent.ExecuteWithCallback(ctx, req.Message, req.History, callback); err != nil {
-		return err
+		return "", err
 	}
 
 	// Send done marker
@@ -181,7 +292,7 @@ func (h *ParrotHandler) executeAgent(
 	if err := stream.Send(&v1pb.ChatResponse{
 		Done: true,
 	}); err != nil {
-		return err
+		return "", err
 
 	}
 
@@ -190,7 +301,7 @@ func (h *ParrotHandler) executeAgent(
 		slog.Int("unique_events", len(eventCount)),
 	)
 
-	return nil
+	return answer.String(), nil
 }
 
 // RoutingHandler routes all agent requests through the parrot handler.
@@ -222,6 +333,7 @@ func ToChatRequest(pbReq *v1pb.ChatRequest) *ChatRequest {
 		Timezone:           pbReq.UserTimezone,
 		ConversationID:     pbReq.ConversationId,
 		IsTempConversation: pbReq.IsTempConversation,
+		WrongAgent:         pbReq.WrongAgent,
 	}
 }
 
@@ -0,0 +1,249 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	agentpkg "github.com/hrygo/divinesense/plugin/ai/agent"
+	"github.com/hrygo/divinesense/store"
+)
+
+// MessageMetadata is the structured metadata persisted with assistant messages.
+// It records what the answer was grounded on so it can be rendered later
+// (e.g. when saving a conversation as a memo).
+type MessageMetadata struct {
+	// Sources are the UIDs of memos returned by memo search during the answer.
+	Sources []string `json:"sources,omitempty"`
+	// Schedules are the schedule cards shown alongside the answer.
+	Schedules []agentpkg.ScheduleSummary `json:"schedules,omitempty"`
+}
+
+// IsEmpty reports whether the metadata carries no information.
+func (m *MessageMetadata) IsEmpty() bool {
+	return m == nil || (len(m.Sources) == 0 && len(m.Schedules) == 0)
+}
+
+// String encodes the metadata as a JSON object, falling back to "{}".
+func (m *MessageMetadata) String() string {
+	if m.IsEmpty() {
+		return emptyMetadata
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return emptyMetadata
+	}
+	return string(data)
+}
+
+// ParseMessageMetadata decodes message metadata, returning an empty value for
+// missing or malformed JSON.
+func ParseMessageMetadata(raw string) *MessageMetadata {
+	metadata := &MessageMetadata{}
+	if raw == "" {
+		return metadata
+	}
+	if err := json.Unmarshal([]byte(raw), metadata); err != nil {
+		return &MessageMetadata{}
+	}
+	return metadata
+}
+
+// MetadataCollector accumulates structured agent events for one assistant answer.
+// It is fed with the raw stream events and produces the MessageMetadata to persist.
+type MetadataCollector struct {
+	sources   []string
+	seen      map[string]bool
+	schedules []agentpkg.ScheduleSummary
+}
+
+// NewMetadataCollector creates an empty metadata collector.
+func NewMetadataCollector() *MetadataCollector {
+	return &MetadataCollector{seen: make(map[string]bool)}
+}
+
+// Collect records the payload of memo and schedule query result events.
+// Other event types are ignored.
+func (c *MetadataCollector) Collect(eventType, eventData string) {
+	switch eventType {
+	case agentpkg.EventTypeMemoQueryResult:
+		var data agentpkg.MemoQueryResultData
+		if err := json.Unmarshal([]byte(eventData), &data); err != nil {
+			return
+		}
+		for _, m := range data.Memos {
+			if m.UID != "" && !c.seen[m.UID] {
+				c.seen[m.UID] = true
+				c.sources = append(c.sources, m.UID)
+			}
+		}
+	case agentpkg.EventTypeScheduleQueryResult:
+		var data agentpkg.ScheduleQueryResultData
+		if err := json.Unmarshal([]byte(eventData), &data); err != nil {
+			return
+		}
+		c.schedules = append(c.schedules, data.Schedules...)
+	}
+}
+
+// Metadata returns the collected metadata.
+func (c *MetadataCollector) Metadata() *MessageMetadata {
+	return &MessageMetadata{
+		Sources:   c.sources,
+		Schedules: c.schedules,
+	}
+}
+
+// citationPattern matches inline memo citations such as "memos/abc123" or "[memos/abc123]".
+var citationPattern = regexp.MustCompile(`\[?\bmemos/([A-Za-z0-9][A-Za-z0-9-]{0,31})\b\]?`)
+
+// MemoExport is the markdown rendering of a conversation.
+type MemoExport struct {
+	Content string
+	// CitedMemoUIDs are the memo UIDs referenced by the rendered messages, in order of first use.
+	CitedMemoUIDs []string
+}
+
+// RenderMessagesAsMemo renders conversation messages to markdown suitable for a memo.
+// Inline citations are converted to memo links, schedule cards are rendered as lists,
+// and all cited memos are collected so the caller can create REFERENCE relations.
+// Separator and summary messages are skipped.
+func RenderMessagesAsMemo(title string, messages []*store.AIMessage, loc *time.Location) *MemoExport {
+	if loc == nil {
+		loc = GetDefaultTimezoneLocation()
+	}
+
+	export := &MemoExport{}
+	seen := make(map[string]bool)
+	cite := func(uid string) {
+		if !seen[uid] {
+			seen[uid] = true
+			export.CitedMemoUIDs = append(export.CitedMemoUIDs, uid)
+		}
+	}
+
+	var b strings.Builder
+	if title = strings.TrimSpace(title); title != "" {
+		fmt.Fprintf(&b, "# %s\n\n", title)
+	}
+
+	// A single answer reads better without role headings.
+	showRoles := countRenderable(messages) > 1
+
+	for _, msg := range messages {
+		if msg.Type != store.AIMessageTypeMessage || strings.TrimSpace(msg.Content) == "" {
+			continue
+		}
+
+		if showRoles {
+			if msg.Role == store.AIMessageRoleUser {
+				b.WriteString("## Q\n\n")
+			} else {
+				b.WriteString("## A\n\n")
+			}
+		}
+
+		content := convertCitations(msg.Content, cite)
+		b.WriteString(strings.TrimSpace(content))
+		b.WriteString("\n\n")
+
+		metadata := ParseMessageMetadata(msg.Metadata)
+		for _, uid := range metadata.Sources {
+			cite(uid)
+		}
+		if len(metadata.Schedules) > 0 {
+			for _, schedule := range metadata.Schedules {
+				b.WriteString(renderScheduleItem(schedule, loc))
+				b.WriteString("\n")
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(export.CitedMemoUIDs) > 0 {
+		b.WriteString("---\n\n")
+		for _, uid := range export.CitedMemoUIDs {
+			fmt.Fprintf(&b, "- [memos/%s](/memos/%s)\n", uid, uid)
+		}
+	}
+
+	export.Content = strings.TrimSpace(b.String())
+	return export
+}
+
+// convertCitations rewrites bare memo citations into memo links and reports every
+// cited UID. Citations that are already part of a link or URL are left untouched, and
+// memo paths in external URLs are not reported.
+func convertCitations(content string, cite func(uid string)) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range citationPattern.FindAllStringSubmatchIndex(content, -1) {
+		start, end := loc[0], loc[1]
+		uid := content[loc[2]:loc[3]]
+
+		if start > 0 && content[start-1] == '/' {
+			// Part of a URL or link target; only "(/memos/uid)" points to a memo of this instance.
+			if start > 1 && content[start-2] == '(' {
+				cite(uid)
+			}
+			continue
+		}
+		if start > 0 && content[start-1] == '(' {
+			// Relative link target "(memos/uid)".
+			cite(uid)
+			continue
+		}
+		if end < len(content) && content[end] == '(' && content[end-1] == ']' {
+			// "[memos/uid](...)" is already a markdown link; its target decides the citation.
+			continue
+		}
+		cite(uid)
+		b.WriteString(content[last:start])
+		fmt.Fprintf(&b, "[memos/%s](/memos/%s)", uid, uid)
+		last = end
+	}
+	b.WriteString(content[last:])
+	return b.String()
+}
+
+// countRenderable counts messages that produce output in RenderMessagesAsMemo.
+func countRenderable(messages []*store.AIMessage) int {
+	count := 0
+	for _, msg := range messages {
+		if msg.Type == store.AIMessageTypeMessage && strings.TrimSpace(msg.Content) != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// renderScheduleItem renders a schedule card as a markdown list item.
+func renderScheduleItem(schedule agentpkg.ScheduleSummary, loc *time.Location) string {
+	start := time.Unix(schedule.StartTimestamp, 0).In(loc)
+
+	var when string
+	switch {
+	case schedule.AllDay:
+		when = start.Format("2006-01-02")
+	case schedule.EndTimestamp > 0:
+		end := time.Unix(schedule.EndTimestamp, 0).In(loc)
+		if end.Format("2006-01-02") == start.Format("2006-01-02") {
+			when = fmt.Sprintf("%s–%s", start.Format("2006-01-02 15:04"), end.Format("15:04"))
+		} else {
+			when = fmt.Sprintf("%s – %s", start.Format("2006-01-02 15:04"), end.Format("2006-01-02 15:04"))
+		}
+	default:
+		when = start.Format("2006-01-02 15:04")
+	}
+
+	item := fmt.Sprintf("- %s **%s**", when, schedule.Title)
+	if schedule.Location != "" {
+		item += " @ " + schedule.Location
+	}
+	if schedule.Status != "" && schedule.Status != "ACTIVE" {
+		item += fmt.Sprintf(" (%s)", strings.ToLower(schedule.Status))
+	}
+	return item
+}
@@ -0,0 +1,62 @@
+package ai
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	agentpkg "github.com/hrygo/divinesense/plugin/ai/agent"
+	"github.com/hrygo/divinesense/store"
+)
+
+func TestRenderMessagesAsMemo_Conversation(t *testing.T) {
+	metadata := (&MessageMetadata{
+		Sources: []string{"src1"},
+		Schedules: []agentpkg.ScheduleSummary{
+			{Title: "周会", StartTimestamp: 1767232800, EndTimestamp: 1767236400, Location: "A101"},
+		},
+	}).String()
+
+	messages := []*store.AIMessage{
+		{Type: store.AIMessageTypeMessage, Role: store.AIMessageRoleUser, Content: "项目进展如何？"},
+		{Type: store.AIMessageTypeSeparator, Role: store.AIMessageRoleSystem, Content: "---"},
+		{Type: store.AIMessageTypeMessage, Role: store.AIMessageRoleAssistant, Content: "见 memos/abc123 和 [memos/def456](/memos/def456)，另见 https://example.com/memos/ext111 与 [memos/ext222](https://example.com/x)。", Metadata: metadata},
+	}
+
+	export := RenderMessagesAsMemo("Weekly", messages, time.UTC)
+
+	assert.True(t, strings.HasPrefix(export.Content, "# Weekly\n\n## Q\n\n项目进展如何？"))
+	assert.Contains(t, export.Content, "见 [memos/abc123](/memos/abc123) 和 [memos/def456](/memos/def456)，另见 https://example.com/memos/ext111 与 [memos/ext222](https://example.com/x)。")
+	assert.Contains(t, export.Content, "- 2026-01-01 02:00–03:00 **周会** @ A101")
+	assert.NotContains(t, export.Content, "\n---\n\n## A")
+	assert.Equal(t, []string{"abc123", "def456", "src1"}, export.CitedMemoUIDs)
+}
+
+func TestRenderMessagesAsMemo_SingleAnswer(t *testing.T) {
+	messages := []*store.AIMessage{
+		{Type: store.AIMessageTypeMessage, Role: store.AIMessageRoleAssistant, Content: "Plain answer."},
+	}
+
+	export := RenderMessagesAsMemo("", messages, nil)
+
+	assert.Equal(t, "Plain answer.", export.Content)
+	assert.Empty(t, export.CitedMemoUIDs)
+}
+
+func TestMetadataCollector(t *testing.T) {
+	collector := NewMetadataCollector()
+	collector.Collect(agentpkg.EventTypeMemoQueryResult, `{"memos":[{"uid":"a"},{"uid":"b"},{"uid":"a"}]}`)
+	collector.Collect(agentpkg.EventTypeScheduleQueryResult, `{"schedules":[{"uid":"s1","title":"Standup"}]}`)
+	collector.Collect(agentpkg.EventTypeThinking, `ignored`)
+	collector.Collect(agentpkg.EventTypeMemoQueryResult, `not json`)
+
+	metadata := ParseMessageMetadata(collector.Metadata().String())
+	require.Equal(t, []string{"a", "b"}, metadata.Sources)
+	require.Len(t, metadata.Schedules, 1)
+	assert.Equal(t, "Standup", metadata.Schedules[0].Title)
+
+	assert.Equal(t, emptyMetadata, NewMetadataCollector().Metadata().String())
+}
@@ -23,6 +23,7 @@ type ChatRequest struct {
 	Timezone           string
 	ConversationID     int32
 	IsTempConversation bool
+	WrongAgent         bool // The previous auto-routed reply to this message came from the wrong assistant
 }
 
 // Handler is the interface for handling chat requests.
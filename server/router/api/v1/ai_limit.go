@@ -0,0 +1,216 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pluginai "github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/proto/gen/api/v1/apiv1connect"
+	"github.com/hrygo/divinesense/server/auth"
+	"github.com/hrygo/divinesense/server/middleware"
+	"github.com/hrygo/divinesense/store"
+)
+
+// aiUsageLimiter tracks AI usage of this server instance against the AI_LIMIT instance setting.
+var aiUsageLimiter = middleware.NewAILimiter()
+
+// AILimitInterceptor enforces the AI rate limits and quotas of the AI_LIMIT
+// instance setting on the AIService and ScheduleAgentService endpoints.
+//
+// Unary calls count against the request rate; streams additionally hold a
+// concurrent stream slot until they end. Once the daily LLM calls are used up,
+// all requests are rejected until the next UTC day.
+type AILimitInterceptor struct {
+	store   *store.Store
+	limiter *middleware.AILimiter
+}
+
+// NewAILimitInterceptor creates a new AI limit interceptor.
+func NewAILimitInterceptor(store *store.Store, limiter *middleware.AILimiter) *AILimitInterceptor {
+	return &AILimitInterceptor{store: store, limiter: limiter}
+}
+
+func (in *AILimitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		userID := auth.GetUserID(ctx)
+		if userID == 0 || !isAILimitedProcedure(req.Spec().Procedure) {
+			return next(ctx, req)
+		}
+
+		if err := in.limiter.Allow(in.limits(ctx), userID); err != nil {
+			return nil, newAILimitConnectError(userID, err)
+		}
+		return next(ctx, req)
+	}
+}
+
+func (*AILimitInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (in *AILimitInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		userID := auth.GetUserID(ctx)
+		if userID == 0 || !isAILimitedProcedure(conn.Spec().Procedure) {
+			return next(ctx, conn)
+		}
+
+		release, err := in.limiter.AcquireStream(in.limits(ctx), userID)
+		if err != nil {
+			return newAILimitConnectError(userID, err)
+		}
+		defer release()
+		return next(ctx, conn)
+	}
+}
+
+// WrapGateway applies the request rate and daily quota limits to the gRPC-Gateway
+// routes of the limited AI services. It must run after the gateway auth middleware.
+// The in-process gateway only serves unary calls, so no stream slot is held.
+func (in *AILimitInterceptor) WrapGateway(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx := r.Context()
+		userID := auth.GetUserID(ctx)
+		if userID == 0 || !isAILimitedPath(r.URL.Path) {
+			next(w, r, pathParams)
+			return
+		}
+
+		if err := in.limiter.Allow(in.limits(ctx), userID); err != nil {
+			writeAILimitGatewayError(w, err)
+			return
+		}
+		next(w, r, pathParams)
+	}
+}
+
+// limits reads the AI limits from the instance setting (cached by the store).
+// Defaults apply if the setting cannot be read.
+func (in *AILimitInterceptor) limits(ctx context.Context) middleware.AILimits {
+	setting, err := in.store.GetInstanceAILimitSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get AI limit setting, using defaults", "error", err)
+		return middleware.AILimits{
+			UserRequestsPerMinute:     store.DefaultAIUserRequestsPerMinute,
+			UserConcurrentStreams:     store.DefaultAIUserConcurrentStreams,
+			UserDailyLLMCalls:         store.DefaultAIUserDailyLLMCalls,
+			InstanceRequestsPerMinute: store.DefaultAIInstanceRequestsPerMinute,
+			InstanceConcurrentStreams: store.DefaultAIInstanceConcurrentStreams,
+			InstanceDailyLLMCalls:     store.DefaultAIInstanceDailyLLMCalls,
+		}
+	}
+	return middleware.AILimits{
+		UserRequestsPerMinute:     int(setting.UserRequestsPerMinute),
+		UserConcurrentStreams:     int(setting.UserConcurrentStreams),
+		UserDailyLLMCalls:         int(setting.UserDailyLlmCalls),
+		InstanceRequestsPerMinute: int(setting.InstanceRequestsPerMinute),
+		InstanceConcurrentStreams: int(setting.InstanceConcurrentStreams),
+		InstanceDailyLLMCalls:     int(setting.InstanceDailyLlmCalls),
+	}
+}
+
+// isAILimitedProcedure reports whether the procedure belongs to a limited AI service.
+func isAILimitedProcedure(procedure string) bool {
+	return strings.HasPrefix(procedure, "/"+apiv1connect.AIServiceName+"/") ||
+		strings.HasPrefix(procedure, "/"+apiv1connect.ScheduleAgentServiceName+"/")
+}
+
+// isAILimitedPath reports whether the gateway path is bound to a limited AI service.
+// The RPC method is not known yet when gateway middlewares run, so paths are matched instead.
+func isAILimitedPath(path string) bool {
+	if strings.HasPrefix(path, "/api/v1/ai/") || strings.HasPrefix(path, "/api/v1/schedule-agent/") {
+		return true
+	}
+	// AIService.GetRelatedMemos: /api/v1/memos/{memo}/related
+	rest, ok := strings.CutPrefix(path, "/api/v1/memos/")
+	if !ok {
+		return false
+	}
+	memo, suffix, ok := strings.Cut(rest, "/")
+	return ok && memo != "" && suffix == "related"
+}
+
+// writeAILimitGatewayError writes a limiter error as an HTTP 429 response with a Retry-After header.
+func writeAILimitGatewayError(w http.ResponseWriter, err error) {
+	var limitErr *middleware.AILimitError
+	if errors.As(err, &limitErr) {
+		retryAfter := int64(math.Ceil(limitErr.RetryAfter.Seconds()))
+		w.Header().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	body, _ := json.Marshal(map[string]any{"code": int(codes.ResourceExhausted), "message": err.Error()})
+	_, _ = w.Write(body)
+}
+
+// newAILimitConnectError converts a limiter error to RESOURCE_EXHAUSTED with
+// RetryInfo and QuotaFailure details and a Retry-After header.
+func newAILimitConnectError(userID int32, err error) error {
+	var limitErr *middleware.AILimitError
+	if !errors.As(err, &limitErr) {
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	}
+
+	connectErr := connect.NewError(connect.CodeResourceExhausted, limitErr)
+	retryAfter := int64(math.Ceil(limitErr.RetryAfter.Seconds()))
+	connectErr.Meta().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+
+	subject := "instance"
+	if limitErr.Scope == middleware.ScopeUser {
+		subject = fmt.Sprintf("users/%d", userID)
+	}
+	details := []*errdetails.QuotaFailure_Violation{{
+		Subject:     subject,
+		Description: fmt.Sprintf("%s limit of %d exceeded", limitErr.Limit, limitErr.Quota),
+	}}
+	if detail, err := connect.NewErrorDetail(&errdetails.RetryInfo{RetryDelay: durationpb.New(limitErr.RetryAfter)}); err == nil {
+		connectErr.AddDetail(detail)
+	}
+	if detail, err := connect.NewErrorDetail(&errdetails.QuotaFailure{Violations: details}); err == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// meteredLLMService counts LLM calls against the daily AI limits.
+// Calls are attributed to the authenticated user of the request context, if any.
+type meteredLLMService struct {
+	pluginai.LLMService
+	limiter *middleware.AILimiter
+}
+
+// newMeteredLLMService wraps llm so its calls are counted by limiter.
+func newMeteredLLMService(llm pluginai.LLMService, limiter *middleware.AILimiter) pluginai.LLMService {
+	if llm == nil {
+		return nil
+	}
+	return &meteredLLMService{LLMService: llm, limiter: limiter}
+}
+
+func (m *meteredLLMService) Chat(ctx context.Context, messages []pluginai.Message) (string, error) {
+	m.limiter.RecordLLMCall(auth.GetUserID(ctx))
+	return m.LLMService.Chat(ctx, messages)
+}
+
+func (m *meteredLLMService) ChatStream(ctx context.Context, messages []pluginai.Message) (<-chan string, <-chan error) {
+	m.limiter.RecordLLMCall(auth.GetUserID(ctx))
+	return m.LLMService.ChatStream(ctx, messages)
+}
+
+func (m *meteredLLMService) ChatWithTools(ctx context.Context, messages []pluginai.Message, tools []pluginai.ToolDescriptor) (*pluginai.ChatResponse, error) {
+	m.limiter.RecordLLMCall(auth.GetUserID(ctx))
+	return m.LLMService.ChatWithTools(ctx, messages, tools)
+}
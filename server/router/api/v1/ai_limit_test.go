@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	"github.com/hrygo/divinesense/proto/gen/api/v1/apiv1connect"
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/server/auth"
+	"github.com/hrygo/divinesense/server/middleware"
+	"github.com/hrygo/divinesense/store"
+	"github.com/hrygo/divinesense/store/db"
+)
+
+// newAILimitTestStore returns a migrated SQLite store with the given AI limit setting.
+func newAILimitTestStore(t *testing.T, setting *storepb.InstanceAILimitSetting) *store.Store {
+	t.Helper()
+	ctx := context.Background()
+	dir := t.TempDir()
+	p := &profile.Profile{Mode: "prod", Driver: "sqlite", Data: dir, DSN: filepath.Join(dir, "test.db"), Version: "0.26.0"}
+	driver, err := db.NewDBDriver(p)
+	require.NoError(t, err)
+	st := store.New(driver, p)
+	t.Cleanup(func() { _ = st.Close() })
+	require.NoError(t, st.Migrate(ctx))
+
+	_, err = st.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_AI_LIMIT,
+		Value: &storepb.InstanceSetting_AiLimitSetting{AiLimitSetting: setting},
+	})
+	require.NoError(t, err)
+	return st
+}
+
+func TestAILimitInterceptor_WrapGateway(t *testing.T) {
+	st := newAILimitTestStore(t, &storepb.InstanceAILimitSetting{UserRequestsPerMinute: 1})
+	in := NewAILimitInterceptor(st, middleware.NewAILimiter())
+
+	calls := 0
+	handler := in.WrapGateway(func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		calls++
+		w.WriteHeader(http.StatusOK)
+	})
+	serve := func(path string, userID int32) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, path, nil)
+		if userID != 0 {
+			r = r.WithContext(context.WithValue(r.Context(), auth.UserIDContextKey, userID))
+		}
+		w := httptest.NewRecorder()
+		handler(w, r, nil)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, serve("/api/v1/ai/chat", 1).Code)
+
+	w := serve("/api/v1/memos/abc/related", 1)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "60", w.Header().Get("Retry-After"))
+	var body struct {
+		Code int `json:"code"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, 8, body.Code) // RESOURCE_EXHAUSTED
+
+	// Other users, other services and unauthenticated requests are not limited here
+	assert.Equal(t, http.StatusOK, serve("/api/v1/schedule-agent/chat", 2).Code)
+	assert.Equal(t, http.StatusOK, serve("/api/v1/memos/abc", 1).Code)
+	assert.Equal(t, http.StatusOK, serve("/api/v1/ai/chat", 0).Code)
+	assert.Equal(t, 4, calls)
+}
+
+func TestAILimitInterceptor_WrapUnary(t *testing.T) {
+	st := newAILimitTestStore(t, &storepb.InstanceAILimitSetting{UserRequestsPerMinute: 1})
+	in := NewAILimitInterceptor(st, middleware.NewAILimiter())
+
+	mux := http.NewServeMux()
+	for _, procedure := range []string{apiv1connect.AIServiceChatProcedure, apiv1connect.MemoServiceGetMemoProcedure} {
+		mux.Handle(procedure, connect.NewUnaryHandler(procedure,
+			func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+				return connect.NewResponse(&emptypb.Empty{}), nil
+			},
+			connect.WithInterceptors(in),
+		))
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), auth.UserIDContextKey, int32(1))
+		mux.ServeHTTP(w, r.WithContext(ctx))
+	}))
+	defer server.Close()
+
+	call := func(procedure string) error {
+		client := connect.NewClient[emptypb.Empty, emptypb.Empty](server.Client(), server.URL+procedure)
+		_, err := client.CallUnary(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+		return err
+	}
+
+	require.NoError(t, call(apiv1connect.AIServiceChatProcedure))
+	err := call(apiv1connect.AIServiceChatProcedure)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	assert.Equal(t, "60", connectErr.Meta().Get("Retry-After"))
+
+	require.NoError(t, call(apiv1connect.MemoServiceGetMemoProcedure))
+}
+
+// TestIsAILimitedPath checks that every HTTP binding of the limited services is matched.
+func TestIsAILimitedPath(t *testing.T) {
+	pathVar := regexp.MustCompile(`\{[^}=]+(=([^}]*))?\}`)
+	services := v1pb.File_api_v1_ai_service_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			method := methods.Get(j)
+			rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+			require.True(t, ok)
+			pattern := rule.GetGet() + rule.GetPost() + rule.GetPatch() + rule.GetPut() + rule.GetDelete()
+			require.NotEmpty(t, pattern, method.FullName())
+
+			path := pathVar.ReplaceAllStringFunc(pattern, func(v string) string {
+				m := pathVar.FindStringSubmatch(v)
+				if m[2] == "" {
+					return "x"
+				}
+				return regexp.MustCompile(`\*+`).ReplaceAllString(m[2], "x")
+			})
+			assert.True(t, isAILimitedPath(path), "%s: %s", method.FullName(), path)
+		}
+	}
+
+	for _, path := range []string{"/api/v1/memos/abc", "/api/v1/memos/abc/comments", "/api/v1/memos//related", "/api/v1/aix"} {
+		assert.False(t, isAILimitedPath(path), path)
+	}
+}
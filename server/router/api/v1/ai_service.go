@@ -5,20 +5,22 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/hrygo/divinesense/internal/profile"
 	pluginai "github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/plugin/ai/agent"
+	aicache "github.com/hrygo/divinesense/plugin/ai/cache"
 	"github.com/hrygo/divinesense/plugin/ai/memory"
 	"github.com/hrygo/divinesense/plugin/ai/router"
+	"github.com/hrygo/divinesense/plugin/ai/session"
+	"github.com/hrygo/divinesense/plugin/ai/vector"
+	"github.com/hrygo/divinesense/plugin/markdown"
 	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
 	"github.com/hrygo/divinesense/server/auth"
-	"github.com/hrygo/divinesense/server/middleware"
 	"github.com/hrygo/divinesense/server/retrieval"
 	aichat "github.com/hrygo/divinesense/server/router/api/v1/ai"
 	"github.com/hrygo/divinesense/store"
 )
 
-// Global AI rate limiter
-var globalAILimiter = middleware.NewRateLimiter()
-
 // Default history retention count for router memory service
 const DefaultHistoryRetention = 10
 
@@ -28,11 +30,23 @@ type AIService struct {
 
 	Store *store.Store
 
+	// Profile provides instance configuration such as SMTP settings for digest delivery
+	Profile *profile.Profile
+
+	// MarkdownService extracts tags and properties for memos created by AI features
+	MarkdownService markdown.Service
+
 	EmbeddingService pluginai.EmbeddingService
 	EmbeddingModel   string // embedding model name for duplicate detection
 	RerankerService  pluginai.RerankerService
 	LLMService       pluginai.LLMService
 
+	// Vector search over memos, schedules, attachments, messages and episodes
+	VectorService vector.VectorService
+
+	// Opt-in semantic cache for idempotent LLM tasks; nil when disabled
+	ResponseCache *aicache.SemanticResponseCache
+
 	// Adaptive retriever for RAG operations
 	AdaptiveRetriever *retrieval.AdaptiveRetriever
 
@@ -43,6 +57,19 @@ type AIService struct {
 	routerServiceMu sync.RWMutex
 	routerService   *router.Service
 
+	// Chat router shared by all chats, so a re-sent message is matched with its
+	// previous routing to learn corrections (lazily initialized)
+	chatRouterMu sync.Mutex
+	chatRouter   *agent.ChatRouter
+
+	// Memory service for episodes and preferences (lazily initialized)
+	memoryServiceOnce sync.Once
+	memoryService     *memory.Service
+
+	// Persisted schedule agent contexts of chat conversations (lazily initialized)
+	scheduleContextsOnce sync.Once
+	scheduleContexts     *agent.ContextStore
+
 	// Chat event bus and conversation service (lazily initialized)
 	chatEventBusMu      sync.RWMutex
 	chatEventBus        *aichat.EventBus
@@ -96,7 +123,7 @@ func (s *AIService) getRouterService() *router.Service {
 	}
 
 	// Create memory service for router
-	memService := memory.NewService(s.Store, DefaultHistoryRetention)
+	memService := s.getMemoryService()
 
 	// Create LLM client wrapper for router
 	var llmClient router.LLMClient
@@ -105,13 +132,46 @@ func (s *AIService) getRouterService() *router.Service {
 	}
 
 	s.routerService = router.NewService(router.Config{
-		MemoryService: memService,
-		LLMClient:     llmClient,
+		MemoryService:    memService,
+		LLMClient:        llmClient,
+		VectorService:    s.VectorService,
+		EmbeddingService: s.EmbeddingService,
 	})
 
 	return s.routerService
 }
 
+// getMemoryService returns the memory service shared by AI features, initializing it on first use.
+// Callers must ensure Store is available.
+func (s *AIService) getMemoryService() *memory.Service {
+	s.memoryServiceOnce.Do(func() {
+		s.memoryService = memory.NewService(s.Store, DefaultHistoryRetention)
+		if s.EmbeddingService != nil {
+			embeddingModel := ""
+			if s.Profile != nil {
+				embeddingModel = s.Profile.AIEmbeddingModel
+			}
+			s.memoryService.SetEmbeddingService(s.EmbeddingService, embeddingModel)
+		}
+	})
+	return s.memoryService
+}
+
+// idempotentLLM returns the LLM service for an idempotent task such as tag suggestion.
+// Its responses are served from the user's semantic response cache when that is enabled.
+func (s *AIService) idempotentLLM(task string, userID int32) pluginai.LLMService {
+	return s.ResponseCache.Wrap(s.LLMService, task, userID)
+}
+
+// getScheduleContexts returns the store for schedule agent conversation contexts.
+// There is no session cache, so all server instances see the latest turn.
+func (s *AIService) getScheduleContexts() *agent.ContextStore {
+	s.scheduleContextsOnce.Do(func() {
+		s.scheduleContexts = agent.NewPersistentContextStore(session.NewSessionStore(s.Store, nil), "schedule")
+	})
+	return s.scheduleContexts
+}
+
 // routerLLMClient adapts LLMService to router.LLMClient interface.
 type routerLLMClient struct {
 	llm pluginai.LLMService
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hrygo/divinesense/plugin/httpgetter"
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	"github.com/hrygo/divinesense/server/service/capture"
+)
+
+// CaptureURL fetches a web page and saves its article, summary and tags as a memo.
+func (s *AIService) CaptureURL(ctx context.Context, req *v1pb.CaptureURLRequest) (*v1pb.CaptureURLResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if req.Url == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "url is required")
+	}
+	visibility, err := parseMemoVisibility(req.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.getCaptureService(user.ID).Capture(ctx, user.ID, &capture.CaptureRequest{
+		URL:        req.Url,
+		Save:       true,
+		Visibility: visibility,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, capture.ErrInvalidURL), errors.Is(err, httpgetter.ErrInternalIP):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, capture.ErrPublicDisallowed):
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		case errors.Is(err, capture.ErrFetchFailed):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to capture page: %v", err)
+		}
+	}
+
+	return &v1pb.CaptureURLResponse{
+		MemoName:  fmt.Sprintf("%s%s", MemoNamePrefix, result.Memo.UID),
+		Title:     result.Article.Title,
+		SourceUrl: result.Article.URL,
+		Summary:   result.Summary,
+		Tags:      result.Tags,
+		Content:   result.Memo.Content,
+	}, nil
+}
+
+// getCaptureService returns the web capture service for a user.
+func (s *AIService) getCaptureService(userID int32) capture.Service {
+	// Page summaries and tag suggestions depend only on the page
+	return capture.NewService(s.Store, s.idempotentLLM("capture", userID))
+}
@@ -3,7 +3,6 @@ package v1
 import (
 	"context"
 	"log/slog"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -11,6 +10,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/hrygo/divinesense/plugin/ai/agent"
+	"github.com/hrygo/divinesense/plugin/ai/tokenizer"
 	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
 	aichat "github.com/hrygo/divinesense/server/router/api/v1/ai"
 )
@@ -36,10 +37,22 @@ func (s *AIService) getContextBuilder() *aichat.ContextBuilder {
 
 	if s.contextBuilder == nil {
 		s.contextBuilder = aichat.NewContextBuilder(s.Store)
+		s.contextBuilder.SetModel(s.llmModel())
 	}
 	return s.contextBuilder
 }
 
+// getChatRouter returns the chat router, initializing it on first use.
+func (s *AIService) getChatRouter() *agent.ChatRouter {
+	s.chatRouterMu.Lock()
+	defer s.chatRouterMu.Unlock()
+
+	if s.chatRouter == nil {
+		s.chatRouter = aichat.NewChatRouter(s.IntentClassifierConfig, s.getRouterService())
+	}
+	return s.chatRouter
+}
+
 // getConversationSummarizer returns the conversation summarizer, initializing on first use.
 func (s *AIService) getConversationSummarizer() *aichat.ConversationSummarizer {
 	s.conversationSummarizerMu.Lock()
@@ -51,10 +64,21 @@ func (s *AIService) getConversationSummarizer() *aichat.ConversationSummarizer {
 			s.LLMService,
 			11, // Default threshold
 		)
+		// Summarize before the history outgrows its budget and old messages are dropped
+		contextBuilder := s.getContextBuilder()
+		s.conversationSummarizer.SetTokenThreshold(contextBuilder.TokenCounter(), contextBuilder.MaxTokens()*3/4)
 	}
 	return s.conversationSummarizer
 }
 
+// llmModel returns the configured LLM model name, empty if unknown.
+func (s *AIService) llmModel() string {
+	if s.Profile == nil {
+		return ""
+	}
+	return s.Profile.AILLMModel
+}
+
 // Chat streams a chat response with AI agents.
 // Emits events for conversation persistence (handled by ConversationService).
 func (s *AIService) Chat(req *v1pb.ChatRequest, stream v1pb.AIService_ChatServer) error {
@@ -72,11 +96,7 @@ func (s *AIService) Chat(req *v1pb.ChatRequest, stream v1pb.AIService_ChatServer
 	if err != nil {
 		return status.Errorf(codes.Unauthenticated, "unauthorized")
 	}
-
-	userKey := strconv.FormatInt(int64(user.ID), 10)
-	if !globalAILimiter.Allow(userKey) {
-		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded")
-	}
+	ctx = withUserLocale(ctx, s.Store, user.ID)
 
 	chatReq := aichat.ToChatRequest(req)
 	chatReq.UserID = user.ID
@@ -197,6 +217,7 @@ func (s *AIService) Chat(req *v1pb.ChatRequest, stream v1pb.AIService_ChatServer
 		agentType:         chatReq.AgentType,
 		conversationID:    chatReq.ConversationID,
 		isTemp:            chatReq.IsTempConversation,
+		metadata:          aichat.NewMetadataCollector(),
 	}
 
 	if err := handler.Handle(ctx, chatReq, collectingStream); err != nil {
@@ -212,14 +233,15 @@ func (s *AIService) createChatHandler() aichat.Handler {
 		s.LLMService,
 		s.AdaptiveRetriever,
 		s.Store,
-	)
+	).WithMemoryService(s.getMemoryService()).
+		WithContextStore(s.getScheduleContexts()).
+		WithTokenizer(tokenizer.ForModel(s.llmModel()))
 	parrotHandler := aichat.NewParrotHandler(factory, s.LLMService)
+	parrotHandler.SetEpisodicMemory(s.getMemoryService())
 
 	// Configure chat router for auto-routing if intent classifier is enabled
 	if s.IntentClassifierConfig != nil && s.IntentClassifierConfig.Enabled {
-		routerSvc := s.getRouterService()
-		chatRouter := aichat.NewChatRouter(s.IntentClassifierConfig, routerSvc)
-		parrotHandler.SetChatRouter(chatRouter)
+		parrotHandler.SetChatRouter(s.getChatRouter())
 		slog.Info("Chat router enabled with three-layer routing",
 			"model", s.IntentClassifierConfig.Model,
 		)
@@ -252,6 +274,7 @@ type eventCollectingStream struct {
 	isTemp         bool
 	mu             sync.Mutex
 	builder        strings.Builder
+	metadata       *aichat.MetadataCollector
 }
 
 func (s *eventCollectingStream) Send(resp *v1pb.ChatResponse) error {
@@ -260,12 +283,18 @@ func (s *eventCollectingStream) Send(resp *v1pb.ChatResponse) error {
 		s.mu.Lock()
 		s.builder.WriteString(resp.EventData)
 		s.mu.Unlock()
+	} else if resp.EventType != "" {
+		// Keep citations and schedule cards so they can be persisted with the answer
+		s.mu.Lock()
+		s.metadata.Collect(resp.EventType, resp.EventData)
+		s.mu.Unlock()
 	}
 
 	// When stream is done, emit assistant response event
 	if resp.Done {
 		s.mu.Lock()
 		response := s.builder.String()
+		metadata := s.metadata.Metadata()
 		s.mu.Unlock()
 
 		if response != "" {
@@ -274,6 +303,7 @@ func (s *eventCollectingStream) Send(resp *v1pb.ChatResponse) error {
 				UserID:             s.userID,
 				AgentType:          s.agentType,
 				AssistantResponse:  response,
+				AssistantMetadata:  metadata,
 				ConversationID:     s.conversationID,
 				IsTempConversation: s.isTemp,
 				Timestamp:          time.Now().Unix(),
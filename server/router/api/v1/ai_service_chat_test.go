@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pluginai "github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+	"github.com/hrygo/divinesense/plugin/ai/router"
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	aichat "github.com/hrygo/divinesense/server/router/api/v1/ai"
+)
+
+// stubLLM is an LLM service that is never reached because agent creation fails first.
+type stubLLM struct{}
+
+func (stubLLM) Chat(context.Context, []pluginai.Message) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (stubLLM) ChatStream(context.Context, []pluginai.Message) (<-chan string, <-chan error) {
+	errCh := make(chan error, 1)
+	errCh <- errors.New("not implemented")
+	return nil, errCh
+}
+
+func (stubLLM) ChatWithTools(context.Context, []pluginai.Message, []pluginai.ToolDescriptor) (*pluginai.ChatResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+type recordingChatStream struct {
+	ctx    context.Context
+	events []*v1pb.ChatResponse
+}
+
+func (s *recordingChatStream) Send(resp *v1pb.ChatResponse) error {
+	s.events = append(s.events, resp)
+	return nil
+}
+
+func (s *recordingChatStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAIService_ChatRecordsRoutingCorrection(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.NewMockMemoryService()
+	s := &AIService{
+		LLMService:             stubLLM{},
+		IntentClassifierConfig: &pluginai.IntentClassifierConfig{Enabled: true},
+		routerService:          router.NewService(router.Config{MemoryService: mem}),
+	}
+
+	// Without a store no agent can be created, but routing and corrections happen before that.
+	// Each chat creates its own handler, as Chat does.
+	stream := &recordingChatStream{ctx: ctx}
+	err := s.createChatHandler().Handle(ctx, &aichat.ChatRequest{
+		Message:   "明天下午3点开会",
+		AgentType: aichat.AgentTypeAuto,
+		UserID:    42,
+	}, stream)
+	require.Error(t, err)
+	require.NotEmpty(t, stream.events)
+	assert.Equal(t, "routing", stream.events[0].EventType)
+
+	// The user re-sends the message to the memo assistant
+	err = s.createChatHandler().Handle(ctx, &aichat.ChatRequest{
+		Message:   "明天下午3点开会",
+		AgentType: aichat.AgentTypeMemo,
+		UserID:    42,
+	}, &recordingChatStream{ctx: ctx})
+	require.Error(t, err)
+
+	userID := int32(42)
+	corrections, err := mem.ListRoutingCorrections(ctx, &userID, 10)
+	require.NoError(t, err)
+	require.Len(t, corrections, 1)
+	assert.Equal(t, "memo", corrections[0].AgentType)
+	assert.Equal(t, memory.RoutingCorrectionSummaryPrefix+string(router.IntentScheduleCreate), corrections[0].Summary)
+}
@@ -0,0 +1,188 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/server/service/digest"
+	memoservice "github.com/hrygo/divinesense/server/service/memo"
+)
+
+// maxDigestsPerUser bounds the number of scheduled digests a user can configure.
+const maxDigestsPerUser = 10
+
+// ListDigests returns the scheduled AI digests of the current user.
+func (s *AIService) ListDigests(ctx context.Context, _ *v1pb.ListDigestsRequest) (*v1pb.ListDigestsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	digests, err := s.Store.GetUserDigests(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get digests: %v", err)
+	}
+	return convertDigestsToResponse(digests), nil
+}
+
+// UpdateDigests replaces the scheduled AI digests of the current user.
+func (s *AIService) UpdateDigests(ctx context.Context, req *v1pb.UpdateDigestsRequest) (*v1pb.ListDigestsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if len(req.Digests) > maxDigestsPerUser {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d digests are allowed", maxDigestsPerUser)
+	}
+
+	existing, err := s.Store.GetUserDigests(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get digests: %v", err)
+	}
+	lastRuns := make(map[string]int64, len(existing))
+	for _, d := range existing {
+		lastRuns[d.Id] = d.LastRunTs
+	}
+
+	seen := make(map[string]bool, len(req.Digests))
+	digests := make([]*storepb.DigestsUserSetting_Digest, 0, len(req.Digests))
+	for _, d := range req.Digests {
+		if d.Id == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "digest id is required")
+		}
+		if seen[d.Id] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate digest id %q", d.Id)
+		}
+		seen[d.Id] = true
+
+		converted, err := convertDigestFromRequest(d)
+		if err != nil {
+			return nil, err
+		}
+		if err := digest.ValidateDigest(converted); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "digest %q: %v", d.Id, err)
+		}
+		// The run time is owned by the server
+		converted.LastRunTs = lastRuns[d.Id]
+		digests = append(digests, converted)
+	}
+
+	if err := s.Store.SetUserDigests(ctx, user.ID, digests); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update digests: %v", err)
+	}
+	return convertDigestsToResponse(digests), nil
+}
+
+// RunDigest generates a digest immediately, regardless of its schedule.
+func (s *AIService) RunDigest(ctx context.Context, req *v1pb.RunDigestRequest) (*v1pb.RunDigestResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+
+	digests, err := s.Store.GetUserDigests(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get digests: %v", err)
+	}
+	var target *storepb.DigestsUserSetting_Digest
+	for _, d := range digests {
+		if d.Id == req.Id {
+			target = d
+			break
+		}
+	}
+	if target == nil {
+		return nil, status.Errorf(codes.NotFound, "digest not found")
+	}
+
+	result, err := digest.NewService(s.Store, s.LLMService, s.Profile).Run(ctx, user.ID, target, time.Now())
+	if errors.Is(err, memoservice.ErrContentTooLong) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to run digest: %v", err)
+	}
+
+	delivered := make([]string, 0, len(result.Delivered))
+	for _, channel := range result.Delivered {
+		delivered = append(delivered, channel.String())
+	}
+	return &v1pb.RunDigestResponse{
+		MemoName:  fmt.Sprintf("%s%s", MemoNamePrefix, result.Memo.UID),
+		Content:   result.Memo.Content,
+		Delivered: delivered,
+	}, nil
+}
+
+func convertDigestsToResponse(digests []*storepb.DigestsUserSetting_Digest) *v1pb.ListDigestsResponse {
+	response := &v1pb.ListDigestsResponse{
+		Digests: make([]*v1pb.Digest, 0, len(digests)),
+	}
+	for _, d := range digests {
+		channels := make([]string, 0, len(d.Channels))
+		for _, channel := range d.Channels {
+			channels = append(channels, channel.String())
+		}
+		response.Digests = append(response.Digests, &v1pb.Digest{
+			Id:               d.Id,
+			Kind:             d.Kind.String(),
+			Cron:             d.Cron,
+			Timezone:         d.Timezone,
+			Enabled:          d.Enabled,
+			Tags:             d.Tags,
+			IncludeMemos:     d.IncludeMemos,
+			IncludeSchedules: d.IncludeSchedules,
+			IncludeReviews:   d.IncludeReviews,
+			Channels:         channels,
+			LastRunTs:        d.LastRunTs,
+		})
+	}
+	return response
+}
+
+func convertDigestFromRequest(d *v1pb.Digest) (*storepb.DigestsUserSetting_Digest, error) {
+	kind, ok := storepb.DigestsUserSetting_Digest_Kind_value[strings.ToUpper(d.Kind)]
+	if !ok || kind == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid digest kind %q", d.Kind)
+	}
+
+	channels := make([]storepb.DigestsUserSetting_Digest_Channel, 0, len(d.Channels))
+	for _, name := range d.Channels {
+		channel, ok := storepb.DigestsUserSetting_Digest_Channel_value[strings.ToUpper(name)]
+		if !ok || channel == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid digest channel %q", name)
+		}
+		channels = append(channels, storepb.DigestsUserSetting_Digest_Channel(channel))
+	}
+
+	tags := make([]string, 0, len(d.Tags))
+	for _, tag := range d.Tags {
+		if tag = strings.TrimPrefix(strings.TrimSpace(tag), "#"); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return &storepb.DigestsUserSetting_Digest{
+		Id:               d.Id,
+		Kind:             storepb.DigestsUserSetting_Digest_Kind(kind),
+		Cron:             strings.TrimSpace(d.Cron),
+		Timezone:         d.Timezone,
+		Enabled:          d.Enabled,
+		Tags:             tags,
+		IncludeMemos:     d.IncludeMemos,
+		IncludeSchedules: d.IncludeSchedules,
+		IncludeReviews:   d.IncludeReviews,
+		Channels:         channels,
+	}, nil
+}
@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hrygo/divinesense/plugin/ai/habit"
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+)
+
+// maxHabitListSize bounds the user-provided habit lists.
+const maxHabitListSize = 20
+
+// GetUserHabits returns the habits learned for the current user.
+func (s *AIService) GetUserHabits(ctx context.Context, _ *v1pb.GetUserHabitsRequest) (*v1pb.UserHabits, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	prefs, err := s.getUserPreferences(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get habits: %v", err)
+	}
+	return convertUserHabitsToProto(prefs), nil
+}
+
+// UpdateUserHabits corrects learned habits. Corrected fields are kept by later learning runs.
+func (s *AIService) UpdateUserHabits(ctx context.Context, req *v1pb.UpdateUserHabitsRequest) (*v1pb.UserHabits, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if req.Habits == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "habits is required")
+	}
+	if req.UpdateMask == nil || len(req.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	corrected := &memory.UserPreferences{}
+	for _, field := range req.UpdateMask.Paths {
+		switch field {
+		case habit.FieldPreferredTimes:
+			times, err := normalizeHabitList(req.Habits.PreferredTimes)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range times {
+				if _, err := time.Parse("15:04", t); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid preferred time %q, expected HH:MM", t)
+				}
+			}
+			corrected.PreferredTimes = times
+		case habit.FieldDefaultDuration:
+			if req.Habits.DefaultDuration <= 0 || req.Habits.DefaultDuration > 24*60 {
+				return nil, status.Errorf(codes.InvalidArgument, "default_duration must be between 1 and 1440 minutes")
+			}
+			corrected.DefaultDuration = int(req.Habits.DefaultDuration)
+		case habit.FieldFrequentLocations:
+			if corrected.FrequentLocations, err = normalizeHabitList(req.Habits.FrequentLocations); err != nil {
+				return nil, err
+			}
+		case habit.FieldFrequentKeywords:
+			if corrected.TagPreferences, err = normalizeHabitList(req.Habits.FrequentKeywords); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update mask path: %s", field)
+		}
+	}
+
+	prefs, err := s.getUserPreferences(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get habits: %v", err)
+	}
+	habit.ApplyCorrection(prefs, corrected, req.UpdateMask.Paths)
+	if err := s.getMemoryService().UpdatePreferences(ctx, user.ID, prefs); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update habits: %v", err)
+	}
+	return convertUserHabitsToProto(prefs), nil
+}
+
+// ResetUserHabits forgets learned habits and corrections of the current user.
+func (s *AIService) ResetUserHabits(ctx context.Context, _ *v1pb.ResetUserHabitsRequest) (*v1pb.UserHabits, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	prefs, err := s.getUserPreferences(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get habits: %v", err)
+	}
+	habit.ResetLearned(prefs)
+	if err := s.getMemoryService().UpdatePreferences(ctx, user.ID, prefs); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reset habits: %v", err)
+	}
+	return convertUserHabitsToProto(prefs), nil
+}
+
+// getUserPreferences returns the stored preferences of a user, or defaults if none exist.
+func (s *AIService) getUserPreferences(ctx context.Context, userID int32) (*memory.UserPreferences, error) {
+	prefs, err := s.getMemoryService().GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if prefs == nil {
+		prefs = memory.DefaultPreferences()
+	}
+	return prefs, nil
+}
+
+// normalizeHabitList trims and de-duplicates a user-provided list.
+func normalizeHabitList(values []string) ([]string, error) {
+	if len(values) > maxHabitListSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d values are allowed", maxHabitListSize)
+	}
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v != "" && !slices.Contains(result, v) {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+func convertUserHabitsToProto(prefs *memory.UserPreferences) *v1pb.UserHabits {
+	return &v1pb.UserHabits{
+		PreferredTimes:    prefs.PreferredTimes,
+		DefaultDuration:   int32(prefs.DefaultDuration),
+		FrequentLocations: prefs.FrequentLocations,
+		FrequentKeywords:  prefs.TagPreferences,
+		OverriddenFields:  habit.Overrides(prefs),
+	}
+}
@@ -0,0 +1,276 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hrygo/divinesense/plugin/ai/tags"
+	"github.com/hrygo/divinesense/plugin/markdown"
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	aichat "github.com/hrygo/divinesense/server/router/api/v1/ai"
+	memoservice "github.com/hrygo/divinesense/server/service/memo"
+	"github.com/hrygo/divinesense/store"
+)
+
+const (
+	// maxSavedMemoTags is the maximum number of suggested tags applied to a saved conversation.
+	maxSavedMemoTags = 5
+	// maxTagSuggestionInput is the content length (in runes) passed to the tag suggester.
+	maxTagSuggestionInput = 5000
+)
+
+// SaveConversationAsMemo renders a conversation (or selected messages) to markdown and saves it as a memo.
+func (s *AIService) SaveConversationAsMemo(ctx context.Context, req *v1pb.SaveConversationAsMemoRequest) (*v1pb.SaveAsMemoResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	conversation, err := s.getOwnedConversation(ctx, req.ConversationId, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.Store.ListAIMessages(ctx, &store.FindAIMessage{
+		ConversationID: &conversation.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list messages: %v", err)
+	}
+
+	if len(req.MessageUids) > 0 {
+		selected := make(map[string]bool, len(req.MessageUids))
+		for _, uid := range req.MessageUids {
+			selected[uid] = true
+		}
+		filtered := make([]*store.AIMessage, 0, len(req.MessageUids))
+		for _, m := range messages {
+			if selected[m.UID] {
+				filtered = append(filtered, m)
+			}
+		}
+		if len(filtered) != len(selected) {
+			return nil, status.Errorf(codes.InvalidArgument, "some messages do not belong to the conversation")
+		}
+		messages = filtered
+	}
+
+	title := req.Title
+	if title == "" {
+		title = conversation.Title
+	}
+
+	export := aichat.RenderMessagesAsMemo(title, messages, loadTimezone(req.UserTimezone))
+	return s.saveExportAsMemo(ctx, user, export, req.Visibility)
+}
+
+// SaveMessageAsMemo saves a single assistant answer (optionally with its question) as a memo.
+func (s *AIService) SaveMessageAsMemo(ctx context.Context, req *v1pb.SaveMessageAsMemoRequest) (*v1pb.SaveAsMemoResponse, error) {
+	if req.MessageUid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "message_uid is required")
+	}
+
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	conversation, err := s.getOwnedConversation(ctx, req.ConversationId, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.Store.ListAIMessages(ctx, &store.FindAIMessage{
+		ConversationID: &conversation.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list messages: %v", err)
+	}
+
+	index := -1
+	for i, m := range messages {
+		if m.UID == req.MessageUid {
+			index = i
+			break
+		}
+	}
+	if index < 0 || messages[index].Type != store.AIMessageTypeMessage {
+		return nil, status.Errorf(codes.NotFound, "message not found")
+	}
+
+	selected := []*store.AIMessage{messages[index]}
+	if req.IncludeQuestion {
+		// Walk back to the user message that prompted this answer
+		for i := index - 1; i >= 0; i-- {
+			if messages[i].Type == store.AIMessageTypeSeparator {
+				break
+			}
+			if messages[i].Type == store.AIMessageTypeMessage && messages[i].Role == store.AIMessageRoleUser {
+				selected = append([]*store.AIMessage{messages[i]}, selected...)
+				break
+			}
+		}
+	}
+
+	export := aichat.RenderMessagesAsMemo("", selected, loadTimezone(req.UserTimezone))
+	return s.saveExportAsMemo(ctx, user, export, req.Visibility)
+}
+
+// getOwnedConversation loads a conversation and verifies it belongs to the user.
+func (s *AIService) getOwnedConversation(ctx context.Context, id, userID int32) (*store.AIConversation, error) {
+	if id == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "conversation_id is required")
+	}
+	conversations, err := s.Store.ListAIConversations(ctx, &store.FindAIConversation{
+		ID:        &id,
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get conversation: %v", err)
+	}
+	if len(conversations) == 0 {
+		return nil, status.Errorf(codes.NotFound, "conversation not found")
+	}
+	return conversations[0], nil
+}
+
+// saveExportAsMemo creates a memo from rendered conversation content, applying
+// suggested tags and linking every cited memo with a REFERENCE relation.
+func (s *AIService) saveExportAsMemo(ctx context.Context, user *store.User, export *aichat.MemoExport, visibility string) (*v1pb.SaveAsMemoResponse, error) {
+	if export.Content == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "nothing to save")
+	}
+
+	memoVisibility, err := parseMemoVisibility(visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	if memoVisibility == store.Public {
+		setting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get instance memo related setting")
+		}
+		if setting.DisallowPublicVisibility {
+			return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+		}
+	}
+
+	content := export.Content
+	tagNames := s.suggestTagsForContent(ctx, user.ID, content)
+	if len(tagNames) > 0 {
+		content += "\n\n#" + strings.Join(tagNames, " #")
+	}
+
+	markdownService := s.MarkdownService
+	if markdownService == nil {
+		markdownService = markdown.NewService(markdown.WithTagExtension())
+	}
+	memo, err := memoservice.Create(ctx, s.Store, markdownService, &store.Memo{
+		UID:        shortuuid.New(),
+		CreatorID:  user.ID,
+		Content:    content,
+		Visibility: memoVisibility,
+	})
+	if errors.Is(err, memoservice.ErrContentTooLong) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo: %v", err)
+	}
+
+	references := make([]string, 0, len(export.CitedMemoUIDs))
+	for _, uid := range export.CitedMemoUIDs {
+		cited, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+		if err != nil || cited == nil {
+			slog.Default().Debug("Skipping reference to missing memo", "memo_uid", uid, "error", err)
+			continue
+		}
+		// Only link memos the user is allowed to see
+		if cited.CreatorID != user.ID && cited.Visibility == store.Private {
+			continue
+		}
+		if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: cited.ID,
+			Type:          store.MemoRelationReference,
+		}); err != nil {
+			slog.Default().Warn("Failed to create memo reference",
+				"memo_id", memo.ID,
+				"related_memo_uid", uid,
+				"error", err,
+			)
+			continue
+		}
+		references = append(references, fmt.Sprintf("%s%s", MemoNamePrefix, uid))
+	}
+
+	return &v1pb.SaveAsMemoResponse{
+		MemoName:   fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+		Content:    content,
+		Tags:       tagNames,
+		References: references,
+	}, nil
+}
+
+// suggestTagsForContent returns tag suggestions that can be written as #tags.
+// Failures are logged and yield no tags; saving the memo must not depend on them.
+func (s *AIService) suggestTagsForContent(ctx context.Context, userID int32, content string) []string {
+	if runes := []rune(content); len(runes) > maxTagSuggestionInput {
+		content = string(runes[:maxTagSuggestionInput])
+	}
+
+	response, err := s.getTagSuggester(userID).Suggest(ctx, &tags.SuggestRequest{
+		UserID:  userID,
+		Content: content,
+		MaxTags: maxSavedMemoTags,
+		UseLLM:  s.LLMService != nil,
+	})
+	if err != nil {
+		slog.Default().Warn("Failed to suggest tags for saved conversation", "user_id", userID, "error", err)
+		return nil
+	}
+
+	names := make([]string, 0, len(response.Tags))
+	for _, tag := range response.Tags {
+		name := strings.Join(strings.Fields(strings.TrimPrefix(tag.Name, "#")), "_")
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// parseMemoVisibility parses a visibility name, defaulting to PRIVATE.
+func parseMemoVisibility(visibility string) (store.Visibility, error) {
+	switch strings.ToUpper(visibility) {
+	case "", string(store.Private):
+		return store.Private, nil
+	case string(store.Protected):
+		return store.Protected, nil
+	case string(store.Public):
+		return store.Public, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "invalid visibility %q", visibility)
+	}
+}
+
+// loadTimezone returns the location for an IANA timezone name, or nil to use the default.
+func loadTimezone(name string) *time.Location {
+	if name == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil
+	}
+	return loc
+}
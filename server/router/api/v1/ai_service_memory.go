@@ -0,0 +1,314 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	aicache "github.com/hrygo/divinesense/plugin/ai/cache"
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	"github.com/hrygo/divinesense/store"
+)
+
+const (
+	// AIMemoryNamePrefix is the resource name prefix of AI memories.
+	AIMemoryNamePrefix = "ai/memories/"
+
+	aiMemoryKindEpisode = "episode"
+	aiMemoryKindSummary = "summary"
+
+	// maxAIMemoryPageSize bounds a page of ListAIMemories.
+	maxAIMemoryPageSize = 100
+)
+
+// ListAIMemories lists what the assistant remembers about the current user, newest first.
+func (s *AIService) ListAIMemories(ctx context.Context, req *v1pb.ListAIMemoriesRequest) (*v1pb.ListAIMemoriesResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	var limit, offset int
+	if req.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(req.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(req.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limit = min(limit, maxAIMemoryPageSize)
+
+	// Both sources are sorted newest first, so the first offset+limit+1 of each
+	// are enough to build the merged page.
+	var memories []*v1pb.AIMemory
+	if req.Type != v1pb.AIMemory_CONVERSATION_SUMMARY {
+		episodes, err := s.getMemoryService().ListEpisodes(ctx, user.ID, offset+limit+1, 0)
+		if err != nil && !errors.Is(err, memory.ErrLongTermNotConfigured) {
+			return nil, status.Errorf(codes.Internal, "failed to list episodes: %v", err)
+		}
+		for _, ep := range episodes {
+			memories = append(memories, convertEpisodeToAIMemory(ep))
+		}
+	}
+	if req.Type != v1pb.AIMemory_EPISODE {
+		summaries, err := s.listConversationSummaries(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list conversation summaries: %v", err)
+		}
+		for _, summary := range summaries {
+			memories = append(memories, convertSummaryToAIMemory(summary))
+		}
+	}
+	sort.SliceStable(memories, func(i, j int) bool {
+		return memories[i].CreateTime > memories[j].CreateTime
+	})
+
+	response := &v1pb.ListAIMemoriesResponse{}
+	if offset < len(memories) {
+		memories = memories[offset:]
+	} else {
+		memories = nil
+	}
+	if len(memories) > limit {
+		memories = memories[:limit]
+		nextPageToken, err := getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+		response.NextPageToken = nextPageToken
+	}
+	response.Memories = memories
+
+	prefs, err := s.getUserPreferences(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get preferences: %v", err)
+	}
+	response.Preferences = convertAIPreferencesToProto(prefs)
+	response.Habits = convertUserHabitsToProto(prefs)
+	return response, nil
+}
+
+// DeleteAIMemory forgets a single episode or conversation summary.
+func (s *AIService) DeleteAIMemory(ctx context.Context, req *v1pb.DeleteAIMemoryRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	kind, id, err := extractAIMemoryFromName(req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memory name: %v", err)
+	}
+
+	switch kind {
+	case aiMemoryKindEpisode:
+		if err := s.getMemoryService().DeleteEpisode(ctx, user.ID, id); err != nil {
+			if errors.Is(err, memory.ErrEpisodeNotFound) {
+				return nil, status.Errorf(codes.NotFound, "memory not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to delete memory: %v", err)
+		}
+	case aiMemoryKindSummary:
+		messageID := int32(id)
+		messages, err := s.Store.ListAIMessages(ctx, &store.FindAIMessage{ID: &messageID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memory: %v", err)
+		}
+		if len(messages) == 0 || messages[0].Type != store.AIMessageTypeSummary {
+			return nil, status.Errorf(codes.NotFound, "memory not found")
+		}
+		conversations, err := s.Store.ListAIConversations(ctx, &store.FindAIConversation{
+			ID:        &messages[0].ConversationID,
+			CreatorID: &user.ID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get conversation: %v", err)
+		}
+		if len(conversations) == 0 {
+			return nil, status.Errorf(codes.NotFound, "memory not found")
+		}
+		if err := s.Store.DeleteAIMessage(ctx, &store.DeleteAIMessage{ID: &messageID}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete memory: %v", err)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateUserPreferences updates the AI preferences of the current user.
+func (s *AIService) UpdateUserPreferences(ctx context.Context, req *v1pb.UpdateUserPreferencesRequest) (*v1pb.AIPreferences, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if req.Preferences == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "preferences is required")
+	}
+	if req.UpdateMask == nil || len(req.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	prefs, err := s.getUserPreferences(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get preferences: %v", err)
+	}
+	for _, field := range req.UpdateMask.Paths {
+		switch field {
+		case "timezone":
+			if _, err := time.LoadLocation(req.Preferences.Timezone); err != nil || req.Preferences.Timezone == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid timezone %q", req.Preferences.Timezone)
+			}
+			prefs.Timezone = req.Preferences.Timezone
+		case "communication_style":
+			if req.Preferences.CommunicationStyle != "concise" && req.Preferences.CommunicationStyle != "detailed" {
+				return nil, status.Errorf(codes.InvalidArgument, "communication_style must be concise or detailed")
+			}
+			prefs.CommunicationStyle = req.Preferences.CommunicationStyle
+		case "incognito":
+			prefs.Incognito = req.Preferences.Incognito
+		case "search_log_disabled":
+			prefs.SearchLogDisabled = req.Preferences.SearchLogDisabled
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update mask path: %s", field)
+		}
+	}
+
+	if err := s.getMemoryService().UpdatePreferences(ctx, user.ID, prefs); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update preferences: %v", err)
+	}
+	// Disabling search logging also forgets the searches logged so far.
+	if prefs.SearchLogDisabled {
+		if _, err := s.Store.DeleteSearchLogs(ctx, &store.DeleteSearchLog{UserID: &user.ID}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete search logs: %v", err)
+		}
+	}
+	return convertAIPreferencesToProto(prefs), nil
+}
+
+// ForgetEverything deletes all memories, preferences, habits, conversation summaries,
+// agent session contexts, search logs and LLM responses cached from the prompts of the
+// current user. Incognito mode and the search log setting are kept.
+//
+// Conversations are kept, and so are the embeddings of their messages: they index the
+// chat history the user still sees, and would be rebuilt from it by the embedding runner.
+// Deleting a conversation deletes its message embeddings.
+func (s *AIService) ForgetEverything(ctx context.Context, _ *v1pb.ForgetEverythingRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.getMemoryService().Forget(ctx, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to forget memories: %v", err)
+	}
+
+	summaries, err := s.listConversationSummaries(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list conversation summaries: %v", err)
+	}
+	for _, summary := range summaries {
+		if err := s.Store.DeleteAIMessage(ctx, &store.DeleteAIMessage{ID: &summary.ID}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete conversation summary: %v", err)
+		}
+	}
+	if _, err := s.Store.DeleteConversationContexts(ctx, &store.DeleteConversationContext{UserID: &user.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete session contexts: %v", err)
+	}
+	if _, err := s.Store.DeleteSearchLogs(ctx, &store.DeleteSearchLog{UserID: &user.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete search logs: %v", err)
+	}
+	if s.ResponseCache != nil {
+		err = s.ResponseCache.ForgetUser(ctx, user.ID)
+	} else {
+		err = aicache.DeleteUserResponses(ctx, s.Store, user.ID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete cached responses: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// listConversationSummaries returns the summary messages of all conversations of a user.
+func (s *AIService) listConversationSummaries(ctx context.Context, userID int32) ([]*store.AIMessage, error) {
+	conversations, err := s.Store.ListAIConversations(ctx, &store.FindAIConversation{CreatorID: &userID})
+	if err != nil {
+		return nil, err
+	}
+
+	summaryType := store.AIMessageTypeSummary
+	var summaries []*store.AIMessage
+	for _, conversation := range conversations {
+		messages, err := s.Store.ListAIMessages(ctx, &store.FindAIMessage{
+			ConversationID: &conversation.ID,
+			Type:           &summaryType,
+		})
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, messages...)
+	}
+	return summaries, nil
+}
+
+// extractAIMemoryFromName parses "ai/memories/{kind}-{id}".
+func extractAIMemoryFromName(name string) (string, int64, error) {
+	token, ok := strings.CutPrefix(name, AIMemoryNamePrefix)
+	if !ok {
+		return "", 0, fmt.Errorf("expected prefix %q in %q", AIMemoryNamePrefix, name)
+	}
+	kind, idText, ok := strings.Cut(token, "-")
+	if !ok || (kind != aiMemoryKindEpisode && kind != aiMemoryKindSummary) {
+		return "", 0, fmt.Errorf("unknown memory kind in %q", name)
+	}
+	id, err := strconv.ParseInt(idText, 10, 64)
+	if err != nil || id <= 0 {
+		return "", 0, fmt.Errorf("invalid memory id in %q", name)
+	}
+	return kind, id, nil
+}
+
+func convertEpisodeToAIMemory(ep memory.EpisodicMemory) *v1pb.AIMemory {
+	return &v1pb.AIMemory{
+		Name:       fmt.Sprintf("%s%s-%d", AIMemoryNamePrefix, aiMemoryKindEpisode, ep.ID),
+		Type:       v1pb.AIMemory_EPISODE,
+		AgentType:  ep.AgentType,
+		UserInput:  ep.UserInput,
+		Content:    ep.Summary,
+		Outcome:    ep.Outcome,
+		Importance: ep.Importance,
+		CreateTime: ep.Timestamp.Unix(),
+	}
+}
+
+func convertSummaryToAIMemory(msg *store.AIMessage) *v1pb.AIMemory {
+	return &v1pb.AIMemory{
+		Name:           fmt.Sprintf("%s%s-%d", AIMemoryNamePrefix, aiMemoryKindSummary, msg.ID),
+		Type:           v1pb.AIMemory_CONVERSATION_SUMMARY,
+		Content:        msg.Content,
+		ConversationId: msg.ConversationID,
+		CreateTime:     msg.CreatedTs,
+	}
+}
+
+func convertAIPreferencesToProto(prefs *memory.UserPreferences) *v1pb.AIPreferences {
+	return &v1pb.AIPreferences{
+		Timezone:           prefs.Timezone,
+		CommunicationStyle: prefs.CommunicationStyle,
+		Incognito:          prefs.Incognito,
+		SearchLogDisabled:  prefs.SearchLogDisabled,
+	}
+}
@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hrygo/divinesense/plugin/ai/router"
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+)
+
+// maxRoutingReportCorrections bounds the corrections aggregated by GetRoutingReport.
+const maxRoutingReportCorrections = 1000
+
+// GetRoutingReport summarizes how users corrected assistant routing, by predicted intent.
+func (s *AIService) GetRoutingReport(ctx context.Context, _ *v1pb.GetRoutingReportRequest) (*v1pb.RoutingReport, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	corrections, err := s.getMemoryService().ListRoutingCorrections(ctx, nil, maxRoutingReportCorrections)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list routing corrections: %v", err)
+	}
+
+	report := &v1pb.RoutingReport{TotalCorrections: int32(len(corrections))}
+	for _, c := range router.BuildConfusionReport(corrections) {
+		report.Confusions = append(report.Confusions, &v1pb.RoutingReport_Confusion{
+			PredictedIntent: string(c.Predicted),
+			CorrectedAgent:  string(c.Corrected),
+			Count:           int32(c.Count),
+		})
+	}
+	return report, nil
+}
@@ -0,0 +1,163 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	"github.com/hrygo/divinesense/server/service/searchlog"
+	"github.com/hrygo/divinesense/store"
+)
+
+const (
+	// allUsersName selects the searches of all users in GetSearchInsights.
+	allUsersName = UserNamePrefix + "-"
+	// defaultSearchInsightDays is the default look-back window of search insights.
+	defaultSearchInsightDays = 30
+	// defaultSearchInsightLimit is the default number of entries per list of search insights.
+	defaultSearchInsightLimit = 10
+	// maxSearchInsightLimit bounds the number of entries per list of search insights.
+	maxSearchInsightLimit = 100
+	// maxSearchInsightLogs bounds the search logs summarized, newest first.
+	maxSearchInsightLogs = 10000
+)
+
+// RecordSearchClick records that a memo was opened from the results of a search of the current user.
+func (s *AIService) RecordSearchClick(ctx context.Context, req *v1pb.RecordSearchClickRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	memoUID, err := ExtractMemoUIDFromName(req.Memo)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+
+	searchLog, err := s.Store.GetSearchLog(ctx, &store.FindSearchLog{ID: &req.SearchId, UserID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get search log: %v", err)
+	}
+	if searchLog == nil {
+		return nil, status.Errorf(codes.NotFound, "search not found")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	if err := s.Store.UpdateSearchLog(ctx, &store.UpdateSearchLog{ID: searchLog.ID, ClickedMemoID: &memo.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update search log: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSearchInsights summarizes the logged searches of the current user, or of
+// another or all users for an admin. Memo suggestions are only computed for a single user.
+func (s *AIService) GetSearchInsights(ctx context.Context, req *v1pb.GetSearchInsightsRequest) (*v1pb.SearchInsights, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	var userID *int32
+	switch req.User {
+	case "":
+		userID = &user.ID
+	case allUsersName:
+		if !isSuperUser(user) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	default:
+		id, err := ExtractUserIDFromName(req.User)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+		}
+		if id != user.ID && !isSuperUser(user) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		userID = &id
+	}
+
+	days := int(req.Days)
+	if days <= 0 {
+		days = defaultSearchInsightDays
+	}
+	days = min(days, searchlog.RetentionDays)
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSearchInsightLimit
+	}
+	limit = min(limit, maxSearchInsightLimit)
+
+	since := time.Now().AddDate(0, 0, -days).Unix()
+	logs, err := s.Store.ListSearchLogs(ctx, &store.FindSearchLog{
+		UserID:         userID,
+		CreatedTsAfter: &since,
+		Limit:          maxSearchInsightLogs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list search logs: %v", err)
+	}
+
+	response := convertSearchInsightsToProto(searchlog.Summarize(logs, limit))
+	response.RetentionDays = searchlog.RetentionDays
+	if userID == nil {
+		return response, nil
+	}
+
+	service := searchlog.NewService(s.Store)
+	response.LoggingDisabled = service.IsDisabled(ctx, *userID)
+	suggestions, err := service.SuggestMemos(ctx, *userID, s.EmbeddingModel, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to suggest memos: %v", err)
+	}
+	for _, memo := range suggestions.WithoutEmbedding {
+		response.MemosWithoutEmbedding = append(response.MemosWithoutEmbedding, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+	}
+	for _, memo := range suggestions.WithoutTags {
+		response.MemosWithoutTags = append(response.MemosWithoutTags, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+	}
+	return response, nil
+}
+
+func convertSearchInsightsToProto(insights *searchlog.Insights) *v1pb.SearchInsights {
+	response := &v1pb.SearchInsights{
+		TotalSearches:      int32(insights.Total),
+		ZeroResultSearches: int32(insights.ZeroResult),
+		AvgLatencyMs:       int32(insights.AvgLatencyMs),
+		TopQueries:         convertQueryStatsToProto(insights.TopQueries),
+		ZeroResultQueries:  convertQueryStatsToProto(insights.ZeroResultQueries),
+		SlowQueries:        convertQueryStatsToProto(insights.SlowQueries),
+		Strategies:         make(map[string]int32, len(insights.Strategies)),
+	}
+	if insights.Total > 0 {
+		response.ClickRate = float64(insights.Clicks) / float64(insights.Total)
+	}
+	for strategy, count := range insights.Strategies {
+		response.Strategies[strategy] = int32(count)
+	}
+	return response
+}
+
+func convertQueryStatsToProto(stats []*searchlog.QueryStat) []*v1pb.SearchInsights_QueryStat {
+	list := make([]*v1pb.SearchInsights_QueryStat, 0, len(stats))
+	for _, stat := range stats {
+		list = append(list, &v1pb.SearchInsights_QueryStat{
+			Query:          stat.Query,
+			Count:          int32(stat.Count),
+			AvgResultCount: stat.AvgResultCount,
+			Clicks:         int32(stat.Clicks),
+			AvgLatencyMs:   int32(stat.AvgLatencyMs),
+			LastSearchTime: stat.LastSearchTs,
+		})
+	}
+	return list
+}
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+)
+
+const (
+	// maxSynonymGroupsPerUser bounds the number of search synonym groups a user can configure.
+	maxSynonymGroupsPerUser = 200
+	// maxSynonymGroupSize bounds the number of terms in a search synonym group.
+	maxSynonymGroupSize = 10
+	// maxSynonymTermLength bounds the length of a search synonym term in runes.
+	maxSynonymTermLength = 64
+)
+
+// ListSearchSynonyms returns the search synonyms of the current user.
+func (s *AIService) ListSearchSynonyms(ctx context.Context, _ *v1pb.ListSearchSynonymsRequest) (*v1pb.ListSearchSynonymsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	groups, err := s.Store.GetUserSearchSynonyms(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get search synonyms: %v", err)
+	}
+	return convertSearchSynonymsToResponse(groups), nil
+}
+
+// UpdateSearchSynonyms replaces the search synonyms of the current user.
+// Terms are trimmed and deduplicated case-insensitively within a group.
+func (s *AIService) UpdateSearchSynonyms(ctx context.Context, req *v1pb.UpdateSearchSynonymsRequest) (*v1pb.ListSearchSynonymsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if len(req.Groups) > maxSynonymGroupsPerUser {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d synonym groups are allowed", maxSynonymGroupsPerUser)
+	}
+
+	groups := make([]*storepb.SearchSynonymsUserSetting_Group, 0, len(req.Groups))
+	for i, g := range req.Groups {
+		if len(g.Terms) > maxSynonymGroupSize {
+			return nil, status.Errorf(codes.InvalidArgument, "synonym group %d: at most %d terms are allowed", i, maxSynonymGroupSize)
+		}
+		terms := make([]string, 0, len(g.Terms))
+		seen := make(map[string]bool, len(g.Terms))
+		for _, term := range g.Terms {
+			term = strings.TrimSpace(term)
+			if term == "" || seen[strings.ToLower(term)] {
+				continue
+			}
+			if utf8.RuneCountInString(term) > maxSynonymTermLength {
+				return nil, status.Errorf(codes.InvalidArgument, "synonym group %d: term %q is too long (max %d characters)", i, term, maxSynonymTermLength)
+			}
+			seen[strings.ToLower(term)] = true
+			terms = append(terms, term)
+		}
+		if len(terms) < 2 {
+			return nil, status.Errorf(codes.InvalidArgument, "synonym group %d: at least 2 distinct terms are required", i)
+		}
+		groups = append(groups, &storepb.SearchSynonymsUserSetting_Group{Terms: terms})
+	}
+
+	if err := s.Store.SetUserSearchSynonyms(ctx, user.ID, groups); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update search synonyms: %v", err)
+	}
+	return convertSearchSynonymsToResponse(groups), nil
+}
+
+func convertSearchSynonymsToResponse(groups []*storepb.SearchSynonymsUserSetting_Group) *v1pb.ListSearchSynonymsResponse {
+	response := &v1pb.ListSearchSynonymsResponse{
+		Groups: make([]*v1pb.SynonymGroup, 0, len(groups)),
+	}
+	for _, g := range groups {
+		response.Groups = append(response.Groups, &v1pb.SynonymGroup{Terms: g.Terms})
+	}
+	return response
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/hrygo/divinesense/plugin/ai/tags"
+	"github.com/hrygo/divinesense/plugin/search"
 	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	aichat "github.com/hrygo/divinesense/server/router/api/v1/ai"
 	"github.com/hrygo/divinesense/store"
 )
 
@@ -48,6 +51,26 @@ func (s *AIService) SemanticSearch(ctx context.Context, req *v1pb.SemanticSearch
 			"query too short: minimum %d characters after trimming", minQueryLength)
 	}
 
+	// Operators such as tag:work filter the results, the remaining text is embedded.
+	// Relative dates are resolved in the user's timezone.
+	loc := loadTimezone(req.UserTimezone)
+	if loc == nil {
+		loc = aichat.GetDefaultTimezoneLocation()
+	}
+	query, err := search.ParseQuery(ctx, req.Query, time.Now().In(loc))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+	// Vector search doesn't require every word, so words joined by OR are embedded as well
+	searchText := strings.TrimSpace(strings.Join(append([]string{query.Text}, query.Alternatives...), " "))
+	if searchText == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query must contain search text besides operators")
+	}
+	var filters []string
+	if query.Filter != "" {
+		filters = []string{query.Filter}
+	}
+
 	limit := int(req.Limit)
 	if limit <= 0 {
 		limit = 10
@@ -57,16 +80,17 @@ func (s *AIService) SemanticSearch(ctx context.Context, req *v1pb.SemanticSearch
 	}
 
 	// Vectorize the query
-	queryVector, err := s.EmbeddingService.Embed(ctx, req.Query)
+	queryVector, err := s.EmbeddingService.Embed(ctx, searchText)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to embed query: %v", err)
 	}
 
 	// Vector search (Top 10, optimized for 2C2G)
 	results, err := s.Store.VectorSearch(ctx, &store.VectorSearchOptions{
-		UserID: user.ID,
-		Vector: queryVector,
-		Limit:  10,
+		UserID:  user.ID,
+		Vector:  queryVector,
+		Limit:   10,
+		Filters: filters,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to search: %v", err)
@@ -92,7 +116,7 @@ func (s *AIService) SemanticSearch(ctx context.Context, req *v1pb.SemanticSearch
 			documents[i] = r.Memo.Content
 		}
 
-		rerankResults, err := s.RerankerService.Rerank(ctx, req.Query, documents, limit)
+		rerankResults, err := s.RerankerService.Rerank(ctx, searchText, documents, limit)
 		if err == nil {
 			// Reorder based on rerank results
 			reordered := make([]*store.MemoWithScore, len(rerankResults))
@@ -167,7 +191,7 @@ func (s *AIService) SuggestTags(ctx context.Context, req *v1pb.SuggestTagsReques
 	}
 
 	// Use TagSuggester for three-layer progressive suggestions
-	suggester := s.getTagSuggester()
+	suggester := s.getTagSuggester(user.ID)
 	response, err := suggester.Suggest(ctx, &tags.SuggestRequest{
 		UserID:  user.ID,
 		Content: req.Content,
@@ -187,10 +211,10 @@ func (s *AIService) SuggestTags(ctx context.Context, req *v1pb.SuggestTagsReques
 	return &v1pb.SuggestTagsResponse{Tags: tagNames}, nil
 }
 
-// getTagSuggester returns a TagSuggester instance.
-func (s *AIService) getTagSuggester() tags.TagSuggester {
+// getTagSuggester returns a TagSuggester instance for a user.
+func (s *AIService) getTagSuggester(userID int32) tags.TagSuggester {
 	// Note: CacheService is nil for now; caching is handled gracefully
-	return tags.NewTagSuggester(s.Store, s.LLMService, nil)
+	return tags.NewTagSuggester(s.Store, s.idempotentLLM("tags", userID), nil)
 }
 
 // GetRelatedMemos finds memos related to a specific memo.
@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/hrygo/divinesense/plugin/ai/prediction"
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	aichat "github.com/hrygo/divinesense/server/router/api/v1/ai"
+	"github.com/hrygo/divinesense/server/service/proactive"
+)
+
+// GetSuggestions returns ranked, explainable next actions for the current user.
+func (s *AIService) GetSuggestions(ctx context.Context, req *v1pb.GetSuggestionsRequest) (*v1pb.GetSuggestionsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	loc := loadTimezone(req.UserTimezone)
+	if loc == nil {
+		loc = aichat.GetDefaultTimezoneLocation()
+	}
+
+	events := make([]prediction.ContextEvent, 0, len(req.RecentEvents))
+	for _, event := range req.RecentEvents {
+		events = append(events, prediction.ContextEvent{
+			Type:      event.Type,
+			TargetID:  event.TargetId,
+			Timestamp: time.Unix(event.Timestamp, 0),
+		})
+	}
+
+	suggestions, err := s.getProactiveService().Suggest(ctx, user.ID, time.Now().In(loc), events, int(req.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get suggestions: %v", err)
+	}
+
+	response := &v1pb.GetSuggestionsResponse{
+		Suggestions: make([]*v1pb.Suggestion, 0, len(suggestions)),
+	}
+	for _, sg := range suggestions {
+		response.Suggestions = append(response.Suggestions, &v1pb.Suggestion{
+			Id:         sg.ID,
+			Type:       string(sg.Type),
+			Action:     string(sg.Action),
+			Title:      sg.Title,
+			Reason:     sg.Reason,
+			Confidence: sg.Confidence,
+			Payload:    sg.Payload,
+		})
+	}
+	return response, nil
+}
+
+// RecordSuggestionFeedback records that a suggestion was accepted or dismissed.
+func (s *AIService) RecordSuggestionFeedback(ctx context.Context, req *v1pb.RecordSuggestionFeedbackRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if req.SuggestionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "suggestion_id is required")
+	}
+
+	if err := s.getProactiveService().RecordFeedback(ctx, user.ID, req.SuggestionId, req.Title, req.Accepted, time.Now()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record feedback: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getProactiveService returns the proactive suggestion service.
+func (s *AIService) getProactiveService() *proactive.Service {
+	return proactive.NewService(s.Store, s.getMemoryService())
+}
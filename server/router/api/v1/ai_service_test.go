@@ -212,3 +212,20 @@ func createTestAIService(st *store.Store, llmService ai.LLMService) *AIService {
 	}
 }
 */
+
+func TestExtractAIMemoryFromName(t *testing.T) {
+	kind, id, err := extractAIMemoryFromName("ai/memories/episode-42")
+	require.NoError(t, err)
+	require.Equal(t, "episode", kind)
+	require.Equal(t, int64(42), id)
+
+	kind, id, err = extractAIMemoryFromName("ai/memories/summary-7")
+	require.NoError(t, err)
+	require.Equal(t, "summary", kind)
+	require.Equal(t, int64(7), id)
+
+	for _, name := range []string{"memories/episode-1", "ai/memories/habit-1", "ai/memories/episode-x", "ai/memories/episode-0"} {
+		_, _, err := extractAIMemoryFromName(name)
+		require.Error(t, err, name)
+	}
+}
@@ -1,12 +1,16 @@
 package v1
 
 import (
+	"context"
 	"encoding/base64"
+	"log/slog"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/hrygo/divinesense/plugin/ai/locale"
 	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
 	"github.com/hrygo/divinesense/store"
 )
 
@@ -66,3 +70,21 @@ func unmarshalPageToken(s string, pageToken *v1pb.PageToken) error {
 func isSuperUser(user *store.User) bool {
 	return user.Role == store.RoleAdmin || user.Role == store.RoleHost
 }
+
+// withUserLocale returns ctx carrying the locale from the user's general setting,
+// which selects the rule packs of the AI routing and time parsing layers.
+// Without an explicit setting ctx is returned unchanged and the script of the input decides.
+func withUserLocale(ctx context.Context, st *store.Store, userID int32) context.Context {
+	setting, err := st.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		slog.Debug("failed to get user general setting", "user_id", userID, "error", err)
+		return ctx
+	}
+	if setting == nil {
+		return ctx
+	}
+	return locale.WithLocale(ctx, locale.Parse(setting.GetGeneral().GetLocale()))
+}
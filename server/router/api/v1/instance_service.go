@@ -47,6 +47,8 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		_, err = s.Store.GetInstanceMemoRelatedSetting(ctx)
 	case storepb.InstanceSettingKey_STORAGE:
 		_, err = s.Store.GetInstanceStorageSetting(ctx)
+	case storepb.InstanceSettingKey_AI_LIMIT:
+		_, err = s.Store.GetInstanceAILimitSetting(ctx)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported instance setting key: %v", instanceSettingKey)
 	}
@@ -122,6 +124,10 @@ func convertInstanceSettingFromStore(setting *storepb.InstanceSetting) *v1pb.Ins
 		instanceSetting.Value = &v1pb.InstanceSetting_MemoRelatedSetting_{
 			MemoRelatedSetting: convertInstanceMemoRelatedSettingFromStore(setting.GetMemoRelatedSetting()),
 		}
+	case *storepb.InstanceSetting_AiLimitSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_AiLimitSetting{
+			AiLimitSetting: convertInstanceAILimitSettingFromStore(setting.GetAiLimitSetting()),
+		}
 	}
 	return instanceSetting
 }
@@ -147,6 +153,10 @@ func convertInstanceSettingToStore(setting *v1pb.InstanceSetting) *storepb.Insta
 		instanceSetting.Value = &storepb.InstanceSetting_MemoRelatedSetting{
 			MemoRelatedSetting: convertInstanceMemoRelatedSettingToStore(setting.GetMemoRelatedSetting()),
 		}
+	case storepb.InstanceSettingKey_AI_LIMIT:
+		instanceSetting.Value = &storepb.InstanceSetting_AiLimitSetting{
+			AiLimitSetting: convertInstanceAILimitSettingToStore(setting.GetAiLimitSetting()),
+		}
 	default:
 		// Keep the default GeneralSetting value
 	}
@@ -270,6 +280,34 @@ func convertInstanceMemoRelatedSettingToStore(setting *v1pb.InstanceSetting_Memo
 	}
 }
 
+func convertInstanceAILimitSettingFromStore(setting *storepb.InstanceAILimitSetting) *v1pb.InstanceSetting_AILimitSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.InstanceSetting_AILimitSetting{
+		UserRequestsPerMinute:     setting.UserRequestsPerMinute,
+		UserConcurrentStreams:     setting.UserConcurrentStreams,
+		UserDailyLlmCalls:         setting.UserDailyLlmCalls,
+		InstanceRequestsPerMinute: setting.InstanceRequestsPerMinute,
+		InstanceConcurrentStreams: setting.InstanceConcurrentStreams,
+		InstanceDailyLlmCalls:     setting.InstanceDailyLlmCalls,
+	}
+}
+
+func convertInstanceAILimitSettingToStore(setting *v1pb.InstanceSetting_AILimitSetting) *storepb.InstanceAILimitSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.InstanceAILimitSetting{
+		UserRequestsPerMinute:     setting.UserRequestsPerMinute,
+		UserConcurrentStreams:     setting.UserConcurrentStreams,
+		UserDailyLlmCalls:         setting.UserDailyLlmCalls,
+		InstanceRequestsPerMinute: setting.InstanceRequestsPerMinute,
+		InstanceConcurrentStreams: setting.InstanceConcurrentStreams,
+		InstanceDailyLlmCalls:     setting.InstanceDailyLlmCalls,
+	}
+}
+
 var ownerCache *v1pb.User
 
 func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error) {
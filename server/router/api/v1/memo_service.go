@@ -14,9 +14,12 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/hrygo/divinesense/internal/base"
+	"github.com/hrygo/divinesense/plugin/search"
 	"github.com/hrygo/divinesense/plugin/webhook"
 	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
 	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/server/retrieval"
+	aichat "github.com/hrygo/divinesense/server/router/api/v1/ai"
 	"github.com/hrygo/divinesense/server/runner/memopayload"
 	"github.com/hrygo/divinesense/server/service/memo"
 	"github.com/hrygo/divinesense/store"
@@ -212,6 +215,23 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	if limit <= 0 {
 		limit = DefaultPageSize
 	}
+
+	// Facets cover all matching memos, so they are only computed for the first page
+	var facets *v1pb.MemoFacets
+	if request.IncludeFacets && offset == 0 {
+		// Months are bucketed in the user's timezone
+		loc := loadTimezone(request.UserTimezone)
+		if loc == nil {
+			loc = aichat.GetDefaultTimezoneLocation()
+		}
+		_, tzOffset := time.Now().In(loc).Zone()
+		memoFacets, err := s.Store.GetMemoFacets(ctx, &store.FindMemoFacets{FindMemo: *memoFind, TimezoneOffset: tzOffset})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo facets: %v", err)
+		}
+		facets = convertMemoFacetsFromStore(memoFacets)
+	}
+
 	limitPlusOne := limit + 1
 	memoFind.Limit = &limitPlusOne
 	memoFind.Offset = &offset
@@ -234,6 +254,7 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		response := &v1pb.ListMemosResponse{
 			Memos:         memoMessages,
 			NextPageToken: nextPageToken,
+			Facets:        facets,
 		}
 		return response, nil
 	}
@@ -283,6 +304,7 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	response := &v1pb.ListMemosResponse{
 		Memos:         memoMessages,
 		NextPageToken: nextPageToken,
+		Facets:        facets,
 	}
 	return response, nil
 }
@@ -849,6 +871,16 @@ func (s *APIV1Service) SearchWithHighlight(ctx context.Context, request *v1pb.Se
 		return nil, status.Errorf(codes.InvalidArgument, "query too long (max %d characters)", maxQueryLength)
 	}
 
+	// Parse operators such as tag:work or after:"last month", with dates in the user's timezone
+	loc := loadTimezone(request.UserTimezone)
+	if loc == nil {
+		loc = aichat.GetDefaultTimezoneLocation()
+	}
+	query, err := search.ParseQuery(ctx, request.Query, time.Now().In(loc))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+
 	// Check if AI service is available (PostgreSQL only)
 	if s.AIService == nil || s.AIService.AdaptiveRetriever == nil {
 		return nil, status.Errorf(codes.Unavailable, "search with highlight requires AI features (PostgreSQL only)")
@@ -880,10 +912,17 @@ func (s *APIV1Service) SearchWithHighlight(ctx context.Context, request *v1pb.Se
 		contextChars = maxContextChars
 	}
 
+	// Expand the query with the user's own vocabulary
+	started := time.Now()
+	expansions := s.AIService.AdaptiveRetriever.ExpandQuery(ctx, user.ID, query.Text)
+
 	// Use HighlightService for search
-	highlightService := memo.NewHighlightService(s.AIService.AdaptiveRetriever)
+	highlightService := memo.NewHighlightService(s.Store, s.AIService.AdaptiveRetriever)
 	results, err := highlightService.SearchWithHighlight(ctx, &memo.SearchWithHighlightOptions{
-		Query:        request.Query,
+		Query:        query.Text,
+		Filter:       query.Filter,
+		Expansions:   expansions,
+		Alternatives: query.Alternatives,
 		UserID:       user.ID,
 		Limit:        limit,
 		ContextChars: contextChars,
@@ -897,33 +936,85 @@ func (s *APIV1Service) SearchWithHighlight(ctx context.Context, request *v1pb.Se
 		return nil, status.Errorf(codes.Internal, "search failed")
 	}
 
+	// Log the search for search insights; the ID lets the client report the opened result
+	searchID := s.AIService.AdaptiveRetriever.RecordSearch(ctx, &store.SearchLog{
+		UserID:      user.ID,
+		Query:       request.Query,
+		Source:      store.SearchLogSourceSearch,
+		Strategy:    memo.SearchWithHighlightStrategy,
+		ResultCount: int32(len(results)),
+		LatencyMs:   int32(time.Since(started).Milliseconds()),
+	})
+
 	// Convert to proto response
 	response := &v1pb.SearchWithHighlightResponse{
-		Memos: make([]*v1pb.HighlightedMemo, 0, len(results)),
+		Memos:      make([]*v1pb.HighlightedMemo, 0, len(results)),
+		Expansions: make([]*v1pb.QueryExpansion, 0, len(expansions)),
+		SearchId:   searchID,
+	}
+	for _, expansion := range expansions {
+		response.Expansions = append(response.Expansions, &v1pb.QueryExpansion{
+			Term:     expansion.Term,
+			Original: expansion.Original,
+			Source:   expansion.Source,
+		})
+	}
+
+	if request.IncludeFacets {
+		memoFacets, err := s.AIService.AdaptiveRetriever.Facets(ctx, &retrieval.RetrievalOptions{
+			Query:    query.Text,
+			Filter:   query.Filter,
+			UserID:   user.ID,
+			Location: loc,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo facets: %v", err)
+		}
+		response.Facets = convertMemoFacetsFromStore(memoFacets)
 	}
 
 	for _, result := range results {
-		highlights := make([]*v1pb.Highlight, 0, len(result.Highlights))
-		for _, h := range result.Highlights {
-			highlights = append(highlights, &v1pb.Highlight{
-				Start:       int32(h.Start),
-				End:         int32(h.End),
-				MatchedText: h.MatchedText,
+		attachmentMatches := make([]*v1pb.HighlightedAttachment, 0, len(result.AttachmentMatches))
+		for _, match := range result.AttachmentMatches {
+			attachmentMatches = append(attachmentMatches, &v1pb.HighlightedAttachment{
+				Name:       fmt.Sprintf("%s%s", AttachmentNamePrefix, match.Name),
+				Filename:   match.Filename,
+				Snippet:    match.Snippet,
+				Highlights: convertHighlightsToProto(match.Highlights),
 			})
 		}
+		similarMemos := make([]string, 0, len(result.SimilarMemos))
+		for _, uid := range result.SimilarMemos {
+			similarMemos = append(similarMemos, fmt.Sprintf("%s%s", MemoNamePrefix, uid))
+		}
 
 		response.Memos = append(response.Memos, &v1pb.HighlightedMemo{
-			Name:       fmt.Sprintf("%s%s", MemoNamePrefix, result.Name),
-			Snippet:    result.Snippet,
-			Score:      result.Score,
-			Highlights: highlights,
-			CreatedTs:  result.CreatedTs,
+			Name:              fmt.Sprintf("%s%s", MemoNamePrefix, result.Name),
+			Snippet:           result.Snippet,
+			Score:             result.Score,
+			Highlights:        convertHighlightsToProto(result.Highlights),
+			CreatedTs:         result.CreatedTs,
+			AttachmentMatches: attachmentMatches,
+			SimilarMemos:      similarMemos,
 		})
 	}
 
 	return response, nil
 }
 
+// convertHighlightsToProto converts highlight positions to their proto representation.
+func convertHighlightsToProto(highlights []memo.Highlight) []*v1pb.Highlight {
+	result := make([]*v1pb.Highlight, 0, len(highlights))
+	for _, h := range highlights {
+		result = append(result, &v1pb.Highlight{
+			Start:       int32(h.Start),
+			End:         int32(h.End),
+			MatchedText: h.MatchedText,
+		})
+	}
+	return result
+}
+
 // GetRelatedMemos returns related memos for a given memo.
 // P1-C003: Related memo recommendations based on semantic similarity and tag co-occurrence.
 func (s *APIV1Service) GetRelatedMemos(ctx context.Context, request *v1pb.GetRelatedMemosRequest) (*v1pb.GetRelatedMemosResponse, error) {
@@ -132,3 +132,42 @@ func convertVisibilityToStore(visibility v1pb.Visibility) store.Visibility {
 		return store.Private
 	}
 }
+
+// maxFacetValues limits the tag and creator counts returned in memo facets.
+const maxFacetValues = 50
+
+func convertMemoFacetsFromStore(facets *store.MemoFacets) *v1pb.MemoFacets {
+	result := &v1pb.MemoFacets{
+		Total:              int32(facets.Total),
+		Tags:               convertFacetCountsFromStore(facets.Tags, maxFacetValues),
+		Months:             convertFacetCountsFromStore(facets.Months, 0),
+		Visibilities:       convertFacetCountsFromStore(facets.Visibilities, 0),
+		HasAttachmentCount: int32(facets.HasAttachment),
+		HasTaskListCount:   int32(facets.HasTaskList),
+	}
+	for i, creator := range facets.Creators {
+		if i == maxFacetValues {
+			break
+		}
+		result.Creators = append(result.Creators, &v1pb.FacetCount{
+			Value: fmt.Sprintf("%s%d", UserNamePrefix, creator.CreatorID),
+			Count: int32(creator.Count),
+		})
+	}
+	return result
+}
+
+// convertFacetCountsFromStore converts facet counts, keeping at most limit values if limit is positive.
+func convertFacetCountsFromStore(counts []*store.FacetCount, limit int) []*v1pb.FacetCount {
+	if limit > 0 && len(counts) > limit {
+		counts = counts[:limit]
+	}
+	result := make([]*v1pb.FacetCount, 0, len(counts))
+	for _, count := range counts {
+		result = append(result, &v1pb.FacetCount{
+			Value: count.Value,
+			Count: int32(count.Count),
+		})
+	}
+	return result
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/hrygo/divinesense/internal/profile"
 	"github.com/hrygo/divinesense/plugin/ai"
 	"github.com/hrygo/divinesense/plugin/ai/agent"
+	"github.com/hrygo/divinesense/plugin/ai/habit"
+	"github.com/hrygo/divinesense/plugin/ai/session"
 	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
 	"github.com/hrygo/divinesense/server/auth"
 	"github.com/hrygo/divinesense/server/service/schedule"
@@ -37,8 +39,9 @@ type ScheduleAgentService struct {
 	Store            *store.Store
 	LLM              ai.LLMService
 	Profile          *profile.Profile
-	ContextStore     *agent.ContextStore // TODO: Persist to PostgreSQL for cross-restart context recovery
+	ContextStore     *agent.ContextStore // Persisted in conversation_context, shared across restarts and instances
 	IntentClassifier *agent.LLMIntentClassifier
+	HabitApplier     *habit.HabitApplier // Learned defaults for schedule_add and fast-create parsing
 }
 
 // NewScheduleAgentService creates a new schedule agent service.
@@ -47,9 +50,8 @@ func NewScheduleAgentService(store *store.Store, llm ai.LLMService, profile *pro
 		Store:   store,
 		LLM:     llm,
 		Profile: profile,
-		// ContextStore uses in-memory storage; context is lost on service restart.
-		// TODO: Migrate to PostgreSQL for persistent storage.
-		ContextStore: agent.NewContextStore(),
+		// No session cache: instances must see each other's latest turn
+		ContextStore: agent.NewPersistentContextStore(session.NewSessionStore(store, nil), "schedule"),
 	}
 
 	// Initialize LLM Intent Classifier if SiliconFlow API key is available
@@ -96,6 +98,9 @@ func (s *ScheduleAgentService) Chat(ctx context.Context, req *v1pb.ScheduleAgent
 	if s.IntentClassifier != nil {
 		schedulerAgent.SetIntentClassifier(s.IntentClassifier)
 	}
+	if s.HabitApplier != nil {
+		schedulerAgent.SetHabitApplier(s.HabitApplier)
+	}
 
 	// Execute agent (non-streaming)
 	response, err := schedulerAgent.Execute(ctx, req.Message)
@@ -144,13 +149,16 @@ func (s *ScheduleAgentService) ChatStream(req *v1pb.ScheduleAgentChatRequest, st
 	if s.IntentClassifier != nil {
 		schedulerAgent.SetIntentClassifier(s.IntentClassifier)
 	}
+	if s.HabitApplier != nil {
+		schedulerAgent.SetHabitApplier(s.HabitApplier)
+	}
 
 	// Context Management: Get or create conversation context
 	// Session ID is derived from user ID; TODO: accept session ID from request for multi-session support
 	sessionID := fmt.Sprintf("default-session-%d", userID)
 
 	// Retrieve conversation context from service's ContextStore
-	conversationCtx := s.ContextStore.GetOrCreate(sessionID, userID, userTimezone)
+	conversationCtx := s.ContextStore.Load(ctx, sessionID, userID, userTimezone)
 
 	// Debug: Log context state
 	summary := conversationCtx.GetSummary()
@@ -260,6 +268,9 @@ func (s *ScheduleAgentService) ChatStream(req *v1pb.ScheduleAgentChatRequest, st
 	// Note: ToolCalls capture is not fully implemented in callback yet,
 	// but we record the text turn at least.
 	conversationCtx.AddTurn(req.Message, sanitizedResponse, nil)
+	if err := s.ContextStore.Save(ctx, conversationCtx); err != nil {
+		logger.Warn("Failed to persist conversation context", "error", err)
+	}
 
 	// Debug: Log updated context state
 	newSummary := conversationCtx.GetSummary()
@@ -663,6 +663,8 @@ func (s *ScheduleService) ParseAndCreateSchedule(ctx context.Context, req *v1pb.
 		return nil, status.Errorf(codes.InvalidArgument, "text is required")
 	}
 
+	ctx = withUserLocale(ctx, s.Store, userID)
+
 	// TODO: Get timezone from user settings instead of hardcoding
 	// For now, use Asia/Shanghai as default
 	// Future enhancement: user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
@@ -909,6 +911,8 @@ func (s *ScheduleService) BatchParseSchedule(ctx context.Context, req *v1pb.Batc
 		return nil, status.Errorf(codes.InvalidArgument, "text is required")
 	}
 
+	ctx = withUserLocale(ctx, s.Store, userID)
+
 	// Create batch schedule service
 	batchService := aischedule.NewBatchScheduleService(nil)
 
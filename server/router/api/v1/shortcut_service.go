@@ -73,11 +73,7 @@ func (s *APIV1Service) ListShortcuts(ctx context.Context, request *v1pb.ListShor
 	shortcutsUserSetting := userSetting.GetShortcuts()
 	shortcuts := []*v1pb.Shortcut{}
 	for _, shortcut := range shortcutsUserSetting.GetShortcuts() {
-		shortcuts = append(shortcuts, &v1pb.Shortcut{
-			Name:   constructShortcutName(userID, shortcut.GetId()),
-			Title:  shortcut.GetTitle(),
-			Filter: shortcut.GetFilter(),
-		})
+		shortcuts = append(shortcuts, convertShortcutFromStore(userID, shortcut))
 	}
 
 	return &v1pb.ListShortcutsResponse{
@@ -113,11 +109,7 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 	shortcutsUserSetting := userSetting.GetShortcuts()
 	for _, shortcut := range shortcutsUserSetting.GetShortcuts() {
 		if shortcut.GetId() == shortcutID {
-			return &v1pb.Shortcut{
-				Name:   constructShortcutName(userID, shortcut.GetId()),
-				Title:  shortcut.GetTitle(),
-				Filter: shortcut.GetFilter(),
-			}, nil
+			return convertShortcutFromStore(userID, shortcut), nil
 		}
 	}
 
@@ -138,10 +130,20 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
+	notifyChannels, err := convertShortcutNotifyChannels(request.Shortcut.GetNotifyChannels())
+	if err != nil {
+		return nil, err
+	}
+	if request.Shortcut.GetQuietPeriodSeconds() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "quiet period cannot be negative")
+	}
 	newShortcut := &storepb.ShortcutsUserSetting_Shortcut{
-		Id:     util.GenUUID(),
-		Title:  request.Shortcut.GetTitle(),
-		Filter: request.Shortcut.GetFilter(),
+		Id:                 util.GenUUID(),
+		Title:              request.Shortcut.GetTitle(),
+		Filter:             request.Shortcut.GetFilter(),
+		Notify:             request.Shortcut.GetNotify(),
+		NotifyChannels:     notifyChannels,
+		QuietPeriodSeconds: request.Shortcut.GetQuietPeriodSeconds(),
 	}
 	if newShortcut.Title == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "title is required")
@@ -150,11 +152,7 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
 	if request.ValidateOnly {
-		return &v1pb.Shortcut{
-			Name:   constructShortcutName(userID, newShortcut.GetId()),
-			Title:  newShortcut.GetTitle(),
-			Filter: newShortcut.GetFilter(),
-		}, nil
+		return convertShortcutFromStore(userID, newShortcut), nil
 	}
 
 	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
@@ -189,11 +187,7 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 		return nil, err
 	}
 
-	return &v1pb.Shortcut{
-		Name:   constructShortcutName(userID, newShortcut.GetId()),
-		Title:  newShortcut.GetTitle(),
-		Filter: newShortcut.GetFilter(),
-	}, nil
+	return convertShortcutFromStore(userID, newShortcut), nil
 }
 
 func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateShortcutRequest) (*v1pb.Shortcut, error) {
@@ -243,6 +237,25 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 						return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 					}
 					shortcut.Filter = request.Shortcut.GetFilter()
+					// Matches of the old filter are no longer relevant
+					resetShortcutAlert(shortcut)
+				case "notify":
+					if request.Shortcut.GetNotify() && !shortcut.Notify {
+						// Only memos saved after enabling notify
+						resetShortcutAlert(shortcut)
+					}
+					shortcut.Notify = request.Shortcut.GetNotify()
+				case "notify_channels":
+					notifyChannels, err := convertShortcutNotifyChannels(request.Shortcut.GetNotifyChannels())
+					if err != nil {
+						return nil, err
+					}
+					shortcut.NotifyChannels = notifyChannels
+				case "quiet_period_seconds":
+					if request.Shortcut.GetQuietPeriodSeconds() < 0 {
+						return nil, status.Errorf(codes.InvalidArgument, "quiet period cannot be negative")
+					}
+					shortcut.QuietPeriodSeconds = request.Shortcut.GetQuietPeriodSeconds()
 				}
 			}
 		}
@@ -262,11 +275,7 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 		return nil, err
 	}
 
-	return &v1pb.Shortcut{
-		Name:   constructShortcutName(userID, foundShortcut.GetId()),
-		Title:  foundShortcut.GetTitle(),
-		Filter: foundShortcut.GetFilter(),
-	}, nil
+	return convertShortcutFromStore(userID, foundShortcut), nil
 }
 
 func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteShortcutRequest) (*emptypb.Empty, error) {
@@ -343,3 +352,41 @@ func (s *APIV1Service) validateFilter(ctx context.Context, filterStr string) err
 	}
 	return nil
 }
+
+// convertShortcutFromStore converts a stored shortcut to its API representation.
+func convertShortcutFromStore(userID int32, shortcut *storepb.ShortcutsUserSetting_Shortcut) *v1pb.Shortcut {
+	notifyChannels := make([]string, 0, len(shortcut.GetNotifyChannels()))
+	for _, channel := range shortcut.GetNotifyChannels() {
+		notifyChannels = append(notifyChannels, channel.String())
+	}
+	return &v1pb.Shortcut{
+		Name:               constructShortcutName(userID, shortcut.GetId()),
+		Title:              shortcut.GetTitle(),
+		Filter:             shortcut.GetFilter(),
+		Notify:             shortcut.GetNotify(),
+		NotifyChannels:     notifyChannels,
+		QuietPeriodSeconds: shortcut.GetQuietPeriodSeconds(),
+		LastNotifiedTs:     shortcut.GetLastNotifiedTs(),
+	}
+}
+
+// convertShortcutNotifyChannels parses the notification channel names of a shortcut.
+func convertShortcutNotifyChannels(names []string) ([]storepb.ShortcutsUserSetting_Shortcut_Channel, error) {
+	channels := make([]storepb.ShortcutsUserSetting_Shortcut_Channel, 0, len(names))
+	for _, name := range names {
+		channel, ok := storepb.ShortcutsUserSetting_Shortcut_Channel_value[strings.ToUpper(name)]
+		if !ok || channel == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid notify channel %q", name)
+		}
+		channels = append(channels, storepb.ShortcutsUserSetting_Shortcut_Channel(channel))
+	}
+	return channels, nil
+}
+
+// resetShortcutAlert restarts match tracking of a shortcut, so that only memos
+// saved from now on notify.
+func resetShortcutAlert(shortcut *storepb.ShortcutsUserSetting_Shortcut) {
+	shortcut.LastCheckedTs = 0
+	shortcut.PendingMemoIds = nil
+	shortcut.NotifiedMemoIds = nil
+}
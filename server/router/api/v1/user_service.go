@@ -1252,24 +1252,29 @@ func (s *APIV1Service) ListUserNotifications(ctx context.Context, request *v1pb.
 	}
 
 	// Fetch inbox items from storage
-	// Filter at database level to only include MEMO_COMMENT notifications (ignore legacy VERSION_UPDATE entries)
-	memoCommentType := storepb.InboxMessage_MEMO_COMMENT
+	// Only MEMO_COMMENT, AI_DIGEST and SHORTCUT_ALERT notifications are listed (ignore legacy VERSION_UPDATE entries)
 	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
-		ReceiverID:  &userID,
-		MessageType: &memoCommentType,
+		ReceiverID: &userID,
+		MessageTypes: []storepb.InboxMessage_Type{
+			storepb.InboxMessage_MEMO_COMMENT,
+			storepb.InboxMessage_AI_DIGEST,
+			storepb.InboxMessage_SHORTCUT_ALERT,
+		},
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list inboxes: %v", err)
 	}
 
+	// Look up the memos of all notifications at once
+	memoUIDs, err := s.listInboxMemoUIDs(ctx, inboxes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
 	// Convert storage layer inboxes to API notifications
-	notifications := []*v1pb.UserNotification{}
+	notifications := make([]*v1pb.UserNotification, 0, len(inboxes))
 	for _, inbox := range inboxes {
-		notification, err := s.convertInboxToUserNotification(ctx, inbox)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to convert inbox: %v", err)
-		}
-		notifications = append(notifications, notification)
+		notifications = append(notifications, convertInboxToUserNotification(inbox, memoUIDs))
 	}
 
 	return &v1pb.ListUserNotificationsResponse{
@@ -1341,12 +1346,12 @@ func (s *APIV1Service) UpdateUserNotification(ctx context.Context, request *v1pb
 		return nil, status.Errorf(codes.Internal, "failed to update inbox: %v", err)
 	}
 
-	notification, err := s.convertInboxToUserNotification(ctx, updatedInbox)
+	memoUIDs, err := s.listInboxMemoUIDs(ctx, []*store.Inbox{updatedInbox})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to convert inbox: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
 	}
 
-	return notification, nil
+	return convertInboxToUserNotification(updatedInbox, memoUIDs), nil
 }
 
 // DeleteUserNotification permanently deletes a notification.
@@ -1391,7 +1396,8 @@ func (s *APIV1Service) DeleteUserNotification(ctx context.Context, request *v1pb
 
 // convertInboxToUserNotification converts a storage-layer inbox to an API notification.
 // This handles the mapping between the internal inbox representation and the public API.
-func (*APIV1Service) convertInboxToUserNotification(_ context.Context, inbox *store.Inbox) (*v1pb.UserNotification, error) {
+// memoUIDs maps the IDs of the memos referenced by the inbox to their UIDs, see listInboxMemoUIDs.
+func convertInboxToUserNotification(inbox *store.Inbox, memoUIDs map[int32]string) *v1pb.UserNotification {
 	notification := &v1pb.UserNotification{
 		Name:       fmt.Sprintf("users/%d/notifications/%d", inbox.ReceiverID, inbox.ID),
 		Sender:     fmt.Sprintf("%s%d", UserNamePrefix, inbox.SenderID),
@@ -1413,6 +1419,10 @@ func (*APIV1Service) convertInboxToUserNotification(_ context.Context, inbox *st
 		switch inbox.Message.Type {
 		case storepb.InboxMessage_MEMO_COMMENT:
 			notification.Type = v1pb.UserNotification_MEMO_COMMENT
+		case storepb.InboxMessage_AI_DIGEST:
+			notification.Type = v1pb.UserNotification_AI_DIGEST
+		case storepb.InboxMessage_SHORTCUT_ALERT:
+			notification.Type = v1pb.UserNotification_SHORTCUT_ALERT
 		default:
 			notification.Type = v1pb.UserNotification_TYPE_UNSPECIFIED
 		}
@@ -1420,9 +1430,55 @@ func (*APIV1Service) convertInboxToUserNotification(_ context.Context, inbox *st
 		if inbox.Message.ActivityId != nil {
 			notification.ActivityId = inbox.Message.ActivityId
 		}
+
+		if inbox.Message.MemoId != nil {
+			if uid, ok := memoUIDs[*inbox.Message.MemoId]; ok {
+				memoName := fmt.Sprintf("%s%s", MemoNamePrefix, uid)
+				notification.Memo = &memoName
+			}
+		}
+
+		if inbox.Message.ShortcutId != "" {
+			shortcutName := constructShortcutName(inbox.ReceiverID, inbox.Message.ShortcutId)
+			notification.Shortcut = &shortcutName
+		}
+
+		for _, memoID := range inbox.Message.MemoIds {
+			if uid, ok := memoUIDs[memoID]; ok {
+				notification.MatchedMemos = append(notification.MatchedMemos, fmt.Sprintf("%s%s", MemoNamePrefix, uid))
+			}
+		}
+	}
+
+	return notification
+}
+
+// listInboxMemoUIDs returns the UIDs of the memos referenced by the inboxes, by memo ID, in one query.
+// Deleted memos are missing from the map.
+func (s *APIV1Service) listInboxMemoUIDs(ctx context.Context, inboxes []*store.Inbox) (map[int32]string, error) {
+	var memoIDs []int32
+	for _, inbox := range inboxes {
+		if inbox.Message == nil {
+			continue
+		}
+		if inbox.Message.MemoId != nil {
+			memoIDs = append(memoIDs, *inbox.Message.MemoId)
+		}
+		memoIDs = append(memoIDs, inbox.Message.MemoIds...)
+	}
+	memoUIDs := make(map[int32]string, len(memoIDs))
+	if len(memoIDs) == 0 {
+		return memoUIDs, nil
 	}
 
-	return notification, nil
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: memoIDs, ExcludeContent: true})
+	if err != nil {
+		return nil, err
+	}
+	for _, memo := range memos {
+		memoUIDs[memo.ID] = memo.UID
+	}
+	return memoUIDs, nil
 }
 
 // ExtractNotificationIDFromName extracts the notification ID from a resource name.
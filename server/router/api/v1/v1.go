@@ -14,10 +14,15 @@ import (
 
 	"github.com/hrygo/divinesense/internal/profile"
 	"github.com/hrygo/divinesense/plugin/ai"
+	aicache "github.com/hrygo/divinesense/plugin/ai/cache"
+	"github.com/hrygo/divinesense/plugin/ai/habit"
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+	"github.com/hrygo/divinesense/plugin/ai/vector"
 	"github.com/hrygo/divinesense/plugin/markdown"
 	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
 	"github.com/hrygo/divinesense/server/auth"
 	"github.com/hrygo/divinesense/server/retrieval"
+	"github.com/hrygo/divinesense/server/service/searchlog"
 	"github.com/hrygo/divinesense/store"
 )
 
@@ -65,6 +70,8 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 		if err := aiConfig.Validate(); err == nil {
 			embeddingService, err := ai.NewEmbeddingService(&aiConfig.Embedding)
 			if err == nil {
+				// Cache query embeddings in memory and the database
+				embeddingService = aicache.NewCachedEmbeddingService(store, embeddingService, aiConfig.Embedding.Model)
 				rerankerService := ai.NewRerankerService(&aiConfig.Reranker)
 				var llmService ai.LLMService
 				if aiConfig.LLM.Provider != "" {
@@ -81,18 +88,36 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 							"provider", aiConfig.LLM.Provider,
 							"model", aiConfig.LLM.Model,
 						)
+						llmService = newMeteredLLMService(llmService, aiUsageLimiter)
 					}
 				}
 
-				// 创建自适应检索器
-				adaptiveRetriever := retrieval.NewAdaptiveRetriever(store, embeddingService, rerankerService)
+				var responseCache *aicache.SemanticResponseCache
+				if profile.AISemanticCacheEnabled {
+					responseCache = aicache.NewSemanticResponseCache(store, embeddingService, aicache.DefaultSemanticCacheConfig())
+				}
+
+				// 创建统一向量检索服务与自适应检索器
+				vectorService := vector.NewStoreVectorService(store, embeddingService, aiConfig.Embedding.Model)
+				adaptiveRetriever := retrieval.NewAdaptiveRetriever(store, vectorService, embeddingService, rerankerService)
+				// 多样性重排（近似重复折叠与 MMR）可选，默认关闭
+				adaptiveRetriever.SetMMREnabled(profile.AIMMREnabled)
+				// 查询扩展使用用户习惯中的常用检索关键词
+				habitApplier := habit.NewHabitApplier(memory.NewService(store, 0))
+				adaptiveRetriever.SetKeywordSuggester(habitApplier)
+				// 记录检索日志，用于检索洞察（用户可在 AI 偏好中关闭）
+				adaptiveRetriever.SetSearchRecorder(searchlog.NewService(store))
 
 				service.AIService = &AIService{
 					Store:                  store,
+					Profile:                profile,
+					MarkdownService:        markdownService,
 					EmbeddingService:       embeddingService,
 					EmbeddingModel:         aiConfig.Embedding.Model,
 					RerankerService:        rerankerService,
 					LLMService:             llmService,
+					VectorService:          vectorService,
+					ResponseCache:          responseCache,
 					AdaptiveRetriever:      adaptiveRetriever,
 					IntentClassifierConfig: &aiConfig.IntentClassifier,
 				}
@@ -104,6 +129,8 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 
 				// Initialize ScheduleAgentService
 				service.ScheduleAgentService = NewScheduleAgentService(store, llmService, profile)
+				// 日程创建（schedule_add 与快速解析）使用用户习惯的默认时间、时长和地点
+				service.ScheduleAgentService.HabitApplier = habitApplier
 			} else {
 				slog.Warn("Failed to initialize embedding service", "error", err)
 			}
@@ -162,8 +189,9 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	}
 
 	// Create gRPC-Gateway mux with auth middleware.
+	// The AI limit middleware runs after auth, which sets the user it limits.
 	gwMux := runtime.NewServeMux(
-		runtime.WithMiddlewares(gatewayAuthMiddleware),
+		runtime.WithMiddlewares(gatewayAuthMiddleware, NewAILimitInterceptor(s.Store, aiUsageLimiter).WrapGateway),
 	)
 	if err := v1pb.RegisterInstanceServiceHandlerServer(ctx, gwMux, s); err != nil {
 		return err
@@ -219,6 +247,7 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 		NewLoggingInterceptor(logStacktraces),
 		NewRecoveryInterceptor(logStacktraces),
 		NewAuthInterceptor(s.Store, s.Secret),
+		NewAILimitInterceptor(s.Store, aiUsageLimiter), // Needs the authenticated user
 	)
 	connectMux := http.NewServeMux()
 	connectHandler := NewConnectServiceHandler(s)
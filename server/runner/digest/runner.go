@@ -0,0 +1,108 @@
+package digest
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/server/service/digest"
+	"github.com/hrygo/divinesense/store"
+)
+
+// Runner periodically generates the scheduled AI digests of all users.
+type Runner struct {
+	store    *store.Store
+	service  *digest.Service
+	interval time.Duration
+}
+
+// NewRunner creates a digest runner.
+// Digests are checked every minute, the resolution of their cron schedules.
+func NewRunner(store *store.Store, service *digest.Service) *Runner {
+	return &Runner{
+		store:    store,
+		service:  service,
+		interval: time.Minute,
+	}
+}
+
+// Run starts the background task.
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			r.RunOnce(ctx, now)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce runs every digest that is due at now.
+func (r *Runner) RunOnce(ctx context.Context, now time.Time) {
+	userSettings, err := r.store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_DIGESTS,
+	})
+	if err != nil {
+		slog.Error("failed to list digest settings", "error", err)
+		return
+	}
+
+	for _, userSetting := range userSettings {
+		for _, d := range userSetting.GetDigests().GetDigests() {
+			if ctx.Err() != nil {
+				return
+			}
+			// Digests that never ran only look back one tick, so new
+			// digests do not fire for schedules that passed before creation.
+			due, err := digest.IsDue(d, now, r.interval)
+			if err != nil {
+				slog.Warn("invalid digest schedule", "user_id", userSetting.UserId, "digest_id", d.Id, "error", err)
+				continue
+			}
+			if !due {
+				continue
+			}
+			r.runDigest(ctx, userSetting.UserId, d, now)
+		}
+	}
+}
+
+func (r *Runner) runDigest(ctx context.Context, userID int32, d *storepb.DigestsUserSetting_Digest, now time.Time) {
+	// Record the run first so a failing digest is not retried every tick.
+	if err := r.markRun(ctx, userID, d.Id, now); err != nil {
+		slog.Error("failed to record digest run", "user_id", userID, "digest_id", d.Id, "error", err)
+		return
+	}
+
+	result, err := r.service.Run(ctx, userID, d, now)
+	if err != nil {
+		slog.Error("failed to run digest", "user_id", userID, "digest_id", d.Id, "error", err)
+		return
+	}
+	slog.Info("digest generated",
+		"user_id", userID,
+		"digest_id", d.Id,
+		"memo_uid", result.Memo.UID,
+		"delivered", len(result.Delivered),
+	)
+}
+
+// markRun stores the run time on the digest, re-reading the settings so
+// concurrent edits by the user are preserved.
+func (r *Runner) markRun(ctx context.Context, userID int32, digestID string, now time.Time) error {
+	digests, err := r.store.GetUserDigests(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, d := range digests {
+		if d.Id == digestID {
+			d.LastRunTs = now.Unix()
+		}
+	}
+	return r.store.SetUserDigests(ctx, userID, digests)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"time"
+	"unicode/utf8"
 
 	"github.com/hrygo/divinesense/plugin/ai"
 	"github.com/hrygo/divinesense/store"
@@ -35,6 +36,7 @@ func NewRunner(store *store.Store, embeddingService ai.EmbeddingService) *Runner
 func (r *Runner) Run(ctx context.Context) {
 	// Process once on startup
 	r.processNewMemos(ctx)
+	r.processNewDocuments(ctx)
 
 	ticker := time.NewTicker(r.interval)
 	defer ticker.Stop()
@@ -43,6 +45,7 @@ func (r *Runner) Run(ctx context.Context) {
 		select {
 		case <-ticker.C:
 			r.processNewMemos(ctx)
+			r.processNewDocuments(ctx)
 		case <-ctx.Done():
 			slog.Info("embedding runner stopped")
 			return
@@ -50,9 +53,10 @@ func (r *Runner) Run(ctx context.Context) {
 	}
 }
 
-// RunOnce processes memos once (for manual trigger).
+// RunOnce processes memos and other documents once (for manual trigger).
 func (r *Runner) RunOnce(ctx context.Context) {
 	r.processNewMemos(ctx)
+	r.processNewDocuments(ctx)
 }
 
 func (r *Runner) processNewMemos(ctx context.Context) {
@@ -137,6 +141,79 @@ func (r *Runner) processBatch(ctx context.Context, memos []*store.Memo) error {
 	return nil
 }
 
+// documentTypes are the document types embedded into document_embedding.
+var documentTypes = []string{store.DocumentTypeSchedule, store.DocumentTypeAttachment, store.DocumentTypeMessage}
+
+// processNewDocuments embeds schedules, attachments and conversation messages
+// that have no embedding yet or changed since they were embedded.
+func (r *Runner) processNewDocuments(ctx context.Context) {
+	for _, docType := range documentTypes {
+		documents, err := r.store.FindDocumentsWithoutEmbedding(ctx, &store.FindDocumentsWithoutEmbedding{
+			DocType: docType,
+			Model:   r.model,
+			Limit:   r.batchSize * 20,
+		})
+		if err != nil {
+			slog.Error("failed to find documents without embedding", "docType", docType, "error", err)
+			continue
+		}
+		if len(documents) == 0 {
+			continue
+		}
+
+		slog.Info("processing documents for embedding", "docType", docType, "count", len(documents))
+		for i := 0; i < len(documents); i += r.batchSize {
+			if ctx.Err() != nil {
+				slog.Info("embedding processing cancelled", "docType", docType, "processed", i, "total", len(documents))
+				return
+			}
+
+			end := min(i+r.batchSize, len(documents))
+			if err := r.processDocumentBatch(ctx, documents[i:end]); err != nil {
+				slog.Error("failed to process document batch", "docType", docType, "error", err)
+			}
+		}
+	}
+}
+
+func (r *Runner) processDocumentBatch(ctx context.Context, documents []*store.DocumentEmbedding) error {
+	texts := make([]string, len(documents))
+	for i, d := range documents {
+		texts[i] = truncateUTF8(d.Content, maxEmbeddingTextBytes)
+	}
+
+	vectors, err := r.embeddingService.EmbedBatch(ctx, texts)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	for i, d := range documents {
+		d.Content = texts[i]
+		d.Embedding = vectors[i]
+		d.CreatedTs = now
+		// UpdatedTs stays the document's update time, so later edits are re-embedded
+		if _, err := r.store.UpsertDocumentEmbedding(ctx, d); err != nil {
+			slog.Error("failed to upsert document embedding", "docType", d.DocType, "docID", d.DocID, "error", err)
+		}
+	}
+	return nil
+}
+
+// maxEmbeddingTextBytes limits the embedded text (BAAI/bge-m3 supports up to 8192 tokens).
+const maxEmbeddingTextBytes = 8000
+
+// truncateUTF8 cuts s to at most n bytes without splitting a character.
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
 // buildMemoContentWithAttachments builds the text content for embedding by combining
 // memo content with OCR/extracted text from attachments.
 func (r *Runner) buildMemoContentWithAttachments(ctx context.Context, m *store.Memo) string {
@@ -312,6 +312,27 @@ func TestMockEmbeddingService(t *testing.T) {
 	})
 }
 
+// TestRunnerProcessDocumentBatch_EmbeddingFailure tests that nothing is stored when embedding fails.
+func TestRunnerProcessDocumentBatch_EmbeddingFailure(t *testing.T) {
+	mockSvc := newMockEmbeddingService(1024)
+	mockSvc.shouldFail = true
+	runner := NewRunner(&store.Store{}, mockSvc)
+
+	err := runner.processDocumentBatch(context.Background(), []*store.DocumentEmbedding{
+		{DocType: store.DocumentTypeSchedule, DocID: 1, Content: "周会"},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), mockSvc.batchCallCount.Load())
+}
+
+// TestTruncateUTF8 tests that long document text is cut on a character boundary.
+func TestTruncateUTF8(t *testing.T) {
+	assert.Equal(t, "short", truncateUTF8("short", 10))
+	assert.Equal(t, "项目", truncateUTF8("项目计划", 8)) // 3 bytes per character
+	assert.Equal(t, "项目计", truncateUTF8("项目计划", 9))
+	assert.Equal(t, "", truncateUTF8("项目", 2))
+}
+
 // TestMemosHelper tests the createMemos helper function.
 func TestMemosHelper(t *testing.T) {
 	memos := createMemos(5)
@@ -0,0 +1,79 @@
+package searchindex
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+// Runner builds the full-text search index of memos saved before segmentation was introduced,
+// and of attachment text extracted before attachments were indexed.
+type Runner struct {
+	store     *store.Store
+	batchSize int
+}
+
+// NewRunner creates a search index runner.
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		store:     store,
+		batchSize: 200,
+	}
+}
+
+// RunOnce indexes all memos and attachments without a search index.
+func (r *Runner) RunOnce(ctx context.Context) {
+	indexed := 0
+	for ctx.Err() == nil {
+		memos, err := r.store.FindMemosWithoutSearchIndex(ctx, &store.FindMemosWithoutSearchIndex{Limit: r.batchSize})
+		if err != nil {
+			slog.Error("failed to find memos without search index", "error", err)
+			return
+		}
+		if len(memos) == 0 {
+			break
+		}
+
+		for _, memo := range memos {
+			if err := r.store.UpdateMemoSearchIndex(ctx, memo); err != nil {
+				slog.Error("failed to update memo search index", "error", err, "memoID", memo.ID)
+				return
+			}
+		}
+		indexed += len(memos)
+	}
+
+	if indexed > 0 {
+		slog.Info("memo search index built", "indexed", indexed)
+	}
+
+	r.indexAttachments(ctx)
+}
+
+// indexAttachments indexes the extracted and OCR text of attachments without a search index.
+func (r *Runner) indexAttachments(ctx context.Context) {
+	indexed := 0
+	for ctx.Err() == nil {
+		attachments, err := r.store.FindAttachmentsWithoutSearchIndex(ctx, &store.FindAttachmentsWithoutSearchIndex{Limit: r.batchSize})
+		if err != nil {
+			slog.Error("failed to find attachments without search index", "error", err)
+			return
+		}
+		if len(attachments) == 0 {
+			break
+		}
+
+		for _, attachment := range attachments {
+			if err := r.store.UpdateAttachmentSearchIndex(ctx, attachment); err != nil {
+				slog.Error("failed to update attachment search index", "error", err, "attachmentID", attachment.ID)
+				return
+			}
+		}
+		indexed += len(attachments)
+	}
+
+	if indexed > 0 {
+		slog.Info("attachment search index built", "indexed", indexed)
+	}
+}
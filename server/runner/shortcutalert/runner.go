@@ -0,0 +1,330 @@
+// Package shortcutalert notifies users of new memos matching their saved shortcuts.
+//
+// A shortcut with notify enabled is evaluated every minute against the memos
+// created or updated since its last check: the user's own memos and the
+// public or protected memos of other users. Matches are batched and delivered
+// to the inbox, email or webhooks once the shortcut's quiet period has passed.
+package shortcutalert
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	"github.com/hrygo/divinesense/plugin/email"
+	"github.com/hrygo/divinesense/plugin/webhook"
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/store"
+)
+
+const (
+	// maxMatchesPerCheck limits the memos matched in one check of a shortcut.
+	maxMatchesPerCheck = 100
+	// maxNotifiedMemos limits the memo IDs remembered to avoid duplicate alerts.
+	maxNotifiedMemos = 200
+	// maxSnippetRunes limits the memo content shown in an email alert.
+	maxSnippetRunes = 120
+
+	// webhookActivityType is the activity type posted to webhooks for matched memos.
+	webhookActivityType = "memos.shortcut.matched"
+)
+
+// Runner periodically checks the shortcuts with notify enabled for new matches.
+type Runner struct {
+	store    *store.Store
+	profile  *profile.Profile
+	interval time.Duration
+}
+
+// NewRunner creates a shortcut alert runner.
+func NewRunner(store *store.Store, profile *profile.Profile) *Runner {
+	return &Runner{
+		store:    store,
+		profile:  profile,
+		interval: time.Minute,
+	}
+}
+
+// Run starts the background task.
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			r.RunOnce(ctx, now)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce checks every shortcut with notify enabled and delivers the alerts that are due at now.
+func (r *Runner) RunOnce(ctx context.Context, now time.Time) {
+	userSettings, err := r.store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_SHORTCUTS,
+	})
+	if err != nil {
+		slog.Error("failed to list shortcut settings", "error", err)
+		return
+	}
+
+	for _, userSetting := range userSettings {
+		for _, shortcut := range userSetting.GetShortcuts().GetShortcuts() {
+			if ctx.Err() != nil {
+				return
+			}
+			if !shortcut.Notify {
+				continue
+			}
+			if err := r.checkShortcut(ctx, userSetting.UserId, shortcut, now); err != nil {
+				slog.Error("failed to check shortcut alert", "user_id", userSetting.UserId, "shortcut_id", shortcut.Id, "error", err)
+			}
+		}
+	}
+}
+
+func (r *Runner) checkShortcut(ctx context.Context, userID int32, shortcut *storepb.ShortcutsUserSetting_Shortcut, now time.Time) error {
+	// Shortcuts that were never checked start from now, so enabling notify
+	// does not alert on every existing match.
+	if shortcut.LastCheckedTs == 0 {
+		return r.saveState(ctx, userID, shortcut.Id, shortcut.LastCheckedTs, func(s *storepb.ShortcutsUserSetting_Shortcut) {
+			s.LastCheckedTs = now.Unix()
+		})
+	}
+
+	matches, checkedTs, err := r.findMatches(ctx, userID, shortcut, now)
+	if err != nil {
+		return err
+	}
+	pending := slices.Clone(shortcut.PendingMemoIds)
+	for _, memo := range matches {
+		if !slices.Contains(pending, memo.ID) && !slices.Contains(shortcut.NotifiedMemoIds, memo.ID) {
+			pending = append(pending, memo.ID)
+		}
+	}
+
+	due := len(pending) > 0 && now.Unix()-shortcut.LastNotifiedTs >= shortcut.QuietPeriodSeconds
+	if due {
+		// Pending memos may have been archived or deleted since they matched.
+		normal := store.Normal
+		memos, err := r.store.ListMemos(ctx, &store.FindMemo{IDList: pending, RowStatus: &normal})
+		if err != nil {
+			return err
+		}
+		// Pending memos may have been made private since they matched.
+		memos = slices.DeleteFunc(memos, func(memo *store.Memo) bool {
+			return memo.CreatorID != userID && memo.Visibility == store.Private
+		})
+		r.deliver(ctx, userID, shortcut, memos)
+	}
+
+	return r.saveState(ctx, userID, shortcut.Id, shortcut.LastCheckedTs, func(s *storepb.ShortcutsUserSetting_Shortcut) {
+		s.LastCheckedTs = checkedTs
+		if !due {
+			s.PendingMemoIds = pending
+			return
+		}
+		notified := append(s.NotifiedMemoIds, pending...)
+		if len(notified) > maxNotifiedMemos {
+			notified = notified[len(notified)-maxNotifiedMemos:]
+		}
+		s.NotifiedMemoIds = notified
+		s.PendingMemoIds = nil
+		s.LastNotifiedTs = now.Unix()
+	})
+}
+
+// findMatches returns the memos visible to the user that match the shortcut
+// filter and were created or updated since the last check, oldest first, and
+// the time up to which memos were checked. If there are more matches than
+// maxMatchesPerCheck, that time is the update time of the last returned memo
+// and the next check continues from there.
+func (r *Runner) findMatches(ctx context.Context, userID int32, shortcut *storepb.ShortcutsUserSetting_Shortcut, now time.Time) ([]*store.Memo, int64, error) {
+	// The window includes the second of the last check, as memos saved in that
+	// second may have been missed; matches seen before are de-duplicated.
+	filters := []string{fmt.Sprintf("updated_ts >= %d && updated_ts <= %d", shortcut.LastCheckedTs, now.Unix())}
+	if shortcut.Filter != "" {
+		filters = append(filters, shortcut.Filter)
+	}
+	normal := store.Normal
+	limit := maxMatchesPerCheck
+
+	own, err := r.store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:        &userID,
+		RowStatus:        &normal,
+		ExcludeComments:  true,
+		Filters:          filters,
+		Limit:            &limit,
+		OrderByUpdatedTs: true,
+		OrderByTimeAsc:   true,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	shared, err := r.store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:        &normal,
+		VisibilityList:   []store.Visibility{store.Public, store.Protected},
+		ExcludeComments:  true,
+		Filters:          filters,
+		Limit:            &limit,
+		OrderByUpdatedTs: true,
+		OrderByTimeAsc:   true,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// A truncated list is only complete up to its last memo. Memos saved in
+	// that second are listed again next time and de-duplicated.
+	checkedTs := now.Unix()
+	for _, list := range [][]*store.Memo{own, shared} {
+		if len(list) == limit {
+			checkedTs = min(checkedTs, list[len(list)-1].UpdatedTs)
+		}
+	}
+
+	matches := make([]*store.Memo, 0, len(own)+len(shared))
+	for _, memo := range own {
+		if memo.UpdatedTs <= checkedTs {
+			matches = append(matches, memo)
+		}
+	}
+	for _, memo := range shared {
+		if memo.CreatorID != userID && memo.UpdatedTs <= checkedTs {
+			matches = append(matches, memo)
+		}
+	}
+	return matches, checkedTs, nil
+}
+
+// deliver sends the matched memos to every channel of the shortcut; failures
+// are logged so one broken channel does not block the others.
+func (r *Runner) deliver(ctx context.Context, userID int32, shortcut *storepb.ShortcutsUserSetting_Shortcut, memos []*store.Memo) {
+	if len(memos) == 0 {
+		return
+	}
+	channels := shortcut.NotifyChannels
+	if len(channels) == 0 {
+		channels = []storepb.ShortcutsUserSetting_Shortcut_Channel{storepb.ShortcutsUserSetting_Shortcut_INBOX}
+	}
+	for _, channel := range channels {
+		if err := r.deliverTo(ctx, channel, userID, shortcut, memos); err != nil {
+			slog.Warn("failed to deliver shortcut alert", "user_id", userID, "shortcut_id", shortcut.Id, "channel", channel.String(), "error", err)
+		}
+	}
+	slog.Info("shortcut alert delivered", "user_id", userID, "shortcut_id", shortcut.Id, "memos", len(memos))
+}
+
+func (r *Runner) deliverTo(ctx context.Context, channel storepb.ShortcutsUserSetting_Shortcut_Channel, userID int32, shortcut *storepb.ShortcutsUserSetting_Shortcut, memos []*store.Memo) error {
+	switch channel {
+	case storepb.ShortcutsUserSetting_Shortcut_INBOX:
+		memoIDs := make([]int32, 0, len(memos))
+		for _, memo := range memos {
+			memoIDs = append(memoIDs, memo.ID)
+		}
+		_, err := r.store.CreateInbox(ctx, &store.Inbox{
+			SenderID:   userID,
+			ReceiverID: userID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_SHORTCUT_ALERT,
+				MemoId:     &memoIDs[0],
+				MemoIds:    memoIDs,
+				ShortcutId: shortcut.Id,
+			},
+		})
+		return err
+	case storepb.ShortcutsUserSetting_Shortcut_EMAIL:
+		if r.profile == nil || !r.profile.IsEmailEnabled() {
+			return errors.New("email is not configured")
+		}
+		user, err := r.store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return err
+		}
+		if user == nil || user.Email == "" {
+			return errors.New("user has no email address")
+		}
+		return email.Send(&email.Config{
+			SMTPHost:     r.profile.SMTPHost,
+			SMTPPort:     r.profile.SMTPPort,
+			SMTPUsername: r.profile.SMTPUsername,
+			SMTPPassword: r.profile.SMTPPassword,
+			FromEmail:    r.profile.SMTPFromEmail,
+			FromName:     r.profile.SMTPFromName,
+			UseTLS:       r.profile.SMTPUseTLS,
+			UseSSL:       r.profile.SMTPUseSSL,
+		}, &email.Message{
+			To:      []string{user.Email},
+			Subject: fmt.Sprintf("New matches for shortcut %q", shortcut.Title),
+			Body:    emailBody(shortcut, memos),
+		})
+	case storepb.ShortcutsUserSetting_Shortcut_WEBHOOK:
+		webhooks, err := r.store.GetUserWebhooks(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, hook := range webhooks {
+			for _, memo := range memos {
+				webhook.PostAsync(&webhook.WebhookRequestPayload{
+					URL:          hook.Url,
+					ActivityType: webhookActivityType,
+					Creator:      fmt.Sprintf("users/%d", userID),
+					Memo: &v1pb.Memo{
+						Name:       fmt.Sprintf("memos/%s", memo.UID),
+						Creator:    fmt.Sprintf("users/%d", memo.CreatorID),
+						Content:    memo.Content,
+						Visibility: v1pb.Visibility(v1pb.Visibility_value[memo.Visibility.String()]),
+						Tags:       memo.Payload.GetTags(),
+						CreateTime: timestamppb.New(time.Unix(memo.CreatedTs, 0)),
+						UpdateTime: timestamppb.New(time.Unix(memo.UpdatedTs, 0)),
+					},
+				})
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported channel %s", channel.String())
+	}
+}
+
+// emailBody lists the matched memos as markdown.
+func emailBody(shortcut *storepb.ShortcutsUserSetting_Shortcut, memos []*store.Memo) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d new memos match your shortcut %q:\n\n", len(memos), shortcut.Title)
+	for _, memo := range memos {
+		snippet := []rune(strings.Join(strings.Fields(memo.Content), " "))
+		if len(snippet) > maxSnippetRunes {
+			snippet = append(snippet[:maxSnippetRunes], '…')
+		}
+		fmt.Fprintf(&sb, "- memos/%s: %s\n", memo.UID, string(snippet))
+	}
+	return sb.String()
+}
+
+// saveState applies update to the stored shortcut, re-reading the settings so
+// concurrent edits by the user are preserved.
+func (r *Runner) saveState(ctx context.Context, userID int32, shortcutID string, lastCheckedTs int64, update func(*storepb.ShortcutsUserSetting_Shortcut)) error {
+	shortcuts, err := r.store.GetUserShortcuts(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, shortcut := range shortcuts {
+		// A shortcut whose notify was turned off or whose filter changed
+		// since it was read is left alone.
+		if shortcut.Id == shortcutID && shortcut.Notify && shortcut.LastCheckedTs == lastCheckedTs {
+			update(shortcut)
+		}
+	}
+	return r.store.SetUserShortcuts(ctx, userID, shortcuts)
+}
@@ -0,0 +1,141 @@
+package shortcutalert
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/store"
+	"github.com/hrygo/divinesense/store/db"
+)
+
+const testStartTs = int64(1_770_000_000)
+
+func newTestStore(t *testing.T) *store.Store {
+	t.Helper()
+	dir := t.TempDir()
+	p := &profile.Profile{Mode: "prod", Driver: "sqlite", Data: dir, DSN: filepath.Join(dir, "test.db"), Version: "0.26.0"}
+	driver, err := db.NewDBDriver(p)
+	require.NoError(t, err)
+	st := store.New(driver, p)
+	t.Cleanup(func() { _ = st.Close() })
+	require.NoError(t, st.Migrate(context.Background()))
+	return st
+}
+
+func createUser(t *testing.T, st *store.Store, username string) *store.User {
+	t.Helper()
+	user, err := st.CreateUser(context.Background(), &store.User{Username: username, Role: store.RoleUser, PasswordHash: "x"})
+	require.NoError(t, err)
+	return user
+}
+
+// createMemo creates a memo last updated at updatedTs.
+func createMemo(t *testing.T, st *store.Store, creatorID int32, uid string, visibility store.Visibility, updatedTs int64) *store.Memo {
+	t.Helper()
+	ctx := context.Background()
+	memo, err := st.CreateMemo(ctx, &store.Memo{UID: uid, CreatorID: creatorID, Content: "content of " + uid, Visibility: visibility})
+	require.NoError(t, err)
+	require.NoError(t, st.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, UpdatedTs: &updatedTs}))
+	memo.UpdatedTs = updatedTs
+	return memo
+}
+
+func setShortcut(t *testing.T, st *store.Store, userID int32, shortcut *storepb.ShortcutsUserSetting_Shortcut) {
+	t.Helper()
+	require.NoError(t, st.SetUserShortcuts(context.Background(), userID, []*storepb.ShortcutsUserSetting_Shortcut{shortcut}))
+}
+
+func getShortcut(t *testing.T, st *store.Store, userID int32) *storepb.ShortcutsUserSetting_Shortcut {
+	t.Helper()
+	shortcuts, err := st.GetUserShortcuts(context.Background(), userID)
+	require.NoError(t, err)
+	require.Len(t, shortcuts, 1)
+	return shortcuts[0]
+}
+
+// alertedMemoIDs returns the memo IDs of each shortcut alert in the user's inbox.
+func alertedMemoIDs(t *testing.T, st *store.Store, userID int32) [][]int32 {
+	t.Helper()
+	messageType := storepb.InboxMessage_SHORTCUT_ALERT
+	inboxes, err := st.ListInboxes(context.Background(), &store.FindInbox{ReceiverID: &userID, MessageType: &messageType})
+	require.NoError(t, err)
+	alerts := make([][]int32, 0, len(inboxes))
+	for _, inbox := range inboxes {
+		alerts = append(alerts, inbox.Message.MemoIds)
+	}
+	return alerts
+}
+
+func TestRunOnce_ContinuesAfterMatchLimit(t *testing.T) {
+	ctx := context.Background()
+	st := newTestStore(t)
+	user := createUser(t, st, "alice")
+	total := maxMatchesPerCheck + 20
+	for i := 1; i <= total; i++ {
+		createMemo(t, st, user.ID, fmt.Sprintf("memo-%d", i), store.Private, testStartTs+int64(i))
+	}
+	setShortcut(t, st, user.ID, &storepb.ShortcutsUserSetting_Shortcut{Id: "s1", Title: "all", Notify: true, LastCheckedTs: testStartTs})
+
+	runner := NewRunner(st, nil)
+	now := time.Unix(testStartTs+1000, 0)
+	runner.RunOnce(ctx, now)
+
+	// Only the oldest matches are processed and the cursor stops at the last one
+	alerts := alertedMemoIDs(t, st, user.ID)
+	require.Len(t, alerts, 1)
+	assert.Len(t, alerts[0], maxMatchesPerCheck)
+	assert.Equal(t, testStartTs+int64(maxMatchesPerCheck), getShortcut(t, st, user.ID).LastCheckedTs)
+
+	runner.RunOnce(ctx, now.Add(time.Minute))
+
+	alerts = alertedMemoIDs(t, st, user.ID)
+	require.Len(t, alerts, 2)
+	alerted := map[int32]bool{}
+	for _, alert := range alerts {
+		for _, memoID := range alert {
+			assert.False(t, alerted[memoID], "memo %d alerted twice", memoID)
+			alerted[memoID] = true
+		}
+	}
+	assert.Len(t, alerted, total)
+	assert.Equal(t, now.Add(time.Minute).Unix(), getShortcut(t, st, user.ID).LastCheckedTs)
+}
+
+func TestRunOnce_SkipsArchivedPendingMemos(t *testing.T) {
+	ctx := context.Background()
+	st := newTestStore(t)
+	user := createUser(t, st, "alice")
+	other := createUser(t, st, "bob")
+	setShortcut(t, st, user.ID, &storepb.ShortcutsUserSetting_Shortcut{
+		Id:                 "s1",
+		Title:              "all",
+		Notify:             true,
+		LastCheckedTs:      testStartTs,
+		LastNotifiedTs:     testStartTs,
+		QuietPeriodSeconds: 3600,
+	})
+	kept := createMemo(t, st, user.ID, "kept", store.Private, testStartTs+1)
+	archived := createMemo(t, st, other.ID, "archived", store.Public, testStartTs+2)
+	createMemo(t, st, other.ID, "private", store.Private, testStartTs+3)
+
+	// Within the quiet period matches are kept pending
+	runner := NewRunner(st, nil)
+	runner.RunOnce(ctx, time.Unix(testStartTs+60, 0))
+	assert.Empty(t, alertedMemoIDs(t, st, user.ID))
+	assert.ElementsMatch(t, []int32{kept.ID, archived.ID}, getShortcut(t, st, user.ID).PendingMemoIds)
+
+	status := store.Archived
+	require.NoError(t, st.UpdateMemo(ctx, &store.UpdateMemo{ID: archived.ID, RowStatus: &status}))
+
+	runner.RunOnce(ctx, time.Unix(testStartTs+3600, 0))
+	assert.Equal(t, [][]int32{{kept.ID}}, alertedMemoIDs(t, st, user.ID))
+	assert.Empty(t, getShortcut(t, st, user.ID).PendingMemoIds)
+}
@@ -16,13 +16,23 @@ import (
 
 	"github.com/hrygo/divinesense/internal/profile"
 	"github.com/hrygo/divinesense/plugin/ai"
+	aicache "github.com/hrygo/divinesense/plugin/ai/cache"
+	"github.com/hrygo/divinesense/plugin/ai/habit"
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+	"github.com/hrygo/divinesense/plugin/ai/session"
+	"github.com/hrygo/divinesense/plugin/ai/tokenizer"
 	storepb "github.com/hrygo/divinesense/proto/gen/store"
 	apiv1 "github.com/hrygo/divinesense/server/router/api/v1"
 	"github.com/hrygo/divinesense/server/router/fileserver"
 	"github.com/hrygo/divinesense/server/router/frontend"
 	"github.com/hrygo/divinesense/server/router/rss"
+	digestrunner "github.com/hrygo/divinesense/server/runner/digest"
 	"github.com/hrygo/divinesense/server/runner/embedding"
 	"github.com/hrygo/divinesense/server/runner/ocr"
+	"github.com/hrygo/divinesense/server/runner/searchindex"
+	"github.com/hrygo/divinesense/server/runner/shortcutalert"
+	"github.com/hrygo/divinesense/server/service/digest"
+	"github.com/hrygo/divinesense/server/service/searchlog"
 	"github.com/hrygo/divinesense/store"
 )
 
@@ -69,6 +79,13 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 
 	rootGroup := echoServer.Group("")
 
+	// Count tokens with the configured vocabularies; a missing one is a configuration error.
+	if profile.AITokenizerVocabDir != "" {
+		if err := tokenizer.LoadVocabDir(profile.AITokenizerVocabDir); err != nil {
+			return nil, errors.Wrap(err, "failed to load tokenizer vocabularies")
+		}
+	}
+
 	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store)
 
 	// Register HTTP file server routes BEFORE gRPC-Gateway to ensure proper range request handling for Safari.
@@ -156,11 +173,86 @@ func (s *Server) StartBackgroundRunners(ctx context.Context) {
 			} else {
 				slog.Warn("failed to create embedding service", "error", err)
 			}
+
+			// Start digest runner; without an LLM digests are generated without a brief
+			var llmService ai.LLMService
+			if aiConfig.LLM.Provider != "" {
+				if llmService, err = ai.NewLLMService(&aiConfig.LLM); err != nil {
+					slog.Warn("failed to create LLM service for digests", "error", err)
+					llmService = nil
+				}
+			}
+			digestRunner := digestrunner.NewRunner(s.Store, digest.NewService(s.Store, llmService, s.Profile))
+			digestCtx, digestCancel := context.WithCancel(ctx)
+			s.runnerCancelFuncs = append(s.runnerCancelFuncs, digestCancel)
+			go func() {
+				digestRunner.Run(digestCtx)
+				slog.Info("digest runner stopped")
+			}()
+			slog.Info("digest runner started")
+
+			// Start habit learner; learned preferences feed schedule defaults and suggestions
+			memoryService := memory.NewService(s.Store, 0)
+			habitLearner := habit.NewHabitLearner(habit.NewHabitAnalyzer(memoryService, nil), memoryService, nil)
+			habitCtx, habitCancel := context.WithCancel(ctx)
+			s.runnerCancelFuncs = append(s.runnerCancelFuncs, habitCancel)
+			if err := habitLearner.Start(habitCtx); err != nil {
+				slog.Warn("failed to start habit learner", "error", err)
+			} else {
+				go func() {
+					<-habitCtx.Done()
+					habitLearner.Stop()
+					memoryService.Close()
+					slog.Info("habit learner stopped")
+				}()
+				slog.Info("habit learner started")
+			}
 		} else {
 			slog.Warn("AI config validation failed", "error", err)
 		}
 	}
 
+	// Start session cleanup; persisted agent conversation contexts expire after the retention period
+	if s.Profile.IsAIEnabled() {
+		sessionCleanup := session.NewSessionCleanupJob(session.NewSessionStore(s.Store, nil), session.DefaultCleanupConfig())
+		sessionCtx, sessionCancel := context.WithCancel(ctx)
+		s.runnerCancelFuncs = append(s.runnerCancelFuncs, sessionCancel)
+		if err := sessionCleanup.Start(sessionCtx); err != nil {
+			slog.Warn("failed to start session cleanup job", "error", err)
+		} else {
+			go func() {
+				<-sessionCtx.Done()
+				sessionCleanup.Stop()
+			}()
+		}
+
+		// Expire persisted embedding and LLM response cache entries
+		cacheCtx, cacheCancel := context.WithCancel(ctx)
+		s.runnerCancelFuncs = append(s.runnerCancelFuncs, cacheCancel)
+		go aicache.RunExpiry(cacheCtx, s.Store, aicache.DefaultExpiryInterval)
+
+		// Delete search logs older than the retention period
+		searchLogCtx, searchLogCancel := context.WithCancel(ctx)
+		s.runnerCancelFuncs = append(s.runnerCancelFuncs, searchLogCancel)
+		go searchlog.RunRetention(searchLogCtx, s.Store, searchlog.DefaultRetentionInterval)
+	}
+
+	// Build the full-text search index of memos saved before segmentation was introduced
+	searchIndexRunner := searchindex.NewRunner(s.Store)
+	searchIndexCtx, searchIndexCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, searchIndexCancel)
+	go searchIndexRunner.RunOnce(searchIndexCtx)
+
+	// Start shortcut alert runner; notifies users of new memos matching their saved shortcuts
+	shortcutAlertRunner := shortcutalert.NewRunner(s.Store, s.Profile)
+	shortcutAlertCtx, shortcutAlertCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, shortcutAlertCancel)
+	go func() {
+		shortcutAlertRunner.Run(shortcutAlertCtx)
+		slog.Info("shortcut alert runner stopped")
+	}()
+	slog.Info("shortcut alert runner started")
+
 	// Start OCR runner for attachment text extraction (if enabled)
 	if s.Profile.OCREnabled || s.Profile.TextExtractEnabled {
 		ocrRunner := ocr.NewRunner(s.Store, s.Profile)
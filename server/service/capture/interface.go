@@ -0,0 +1,36 @@
+package capture
+
+import (
+	"context"
+
+	"github.com/hrygo/divinesense/plugin/httpgetter"
+	"github.com/hrygo/divinesense/store"
+)
+
+// Service captures web pages into the user's knowledge base.
+// It is shared by the web_capture agent tool and the CaptureURL API.
+type Service interface {
+	// Capture fetches the page at rawURL, extracts its main content, summarizes it
+	// and suggests tags. When req.Save is set the result is stored as a memo.
+	Capture(ctx context.Context, userID int32, req *CaptureRequest) (*CaptureResult, error)
+}
+
+// CaptureRequest describes a page to capture.
+type CaptureRequest struct {
+	URL string
+	// Save creates a memo from the captured page.
+	Save bool
+	// Visibility of the created memo. Defaults to PRIVATE.
+	Visibility store.Visibility
+}
+
+// CaptureResult is a captured page.
+type CaptureResult struct {
+	Article *httpgetter.Article
+	// Summary is the LLM summary of the article. Empty when no LLM is configured.
+	Summary string
+	// Tags are suggested tag names without the leading '#'.
+	Tags []string
+	// Memo is the created memo, nil when the page was not saved.
+	Memo *store.Memo
+}
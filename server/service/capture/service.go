@@ -0,0 +1,215 @@
+package capture
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+
+	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/plugin/ai/tags"
+	"github.com/hrygo/divinesense/plugin/httpgetter"
+	"github.com/hrygo/divinesense/plugin/markdown"
+	memoservice "github.com/hrygo/divinesense/server/service/memo"
+	"github.com/hrygo/divinesense/store"
+)
+
+const (
+	// maxTags is the maximum number of suggested tags for a captured page.
+	maxTags = 5
+	// maxSummaryInput is the article length (in runes) passed to the LLM for summarization.
+	maxSummaryInput = 8000
+	// maxTagInput is the content length (in runes) passed to the tag suggester.
+	maxTagInput = 5000
+	// truncatedNotice is appended when the article does not fit the memo content limit.
+	truncatedNotice = "\n\n…"
+)
+
+const summarizePrompt = `Summarize the following web article in 3-5 concise bullet points.
+Use the same language as the article. Output only the bullet points, each starting with "- ".`
+
+// ErrPublicDisallowed is returned when saving a public memo while public memos are disabled.
+var ErrPublicDisallowed = errors.New("disable public memos system setting is enabled")
+
+// ErrFetchFailed is returned when the page cannot be fetched or has no readable content.
+// It wraps the underlying error, e.g. httpgetter.ErrInternalIP.
+var ErrFetchFailed = errors.New("failed to fetch page")
+
+// ErrInvalidURL is returned for URLs that are not absolute http(s) URLs.
+var ErrInvalidURL = errors.New("invalid URL: only absolute http and https URLs are supported")
+
+type service struct {
+	store        *store.Store
+	llm          ai.LLMService
+	tagSuggester tags.TagSuggester
+	markdown     markdown.Service
+
+	// fetch retrieves and extracts the article; replaced in tests.
+	fetch func(ctx context.Context, urlStr string) (*httpgetter.Article, error)
+}
+
+// NewService creates a new capture service.
+// llmService may be nil, in which case pages are captured without a summary.
+func NewService(store *store.Store, llmService ai.LLMService) Service {
+	return &service{
+		store:        store,
+		llm:          llmService,
+		tagSuggester: tags.NewTagSuggester(store, llmService, nil),
+		markdown:     markdown.NewService(markdown.WithTagExtension()),
+		fetch:        httpgetter.GetArticle,
+	}
+}
+
+// Capture fetches, summarizes and tags a page, optionally saving it as a memo.
+func (s *service) Capture(ctx context.Context, userID int32, req *CaptureRequest) (*CaptureResult, error) {
+	u, err := url.Parse(strings.TrimSpace(req.URL))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrInvalidURL
+	}
+
+	article, err := s.fetch(ctx, u.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFetchFailed, err)
+	}
+
+	result := &CaptureResult{
+		Article: article,
+		Summary: s.summarize(ctx, article),
+		Tags:    s.suggestTags(ctx, userID, article),
+	}
+	if !req.Save {
+		return result, nil
+	}
+
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = store.Private
+	}
+	setting, err := s.store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance memo related setting")
+	}
+	if visibility == store.Public && setting.DisallowPublicVisibility {
+		return nil, ErrPublicDisallowed
+	}
+
+	// Created like any other memo, so memo created webhooks fire for captures too
+	memo, err := memoservice.Create(ctx, s.store, s.markdown, &store.Memo{
+		UID:        shortuuid.New(),
+		CreatorID:  userID,
+		Content:    RenderMemo(result, int(setting.ContentLengthLimit)),
+		Visibility: visibility,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Memo = memo
+	return result, nil
+}
+
+// summarize asks the LLM for a short summary. Failures are logged and yield no summary.
+func (s *service) summarize(ctx context.Context, article *httpgetter.Article) string {
+	if s.llm == nil {
+		return ""
+	}
+	summary, err := s.llm.Chat(ctx, []ai.Message{
+		ai.SystemPrompt(summarizePrompt),
+		ai.UserMessage(fmt.Sprintf("Title: %s\n\n%s", article.Title, truncateRunes(article.Markdown, maxSummaryInput))),
+	})
+	if err != nil {
+		slog.Default().Warn("Failed to summarize captured page", "url", article.URL, "error", err)
+		return ""
+	}
+	return strings.TrimSpace(summary)
+}
+
+// suggestTags returns tag names usable as #tags. Failures are logged and yield no tags.
+func (s *service) suggestTags(ctx context.Context, userID int32, article *httpgetter.Article) []string {
+	response, err := s.tagSuggester.Suggest(ctx, &tags.SuggestRequest{
+		UserID:  userID,
+		Title:   article.Title,
+		Content: truncateRunes(article.Markdown, maxTagInput),
+		MaxTags: maxTags,
+		UseLLM:  s.llm != nil,
+	})
+	if err != nil {
+		slog.Default().Warn("Failed to suggest tags for captured page", "user_id", userID, "error", err)
+		return nil
+	}
+
+	names := make([]string, 0, len(response.Tags))
+	for _, tag := range response.Tags {
+		name := strings.Join(strings.Fields(strings.TrimPrefix(tag.Name, "#")), "_")
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// RenderMemo renders a captured page as memo content: title, source link, summary,
+// the article itself and the tags. The article is truncated so the whole memo fits
+// contentLengthLimit bytes; a limit of zero disables truncation.
+func RenderMemo(result *CaptureResult, contentLengthLimit int) string {
+	article := result.Article
+
+	var head strings.Builder
+	title := article.Title
+	if title == "" {
+		title = article.URL
+	}
+	fmt.Fprintf(&head, "# %s\n\n", title)
+	source := article.URL
+	if article.SiteName != "" {
+		source = fmt.Sprintf("%s · %s", article.SiteName, article.URL)
+	}
+	fmt.Fprintf(&head, "> Source: [%s](%s)\n", source, article.URL)
+	if article.Byline != "" {
+		fmt.Fprintf(&head, "> Author: %s\n", article.Byline)
+	}
+	if result.Summary != "" {
+		fmt.Fprintf(&head, "\n## Summary\n\n%s\n", result.Summary)
+	}
+	head.WriteString("\n---\n\n")
+
+	var tail string
+	if len(result.Tags) > 0 {
+		tail = "\n\n#" + strings.Join(result.Tags, " #")
+	}
+
+	body := article.Markdown
+	if contentLengthLimit > 0 {
+		available := contentLengthLimit - head.Len() - len(tail)
+		if len(body) > available {
+			body = truncateBytes(body, available-len(truncatedNotice)) + truncatedNotice
+		}
+	}
+	return head.String() + body + tail
+}
+
+// truncateRunes shortens s to at most n runes.
+func truncateRunes(s string, n int) string {
+	if runes := []rune(s); len(runes) > n {
+		return string(runes[:n])
+	}
+	return s
+}
+
+// truncateBytes shortens s to at most n bytes without splitting a UTF-8 sequence.
+func truncateBytes(s string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
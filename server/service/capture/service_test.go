@@ -0,0 +1,55 @@
+package capture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/plugin/httpgetter"
+)
+
+func TestRenderMemo(t *testing.T) {
+	result := &CaptureResult{
+		Article: &httpgetter.Article{
+			URL:      "https://example.com/post",
+			Title:    "Post",
+			SiteName: "Example",
+			Markdown: "Body text.",
+		},
+		Summary: "- point",
+		Tags:    []string{"reading", "go"},
+	}
+
+	content := RenderMemo(result, 0)
+	assert.Equal(t, "# Post\n\n> Source: [Example · https://example.com/post](https://example.com/post)\n\n## Summary\n\n- point\n\n---\n\nBody text.\n\n#reading #go", content)
+}
+
+func TestRenderMemo_Truncates(t *testing.T) {
+	result := &CaptureResult{
+		Article: &httpgetter.Article{
+			URL:      "https://example.com/post",
+			Title:    "Post",
+			Markdown: strings.Repeat("字", 1000),
+		},
+		Tags: []string{"reading"},
+	}
+
+	content := RenderMemo(result, 500)
+	assert.LessOrEqual(t, len(content), 500)
+	assert.True(t, strings.HasSuffix(content, "…\n\n#reading"))
+	assert.True(t, strings.Contains(content, "字"))
+}
+
+func TestCapture_RejectsInvalidURL(t *testing.T) {
+	s := &service{fetch: func(context.Context, string) (*httpgetter.Article, error) {
+		t.Fatal("fetch must not be called")
+		return nil, nil
+	}}
+	for _, rawURL := range []string{"", "ftp://example.com", "file:///etc/passwd", "/relative"} {
+		_, err := s.Capture(context.Background(), 1, &CaptureRequest{URL: rawURL})
+		require.ErrorIs(t, err, ErrInvalidURL, rawURL)
+	}
+}
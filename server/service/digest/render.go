@@ -0,0 +1,137 @@
+package digest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/server/service/schedule"
+)
+
+// maxListSnippetRunes limits memo snippets in the rendered source lists.
+const maxListSnippetRunes = 80
+
+// digestTitle returns the heading of a digest generated at now.
+func digestTitle(kind storepb.DigestsUserSetting_Digest_Kind, now time.Time) string {
+	if kind == storepb.DigestsUserSetting_Digest_WEEKLY_REVIEW {
+		return fmt.Sprintf("Weekly Review · %s – %s", now.AddDate(0, 0, -6).Format("2006-01-02"), now.Format("2006-01-02"))
+	}
+	return fmt.Sprintf("Daily Brief · %s", now.Format("2006-01-02"))
+}
+
+// digestKindTag returns the tag of a digest kind, nested under the digest tag.
+func digestKindTag(kind storepb.DigestsUserSetting_Digest_Kind) string {
+	if kind == storepb.DigestsUserSetting_Digest_WEEKLY_REVIEW {
+		return digestTag + "/weekly"
+	}
+	return digestTag + "/daily"
+}
+
+// RenderDigest renders the digest memo: title, LLM brief, the source lists and the digest tag.
+func RenderDigest(kind storepb.DigestsUserSetting_Digest_Kind, now time.Time, data *Data, brief string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", digestTitle(kind, now))
+
+	switch {
+	case brief != "":
+		b.WriteString(brief)
+		b.WriteString("\n\n")
+	case data.IsEmpty():
+		b.WriteString("No new activity in this period.\n\n")
+	}
+
+	if len(data.Memos) > 0 {
+		b.WriteString("## Memos\n\n")
+		for _, memo := range data.Memos {
+			fmt.Fprintf(&b, "- [memos/%s](/memos/%s) %s\n", memo.UID, memo.UID, snippet(memo.Content))
+		}
+		b.WriteString("\n")
+	}
+	if len(data.Completed) > 0 {
+		b.WriteString("## Completed\n\n")
+		for _, instance := range data.Completed {
+			b.WriteString(renderSchedule(instance, data.Location))
+		}
+		b.WriteString("\n")
+	}
+	if len(data.Upcoming) > 0 {
+		b.WriteString("## Upcoming\n\n")
+		for _, instance := range data.Upcoming {
+			b.WriteString(renderSchedule(instance, data.Location))
+		}
+		b.WriteString("\n")
+	}
+	if len(data.Reviews) > 0 {
+		b.WriteString("## Due for review\n\n")
+		for _, item := range data.Reviews {
+			fmt.Fprintf(&b, "- [%s](/%s) %s\n", item.MemoName, item.MemoName, item.Title)
+		}
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(&b, "#%s", digestKindTag(kind))
+	return b.String()
+}
+
+// FormatData renders the digest material as plain text for the LLM.
+func FormatData(data *Data) string {
+	var b strings.Builder
+	if len(data.Memos) > 0 {
+		b.WriteString("## Notes\n")
+		for _, memo := range data.Memos {
+			content := strings.TrimSpace(memo.Content)
+			if runes := []rune(content); len(runes) > maxMemoSnippetRunes {
+				content = string(runes[:maxMemoSnippetRunes]) + "…"
+			}
+			fmt.Fprintf(&b, "- memos/%s (%s): %s\n", memo.UID,
+				time.Unix(memo.CreatedTs, 0).In(data.Location).Format("2006-01-02 15:04"),
+				strings.ReplaceAll(content, "\n", " "))
+		}
+	}
+	if len(data.Completed) > 0 {
+		b.WriteString("## Past schedules\n")
+		for _, instance := range data.Completed {
+			b.WriteString(renderSchedule(instance, data.Location))
+		}
+	}
+	if len(data.Upcoming) > 0 {
+		b.WriteString("## Upcoming schedules\n")
+		for _, instance := range data.Upcoming {
+			b.WriteString(renderSchedule(instance, data.Location))
+		}
+	}
+	if len(data.Reviews) > 0 {
+		b.WriteString("## Notes due for review\n")
+		for _, item := range data.Reviews {
+			fmt.Fprintf(&b, "- %s: %s\n", item.MemoName, item.Title)
+		}
+	}
+	return b.String()
+}
+
+// renderSchedule renders a schedule instance as a list item.
+func renderSchedule(instance *schedule.ScheduleInstance, loc *time.Location) string {
+	start := time.Unix(instance.StartTs, 0).In(loc)
+	when := start.Format("01-02 15:04")
+	if instance.AllDay {
+		when = start.Format("01-02")
+	} else if instance.EndTs != nil {
+		when += "–" + time.Unix(*instance.EndTs, 0).In(loc).Format("15:04")
+	}
+	item := fmt.Sprintf("- %s **%s**", when, instance.Title)
+	if instance.Location != "" {
+		item += " @ " + instance.Location
+	}
+	return item + "\n"
+}
+
+// snippet returns the first line of a memo, shortened for lists.
+func snippet(content string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(content), "\n")
+	line = strings.TrimSpace(strings.TrimLeft(line, "# "))
+	if runes := []rune(line); len(runes) > maxListSnippetRunes {
+		line = string(runes[:maxListSnippetRunes]) + "…"
+	}
+	return line
+}
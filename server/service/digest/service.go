@@ -0,0 +1,365 @@
+// Package digest generates scheduled AI digests: a daily brief or weekly review
+// of the user's memos, schedules and due reviews, saved as a tagged memo and
+// optionally delivered to the inbox, email or webhooks.
+package digest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	"github.com/hrygo/divinesense/plugin/ai"
+	"github.com/hrygo/divinesense/plugin/ai/review"
+	"github.com/hrygo/divinesense/plugin/email"
+	"github.com/hrygo/divinesense/plugin/markdown"
+	"github.com/hrygo/divinesense/plugin/scheduler"
+	"github.com/hrygo/divinesense/plugin/webhook"
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	memoservice "github.com/hrygo/divinesense/server/service/memo"
+	"github.com/hrygo/divinesense/server/service/schedule"
+	"github.com/hrygo/divinesense/store"
+)
+
+const (
+	// maxDigestMemos limits the memos included in one digest.
+	maxDigestMemos = 50
+	// maxMemoSnippetRunes limits each memo's content passed to the LLM.
+	maxMemoSnippetRunes = 300
+	// maxDigestReviews limits the due reviews included in one digest.
+	maxDigestReviews = 5
+
+	// digestTag is the parent tag of all digest memos; digests never include each other.
+	digestTag = "digest"
+
+	// webhookActivityType is the activity type posted to webhooks for digests.
+	webhookActivityType = "memos.digest.created"
+)
+
+const briefPrompt = `You are a personal knowledge assistant writing a %s for the user.
+Based on the data below, write a concise brief in markdown:
+- Start with 2-3 sentences on the main themes.
+- Then short sections for notable notes, schedules and reviews (skip empty ones).
+- Cite notes as memos/<uid>.
+Use the same language as the user's notes. Do not invent facts.`
+
+// Service generates and delivers digests.
+type Service struct {
+	store     *store.Store
+	llm       ai.LLMService
+	profile   *profile.Profile
+	schedules schedule.Service
+	markdown  markdown.Service
+}
+
+// NewService creates a new digest service.
+// llmService may be nil, in which case digests list the gathered data without a brief.
+func NewService(st *store.Store, llmService ai.LLMService, prof *profile.Profile) *Service {
+	return &Service{
+		store:     st,
+		llm:       llmService,
+		profile:   prof,
+		schedules: schedule.NewService(st),
+		markdown:  markdown.NewService(markdown.WithTagExtension()),
+	}
+}
+
+// Result is a generated digest.
+type Result struct {
+	Memo *store.Memo
+	// Delivered lists the channels the digest was delivered to.
+	Delivered []storepb.DigestsUserSetting_Digest_Channel
+}
+
+// Window is the time range a digest covers.
+type Window struct {
+	Start time.Time
+	End   time.Time
+	// UpcomingEnd bounds the upcoming schedules, starting at End.
+	UpcomingEnd time.Time
+}
+
+// WindowFor returns the covered range of a digest kind ending at now.
+func WindowFor(kind storepb.DigestsUserSetting_Digest_Kind, now time.Time) Window {
+	period := 24 * time.Hour
+	if kind == storepb.DigestsUserSetting_Digest_WEEKLY_REVIEW {
+		period = 7 * 24 * time.Hour
+	}
+	return Window{
+		Start:       now.Add(-period),
+		End:         now,
+		UpcomingEnd: now.Add(period),
+	}
+}
+
+// IsDue reports whether a digest should run at now: its cron schedule has
+// triggered since the last run, or since lookback for digests that never ran.
+func IsDue(digest *storepb.DigestsUserSetting_Digest, now time.Time, lookback time.Duration) (bool, error) {
+	if !digest.Enabled {
+		return false, nil
+	}
+	cron, err := scheduler.ParseCronExpression(digest.Cron)
+	if err != nil {
+		return false, err
+	}
+
+	loc := LoadLocation(digest.Timezone)
+	from := now.Add(-lookback)
+	if digest.LastRunTs > 0 {
+		from = time.Unix(digest.LastRunTs, 0)
+	}
+	next := cron.Next(from.In(loc))
+	return !next.IsZero() && !next.After(now), nil
+}
+
+// ValidateDigest checks a digest configuration.
+func ValidateDigest(digest *storepb.DigestsUserSetting_Digest) error {
+	if digest.Kind == storepb.DigestsUserSetting_Digest_KIND_UNSPECIFIED {
+		return errors.New("digest kind is required")
+	}
+	if _, err := scheduler.ParseCronExpression(digest.Cron); err != nil {
+		return errors.Wrap(err, "invalid cron expression")
+	}
+	if digest.Timezone != "" {
+		if _, err := time.LoadLocation(digest.Timezone); err != nil {
+			return errors.Errorf("invalid timezone %q", digest.Timezone)
+		}
+	}
+	return nil
+}
+
+// LoadLocation returns the location for an IANA timezone name, falling back to the server timezone.
+func LoadLocation(name string) *time.Location {
+	if name != "" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+	return time.Local
+}
+
+// Run generates a digest for the user, saves it as a memo and delivers it.
+// Delivery failures are logged; the digest memo is still returned.
+func (s *Service) Run(ctx context.Context, userID int32, digest *storepb.DigestsUserSetting_Digest, now time.Time) (*Result, error) {
+	loc := LoadLocation(digest.Timezone)
+	now = now.In(loc)
+	window := WindowFor(digest.Kind, now)
+
+	data, err := s.gather(ctx, userID, digest, window)
+	if err != nil {
+		return nil, err
+	}
+
+	content := RenderDigest(digest.Kind, now, data, s.brief(ctx, digest.Kind, data))
+	memo, err := memoservice.Create(ctx, s.store, s.markdown, &store.Memo{
+		UID:        shortuuid.New(),
+		CreatorID:  userID,
+		Content:    content,
+		Visibility: store.Private,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create digest memo")
+	}
+
+	result := &Result{Memo: memo}
+	for _, channel := range digest.Channels {
+		if err := s.deliver(ctx, channel, memo, digestTitle(digest.Kind, now)); err != nil {
+			slog.Warn("failed to deliver digest",
+				"user_id", userID,
+				"digest_id", digest.Id,
+				"channel", channel.String(),
+				"error", err,
+			)
+			continue
+		}
+		result.Delivered = append(result.Delivered, channel)
+	}
+	return result, nil
+}
+
+// Data is the material a digest is built from.
+type Data struct {
+	Memos     []*store.Memo
+	Completed []*schedule.ScheduleInstance
+	Upcoming  []*schedule.ScheduleInstance
+	Reviews   []review.ReviewItem
+	Location  *time.Location
+}
+
+// IsEmpty reports whether there is nothing to digest.
+func (d *Data) IsEmpty() bool {
+	return len(d.Memos) == 0 && len(d.Completed) == 0 && len(d.Upcoming) == 0 && len(d.Reviews) == 0
+}
+
+// gather collects memos, schedules and due reviews within the digest scope.
+func (s *Service) gather(ctx context.Context, userID int32, digest *storepb.DigestsUserSetting_Digest, window Window) (*Data, error) {
+	data := &Data{Location: window.End.Location()}
+
+	if digest.IncludeMemos {
+		normal := store.Normal
+		limit := maxDigestMemos
+		filters := []string{fmt.Sprintf("created_ts >= %d", window.Start.Unix())}
+		if len(digest.Tags) > 0 {
+			quoted := make([]string, 0, len(digest.Tags))
+			for _, tag := range digest.Tags {
+				quoted = append(quoted, fmt.Sprintf("%q", strings.TrimPrefix(tag, "#")))
+			}
+			filters = append(filters, fmt.Sprintf("tag in [%s]", strings.Join(quoted, ", ")))
+		}
+		memos, err := s.store.ListMemos(ctx, &store.FindMemo{
+			CreatorID:       &userID,
+			RowStatus:       &normal,
+			ExcludeComments: true,
+			Filters:         filters,
+			Limit:           &limit,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memos")
+		}
+		for _, memo := range memos {
+			if !isDigestMemo(memo) {
+				data.Memos = append(data.Memos, memo)
+			}
+		}
+	}
+
+	if digest.IncludeSchedules {
+		past, err := s.schedules.FindSchedules(ctx, userID, window.Start, window.End)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find completed schedules")
+		}
+		for _, instance := range past {
+			end := instance.StartTs
+			if instance.EndTs != nil {
+				end = *instance.EndTs
+			}
+			if end <= window.End.Unix() {
+				data.Completed = append(data.Completed, instance)
+			}
+		}
+
+		upcoming, err := s.schedules.FindSchedules(ctx, userID, window.End, window.UpcomingEnd)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find upcoming schedules")
+		}
+		for _, instance := range upcoming {
+			if instance.StartTs >= window.End.Unix() {
+				data.Upcoming = append(data.Upcoming, instance)
+			}
+		}
+	}
+
+	if digest.IncludeReviews {
+		items, _, err := review.NewService(s.store).GetDueReviews(ctx, userID, maxDigestReviews)
+		if err != nil {
+			// Reviews are supplementary; a digest without them is still useful
+			slog.Warn("failed to get due reviews for digest", "user_id", userID, "error", err)
+		} else {
+			data.Reviews = items
+		}
+	}
+
+	return data, nil
+}
+
+// isDigestMemo reports whether a memo was created by a digest.
+func isDigestMemo(memo *store.Memo) bool {
+	for _, tag := range memo.Payload.GetTags() {
+		if tag == digestTag || strings.HasPrefix(tag, digestTag+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// brief asks the LLM to write the digest brief. Failures are logged and yield no brief.
+func (s *Service) brief(ctx context.Context, kind storepb.DigestsUserSetting_Digest_Kind, data *Data) string {
+	if s.llm == nil || data.IsEmpty() {
+		return ""
+	}
+	name := "daily brief"
+	if kind == storepb.DigestsUserSetting_Digest_WEEKLY_REVIEW {
+		name = "weekly review"
+	}
+	content, err := s.llm.Chat(ctx, []ai.Message{
+		ai.SystemPrompt(fmt.Sprintf(briefPrompt, name)),
+		ai.UserMessage(FormatData(data)),
+	})
+	if err != nil {
+		slog.Warn("failed to generate digest brief", "error", err)
+		return ""
+	}
+	return strings.TrimSpace(content)
+}
+
+// deliver sends a digest memo to one channel.
+func (s *Service) deliver(ctx context.Context, channel storepb.DigestsUserSetting_Digest_Channel, memo *store.Memo, title string) error {
+	switch channel {
+	case storepb.DigestsUserSetting_Digest_INBOX:
+		_, err := s.store.CreateInbox(ctx, &store.Inbox{
+			SenderID:   memo.CreatorID,
+			ReceiverID: memo.CreatorID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:   storepb.InboxMessage_AI_DIGEST,
+				MemoId: &memo.ID,
+			},
+		})
+		return err
+	case storepb.DigestsUserSetting_Digest_EMAIL:
+		if s.profile == nil || !s.profile.IsEmailEnabled() {
+			return errors.New("email is not configured")
+		}
+		user, err := s.store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
+		if err != nil {
+			return err
+		}
+		if user == nil || user.Email == "" {
+			return errors.New("user has no email address")
+		}
+		return email.Send(&email.Config{
+			SMTPHost:     s.profile.SMTPHost,
+			SMTPPort:     s.profile.SMTPPort,
+			SMTPUsername: s.profile.SMTPUsername,
+			SMTPPassword: s.profile.SMTPPassword,
+			FromEmail:    s.profile.SMTPFromEmail,
+			FromName:     s.profile.SMTPFromName,
+			UseTLS:       s.profile.SMTPUseTLS,
+			UseSSL:       s.profile.SMTPUseSSL,
+		}, &email.Message{
+			To:      []string{user.Email},
+			Subject: title,
+			Body:    memo.Content,
+		})
+	case storepb.DigestsUserSetting_Digest_WEBHOOK:
+		webhooks, err := s.store.GetUserWebhooks(ctx, memo.CreatorID)
+		if err != nil {
+			return err
+		}
+		for _, hook := range webhooks {
+			webhook.PostAsync(&webhook.WebhookRequestPayload{
+				URL:          hook.Url,
+				ActivityType: webhookActivityType,
+				Creator:      fmt.Sprintf("users/%d", memo.CreatorID),
+				Memo: &v1pb.Memo{
+					Name:       fmt.Sprintf("memos/%s", memo.UID),
+					Creator:    fmt.Sprintf("users/%d", memo.CreatorID),
+					Content:    memo.Content,
+					Visibility: v1pb.Visibility_PRIVATE,
+					Tags:       memo.Payload.GetTags(),
+					CreateTime: timestamppb.New(time.Unix(memo.CreatedTs, 0)),
+				},
+			})
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported channel %s", channel.String())
+	}
+}
@@ -0,0 +1,83 @@
+package digest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/store"
+)
+
+func TestIsDue(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+
+	d := &storepb.DigestsUserSetting_Digest{
+		Kind:     storepb.DigestsUserSetting_Digest_DAILY_BRIEF,
+		Cron:     "0 8 * * *",
+		Timezone: "Asia/Shanghai",
+		Enabled:  true,
+	}
+	// 08:00 in Shanghai is 00:00 UTC
+	at := time.Date(2026, 3, 2, 8, 0, 30, 0, shanghai).UTC()
+
+	due, err := IsDue(d, at, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, due)
+
+	due, err = IsDue(d, at.Add(time.Hour), time.Minute)
+	require.NoError(t, err)
+	assert.False(t, due, "a digest that never ran only looks back one tick")
+
+	d.LastRunTs = at.Unix()
+	due, err = IsDue(d, at.Add(time.Minute), time.Minute)
+	require.NoError(t, err)
+	assert.False(t, due)
+
+	due, err = IsDue(d, at.Add(24*time.Hour), time.Minute)
+	require.NoError(t, err)
+	assert.True(t, due)
+
+	d.Enabled = false
+	due, err = IsDue(d, at.Add(24*time.Hour), time.Minute)
+	require.NoError(t, err)
+	assert.False(t, due)
+}
+
+func TestValidateDigest(t *testing.T) {
+	valid := &storepb.DigestsUserSetting_Digest{
+		Kind:     storepb.DigestsUserSetting_Digest_WEEKLY_REVIEW,
+		Cron:     "0 18 * * 5",
+		Timezone: "Europe/Berlin",
+	}
+	assert.NoError(t, ValidateDigest(valid))
+
+	assert.Error(t, ValidateDigest(&storepb.DigestsUserSetting_Digest{Cron: "0 8 * * *"}))
+	assert.Error(t, ValidateDigest(&storepb.DigestsUserSetting_Digest{Kind: storepb.DigestsUserSetting_Digest_DAILY_BRIEF, Cron: "nope"}))
+	assert.Error(t, ValidateDigest(&storepb.DigestsUserSetting_Digest{Kind: storepb.DigestsUserSetting_Digest_DAILY_BRIEF, Cron: "0 8 * * *", Timezone: "Mars/Olympus"}))
+}
+
+func TestRenderDigest(t *testing.T) {
+	now := time.Date(2026, 3, 6, 18, 0, 0, 0, time.UTC)
+
+	empty := RenderDigest(storepb.DigestsUserSetting_Digest_DAILY_BRIEF, now, &Data{Location: time.UTC}, "")
+	assert.True(t, strings.HasPrefix(empty, "# Daily Brief · 2026-03-06\n\nNo new activity"))
+	assert.True(t, strings.HasSuffix(empty, "#digest/daily"))
+
+	data := &Data{
+		Memos:    []*store.Memo{{UID: "abc", Content: "Shipped the importer"}},
+		Location: time.UTC,
+	}
+	weekly := RenderDigest(storepb.DigestsUserSetting_Digest_WEEKLY_REVIEW, now, data, "A productive week.")
+	assert.Contains(t, weekly, "# Weekly Review · 2026-02-28 – 2026-03-06")
+	assert.Contains(t, weekly, "A productive week.")
+	assert.Contains(t, weekly, "- [memos/abc](/memos/abc) Shipped the importer")
+	assert.True(t, strings.HasSuffix(weekly, "#digest/weekly"))
+
+	assert.True(t, isDigestMemo(&store.Memo{Payload: &storepb.MemoPayload{Tags: []string{"digest/daily"}}}))
+	assert.False(t, isDigestMemo(&store.Memo{Payload: &storepb.MemoPayload{Tags: []string{"digestion"}}}))
+}
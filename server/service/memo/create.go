@@ -0,0 +1,75 @@
+package memo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hrygo/divinesense/plugin/markdown"
+	"github.com/hrygo/divinesense/plugin/webhook"
+	v1pb "github.com/hrygo/divinesense/proto/gen/api/v1"
+	"github.com/hrygo/divinesense/server/runner/memopayload"
+	"github.com/hrygo/divinesense/store"
+)
+
+// memoCreatedActivityType is the webhook activity type of a created memo.
+const memoCreatedActivityType = "memos.memo.created"
+
+// ErrContentTooLong is returned when the memo content exceeds the instance content length limit.
+var ErrContentTooLong = errors.New("content too long")
+
+// Create saves a memo created by a server feature such as an AI export, a web
+// capture or a digest, the way MemoService.CreateMemo does: it enforces the
+// instance content length limit, rebuilds the payload (tags and properties)
+// and dispatches the memo created webhook.
+func Create(ctx context.Context, s *store.Store, markdownService markdown.Service, create *store.Memo) (*store.Memo, error) {
+	setting, err := s.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance memo related setting")
+	}
+	if limit := int(setting.ContentLengthLimit); len(create.Content) > limit {
+		return nil, errors.Wrapf(ErrContentTooLong, "max %d characters", limit)
+	}
+	if err := memopayload.RebuildMemoPayload(create, markdownService); err != nil {
+		return nil, errors.Wrap(err, "failed to rebuild memo payload")
+	}
+	memo, err := s.CreateMemo(ctx, create)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create memo")
+	}
+
+	if err := dispatchMemoCreatedWebhook(ctx, s, memo); err != nil {
+		slog.Warn("Failed to dispatch memo created webhook", "memo_id", memo.ID, "error", err)
+	}
+	return memo, nil
+}
+
+// dispatchMemoCreatedWebhook posts the memo to the creator's webhooks.
+func dispatchMemoCreatedWebhook(ctx context.Context, s *store.Store, memo *store.Memo) error {
+	webhooks, err := s.GetUserWebhooks(ctx, memo.CreatorID)
+	if err != nil {
+		return err
+	}
+	creator := fmt.Sprintf("users/%d", memo.CreatorID)
+	for _, hook := range webhooks {
+		webhook.PostAsync(&webhook.WebhookRequestPayload{
+			URL:          hook.Url,
+			ActivityType: memoCreatedActivityType,
+			Creator:      creator,
+			Memo: &v1pb.Memo{
+				Name:       fmt.Sprintf("memos/%s", memo.UID),
+				Creator:    creator,
+				Content:    memo.Content,
+				Visibility: v1pb.Visibility(v1pb.Visibility_value[memo.Visibility.String()]),
+				Tags:       memo.Payload.GetTags(),
+				CreateTime: timestamppb.New(time.Unix(memo.CreatedTs, 0)),
+				UpdateTime: timestamppb.New(time.Unix(memo.UpdatedTs, 0)),
+			},
+		})
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package memo
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	"github.com/hrygo/divinesense/plugin/markdown"
+	"github.com/hrygo/divinesense/store"
+	"github.com/hrygo/divinesense/store/db"
+)
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	p := &profile.Profile{Mode: "prod", Driver: "sqlite", Data: dir, DSN: filepath.Join(dir, "test.db"), Version: "0.26.0"}
+	driver, err := db.NewDBDriver(p)
+	require.NoError(t, err)
+	st := store.New(driver, p)
+	defer st.Close()
+	require.NoError(t, st.Migrate(ctx))
+	user, err := st.CreateUser(ctx, &store.User{Username: "alice", Role: store.RoleUser, PasswordHash: "x"})
+	require.NoError(t, err)
+	markdownService := markdown.NewService(markdown.WithTagExtension())
+
+	memo, err := Create(ctx, st, markdownService, &store.Memo{
+		UID:        "saved",
+		CreatorID:  user.ID,
+		Content:    "- [ ] follow up #work",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work"}, memo.Payload.GetTags())
+	assert.True(t, memo.Payload.GetProperty().GetHasTaskList())
+
+	_, err = Create(ctx, st, markdownService, &store.Memo{
+		UID:        "too-long",
+		CreatorID:  user.ID,
+		Content:    strings.Repeat("a", store.DefaultContentLengthLimit+1),
+		Visibility: store.Private,
+	})
+	require.ErrorIs(t, err, ErrContentTooLong)
+	tooLongUID := "too-long"
+	found, err := st.GetMemo(ctx, &store.FindMemo{UID: &tooLongUID})
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
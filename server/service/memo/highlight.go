@@ -2,12 +2,17 @@ package memo
 
 import (
 	"context"
+	"log/slog"
 	"sort"
 	"strings"
 
 	"github.com/hrygo/divinesense/server/retrieval"
+	"github.com/hrygo/divinesense/store"
 )
 
+// SearchWithHighlightStrategy is the retrieval strategy of highlighted search.
+const SearchWithHighlightStrategy = "hybrid_standard"
+
 // Highlight represents a highlighted match in the content.
 type Highlight struct {
 	Start       int    `json:"start"`
@@ -17,23 +22,35 @@ type Highlight struct {
 
 // HighlightedMemo represents a memo with highlighted search matches.
 type HighlightedMemo struct {
+	Name              string                  `json:"name"`
+	Snippet           string                  `json:"snippet"`
+	Score             float32                 `json:"score"`
+	Highlights        []Highlight             `json:"highlights"`
+	CreatedTs         int64                   `json:"created_ts"`
+	AttachmentMatches []HighlightedAttachment `json:"attachment_matches,omitempty"`
+	SimilarMemos      []string                `json:"similar_memos,omitempty"` // UIDs of collapsed near-duplicates
+}
+
+// HighlightedAttachment represents an attachment whose extracted or OCR text matches the search.
+type HighlightedAttachment struct {
 	Name       string      `json:"name"`
+	Filename   string      `json:"filename"`
 	Snippet    string      `json:"snippet"`
-	Score      float32     `json:"score"`
 	Highlights []Highlight `json:"highlights"`
-	CreatedTs  int64       `json:"created_ts"`
 }
 
 // HighlightService provides search highlighting functionality.
 type HighlightService struct {
+	store            *store.Store // Optional; without it attachments are not highlighted
 	retriever        *retrieval.AdaptiveRetriever
 	tokenizer        *Tokenizer
 	snippetExtractor *SnippetExtractor
 }
 
 // NewHighlightService creates a new HighlightService instance.
-func NewHighlightService(retriever *retrieval.AdaptiveRetriever) *HighlightService {
+func NewHighlightService(s *store.Store, retriever *retrieval.AdaptiveRetriever) *HighlightService {
 	return &HighlightService{
+		store:            s,
 		retriever:        retriever,
 		tokenizer:        NewTokenizer(),
 		snippetExtractor: NewSnippetExtractor(),
@@ -42,7 +59,10 @@ func NewHighlightService(retriever *retrieval.AdaptiveRetriever) *HighlightServi
 
 // SearchWithHighlightOptions contains options for highlighted search.
 type SearchWithHighlightOptions struct {
-	Query        string
+	Query        string                   // Free text to retrieve and highlight
+	Filter       string                   // CEL memo filter of the query operators, see plugin/search
+	Expansions   []retrieval.ExpandedTerm // Terms the query is expanded with, see retrieval.AdaptiveRetriever.ExpandQuery
+	Alternatives []string                 // Words joined by OR, matched by Filter and only highlighted
 	UserID       int32
 	Limit        int
 	ContextChars int
@@ -63,19 +83,36 @@ func (s *HighlightService) SearchWithHighlight(
 
 	// 1. Execute hybrid retrieval
 	results, err := s.retriever.Retrieve(ctx, &retrieval.RetrievalOptions{
-		Query:    opts.Query,
-		UserID:   opts.UserID,
-		Strategy: "hybrid_standard",
-		Limit:    opts.Limit,
+		Query:      opts.Query,
+		Filter:     opts.Filter,
+		UserID:     opts.UserID,
+		Strategy:   SearchWithHighlightStrategy,
+		Limit:      opts.Limit,
+		Expansions: opts.Expansions,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	// 2. Tokenize query
+	// 2. Tokenize query, highlighting expansion terms and alternatives as well
 	tokens := s.tokenizer.Tokenize(opts.Query)
+	for _, expansion := range opts.Expansions {
+		tokens = append(tokens, s.tokenizer.Tokenize(expansion.Term)...)
+	}
+	for _, alternative := range opts.Alternatives {
+		tokens = append(tokens, s.tokenizer.Tokenize(alternative)...)
+	}
 
-	// 3. Build highlighted results
+	// 3. Highlight matches in attachment text, which BM25 attributes to the parent memo
+	memoIDs := make([]int32, 0, len(results))
+	for _, result := range results {
+		if result.Memo != nil {
+			memoIDs = append(memoIDs, result.Memo.ID)
+		}
+	}
+	attachmentMatches := s.findAttachmentMatches(ctx, memoIDs, tokens, opts.ContextChars)
+
+	// 4. Build highlighted results
 	highlighted := make([]HighlightedMemo, 0, len(results))
 	for _, result := range results {
 		if result.Memo == nil {
@@ -97,6 +134,12 @@ func (s *HighlightService) SearchWithHighlight(
 			matches,
 			&ExtractOptions{ContextChars: opts.ContextChars, AddEllipsis: true},
 		)
+		h.AttachmentMatches = attachmentMatches[result.Memo.ID]
+		for _, similar := range result.Similar {
+			if similar.Memo != nil {
+				h.SimilarMemos = append(h.SimilarMemos, similar.Memo.UID)
+			}
+		}
 
 		highlighted = append(highlighted, h)
 	}
@@ -104,6 +147,44 @@ func (s *HighlightService) SearchWithHighlight(
 	return highlighted, nil
 }
 
+// findAttachmentMatches highlights the tokens in the extracted and OCR text of
+// the attachments of the given memos. The result is keyed by memo ID.
+func (s *HighlightService) findAttachmentMatches(ctx context.Context, memoIDs []int32, tokens []string, contextChars int) map[int32][]HighlightedAttachment {
+	if s.store == nil || len(memoIDs) == 0 || len(tokens) == 0 {
+		return nil
+	}
+
+	attachments, err := s.store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDs})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to list attachments for highlighting", "error", err)
+		return nil
+	}
+
+	matches := make(map[int32][]HighlightedAttachment)
+	for _, attachment := range attachments {
+		if attachment.MemoID == nil {
+			continue
+		}
+		text := strings.TrimSpace(attachment.ExtractedText + "\n" + attachment.OCRText)
+		found := s.findMatches(text, tokens)
+		if len(found) == 0 {
+			continue
+		}
+
+		h := HighlightedAttachment{
+			Name:     attachment.UID,
+			Filename: attachment.Filename,
+		}
+		h.Snippet, h.Highlights = s.snippetExtractor.ExtractSnippet(
+			text,
+			found,
+			&ExtractOptions{ContextChars: contextChars, AddEllipsis: true},
+		)
+		matches[*attachment.MemoID] = append(matches[*attachment.MemoID], h)
+	}
+	return matches
+}
+
 // findMatches finds all occurrences of tokens in the content.
 func (s *HighlightService) findMatches(content string, tokens []string) []Highlight {
 	if len(tokens) == 0 {
@@ -0,0 +1,450 @@
+// Package proactive ranks explainable next actions for a user: predictions from
+// the habit-driven prediction engine, recurring schedules, writing habits and due
+// reviews. Accept/dismiss feedback is stored in the habit data and reweights
+// future suggestions.
+package proactive
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hrygo/divinesense/plugin/ai/habit"
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+	"github.com/hrygo/divinesense/plugin/ai/prediction"
+	"github.com/hrygo/divinesense/plugin/ai/review"
+	"github.com/hrygo/divinesense/server/scheduler/suggestion"
+	"github.com/hrygo/divinesense/store"
+)
+
+const (
+	// DefaultLimit is the number of suggestions returned when no limit is given.
+	DefaultLimit = 5
+	// MaxLimit bounds the number of suggestions returned.
+	MaxLimit = 10
+
+	// dismissCooldown hides a dismissed suggestion for this long.
+	dismissCooldown = 24 * time.Hour
+	// historyWeeks is how far back recurring schedules are detected.
+	historyWeeks = 4
+	// minRecurringWeeks is the number of weeks a schedule must repeat to be "usual".
+	minRecurringWeeks = 3
+	// recurringHorizon is how far ahead a usual schedule is suggested.
+	recurringHorizon = 3 * 24 * time.Hour
+	// writingHistoryDays is how far back writing habits are detected.
+	writingHistoryDays = 14
+	// minWritingDays is the number of days a tag must be written at the same hour.
+	minWritingDays = 4
+)
+
+// Actions produced by this package in addition to the prediction engine's actions.
+const (
+	ActionReviewMemos   prediction.ActionType = "review_memos"
+	ActionUsualSchedule prediction.ActionType = "create_usual_schedule"
+	ActionWritingHabit  prediction.ActionType = "write_memo"
+)
+
+// Suggestion is a ranked, explainable next action.
+type Suggestion struct {
+	// ID identifies the suggestion across requests; feedback is keyed by it.
+	ID         string
+	Type       prediction.PredictionType
+	Action     prediction.ActionType
+	Title      string
+	Reason     string
+	Confidence float64
+	Payload    map[string]string
+}
+
+// Service generates proactive suggestions.
+type Service struct {
+	store    *store.Store
+	engine   *prediction.Engine
+	slots    *suggestion.Analyzer
+	reviews  *review.Service
+	feedback *habit.FeedbackRecorder
+}
+
+// NewService creates a proactive suggestion service.
+func NewService(st *store.Store, memSvc memory.MemoryService) *Service {
+	engine := prediction.NewEngine(habit.NewHabitAnalyzer(memSvc, nil), memSvc)
+	engine.SetMaxPredictions(MaxLimit)
+	return &Service{
+		store:    st,
+		engine:   engine,
+		slots:    suggestion.NewAnalyzer(st),
+		reviews:  review.NewService(st),
+		feedback: habit.NewFeedbackRecorder(memSvc),
+	}
+}
+
+// Suggest returns up to limit suggestions for the user at now, ranked by
+// confidence after applying the user's feedback. now should be in the user's timezone.
+func (s *Service) Suggest(ctx context.Context, userID int32, now time.Time, events []prediction.ContextEvent, limit int) ([]*Suggestion, error) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	var suggestions []*Suggestion
+
+	predictions, err := s.engine.PredictAt(ctx, userID, now, events)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range predictions {
+		suggestions = append(suggestions, s.fromPrediction(ctx, userID, now, p))
+	}
+
+	// Each source is best effort; a failing source must not hide the others.
+	if reviewSuggestion, err := s.suggestReviews(ctx, userID); err != nil {
+		slog.Warn("failed to suggest reviews", "user_id", userID, "error", err)
+	} else if reviewSuggestion != nil {
+		suggestions = append(suggestions, reviewSuggestion)
+	}
+
+	if usual, err := s.suggestUsualSchedules(ctx, userID, now); err != nil {
+		slog.Warn("failed to suggest usual schedules", "user_id", userID, "error", err)
+	} else {
+		suggestions = append(suggestions, usual...)
+	}
+
+	if writing, err := s.suggestWritingHabits(ctx, userID, now); err != nil {
+		slog.Warn("failed to suggest writing habits", "user_id", userID, "error", err)
+	} else {
+		suggestions = append(suggestions, writing...)
+	}
+
+	feedback, err := s.feedback.Load(ctx, userID)
+	if err != nil {
+		slog.Warn("failed to load suggestion feedback", "user_id", userID, "error", err)
+		feedback = nil
+	}
+	return Rank(suggestions, feedback, now, limit), nil
+}
+
+// RecordFeedback stores an accept or dismiss of a suggestion.
+func (s *Service) RecordFeedback(ctx context.Context, userID int32, suggestionID, title string, accepted bool, now time.Time) error {
+	return s.feedback.Record(ctx, userID, suggestionID, title, accepted, now)
+}
+
+// Rank applies feedback weights, drops recently dismissed and duplicate
+// suggestions, and returns the top limit by confidence.
+func Rank(suggestions []*Suggestion, feedback map[string]habit.SuggestionFeedback, now time.Time, limit int) []*Suggestion {
+	seen := make(map[string]bool, len(suggestions))
+	ranked := make([]*Suggestion, 0, len(suggestions))
+	for _, sg := range suggestions {
+		if seen[sg.ID] {
+			continue
+		}
+		seen[sg.ID] = true
+
+		if entry, ok := feedback[sg.ID]; ok {
+			if entry.LastDismissedTs > 0 && now.Sub(time.Unix(entry.LastDismissedTs, 0)) < dismissCooldown {
+				continue
+			}
+			sg.Confidence = min(1.0, sg.Confidence*entry.Weight())
+		}
+		ranked = append(ranked, sg)
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Confidence > ranked[j].Confidence
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// fromPrediction converts an engine prediction. Schedule creation predictions
+// are completed with the best free slot from the schedule analyzer.
+func (s *Service) fromPrediction(ctx context.Context, userID int32, now time.Time, p prediction.Prediction) *Suggestion {
+	sg := &Suggestion{
+		ID:         suggestionID(string(p.Action)),
+		Type:       p.Type,
+		Action:     p.Action,
+		Title:      p.Label,
+		Reason:     p.Reason,
+		Confidence: p.Confidence,
+		Payload:    map[string]string{},
+	}
+	if payload, ok := p.Payload.(map[string]string); ok {
+		for k, v := range payload {
+			sg.Payload[k] = v
+		}
+	}
+
+	if p.Action == prediction.ActionCreateSchedule {
+		if slot := s.freeSlot(ctx, userID, now, time.Hour); slot != nil {
+			sg.Payload["start_ts"] = fmt.Sprintf("%d", slot.StartTime.Unix())
+			sg.Payload["end_ts"] = fmt.Sprintf("%d", slot.EndTime.Unix())
+			sg.Reason = fmt.Sprintf("%s；%s 有空", sg.Reason, slot.StartTime.In(now.Location()).Format("01-02 15:04"))
+		}
+	}
+	return sg
+}
+
+// freeSlot returns the best conflict-free slot in the next day, or nil.
+func (s *Service) freeSlot(ctx context.Context, userID int32, now time.Time, duration time.Duration) *suggestion.Suggestion {
+	slots, err := s.slots.GenerateSuggestions(ctx, &suggestion.SuggestionOptions{
+		UserID:    userID,
+		StartDate: now.Truncate(time.Hour),
+		EndDate:   now.Add(24 * time.Hour),
+		Duration:  duration,
+	})
+	if err != nil {
+		slog.Debug("failed to generate time slots", "user_id", userID, "error", err)
+		return nil
+	}
+	for _, slot := range slots {
+		if slot.IsAvailable && slot.StartTime.After(now) {
+			return slot
+		}
+	}
+	return nil
+}
+
+// suggestReviews suggests reviewing memos that are due.
+func (s *Service) suggestReviews(ctx context.Context, userID int32) (*Suggestion, error) {
+	items, total, err := s.reviews.GetDueReviews(ctx, userID, 1)
+	if err != nil {
+		return nil, err
+	}
+	if total == 0 || len(items) == 0 {
+		return nil, nil
+	}
+	return &Suggestion{
+		ID:         suggestionID(string(ActionReviewMemos)),
+		Type:       prediction.PredictionTypeReminder,
+		Action:     ActionReviewMemos,
+		Title:      fmt.Sprintf("复习 %d 条到期笔记", total),
+		Reason:     fmt.Sprintf("间隔重复计划中有 %d 条笔记到期，最优先：%s", total, items[0].Title),
+		Confidence: min(0.9, 0.6+0.05*float64(total)),
+		Payload:    map[string]string{"count": fmt.Sprintf("%d", total), "memo_name": items[0].MemoName},
+	}, nil
+}
+
+// suggestUsualSchedules suggests creating schedules that repeated weekly at the
+// same weekday and time but are missing for their next occurrence.
+func (s *Service) suggestUsualSchedules(ctx context.Context, userID int32, now time.Time) ([]*Suggestion, error) {
+	since := now.AddDate(0, 0, -7*historyWeeks).Unix()
+	until := now.Add(recurringHorizon + 12*time.Hour).Unix()
+	normal := store.Normal
+	schedules, err := s.store.ListSchedules(ctx, &store.FindSchedule{
+		CreatorID: &userID,
+		StartTs:   &since,
+		EndTs:     &until,
+		RowStatus: &normal,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return DetectUsualSchedules(schedules, now), nil
+}
+
+// usualKey groups schedules that recur at the same weekday and time.
+type usualKey struct {
+	title   string
+	weekday time.Weekday
+	hour    int
+	minute  int
+}
+
+// DetectUsualSchedules finds one-off schedules that the user created on at
+// least minRecurringWeeks distinct weeks at the same weekday and time, and whose
+// next occurrence within recurringHorizon has not been created yet.
+func DetectUsualSchedules(schedules []*store.Schedule, now time.Time) []*Suggestion {
+	loc := now.Location()
+	since := now.AddDate(0, 0, -7*historyWeeks)
+	weeks := make(map[usualKey]map[int]bool)
+	titles := make(map[usualKey]string)
+	durations := make(map[usualKey]int64)
+	for _, sc := range schedules {
+		// Recurring schedules are created once by design
+		if sc.RecurrenceRule != nil && *sc.RecurrenceRule != "" {
+			continue
+		}
+		start := time.Unix(sc.StartTs, 0).In(loc)
+		if start.Before(since) || !start.Before(now) {
+			continue
+		}
+		key := usualKey{
+			title:   strings.ToLower(strings.TrimSpace(sc.Title)),
+			weekday: start.Weekday(),
+			hour:    start.Hour(),
+			minute:  start.Minute(),
+		}
+		if key.title == "" {
+			continue
+		}
+		if weeks[key] == nil {
+			weeks[key] = make(map[int]bool)
+		}
+		year, week := start.ISOWeek()
+		weeks[key][year*100+week] = true
+		titles[key] = sc.Title
+		if sc.EndTs != nil && *sc.EndTs > sc.StartTs {
+			durations[key] = *sc.EndTs - sc.StartTs
+		}
+	}
+
+	var suggestions []*Suggestion
+	for key, seen := range weeks {
+		if len(seen) < minRecurringWeeks {
+			continue
+		}
+		next := nextOccurrence(now, key.weekday, key.hour, key.minute)
+		if next.Sub(now) > recurringHorizon || hasScheduleNear(schedules, key.title, next) {
+			continue
+		}
+
+		duration := durations[key]
+		if duration == 0 {
+			duration = int64(time.Hour / time.Second)
+		}
+		suggestions = append(suggestions, &Suggestion{
+			ID:         suggestionID(string(ActionUsualSchedule), key.title, key.weekday.String(), fmt.Sprintf("%02d%02d", key.hour, key.minute)),
+			Type:       prediction.PredictionTypeAction,
+			Action:     ActionUsualSchedule,
+			Title:      fmt.Sprintf("创建%s的%s", weekdayNames[key.weekday], titles[key]),
+			Reason:     fmt.Sprintf("过去 %d 周中有 %d 周在%s %02d:%02d 安排了「%s」", historyWeeks, len(seen), weekdayNames[key.weekday], key.hour, key.minute, titles[key]),
+			Confidence: min(0.9, 0.6+0.1*float64(len(seen)-minRecurringWeeks)),
+			Payload: map[string]string{
+				"title":    titles[key],
+				"start_ts": fmt.Sprintf("%d", next.Unix()),
+				"end_ts":   fmt.Sprintf("%d", next.Unix()+duration),
+			},
+		})
+	}
+	sortSuggestions(suggestions)
+	return suggestions
+}
+
+// suggestWritingHabits suggests writing a memo when the user usually writes
+// memos with the same tag around this hour and has not done so today.
+func (s *Service) suggestWritingHabits(ctx context.Context, userID int32, now time.Time) ([]*Suggestion, error) {
+	since := now.AddDate(0, 0, -writingHistoryDays).Unix()
+	normal := store.Normal
+	memos, err := s.store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &normal,
+		ExcludeComments: true,
+		Filters:         []string{fmt.Sprintf("created_ts >= %d", since)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return DetectWritingHabits(memos, now), nil
+}
+
+// DetectWritingHabits finds tags the user wrote on at least minWritingDays
+// distinct days at the current hour or the next, and not yet today.
+func DetectWritingHabits(memos []*store.Memo, now time.Time) []*Suggestion {
+	type habitKey struct {
+		tag  string
+		hour int
+	}
+
+	loc := now.Location()
+	today := now.Format("2006-01-02")
+	days := make(map[habitKey]map[string]bool)
+	writtenToday := make(map[string]bool)
+	for _, memo := range memos {
+		created := time.Unix(memo.CreatedTs, 0).In(loc)
+		day := created.Format("2006-01-02")
+		for _, tag := range memo.Payload.GetTags() {
+			if day == today {
+				writtenToday[tag] = true
+				continue
+			}
+			key := habitKey{tag: tag, hour: created.Hour()}
+			if days[key] == nil {
+				days[key] = make(map[string]bool)
+			}
+			days[key][day] = true
+		}
+	}
+
+	var suggestions []*Suggestion
+	for key, seen := range days {
+		if len(seen) < minWritingDays || writtenToday[key.tag] {
+			continue
+		}
+		// Suggest during the usual hour and the hour before it
+		if now.Hour() != key.hour && now.Hour() != (key.hour+23)%24 {
+			continue
+		}
+		suggestions = append(suggestions, &Suggestion{
+			ID:         suggestionID(string(ActionWritingHabit), key.tag, fmt.Sprintf("%02d", key.hour)),
+			Type:       prediction.PredictionTypeAction,
+			Action:     ActionWritingHabit,
+			Title:      fmt.Sprintf("写一条 #%s", key.tag),
+			Reason:     fmt.Sprintf("最近 %d 天中有 %d 天在 %02d:00 左右写 #%s", writingHistoryDays, len(seen), key.hour, key.tag),
+			Confidence: min(0.9, 0.5+0.05*float64(len(seen))),
+			Payload:    map[string]string{"tag": key.tag},
+		})
+	}
+	sortSuggestions(suggestions)
+	return suggestions
+}
+
+var weekdayNames = map[time.Weekday]string{
+	time.Sunday:    "周日",
+	time.Monday:    "周一",
+	time.Tuesday:   "周二",
+	time.Wednesday: "周三",
+	time.Thursday:  "周四",
+	time.Friday:    "周五",
+	time.Saturday:  "周六",
+}
+
+// nextOccurrence returns the next time after now on weekday at hour:minute.
+func nextOccurrence(now time.Time, weekday time.Weekday, hour, minute int) time.Time {
+	days := (int(weekday) - int(now.Weekday()) + 7) % 7
+	next := time.Date(now.Year(), now.Month(), now.Day()+days, hour, minute, 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 7)
+	}
+	return next
+}
+
+// hasScheduleNear reports whether a schedule with the title starts within 12 hours of at.
+func hasScheduleNear(schedules []*store.Schedule, title string, at time.Time) bool {
+	for _, sc := range schedules {
+		if strings.ToLower(strings.TrimSpace(sc.Title)) != title {
+			continue
+		}
+		diff := time.Unix(sc.StartTs, 0).Sub(at)
+		if diff > -12*time.Hour && diff < 12*time.Hour {
+			return true
+		}
+	}
+	return false
+}
+
+// sortSuggestions orders suggestions by confidence, then ID, for stable output.
+func sortSuggestions(suggestions []*Suggestion) {
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Confidence != suggestions[j].Confidence {
+			return suggestions[i].Confidence > suggestions[j].Confidence
+		}
+		return suggestions[i].ID < suggestions[j].ID
+	})
+}
+
+// suggestionID builds a URL-safe, stable suggestion ID from its action and key parts.
+func suggestionID(action string, parts ...string) string {
+	if len(parts) == 0 {
+		return action
+	}
+	sum := sha1.Sum([]byte(strings.Join(parts, "\x00")))
+	return action + "-" + hex.EncodeToString(sum[:6])
+}
@@ -0,0 +1,105 @@
+package proactive
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/plugin/ai/habit"
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/store"
+)
+
+func TestDetectUsualSchedules(t *testing.T) {
+	// Saturday 2026-03-07 10:00
+	now := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+	standup := func(day int) *store.Schedule {
+		start := time.Date(2026, 2, day, 9, 30, 0, 0, time.UTC)
+		end := start.Add(15 * time.Minute).Unix()
+		return &store.Schedule{Title: "Standup", StartTs: start.Unix(), EndTs: &end}
+	}
+	// Mondays Feb 9, 16, 23 and Mar 2
+	schedules := []*store.Schedule{standup(9), standup(16), standup(23), standup(23 + 7)}
+
+	suggestions := DetectUsualSchedules(schedules, now)
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, ActionUsualSchedule, suggestions[0].Action)
+	assert.Equal(t, "Standup", suggestions[0].Payload["title"])
+	next := time.Date(2026, 3, 9, 9, 30, 0, 0, time.UTC)
+	assert.Equal(t, next.Unix(), mustParseInt(t, suggestions[0].Payload["start_ts"]))
+	assert.Equal(t, next.Add(15*time.Minute).Unix(), mustParseInt(t, suggestions[0].Payload["end_ts"]))
+
+	// Already created for next Monday
+	endTs := next.Add(15 * time.Minute).Unix()
+	created := &store.Schedule{Title: "standup", StartTs: next.Unix(), EndTs: &endTs}
+	assert.Empty(t, DetectUsualSchedules(append(schedules, created), now))
+
+	// Only two weeks is not a habit
+	assert.Empty(t, DetectUsualSchedules(schedules[:2], now))
+}
+
+func TestDetectWritingHabits(t *testing.T) {
+	now := time.Date(2026, 3, 7, 21, 30, 0, 0, time.UTC)
+	journal := func(day int) *store.Memo {
+		return &store.Memo{
+			CreatedTs: time.Date(2026, 3, day, 22, 5, 0, 0, time.UTC).Unix(),
+			Payload:   &storepb.MemoPayload{Tags: []string{"journal"}},
+		}
+	}
+	memos := []*store.Memo{journal(2), journal(3), journal(4), journal(6)}
+
+	suggestions := DetectWritingHabits(memos, now)
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, "journal", suggestions[0].Payload["tag"])
+	assert.Contains(t, suggestions[0].Reason, "22:00")
+
+	// Not around the usual hour
+	assert.Empty(t, DetectWritingHabits(memos, now.Add(-5*time.Hour)))
+
+	// Already written today
+	today := &store.Memo{CreatedTs: now.Add(-time.Hour).Unix(), Payload: &storepb.MemoPayload{Tags: []string{"journal"}}}
+	assert.Empty(t, DetectWritingHabits(append(memos, today), now))
+
+	// The hour before a midnight habit is the previous day's 23:00
+	midnight := func(day int) *store.Memo {
+		return &store.Memo{
+			CreatedTs: time.Date(2026, 3, day, 0, 10, 0, 0, time.UTC).Unix(),
+			Payload:   &storepb.MemoPayload{Tags: []string{"dream"}},
+		}
+	}
+	suggestions = DetectWritingHabits([]*store.Memo{midnight(2), midnight(3), midnight(4), midnight(5)}, time.Date(2026, 3, 6, 23, 30, 0, 0, time.UTC))
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, "dream", suggestions[0].Payload["tag"])
+}
+
+func TestRank(t *testing.T) {
+	now := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+	suggestions := []*Suggestion{
+		{ID: "a", Confidence: 0.8},
+		{ID: "b", Confidence: 0.6},
+		{ID: "c", Confidence: 0.7},
+		{ID: "a", Confidence: 0.5},
+	}
+	feedback := map[string]habit.SuggestionFeedback{
+		"a": {Dismissed: 1, LastDismissedTs: now.Add(-time.Hour).Unix()},
+		"b": {Accepted: 3},
+	}
+
+	ranked := Rank(suggestions, feedback, now, 5)
+	require.Len(t, ranked, 2)
+	assert.Equal(t, "b", ranked[0].ID)
+	assert.InDelta(t, 0.96, ranked[0].Confidence, 0.001)
+	assert.Equal(t, "c", ranked[1].ID)
+
+	assert.Len(t, Rank([]*Suggestion{{ID: "x"}, {ID: "y"}}, nil, now, 1), 1)
+}
+
+func mustParseInt(t *testing.T, s string) int64 {
+	t.Helper()
+	parsed, err := strconv.ParseInt(s, 10, 64)
+	require.NoError(t, err)
+	return parsed
+}
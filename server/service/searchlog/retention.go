@@ -0,0 +1,36 @@
+package searchlog
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+// DefaultRetentionInterval is the default interval between deletions of expired search logs.
+const DefaultRetentionInterval = 6 * time.Hour
+
+// RunRetention deletes search logs older than RetentionDays every interval until ctx is done.
+func RunRetention(ctx context.Context, st *store.Store, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultRetentionInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		before := time.Now().AddDate(0, 0, -RetentionDays).Unix()
+		if deleted, err := st.DeleteSearchLogs(ctx, &store.DeleteSearchLog{CreatedTsBefore: &before}); err != nil {
+			slog.Warn("failed to delete expired search logs", "error", err)
+		} else if deleted > 0 {
+			slog.Info("deleted expired search logs", "deleted", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Package searchlog logs the memo searches of users and summarizes them into
+// search insights: top queries, queries that find nothing, slow queries, and
+// memos that search cannot find well because they lack embeddings or tags.
+//
+// Users can disable logging in their AI preferences; logs older than
+// RetentionDays are deleted by RunRetention.
+package searchlog
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/hrygo/divinesense/plugin/ai/memory"
+	"github.com/hrygo/divinesense/store"
+)
+
+const (
+	// RetentionDays is how long search logs are kept.
+	RetentionDays = 90
+	// SlowQueryMs is the average latency from which a query counts as slow.
+	SlowQueryMs = 1000
+
+	// maxQueryRunes limits the query text kept in a log.
+	maxQueryRunes = 500
+	// maxSuggestionScan limits the memos scanned for missing tags.
+	maxSuggestionScan = 1000
+)
+
+// Service records searches and computes search insights.
+type Service struct {
+	store  *store.Store
+	memory *memory.Service
+}
+
+// NewService creates a search log service.
+func NewService(st *store.Store) *Service {
+	return &Service{
+		store:  st,
+		memory: memory.NewService(st, 0),
+	}
+}
+
+// IsDisabled reports whether the user disabled search logging.
+func (s *Service) IsDisabled(ctx context.Context, userID int32) bool {
+	return s.memory.IsSearchLogDisabled(ctx, userID)
+}
+
+// Record saves a search unless the user disabled search logging, and returns
+// the ID of the log, or 0 if the search was not logged. Logging is best-effort:
+// failures are logged and never fail the search.
+func (s *Service) Record(ctx context.Context, log *store.SearchLog) int64 {
+	if log.UserID == 0 || strings.TrimSpace(log.Query) == "" || s.IsDisabled(ctx, log.UserID) {
+		return 0
+	}
+	if runes := []rune(log.Query); len(runes) > maxQueryRunes {
+		log.Query = string(runes[:maxQueryRunes])
+	}
+
+	created, err := s.store.CreateSearchLog(ctx, log)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to record search", "user_id", log.UserID, "error", err)
+		return 0
+	}
+	return created.ID
+}
+
+// QueryStat aggregates the searches of one query.
+type QueryStat struct {
+	Query          string // Most recent spelling of the query
+	Count          int
+	AvgResultCount float64
+	Clicks         int // Searches followed by opening a result
+	AvgLatencyMs   int
+	LastSearchTs   int64
+
+	resultCount int
+	latencyMs   int
+	zeroResults int
+}
+
+// Insights summarizes search logs.
+type Insights struct {
+	Total             int
+	ZeroResult        int // Searches without results
+	Clicks            int // Searches followed by opening a result
+	AvgLatencyMs      int
+	Strategies        map[string]int // Searches by retrieval strategy
+	TopQueries        []*QueryStat   // Most frequent first
+	ZeroResultQueries []*QueryStat   // Queries that never found anything, most frequent first
+	SlowQueries       []*QueryStat   // Queries averaging at least SlowQueryMs, slowest first
+}
+
+// Summarize aggregates search logs, newest first, into insights with at most
+// limit queries per list. Queries are compared case-insensitively, ignoring
+// repeated whitespace.
+func Summarize(logs []*store.SearchLog, limit int) *Insights {
+	insights := &Insights{Strategies: make(map[string]int)}
+	stats := make(map[string]*QueryStat)
+	var queries []*QueryStat
+	var latencyMs int
+	for _, log := range logs {
+		insights.Total++
+		latencyMs += int(log.LatencyMs)
+		if log.ResultCount == 0 {
+			insights.ZeroResult++
+		}
+		if log.ClickedMemoID != nil {
+			insights.Clicks++
+		}
+		if log.Strategy != "" {
+			insights.Strategies[log.Strategy]++
+		}
+
+		key := normalizeQuery(log.Query)
+		stat, ok := stats[key]
+		if !ok {
+			stat = &QueryStat{Query: strings.TrimSpace(log.Query), LastSearchTs: log.CreatedTs}
+			stats[key] = stat
+			queries = append(queries, stat)
+		}
+		stat.Count++
+		stat.resultCount += int(log.ResultCount)
+		stat.latencyMs += int(log.LatencyMs)
+		if log.ResultCount == 0 {
+			stat.zeroResults++
+		}
+		if log.ClickedMemoID != nil {
+			stat.Clicks++
+		}
+	}
+	if insights.Total > 0 {
+		insights.AvgLatencyMs = latencyMs / insights.Total
+	}
+
+	var zeroResult, slow []*QueryStat
+	for _, stat := range queries {
+		stat.AvgResultCount = float64(stat.resultCount) / float64(stat.Count)
+		stat.AvgLatencyMs = stat.latencyMs / stat.Count
+		if stat.zeroResults == stat.Count {
+			zeroResult = append(zeroResult, stat)
+		}
+		if stat.AvgLatencyMs >= SlowQueryMs {
+			slow = append(slow, stat)
+		}
+	}
+
+	// Stable sorts keep the most recent query first among equals.
+	byCount := func(list []*QueryStat) {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Count > list[j].Count })
+	}
+	byCount(queries)
+	byCount(zeroResult)
+	sort.SliceStable(slow, func(i, j int) bool { return slow[i].AvgLatencyMs > slow[j].AvgLatencyMs })
+
+	insights.TopQueries = truncate(queries, limit)
+	insights.ZeroResultQueries = truncate(zeroResult, limit)
+	insights.SlowQueries = truncate(slow, limit)
+	return insights
+}
+
+// Suggestions are memos of a user that search cannot find well.
+type Suggestions struct {
+	WithoutEmbedding []*store.Memo // Missing from semantic search until the embedding runner catches up
+	WithoutTags      []*store.Memo // Not found by tag filters or tag expansion
+}
+
+// SuggestMemos returns up to limit memos of the user, newest first, without
+// an embedding for the model and without tags. Memos without embeddings are
+// skipped when the database does not support embeddings.
+func (s *Service) SuggestMemos(ctx context.Context, userID int32, embeddingModel string, limit int) (*Suggestions, error) {
+	suggestions := &Suggestions{}
+	if embeddingModel != "" {
+		memos, err := s.store.FindMemosWithoutEmbedding(ctx, &store.FindMemosWithoutEmbedding{
+			Model:     embeddingModel,
+			CreatorID: &userID,
+			Limit:     limit,
+		})
+		if err != nil {
+			slog.DebugContext(ctx, "skipping memos without embedding", "user_id", userID, "error", err)
+		} else {
+			suggestions.WithoutEmbedding = memos
+		}
+	}
+
+	rowStatus, scanLimit := store.Normal, maxSuggestionScan
+	memos, err := s.store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:      &userID,
+		RowStatus:      &rowStatus,
+		ExcludeContent: true,
+		Limit:          &scanLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, memo := range memos {
+		if len(suggestions.WithoutTags) >= limit {
+			break
+		}
+		if len(memo.Payload.GetTags()) == 0 {
+			suggestions.WithoutTags = append(suggestions.WithoutTags, memo)
+		}
+	}
+	return suggestions, nil
+}
+
+// normalizeQuery lowercases a query and collapses whitespace.
+func normalizeQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
+
+func truncate(list []*QueryStat, limit int) []*QueryStat {
+	if limit > 0 && len(list) > limit {
+		return list[:limit]
+	}
+	return list
+}
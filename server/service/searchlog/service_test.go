@@ -0,0 +1,49 @@
+package searchlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+func TestSummarize(t *testing.T) {
+	clicked := int32(7)
+	// Newest first, as listed by the store.
+	logs := []*store.SearchLog{
+		{Query: "K8s  Deploy", Strategy: "hybrid_standard", ResultCount: 3, LatencyMs: 200, ClickedMemoID: &clicked, CreatedTs: 300},
+		{Query: "quarterly okr", Strategy: "hybrid_standard", ResultCount: 0, LatencyMs: 1500, CreatedTs: 250},
+		{Query: "k8s deploy", Strategy: "memo_semantic_only", ResultCount: 5, LatencyMs: 400, CreatedTs: 200},
+		{Query: "quarterly OKR", Strategy: "hybrid_standard", ResultCount: 0, LatencyMs: 900, CreatedTs: 150},
+		{Query: "travel", Strategy: "hybrid_standard", ResultCount: 0, LatencyMs: 100, CreatedTs: 100},
+		{Query: "travel", Strategy: "hybrid_standard", ResultCount: 2, LatencyMs: 100, CreatedTs: 50},
+	}
+
+	insights := Summarize(logs, 10)
+	assert.Equal(t, 6, insights.Total)
+	assert.Equal(t, 3, insights.ZeroResult)
+	assert.Equal(t, 1, insights.Clicks)
+	assert.Equal(t, 533, insights.AvgLatencyMs)
+	assert.Equal(t, map[string]int{"hybrid_standard": 5, "memo_semantic_only": 1}, insights.Strategies)
+
+	require.Len(t, insights.TopQueries, 3)
+	top := insights.TopQueries[0]
+	assert.Equal(t, "K8s  Deploy", top.Query, "the most recent spelling is kept")
+	assert.Equal(t, 2, top.Count)
+	assert.Equal(t, 4.0, top.AvgResultCount)
+	assert.Equal(t, 1, top.Clicks)
+	assert.Equal(t, 300, top.AvgLatencyMs)
+	assert.Equal(t, int64(300), top.LastSearchTs)
+
+	// "travel" found something once, so only the OKR query never found anything.
+	require.Len(t, insights.ZeroResultQueries, 1)
+	assert.Equal(t, "quarterly okr", insights.ZeroResultQueries[0].Query)
+
+	require.Len(t, insights.SlowQueries, 1)
+	assert.Equal(t, 1200, insights.SlowQueries[0].AvgLatencyMs)
+
+	assert.Len(t, Summarize(logs, 1).TopQueries, 1)
+	assert.Zero(t, Summarize(nil, 10).AvgLatencyMs)
+}
@@ -0,0 +1,80 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// AICacheEntry is a persisted AI cache entry, e.g. an embedding vector or an LLM response.
+// Entries are shared by all server instances.
+type AICacheEntry struct {
+	Key       string
+	Namespace string // e.g. "embedding:BAAI/bge-m3" or "llm:user:1:tags"
+	Value     []byte
+	Embedding []float32 // Optional, the embedding of the cached prompt for semantic lookups
+	CreatedTs int64
+	ExpiresTs int64 // 0 means the entry does not expire
+}
+
+// FindAICacheEntry specifies the conditions for finding AI cache entries.
+type FindAICacheEntry struct {
+	Keys      []string
+	Namespace *string
+	ValidAt   int64 // Unix timestamp; if set, entries expired at this time are skipped
+	Limit     int   // 0 means no limit; newest entries first
+}
+
+// DeleteAICacheEntry specifies the conditions for deleting AI cache entries.
+// At least one condition is required.
+type DeleteAICacheEntry struct {
+	Namespace       *string
+	NamespacePrefix *string // Deletes entries whose namespace starts with it
+	ExpiredBefore   *int64  // Unix timestamp; deletes entries expired before
+}
+
+// UpsertAICacheEntry inserts or replaces an AI cache entry.
+func (s *Store) UpsertAICacheEntry(ctx context.Context, upsert *AICacheEntry) error {
+	return s.driver.UpsertAICacheEntry(ctx, upsert)
+}
+
+// ListAICacheEntries lists AI cache entries.
+func (s *Store) ListAICacheEntries(ctx context.Context, find *FindAICacheEntry) ([]*AICacheEntry, error) {
+	return s.driver.ListAICacheEntries(ctx, find)
+}
+
+// DeleteAICacheEntries deletes AI cache entries and returns the number of deleted entries.
+func (s *Store) DeleteAICacheEntries(ctx context.Context, delete *DeleteAICacheEntry) (int64, error) {
+	if delete.Namespace == nil && (delete.NamespacePrefix == nil || *delete.NamespacePrefix == "") && delete.ExpiredBefore == nil {
+		return 0, fmt.Errorf("at least one condition is required for deletion")
+	}
+	return s.driver.DeleteAICacheEntries(ctx, delete)
+}
+
+// EncodeVector encodes a vector as little-endian float32 bytes for BLOB storage.
+func EncodeVector(vector []float32) []byte {
+	if len(vector) == 0 {
+		return nil
+	}
+	data := make([]byte, 4*len(vector))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(v))
+	}
+	return data
+}
+
+// DecodeVector decodes a vector encoded by EncodeVector.
+func DecodeVector(data []byte) ([]float32, error) {
+	if len(data)%4 != 0 {
+		return nil, fmt.Errorf("invalid vector length: %d bytes", len(data))
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	vector := make([]float32, len(data)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+	}
+	return vector, nil
+}
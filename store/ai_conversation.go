@@ -64,6 +64,7 @@ type FindAIMessage struct {
 	ID             *int32
 	UID            *string
 	ConversationID *int32
+	Type           *AIMessageType
 }
 
 type DeleteAIMessage struct {
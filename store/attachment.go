@@ -127,7 +127,23 @@ func (s *Store) UpdateAttachment(ctx context.Context, update *UpdateAttachment)
 	if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
-	return s.driver.UpdateAttachment(ctx, update)
+	if err := s.driver.UpdateAttachment(ctx, update); err != nil {
+		return err
+	}
+
+	// Keep the full-text index in sync with the extracted text
+	if update.ExtractedText != nil || update.OCRText != nil {
+		attachment, err := s.GetAttachment(ctx, &FindAttachment{ID: &update.ID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get attachment")
+		}
+		if attachment != nil {
+			if err := s.UpdateAttachmentSearchIndex(ctx, attachment); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func (s *Store) DeleteAttachment(ctx context.Context, delete *DeleteAttachment) error {
@@ -154,5 +170,8 @@ func (s *Store) DeleteAttachment(ctx context.Context, delete *DeleteAttachment)
 		}
 	}
 
-	return s.driver.DeleteAttachment(ctx, delete)
+	if err := s.driver.DeleteAttachment(ctx, delete); err != nil {
+		return err
+	}
+	return s.deleteDocumentEmbeddings(ctx, DocumentTypeAttachment, []int32{delete.ID})
 }
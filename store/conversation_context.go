@@ -0,0 +1,38 @@
+package store
+
+// ConversationContext is the persisted state of a multi-turn agent session.
+type ConversationContext struct {
+	ID          int32
+	SessionID   string
+	UserID      int32
+	AgentType   string
+	ContextData string // JSON: messages, metadata and agent working state
+	CreatedTs   int64
+	UpdatedTs   int64
+}
+
+// FindConversationContext specifies the conditions for finding conversation contexts.
+type FindConversationContext struct {
+	SessionID *string
+	UserID    *int32
+	Limit     int // 0 means no limit
+}
+
+// UpsertConversationContext specifies the data for saving a conversation context.
+// An existing context with the same session ID keeps its user and creation time.
+type UpsertConversationContext struct {
+	SessionID   string
+	UserID      int32
+	AgentType   string
+	ContextData string
+	CreatedTs   int64
+	UpdatedTs   int64
+}
+
+// DeleteConversationContext specifies the conditions for deleting conversation contexts.
+// At least one condition is required.
+type DeleteConversationContext struct {
+	SessionID     *string
+	UserID        *int32
+	UpdatedBefore *int64 // Unix timestamp; deletes contexts not updated since
+}
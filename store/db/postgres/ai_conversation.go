@@ -159,6 +159,9 @@ func (d *DB) ListAIMessages(ctx context.Context, find *store.FindAIMessage) ([]*
 	if find.ConversationID != nil {
 		where, args = append(where, "conversation_id = "+placeholder(len(args)+1)), append(args, *find.ConversationID)
 	}
+	if find.Type != nil {
+		where, args = append(where, "type = "+placeholder(len(args)+1)), append(args, string(*find.Type))
+	}
 
 	query := `SELECT id, uid, conversation_id, type, role, content, metadata, created_ts FROM ai_message WHERE ` + strings.Join(where, " AND ") + ` ORDER BY created_ts ASC`
 	rows, err := d.db.QueryContext(ctx, query, args...)
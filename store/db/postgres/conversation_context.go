@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+func (d *DB) UpsertConversationContext(ctx context.Context, upsert *store.UpsertConversationContext) (*store.ConversationContext, error) {
+	if upsert == nil {
+		return nil, fmt.Errorf("upsert parameter cannot be nil")
+	}
+
+	stmt := `INSERT INTO conversation_context (session_id, user_id, agent_type, context_data, created_ts, updated_ts)
+		VALUES (` + placeholders(6) + `)
+		ON CONFLICT (session_id) DO UPDATE SET
+			agent_type = EXCLUDED.agent_type,
+			context_data = EXCLUDED.context_data,
+			updated_ts = EXCLUDED.updated_ts
+		RETURNING id, session_id, user_id, agent_type, context_data, created_ts, updated_ts`
+
+	result := &store.ConversationContext{}
+	err := d.db.QueryRowContext(ctx, stmt,
+		upsert.SessionID, upsert.UserID, upsert.AgentType, upsert.ContextData, upsert.CreatedTs, upsert.UpdatedTs,
+	).Scan(
+		&result.ID, &result.SessionID, &result.UserID, &result.AgentType,
+		&result.ContextData, &result.CreatedTs, &result.UpdatedTs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert conversation_context: %w", err)
+	}
+
+	return result, nil
+}
+
+func (d *DB) ListConversationContexts(ctx context.Context, find *store.FindConversationContext) ([]*store.ConversationContext, error) {
+	if find == nil {
+		return nil, fmt.Errorf("find parameter cannot be nil")
+	}
+
+	where, args := []string{"1 = 1"}, []any{}
+	if find.SessionID != nil {
+		where, args = append(where, "session_id = "+placeholder(len(args)+1)), append(args, *find.SessionID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+
+	query := `SELECT id, session_id, user_id, agent_type, context_data, created_ts, updated_ts
+		FROM conversation_context
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY updated_ts DESC`
+	if find.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", find.Limit)
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conversation_context: %w", err)
+	}
+	defer rows.Close()
+
+	list := []*store.ConversationContext{}
+	for rows.Next() {
+		item := &store.ConversationContext{}
+		if err := rows.Scan(
+			&item.ID, &item.SessionID, &item.UserID, &item.AgentType,
+			&item.ContextData, &item.CreatedTs, &item.UpdatedTs,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan conversation_context: %w", err)
+		}
+		list = append(list, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteConversationContexts(ctx context.Context, delete *store.DeleteConversationContext) (int64, error) {
+	if delete == nil {
+		return 0, fmt.Errorf("delete parameter cannot be nil")
+	}
+
+	where, args := []string{}, []any{}
+	if delete.SessionID != nil {
+		where, args = append(where, "session_id = "+placeholder(len(args)+1)), append(args, *delete.SessionID)
+	}
+	if delete.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *delete.UserID)
+	}
+	if delete.UpdatedBefore != nil {
+		where, args = append(where, "updated_ts < "+placeholder(len(args)+1)), append(args, *delete.UpdatedBefore)
+	}
+	if len(where) == 0 {
+		return 0, fmt.Errorf("at least one condition is required for deletion")
+	}
+
+	result, err := d.db.ExecContext(ctx, `DELETE FROM conversation_context WHERE `+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete conversation_context: %w", err)
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,326 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pgvector/pgvector-go"
+	"github.com/pkg/errors"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+// UpsertDocumentEmbedding inserts or updates a document embedding.
+func (d *DB) UpsertDocumentEmbedding(ctx context.Context, embedding *store.DocumentEmbedding) (*store.DocumentEmbedding, error) {
+	tags, err := json.Marshal(normalizeTags(embedding.Tags))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal tags")
+	}
+	visibility := embedding.Visibility
+	if visibility == "" {
+		visibility = store.Private
+	}
+
+	stmt := `
+		INSERT INTO document_embedding (doc_type, doc_id, user_id, visibility, tags, content, embedding, model, doc_created_ts, created_ts, updated_ts)
+		VALUES (` + placeholders(11) + `)
+		ON CONFLICT (doc_type, doc_id, model)
+		DO UPDATE SET
+			user_id = EXCLUDED.user_id,
+			visibility = EXCLUDED.visibility,
+			tags = EXCLUDED.tags,
+			content = EXCLUDED.content,
+			embedding = EXCLUDED.embedding,
+			doc_created_ts = EXCLUDED.doc_created_ts,
+			updated_ts = EXCLUDED.updated_ts
+		RETURNING id, created_ts, updated_ts
+	`
+
+	err = d.db.QueryRowContext(ctx, stmt,
+		embedding.DocType,
+		embedding.DocID,
+		embedding.UserID,
+		visibility,
+		string(tags),
+		embedding.Content,
+		pgvector.NewVector(embedding.Embedding),
+		embedding.Model,
+		embedding.DocCreatedTs,
+		embedding.CreatedTs,
+		embedding.UpdatedTs,
+	).Scan(&embedding.ID, &embedding.CreatedTs, &embedding.UpdatedTs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to upsert document embedding")
+	}
+
+	embedding.Visibility = visibility
+	return embedding, nil
+}
+
+// ListDocumentEmbeddings lists document embeddings.
+func (d *DB) ListDocumentEmbeddings(ctx context.Context, find *store.FindDocumentEmbedding) ([]*store.DocumentEmbedding, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.DocType != nil {
+		where, args = append(where, "doc_type = "+placeholder(len(args)+1)), append(args, *find.DocType)
+	}
+	if find.DocID != nil {
+		where, args = append(where, "doc_id = "+placeholder(len(args)+1)), append(args, *find.DocID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+	if find.Model != nil {
+		where, args = append(where, "model = "+placeholder(len(args)+1)), append(args, *find.Model)
+	}
+
+	query := `
+		SELECT id, doc_type, doc_id, user_id, visibility, tags, content, embedding, model, doc_created_ts, created_ts, updated_ts
+		FROM document_embedding
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC
+	`
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list document embeddings")
+	}
+	defer rows.Close()
+
+	list := []*store.DocumentEmbedding{}
+	for rows.Next() {
+		var embedding store.DocumentEmbedding
+		var tags []byte
+		var vector pgvector.Vector
+		if err := rows.Scan(
+			&embedding.ID,
+			&embedding.DocType,
+			&embedding.DocID,
+			&embedding.UserID,
+			&embedding.Visibility,
+			&tags,
+			&embedding.Content,
+			&vector,
+			&embedding.Model,
+			&embedding.DocCreatedTs,
+			&embedding.CreatedTs,
+			&embedding.UpdatedTs,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan document embedding")
+		}
+		if err := json.Unmarshal(tags, &embedding.Tags); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal tags")
+		}
+		embedding.Embedding = vector.Slice()
+		list = append(list, &embedding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// DeleteDocumentEmbeddings deletes document embeddings.
+func (d *DB) DeleteDocumentEmbeddings(ctx context.Context, delete *store.DeleteDocumentEmbedding) error {
+	where, args := []string{"doc_type = " + placeholder(1)}, []any{delete.DocType}
+	if delete.DocID != nil {
+		where, args = append(where, "doc_id = "+placeholder(len(args)+1)), append(args, *delete.DocID)
+	}
+	if len(delete.DocIDs) > 0 {
+		holders := make([]string, 0, len(delete.DocIDs))
+		for _, docID := range delete.DocIDs {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, docID)
+		}
+		where = append(where, "doc_id IN ("+strings.Join(holders, ", ")+")")
+	}
+	if delete.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *delete.UserID)
+	}
+
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM document_embedding WHERE `+strings.Join(where, " AND "), args...); err != nil {
+		return errors.Wrap(err, "failed to delete document embeddings")
+	}
+	return nil
+}
+
+// documentSources selects the embeddable documents of each doc type as
+// doc_id, user_id, visibility, tags, content, created_ts and updated_ts.
+var documentSources = map[string]string{
+	// Schedules are private to their creator
+	store.DocumentTypeSchedule: `
+		SELECT id AS doc_id, creator_id AS user_id, 'PRIVATE' AS visibility, '[]'::jsonb AS tags,
+			concat_ws(E'\n', title, NULLIF(description, ''), NULLIF(location, '')) AS content,
+			created_ts, updated_ts
+		FROM schedule
+		WHERE row_status = 'NORMAL'`,
+	// Attachments with extracted text share the visibility and tags of their memo
+	store.DocumentTypeAttachment: `
+		SELECT a.id AS doc_id, a.creator_id AS user_id, COALESCE(m.visibility, 'PRIVATE') AS visibility,
+			COALESCE(m.payload->'tags', '[]'::jsonb) AS tags,
+			concat_ws(E'\n', a.filename, COALESCE(NULLIF(a.ocr_text, ''), a.extracted_text)) AS content,
+			a.created_ts, a.updated_ts
+		FROM attachment a
+		LEFT JOIN memo m ON m.id = a.memo_id
+		WHERE a.row_status = 'NORMAL'
+			AND (COALESCE(a.ocr_text, '') <> '' OR COALESCE(a.extracted_text, '') <> '')`,
+	// Messages never change, so their creation time doubles as update time
+	store.DocumentTypeMessage: `
+		SELECT am.id AS doc_id, c.creator_id AS user_id, 'PRIVATE' AS visibility, '[]'::jsonb AS tags,
+			am.content, am.created_ts, am.created_ts AS updated_ts
+		FROM ai_message am
+		JOIN ai_conversation c ON c.id = am.conversation_id
+		WHERE am.type = 'MESSAGE'
+			AND am.role IN ('USER', 'ASSISTANT')
+			AND am.content <> ''
+			AND c.row_status = 'NORMAL'`,
+}
+
+// FindDocumentsWithoutEmbedding finds documents whose embedding for the model is missing or older than the document.
+func (d *DB) FindDocumentsWithoutEmbedding(ctx context.Context, find *store.FindDocumentsWithoutEmbedding) ([]*store.DocumentEmbedding, error) {
+	source, ok := documentSources[find.DocType]
+	if !ok {
+		return nil, errors.Errorf("unknown document type: %s", find.DocType)
+	}
+	limit := find.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `
+		SELECT d.doc_id, d.user_id, d.visibility, d.tags, d.content, d.created_ts, d.updated_ts
+		FROM (` + source + `) d
+		LEFT JOIN document_embedding e
+			ON e.doc_type = ` + placeholder(1) + ` AND e.doc_id = d.doc_id AND e.model = ` + placeholder(2) + `
+		WHERE e.id IS NULL OR e.updated_ts < d.updated_ts
+		ORDER BY d.created_ts DESC
+		LIMIT ` + placeholder(3)
+
+	rows, err := d.db.QueryContext(ctx, query, find.DocType, find.Model, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find documents without embedding")
+	}
+	defer rows.Close()
+
+	list := []*store.DocumentEmbedding{}
+	for rows.Next() {
+		document := &store.DocumentEmbedding{DocType: find.DocType, Model: find.Model}
+		var tags []byte
+		if err := rows.Scan(
+			&document.DocID,
+			&document.UserID,
+			&document.Visibility,
+			&tags,
+			&document.Content,
+			&document.DocCreatedTs,
+			&document.UpdatedTs,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan document")
+		}
+		if err := json.Unmarshal(tags, &document.Tags); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal tags")
+		}
+		list = append(list, document)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// SearchDocumentEmbeddings performs vector similarity search over document embeddings using pgvector.
+func (d *DB) SearchDocumentEmbeddings(ctx context.Context, opts *store.SearchDocumentEmbeddingOptions) ([]*store.DocumentEmbeddingWithScore, error) {
+	// The <=> operator computes cosine distance (1 - cosine_similarity)
+	where, args := []string{"1 = 1"}, []any{pgvector.NewVector(opts.Vector)}
+	if opts.Model != "" {
+		where, args = append(where, "model = "+placeholder(len(args)+1)), append(args, opts.Model)
+	}
+	if opts.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *opts.UserID)
+	}
+	if len(opts.DocTypes) > 0 {
+		holders := make([]string, 0, len(opts.DocTypes))
+		for _, docType := range opts.DocTypes {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, docType)
+		}
+		where = append(where, "doc_type IN ("+strings.Join(holders, ", ")+")")
+	}
+	if opts.CreatedAfter != nil {
+		where, args = append(where, "doc_created_ts >= "+placeholder(len(args)+1)), append(args, *opts.CreatedAfter)
+	}
+	if opts.CreatedBefore != nil {
+		where, args = append(where, "doc_created_ts < "+placeholder(len(args)+1)), append(args, *opts.CreatedBefore)
+	}
+	if len(opts.Visibilities) > 0 {
+		holders := make([]string, 0, len(opts.Visibilities))
+		for _, visibility := range opts.Visibilities {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, visibility)
+		}
+		where = append(where, "visibility IN ("+strings.Join(holders, ", ")+")")
+	}
+	if len(opts.Tags) > 0 {
+		conditions := make([]string, 0, len(opts.Tags))
+		for _, tag := range opts.Tags {
+			conditions, args = append(conditions, "tags ? "+placeholder(len(args)+1)), append(args, tag)
+		}
+		where = append(where, "("+strings.Join(conditions, " OR ")+")")
+	}
+	args = append(args, opts.Limit)
+
+	query := `
+		SELECT id, doc_type, doc_id, user_id, visibility, tags, content, model, doc_created_ts, created_ts, updated_ts,
+			1 - (embedding <=> ` + placeholder(1) + `) AS score
+		FROM document_embedding
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY embedding <=> ` + placeholder(1) + `
+		LIMIT ` + placeholder(len(args))
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to search document embeddings")
+	}
+	defer rows.Close()
+
+	results := []*store.DocumentEmbeddingWithScore{}
+	for rows.Next() {
+		result := &store.DocumentEmbeddingWithScore{DocumentEmbedding: &store.DocumentEmbedding{}}
+		var tags []byte
+		if err := rows.Scan(
+			&result.ID,
+			&result.DocType,
+			&result.DocID,
+			&result.UserID,
+			&result.Visibility,
+			&tags,
+			&result.Content,
+			&result.Model,
+			&result.DocCreatedTs,
+			&result.CreatedTs,
+			&result.UpdatedTs,
+			&result.Score,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan document search result")
+		}
+		if err := json.Unmarshal(tags, &result.Tags); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal tags")
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// normalizeTags returns a non-nil tag list so it is stored as a JSON array.
+func normalizeTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
@@ -6,11 +6,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pgvector/pgvector-go"
+
 	"github.com/hrygo/divinesense/store"
 )
 
 func (d *DB) CreateEpisodicMemory(ctx context.Context, create *store.EpisodicMemory) (*store.EpisodicMemory, error) {
-	fields := []string{"user_id", "timestamp", "agent_type", "user_input", "outcome", "summary", "importance", "created_ts"}
+	fields := []string{"user_id", "timestamp", "agent_type", "user_input", "outcome", "summary", "importance", "created_ts", "embedding", "embedding_model"}
 
 	if create.Timestamp.IsZero() {
 		create.Timestamp = time.Now()
@@ -28,6 +30,12 @@ func (d *DB) CreateEpisodicMemory(ctx context.Context, create *store.EpisodicMem
 		create.Summary,
 		create.Importance,
 		create.CreatedTs,
+		nil,
+		nil,
+	}
+	if len(create.Embedding) > 0 {
+		args[8] = pgvector.NewVector(create.Embedding)
+		args[9] = create.EmbeddingModel
 	}
 
 	stmt := `INSERT INTO episodic_memory (` + strings.Join(fields, ", ") + `)
@@ -63,6 +71,9 @@ func (d *DB) ListEpisodicMemories(ctx context.Context, find *store.FindEpisodicM
 		where = append(where, "(user_input ILIKE "+placeholder(len(args)+1)+" OR summary ILIKE "+placeholder(len(args)+2)+")")
 		args = append(args, searchPattern, searchPattern)
 	}
+	if find.SummaryPrefix != nil {
+		where, args = append(where, "starts_with(summary, "+placeholder(len(args)+1)+")"), append(args, *find.SummaryPrefix)
+	}
 
 	query := `SELECT id, user_id, timestamp, agent_type, user_input, outcome, summary, importance, created_ts 
 		FROM episodic_memory WHERE ` + strings.Join(where, " AND ") + ` ORDER BY timestamp DESC`
@@ -111,6 +122,66 @@ func (d *DB) ListEpisodicMemories(ctx context.Context, find *store.FindEpisodicM
 	return list, nil
 }
 
+func (d *DB) SearchEpisodicMemories(ctx context.Context, search *store.SearchEpisodicMemory) ([]*store.EpisodicMemoryWithScore, error) {
+	if search == nil || len(search.Vector) == 0 {
+		return nil, fmt.Errorf("search vector cannot be empty")
+	}
+
+	limit := search.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	// The <=> operator computes cosine distance (1 - cosine_similarity)
+	vector := pgvector.NewVector(search.Vector)
+	where, args := []string{"user_id = " + placeholder(2), "embedding IS NOT NULL"}, []any{vector, search.UserID}
+	if search.Model != "" {
+		where, args = append(where, "embedding_model = "+placeholder(len(args)+1)), append(args, search.Model)
+	}
+	args = append(args, limit)
+
+	query := `SELECT id, user_id, timestamp, agent_type, user_input, outcome, summary, importance, created_ts,
+			1 - (embedding <=> ` + placeholder(1) + `) AS similarity
+		FROM episodic_memory WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY embedding <=> ` + placeholder(1) + `
+		LIMIT ` + placeholder(len(args))
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search episodic_memories: %w", err)
+	}
+	defer rows.Close()
+
+	list := make([]*store.EpisodicMemoryWithScore, 0)
+	for rows.Next() {
+		m := &store.EpisodicMemoryWithScore{EpisodicMemory: &store.EpisodicMemory{}}
+		if err := rows.Scan(
+			&m.ID,
+			&m.UserID,
+			&m.Timestamp,
+			&m.AgentType,
+			&m.UserInput,
+			&m.Outcome,
+			&m.Summary,
+			&m.Importance,
+			&m.CreatedTs,
+			&m.Similarity,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan episodic_memory: %w", err)
+		}
+		list = append(list, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate episodic_memories: %w", err)
+	}
+
+	return list, nil
+}
+
 func (d *DB) ListActiveUserIDs(ctx context.Context, cutoff time.Time) ([]int32, error) {
 	query := `SELECT DISTINCT user_id FROM episodic_memory WHERE timestamp > $1`
 
@@ -35,7 +35,12 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		args = append(args, create.UpdatedTs)
 	}
 
-	stmt := "INSERT INTO memo (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts, row_status"
+	// Index the segmented content for full-text search
+	values := placeholders(len(args)) + ", to_tsvector('simple', " + placeholder(len(args)+1) + ")"
+	fields = append(fields, "search_vector")
+	args = append(args, store.SearchIndexText(create.Content, nil))
+
+	stmt := "INSERT INTO memo (" + strings.Join(fields, ", ") + ") VALUES (" + values + ") RETURNING id, created_ts, updated_ts, row_status"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
@@ -48,15 +53,17 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 	return create, nil
 }
 
-func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
+// memoConditions returns the WHERE conditions of find. They reference memo and,
+// for excluding comments, the joined memo_relation.
+func memoConditions(ctx context.Context, find *store.FindMemo) ([]string, []any, error) {
 	where, args := []string{"1 = 1"}, []any{}
 
 	engine, err := filter.DefaultEngine()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if err := filter.AppendConditions(ctx, engine, find.Filters, filter.DialectPostgres, &where, &args); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if v := find.ID; v != nil {
 		where, args = append(where, "memo.id = "+placeholder(len(args)+1)), append(args, *v)
@@ -98,6 +105,15 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		where = append(where, "memo_relation.related_memo_id IS NULL")
 	}
 
+	return where, args, nil
+}
+
+func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
+	where, args, err := memoConditions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
 	order := "DESC"
 	if find.OrderByTimeAsc {
 		order = "ASC"
@@ -225,6 +241,7 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	}
 	if v := update.Content; v != nil {
 		set, args = append(set, "content = "+placeholder(len(args)+1)), append(args, *v)
+		set, args = append(set, "search_vector = to_tsvector('simple', "+placeholder(len(args)+1)+")"), append(args, store.SearchIndexText(*v, nil))
 	}
 	if v := update.Visibility; v != nil {
 		set, args = append(set, "visibility = "+placeholder(len(args)+1)), append(args, *v)
@@ -8,6 +8,7 @@ import (
 	"github.com/pgvector/pgvector-go"
 	"github.com/pkg/errors"
 
+	"github.com/hrygo/divinesense/plugin/filter"
 	storepb "github.com/hrygo/divinesense/proto/gen/store"
 	"github.com/hrygo/divinesense/store"
 )
@@ -48,6 +49,14 @@ func (d *DB) ListMemoEmbeddings(ctx context.Context, find *store.FindMemoEmbeddi
 	if find.MemoID != nil {
 		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
 	}
+	if len(find.MemoIDList) > 0 {
+		holders := make([]string, 0, len(find.MemoIDList))
+		for _, id := range find.MemoIDList {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, "memo_id IN ("+strings.Join(holders, ", ")+")")
+	}
 	if find.Model != nil {
 		where, args = append(where, "model = "+placeholder(len(args)+1)), append(args, *find.Model)
 	}
@@ -116,33 +125,64 @@ func (d *DB) VectorSearch(ctx context.Context, opts *store.VectorSearchOptions)
 		limit = 10
 	}
 
+	// Use default model if not specified
+	model := opts.Model
+	if model == "" {
+		model = "BAAI/bge-m3"
+	}
+
+	vector := pgvector.NewVector(opts.Vector)
+	where, args := []string{
+		"memo.creator_id = " + placeholder(2),
+		"memo.row_status = 'NORMAL'",
+		"e.model = " + placeholder(3),
+	}, []any{vector, opts.UserID, model}
+	if opts.CreatedAfter != nil {
+		where, args = append(where, "memo.created_ts >= "+placeholder(len(args)+1)), append(args, *opts.CreatedAfter)
+	}
+	if opts.CreatedBefore != nil {
+		where, args = append(where, "memo.created_ts < "+placeholder(len(args)+1)), append(args, *opts.CreatedBefore)
+	}
+	if len(opts.Visibilities) > 0 {
+		holders := make([]string, 0, len(opts.Visibilities))
+		for _, visibility := range opts.Visibilities {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, visibility)
+		}
+		where = append(where, "memo.visibility IN ("+strings.Join(holders, ", ")+")")
+	}
+	if len(opts.Tags) > 0 {
+		conditions := make([]string, 0, len(opts.Tags))
+		for _, tag := range opts.Tags {
+			conditions, args = append(conditions, "memo.payload->'tags' ? "+placeholder(len(args)+1)), append(args, tag)
+		}
+		where = append(where, "("+strings.Join(conditions, " OR ")+")")
+	}
+	if len(opts.Filters) > 0 {
+		engine, err := filter.DefaultEngine()
+		if err != nil {
+			return nil, err
+		}
+		if err := filter.AppendConditions(ctx, engine, opts.Filters, filter.DialectPostgres, &where, &args); err != nil {
+			return nil, errors.Wrap(err, "invalid memo filter")
+		}
+	}
+	args = append(args, limit)
+
 	// Use cosine similarity with pgvector
 	// The <=> operator computes cosine distance (1 - cosine_similarity)
 	// So we order by distance ASC to get most similar first
 	query := `
 		SELECT
-			m.id, m.uid, m.creator_id, m.created_ts, m.updated_ts, m.row_status,
-			m.visibility, m.pinned, m.content, m.payload,
+			memo.id, memo.uid, memo.creator_id, memo.created_ts, memo.updated_ts, memo.row_status,
+			memo.visibility, memo.pinned, memo.content, memo.payload,
 			1 - (e.embedding <=> ` + placeholder(1) + `) AS score
-		FROM memo m
-		INNER JOIN memo_embedding e ON m.id = e.memo_id
-		WHERE m.creator_id = ` + placeholder(2) + `
-			AND m.row_status = 'NORMAL'
-			AND e.model = ` + placeholder(3) + `
-		ORDER BY e.embedding <=> ` + placeholder(4) + `
-		LIMIT ` + placeholder(5)
-
-	// Use default model if not specified
-	model := "BAAI/bge-m3"
+		FROM memo
+		INNER JOIN memo_embedding e ON memo.id = e.memo_id
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY e.embedding <=> ` + placeholder(1) + `
+		LIMIT ` + placeholder(len(args))
 
-	vector := pgvector.NewVector(opts.Vector)
-	rows, err := d.db.QueryContext(ctx, query,
-		vector,
-		opts.UserID,
-		model,
-		vector,
-		limit,
-	)
+	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to vector search")
 	}
@@ -198,6 +238,14 @@ func (d *DB) FindMemosWithoutEmbedding(ctx context.Context, find *store.FindMemo
 		limit = 100
 	}
 
+	args := []any{find.Model}
+	creatorCondition := ""
+	if find.CreatorID != nil {
+		args = append(args, *find.CreatorID)
+		creatorCondition = "AND m.creator_id = " + placeholder(len(args))
+	}
+	args = append(args, limit)
+
 	query := `
 		SELECT
 			m.id, m.uid, m.creator_id, m.created_ts, m.updated_ts, m.row_status,
@@ -207,10 +255,11 @@ func (d *DB) FindMemosWithoutEmbedding(ctx context.Context, find *store.FindMemo
 		WHERE e.id IS NULL
 			AND m.row_status = 'NORMAL'
 			AND LENGTH(m.content) > 0
+			` + creatorCondition + `
 		ORDER BY m.created_ts DESC
-		LIMIT ` + placeholder(2)
+		LIMIT ` + placeholder(len(args))
 
-	rows, err := d.db.QueryContext(ctx, query, find.Model, limit)
+	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to find memos without embedding")
 	}
@@ -257,28 +306,53 @@ func (d *DB) FindMemosWithoutEmbedding(ctx context.Context, find *store.FindMemo
 }
 
 // BM25Search performs full-text search using PostgreSQL's ts_vector with BM25 ranking.
-// Uses the 'simple' text search configuration for better multilingual support.
+// Memo content is segmented into words on save and indexed in the GIN-indexed search_vector
+// column, so the 'simple' configuration only needs to lowercase tokens.
 func (d *DB) BM25Search(ctx context.Context, opts *store.BM25SearchOptions) ([]*store.BM25Result, error) {
 	limit := opts.Limit
 	if limit <= 0 {
 		limit = 10
 	}
 
+	// The query goes through the same segmenter as the indexed content
+	queryText := store.SearchQueryText(opts.Query, opts.Words)
+	if queryText == "" {
+		return []*store.BM25Result{}, nil
+	}
+
+	// A memo also matches through the extracted text of its attachments,
+	// scored by its best matching attachment.
+	attachmentMatches := "SELECT attachment.memo_id FROM attachment WHERE attachment.search_vector @@ plainto_tsquery('simple', " + placeholder(1) + ")"
+	attachmentScore := "(SELECT MAX(ts_rank(attachment.search_vector, plainto_tsquery('simple', " + placeholder(1) + "))) FROM attachment WHERE attachment.memo_id = memo.id)"
+
+	where, args := []string{
+		"memo.creator_id = " + placeholder(2),
+		"memo.row_status = 'NORMAL'",
+		"(memo.search_vector @@ plainto_tsquery('simple', " + placeholder(1) + ") OR memo.id IN (" + attachmentMatches + "))",
+	}, []any{queryText, opts.UserID}
+	if len(opts.Filters) > 0 {
+		engine, err := filter.DefaultEngine()
+		if err != nil {
+			return nil, err
+		}
+		if err := filter.AppendConditions(ctx, engine, opts.Filters, filter.DialectPostgres, &where, &args); err != nil {
+			return nil, errors.Wrap(err, "invalid memo filter")
+		}
+	}
+	args = append(args, limit)
+
 	// Use PostgreSQL's full-text search with ts_rank for BM25-like ranking
-	// The 'simple' configuration works well for Chinese and English mixed content
 	query := `
 		SELECT
-			m.id, m.uid, m.creator_id, m.created_ts, m.updated_ts, m.row_status,
-			m.visibility, m.pinned, m.content, m.payload,
-			ts_rank(to_tsvector('simple', COALESCE(m.content, '')), plainto_tsquery('simple', ` + placeholder(1) + `)) AS score
-		FROM memo m
-		WHERE m.creator_id = ` + placeholder(2) + `
-			AND m.row_status = 'NORMAL'
-			AND to_tsvector('simple', COALESCE(m.content, '')) @@ plainto_tsquery('simple', ` + placeholder(3) + `)
-		ORDER BY score DESC, m.updated_ts DESC
-		LIMIT ` + placeholder(4)
+			memo.id, memo.uid, memo.creator_id, memo.created_ts, memo.updated_ts, memo.row_status,
+			memo.visibility, memo.pinned, memo.content, memo.payload,
+			GREATEST(ts_rank(memo.search_vector, plainto_tsquery('simple', ` + placeholder(1) + `)), ` + attachmentScore + `) AS score
+		FROM memo
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY score DESC, memo.updated_ts DESC
+		LIMIT ` + placeholder(len(args))
 
-	rows, err := d.db.QueryContext(ctx, query, opts.Query, opts.UserID, opts.Query, limit)
+	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to BM25 search")
 	}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+func (d *DB) GetMemoFacets(ctx context.Context, find *store.FindMemoFacets) (*store.MemoFacets, error) {
+	where, args, err := memoConditions(ctx, &find.FindMemo)
+	if err != nil {
+		return nil, err
+	}
+	if find.TextQuery != "" {
+		queryText := store.SearchQueryText(find.TextQuery, find.Words)
+		if queryText == "" {
+			return &store.MemoFacets{}, nil
+		}
+		args = append(args, queryText)
+		tsQuery := "plainto_tsquery('simple', " + placeholder(len(args)) + ")"
+		where = append(where, "(memo.search_vector @@ "+tsQuery+
+			" OR memo.id IN (SELECT attachment.memo_id FROM attachment WHERE attachment.search_vector @@ "+tsQuery+"))")
+	}
+
+	// All facets are counted over the same result set in one round trip.
+	args = append(args, find.TimezoneOffset)
+	query := `
+		WITH result AS (
+			SELECT memo.id, memo.creator_id, memo.created_ts, memo.visibility, memo.payload
+			FROM memo
+			LEFT JOIN memo_relation ON memo.id = memo_relation.memo_id AND memo_relation.type = 'COMMENT'
+			WHERE ` + strings.Join(where, " AND ") + `
+		)
+		SELECT 'total', '', COUNT(*) FROM result
+		UNION ALL
+		SELECT 'tag', tag, COUNT(DISTINCT result.id)
+		FROM result, jsonb_array_elements_text(COALESCE(result.payload->'tags', '[]'::jsonb)) AS tag
+		GROUP BY tag
+		UNION ALL
+		SELECT 'creator', result.creator_id::text, COUNT(*) FROM result GROUP BY result.creator_id
+		UNION ALL
+		SELECT 'month', to_char(to_timestamp(result.created_ts + ` + placeholder(len(args)) + `) AT TIME ZONE 'UTC', 'YYYY-MM'), COUNT(*)
+		FROM result GROUP BY 2
+		UNION ALL
+		SELECT 'visibility', result.visibility, COUNT(*) FROM result GROUP BY result.visibility
+		UNION ALL
+		SELECT 'has_attachment', '', COUNT(*) FROM result
+		WHERE EXISTS (SELECT 1 FROM attachment WHERE attachment.memo_id = result.id)
+		UNION ALL
+		SELECT 'has_task_list', '', COUNT(*) FROM result
+		WHERE (result.payload->'property'->>'hasTaskList')::boolean IS TRUE`
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute memo facets")
+	}
+	defer rows.Close()
+
+	facets := &store.MemoFacets{}
+	for rows.Next() {
+		var facet, value string
+		var count int
+		if err := rows.Scan(&facet, &value, &count); err != nil {
+			return nil, err
+		}
+		facets.AddFacetCount(facet, value, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return facets, nil
+}
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/store"
+)
+
+// testDSNEnv names the DSN of a disposable PostgreSQL database with the migrations
+// in store/migration/postgres applied. Tests that need it are skipped without it.
+const testDSNEnv = "DIVINESENSE_TEST_POSTGRES_DSN"
+
+func TestGetMemoFacets(t *testing.T) {
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+	ctx := context.Background()
+	p := &profile.Profile{Mode: "prod", Driver: "postgres", DSN: dsn}
+	driver, err := NewDB(p)
+	require.NoError(t, err)
+	st := store.New(driver, p)
+	defer st.Close()
+
+	// The database may hold other rows, so names are unique and every query is limited to the test users.
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano()%1_000_000_000)
+	createUser := func(name string) *store.User {
+		user, err := st.CreateUser(ctx, &store.User{Username: name + suffix, Role: store.RoleUser, PasswordHash: "x"})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = st.DeleteUser(ctx, &store.DeleteUser{ID: user.ID}) })
+		return user
+	}
+	alice, bob := createUser("alice"), createUser("bob")
+
+	createMemo := func(uid string, creatorID int32, visibility store.Visibility, created string, content string, payload *storepb.MemoPayload) *store.Memo {
+		createdTime, err := time.Parse(time.DateOnly, created)
+		require.NoError(t, err)
+		memo, err := st.CreateMemo(ctx, &store.Memo{
+			UID:        uid + "-" + suffix,
+			CreatorID:  creatorID,
+			Content:    content,
+			Visibility: visibility,
+			Payload:    payload,
+			CreatedTs:  createdTime.Unix(),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = st.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}) })
+		return memo
+	}
+	createMemo("a1", alice.ID, store.Private, "2026-01-05", "开项目周会 #work #周会",
+		&storepb.MemoPayload{Tags: []string{"work", "周会"}, Property: &storepb.MemoPayload_Property{HasTaskList: true}})
+	a2 := createMemo("a2", alice.ID, store.Public, "2026-02-10", "发布计划 #work", &storepb.MemoPayload{Tags: []string{"work"}})
+	createMemo("a3", alice.ID, store.Protected, "2026-02-20", "随手记", nil)
+	createMemo("b1", bob.ID, store.Public, "2026-02-11", "项目周会纪要 #work", &storepb.MemoPayload{Tags: []string{"work"}})
+	createMemo("b2", bob.ID, store.Private, "2026-03-01", "去杭州 #travel", &storepb.MemoPayload{Tags: []string{"travel"}})
+	attachment, err := st.CreateAttachment(ctx, &store.Attachment{UID: "att-" + suffix, CreatorID: alice.ID, Filename: "plan.pdf", MemoID: &a2.ID})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = st.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID}) })
+
+	testUsers := fmt.Sprintf("creator_id in [%d, %d]", alice.ID, bob.ID)
+
+	t.Run("creator", func(t *testing.T) {
+		facets, err := st.GetMemoFacets(ctx, &store.FindMemoFacets{FindMemo: store.FindMemo{CreatorID: &alice.ID}})
+		require.NoError(t, err)
+		assert.Equal(t, 3, facets.Total)
+		assert.Equal(t, []*store.FacetCount{{Value: "work", Count: 2}, {Value: "周会", Count: 1}}, facets.Tags)
+		assert.Equal(t, []*store.CreatorFacetCount{{CreatorID: alice.ID, Count: 3}}, facets.Creators)
+		assert.Equal(t, []*store.FacetCount{{Value: "2026-01", Count: 1}, {Value: "2026-02", Count: 2}}, facets.Months)
+		assert.Equal(t, []*store.FacetCount{{Value: "PRIVATE", Count: 1}, {Value: "PROTECTED", Count: 1}, {Value: "PUBLIC", Count: 1}}, facets.Visibilities)
+		assert.Equal(t, 1, facets.HasAttachment)
+		assert.Equal(t, 1, facets.HasTaskList)
+	})
+
+	t.Run("visibility", func(t *testing.T) {
+		facets, err := st.GetMemoFacets(ctx, &store.FindMemoFacets{FindMemo: store.FindMemo{
+			VisibilityList: []store.Visibility{store.Public},
+			Filters:        []string{testUsers},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, 2, facets.Total)
+		assert.Equal(t, []*store.FacetCount{{Value: "work", Count: 2}}, facets.Tags)
+		assert.Equal(t, []*store.CreatorFacetCount{{CreatorID: alice.ID, Count: 1}, {CreatorID: bob.ID, Count: 1}}, facets.Creators)
+		assert.Equal(t, []*store.FacetCount{{Value: "2026-02", Count: 2}}, facets.Months)
+		assert.Equal(t, []*store.FacetCount{{Value: "PUBLIC", Count: 2}}, facets.Visibilities)
+		assert.Equal(t, 1, facets.HasAttachment)
+		assert.Equal(t, 0, facets.HasTaskList)
+	})
+
+	t.Run("timezone", func(t *testing.T) {
+		// b2 is created at midnight UTC on March 1, which is still February west of UTC
+		facets, err := st.GetMemoFacets(ctx, &store.FindMemoFacets{
+			FindMemo:       store.FindMemo{CreatorID: &bob.ID},
+			TimezoneOffset: -5 * 60 * 60,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*store.FacetCount{{Value: "2026-02", Count: 2}}, facets.Months)
+
+		facets, err = st.GetMemoFacets(ctx, &store.FindMemoFacets{
+			FindMemo:       store.FindMemo{CreatorID: &bob.ID},
+			TimezoneOffset: 8 * 60 * 60,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*store.FacetCount{{Value: "2026-02", Count: 1}, {Value: "2026-03", Count: 1}}, facets.Months)
+	})
+
+	t.Run("text query", func(t *testing.T) {
+		facets, err := st.GetMemoFacets(ctx, &store.FindMemoFacets{
+			FindMemo:  store.FindMemo{Filters: []string{testUsers}},
+			TextQuery: "周会",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, facets.Total)
+		assert.Equal(t, []*store.CreatorFacetCount{{CreatorID: alice.ID, Count: 1}, {CreatorID: bob.ID, Count: 1}}, facets.Creators)
+	})
+}
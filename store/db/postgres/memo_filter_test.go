@@ -116,6 +116,21 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "jsonb_array_length(COALESCE(memo.payload->'tags', '[]'::jsonb)) = $1",
 			args:   []any{int64(2)},
 		},
+		{
+			filter: `has_attachment`,
+			want:   "EXISTS (SELECT 1 FROM attachment WHERE attachment.memo_id = memo.id)",
+			args:   []any{},
+		},
+		{
+			filter: `has_attachment == false`,
+			want:   "NOT (EXISTS (SELECT 1 FROM attachment WHERE attachment.memo_id = memo.id))",
+			args:   []any{},
+		},
+		{
+			filter: `attachment_text.contains("invoice")`,
+			want:   "(SELECT string_agg(COALESCE(attachment.extracted_text, '') || ' ' || COALESCE(attachment.ocr_text, ''), ' ') FROM attachment WHERE attachment.memo_id = memo.id) ILIKE $1",
+			args:   []any{"%invoice%"},
+		},
 		{
 			filter: `has_link == true`,
 			want:   "(memo.payload->'property'->>'hasLink')::boolean = $1",
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/store"
+)
+
+// FindMemosWithoutSearchIndex finds memos whose search_vector has not been computed yet.
+func (d *DB) FindMemosWithoutSearchIndex(ctx context.Context, find *store.FindMemosWithoutSearchIndex) ([]*store.Memo, error) {
+	limit := find.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `
+		SELECT id, uid, creator_id, created_ts, updated_ts, row_status, visibility, pinned, content, payload
+		FROM memo
+		WHERE search_vector IS NULL
+		ORDER BY id
+		LIMIT ` + placeholder(1)
+
+	rows, err := d.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find memos without search index")
+	}
+	defer rows.Close()
+
+	list := []*store.Memo{}
+	for rows.Next() {
+		var memo store.Memo
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&memo.ID,
+			&memo.UID,
+			&memo.CreatorID,
+			&memo.CreatedTs,
+			&memo.UpdatedTs,
+			&memo.RowStatus,
+			&memo.Visibility,
+			&memo.Pinned,
+			&memo.Content,
+			&payloadBytes,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan memo")
+		}
+
+		payload := &storepb.MemoPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		}
+		memo.Payload = payload
+		list = append(list, &memo)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// UpdateMemoSearchIndex recomputes the search_vector of a memo.
+func (d *DB) UpdateMemoSearchIndex(ctx context.Context, memo *store.Memo, words []string) error {
+	stmt := `UPDATE memo SET search_vector = to_tsvector('simple', ` + placeholder(1) + `) WHERE id = ` + placeholder(2)
+	if _, err := d.db.ExecContext(ctx, stmt, store.SearchIndexText(memo.Content, words), memo.ID); err != nil {
+		return errors.Wrap(err, "failed to update memo search index")
+	}
+	return nil
+}
+
+// FindAttachmentsWithoutSearchIndex finds attachments with extracted text whose search_vector has not been computed yet.
+func (d *DB) FindAttachmentsWithoutSearchIndex(ctx context.Context, find *store.FindAttachmentsWithoutSearchIndex) ([]*store.Attachment, error) {
+	limit := find.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `
+		SELECT id, uid, creator_id, memo_id, COALESCE(extracted_text, ''), COALESCE(ocr_text, '')
+		FROM attachment
+		WHERE search_vector IS NULL AND (COALESCE(extracted_text, '') <> '' OR COALESCE(ocr_text, '') <> '')
+		ORDER BY id
+		LIMIT ` + placeholder(1)
+
+	rows, err := d.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find attachments without search index")
+	}
+	defer rows.Close()
+
+	list := []*store.Attachment{}
+	for rows.Next() {
+		var attachment store.Attachment
+		var memoID sql.NullInt32
+		if err := rows.Scan(
+			&attachment.ID,
+			&attachment.UID,
+			&attachment.CreatorID,
+			&memoID,
+			&attachment.ExtractedText,
+			&attachment.OCRText,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan attachment")
+		}
+		if memoID.Valid {
+			attachment.MemoID = &memoID.Int32
+		}
+		list = append(list, &attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// UpdateAttachmentSearchIndex recomputes the search_vector of an attachment.
+func (d *DB) UpdateAttachmentSearchIndex(ctx context.Context, attachment *store.Attachment, words []string) error {
+	stmt := `UPDATE attachment SET search_vector = to_tsvector('simple', ` + placeholder(1) + `) WHERE id = ` + placeholder(2)
+	if _, err := d.db.ExecContext(ctx, stmt, store.AttachmentSearchIndexText(attachment, words), attachment.ID); err != nil {
+		return errors.Wrap(err, "failed to update attachment search index")
+	}
+	return nil
+}
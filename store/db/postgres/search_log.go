@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+func (d *DB) CreateSearchLog(ctx context.Context, create *store.SearchLog) (*store.SearchLog, error) {
+	if create == nil {
+		return nil, fmt.Errorf("create parameter cannot be nil")
+	}
+
+	stmt := `INSERT INTO search_log (user_id, query, source, strategy, result_count, latency_ms, clicked_memo_id)
+		VALUES (` + placeholders(7) + `)
+		RETURNING id, created_ts`
+
+	result := *create
+	if err := d.db.QueryRowContext(ctx, stmt,
+		create.UserID, create.Query, create.Source, create.Strategy, create.ResultCount, create.LatencyMs, create.ClickedMemoID,
+	).Scan(&result.ID, &result.CreatedTs); err != nil {
+		return nil, fmt.Errorf("failed to create search_log: %w", err)
+	}
+
+	return &result, nil
+}
+
+func (d *DB) ListSearchLogs(ctx context.Context, find *store.FindSearchLog) ([]*store.SearchLog, error) {
+	if find == nil {
+		return nil, fmt.Errorf("find parameter cannot be nil")
+	}
+
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts >= "+placeholder(len(args)+1)), append(args, *find.CreatedTsAfter)
+	}
+
+	query := `SELECT id, user_id, query, source, strategy, result_count, latency_ms, clicked_memo_id, created_ts
+		FROM search_log
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, id DESC`
+	if find.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", find.Limit)
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list search_log: %w", err)
+	}
+	defer rows.Close()
+
+	list := []*store.SearchLog{}
+	for rows.Next() {
+		item := &store.SearchLog{}
+		if err := rows.Scan(
+			&item.ID, &item.UserID, &item.Query, &item.Source, &item.Strategy,
+			&item.ResultCount, &item.LatencyMs, &item.ClickedMemoID, &item.CreatedTs,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan search_log: %w", err)
+		}
+		list = append(list, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateSearchLog(ctx context.Context, update *store.UpdateSearchLog) error {
+	if update == nil {
+		return fmt.Errorf("update parameter cannot be nil")
+	}
+
+	stmt := `UPDATE search_log SET clicked_memo_id = ` + placeholder(1) + ` WHERE id = ` + placeholder(2)
+	if _, err := d.db.ExecContext(ctx, stmt, update.ClickedMemoID, update.ID); err != nil {
+		return fmt.Errorf("failed to update search_log: %w", err)
+	}
+
+	return nil
+}
+
+func (d *DB) DeleteSearchLogs(ctx context.Context, delete *store.DeleteSearchLog) (int64, error) {
+	if delete == nil {
+		return 0, fmt.Errorf("delete parameter cannot be nil")
+	}
+
+	where, args := []string{}, []any{}
+	if delete.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *delete.UserID)
+	}
+	if delete.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts < "+placeholder(len(args)+1)), append(args, *delete.CreatedTsBefore)
+	}
+	if len(where) == 0 {
+		return 0, fmt.Errorf("at least one condition is required for deletion")
+	}
+
+	result, err := d.db.ExecContext(ctx, `DELETE FROM search_log WHERE `+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete search_log: %w", err)
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,113 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+func (d *DB) UpsertAICacheEntry(ctx context.Context, upsert *store.AICacheEntry) error {
+	if upsert == nil {
+		return fmt.Errorf("upsert parameter cannot be nil")
+	}
+
+	stmt := `INSERT INTO ai_cache (key, namespace, value, embedding, created_ts, expires_ts)
+		VALUES (` + placeholders(6) + `)
+		ON CONFLICT (key) DO UPDATE SET
+			namespace = EXCLUDED.namespace,
+			value = EXCLUDED.value,
+			embedding = EXCLUDED.embedding,
+			created_ts = EXCLUDED.created_ts,
+			expires_ts = EXCLUDED.expires_ts`
+	if _, err := d.db.ExecContext(ctx, stmt,
+		upsert.Key, upsert.Namespace, upsert.Value, store.EncodeVector(upsert.Embedding), upsert.CreatedTs, upsert.ExpiresTs,
+	); err != nil {
+		return fmt.Errorf("failed to upsert ai_cache: %w", err)
+	}
+	return nil
+}
+
+func (d *DB) ListAICacheEntries(ctx context.Context, find *store.FindAICacheEntry) ([]*store.AICacheEntry, error) {
+	if find == nil {
+		return nil, fmt.Errorf("find parameter cannot be nil")
+	}
+
+	where, args := []string{"1 = 1"}, []any{}
+	if len(find.Keys) > 0 {
+		holders := make([]string, 0, len(find.Keys))
+		for _, key := range find.Keys {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, key)
+		}
+		where = append(where, "key IN ("+strings.Join(holders, ", ")+")")
+	}
+	if find.Namespace != nil {
+		where, args = append(where, "namespace = "+placeholder(len(args)+1)), append(args, *find.Namespace)
+	}
+	if find.ValidAt > 0 {
+		where, args = append(where, "(expires_ts = 0 OR expires_ts > "+placeholder(len(args)+1)+")"), append(args, find.ValidAt)
+	}
+
+	query := `SELECT key, namespace, value, embedding, created_ts, expires_ts
+		FROM ai_cache
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC`
+	if find.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", find.Limit)
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ai_cache: %w", err)
+	}
+	defer rows.Close()
+
+	list := []*store.AICacheEntry{}
+	for rows.Next() {
+		entry := &store.AICacheEntry{}
+		var embedding []byte
+		if err := rows.Scan(&entry.Key, &entry.Namespace, &entry.Value, &embedding, &entry.CreatedTs, &entry.ExpiresTs); err != nil {
+			return nil, fmt.Errorf("failed to scan ai_cache: %w", err)
+		}
+		if entry.Embedding, err = store.DecodeVector(embedding); err != nil {
+			return nil, fmt.Errorf("failed to decode ai_cache embedding: %w", err)
+		}
+		list = append(list, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteAICacheEntries(ctx context.Context, delete *store.DeleteAICacheEntry) (int64, error) {
+	if delete == nil {
+		return 0, fmt.Errorf("delete parameter cannot be nil")
+	}
+
+	where, args := []string{}, []any{}
+	if delete.Namespace != nil {
+		where, args = append(where, "namespace = "+placeholder(len(args)+1)), append(args, *delete.Namespace)
+	}
+	if delete.NamespacePrefix != nil {
+		prefix := *delete.NamespacePrefix
+		where = append(where, "substr(namespace, 1, "+placeholder(len(args)+1)+") = "+placeholder(len(args)+2))
+		args = append(args, utf8.RuneCountInString(prefix), prefix)
+	}
+	if delete.ExpiredBefore != nil {
+		where, args = append(where, "expires_ts > 0 AND expires_ts < "+placeholder(len(args)+1)), append(args, *delete.ExpiredBefore)
+	}
+	if len(where) == 0 {
+		return 0, fmt.Errorf("at least one condition is required for deletion")
+	}
+
+	result, err := d.db.ExecContext(ctx, `DELETE FROM ai_cache WHERE `+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete ai_cache: %w", err)
+	}
+
+	return result.RowsAffected()
+}
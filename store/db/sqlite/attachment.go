@@ -100,6 +100,8 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 		"`attachment`.`storage_type` AS `storage_type`",
 		"`attachment`.`reference` AS `reference`",
 		"`attachment`.`payload` AS `payload`",
+		"`attachment`.`extracted_text` AS `extracted_text`",
+		"`attachment`.`ocr_text` AS `ocr_text`",
 		"CASE WHEN `memo`.`uid` IS NOT NULL THEN `memo`.`uid` ELSE NULL END AS `memo_uid`",
 	}
 	if find.GetBlob {
@@ -142,6 +144,8 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 			&storageType,
 			&attachment.Reference,
 			&payloadBytes,
+			&attachment.ExtractedText,
+			&attachment.OCRText,
 			&attachment.MemoUID,
 		}
 		if find.GetBlob {
@@ -195,6 +199,12 @@ func (d *DB) UpdateAttachment(ctx context.Context, update *store.UpdateAttachmen
 		}
 		set, args = append(set, "`payload` = ?"), append(args, string(bytes))
 	}
+	if v := update.ExtractedText; v != nil {
+		set, args = append(set, "`extracted_text` = ?"), append(args, *v)
+	}
+	if v := update.OCRText; v != nil {
+		set, args = append(set, "`ocr_text` = ?"), append(args, *v)
+	}
 
 	args = append(args, update.ID)
 	stmt := "UPDATE `attachment` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
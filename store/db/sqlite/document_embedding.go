@@ -0,0 +1,37 @@
+package sqlite
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+// UpsertDocumentEmbedding is NOT supported for SQLite.
+// Vector storage requires PostgreSQL with pgvector extension.
+func (d *DB) UpsertDocumentEmbedding(ctx context.Context, embedding *store.DocumentEmbedding) (*store.DocumentEmbedding, error) {
+	return nil, errors.New("document embedding (vector storage) requires PostgreSQL with pgvector extension")
+}
+
+// ListDocumentEmbeddings is NOT supported for SQLite.
+func (d *DB) ListDocumentEmbeddings(ctx context.Context, find *store.FindDocumentEmbedding) ([]*store.DocumentEmbedding, error) {
+	return nil, errors.New("document embedding (vector storage) requires PostgreSQL with pgvector extension")
+}
+
+// DeleteDocumentEmbeddings is NOT supported for SQLite.
+func (d *DB) DeleteDocumentEmbeddings(ctx context.Context, delete *store.DeleteDocumentEmbedding) error {
+	// Return nil (success) so deleting documents does not fail
+	return nil
+}
+
+// FindDocumentsWithoutEmbedding is NOT supported for SQLite.
+func (d *DB) FindDocumentsWithoutEmbedding(ctx context.Context, find *store.FindDocumentsWithoutEmbedding) ([]*store.DocumentEmbedding, error) {
+	return nil, errors.New("document embedding (vector storage) requires PostgreSQL with pgvector extension")
+}
+
+// SearchDocumentEmbeddings is NOT supported for SQLite.
+// Vector similarity search requires PostgreSQL with pgvector extension.
+func (d *DB) SearchDocumentEmbeddings(ctx context.Context, opts *store.SearchDocumentEmbeddingOptions) ([]*store.DocumentEmbeddingWithScore, error) {
+	return nil, errors.New("vector search requires PostgreSQL with pgvector extension")
+}
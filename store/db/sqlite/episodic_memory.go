@@ -2,7 +2,7 @@ package sqlite
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"time"
 
 	"github.com/hrygo/divinesense/store"
@@ -15,7 +15,7 @@ import (
 // SQLite users should use PostgreSQL for AI features.
 // ============================================================================
 
-var errAIFeatureNotSupported = errors.New("AI features (episodic memory, user preferences) are not supported in SQLite; please use PostgreSQL")
+var errAIFeatureNotSupported = fmt.Errorf("%w in SQLite; please use PostgreSQL", store.ErrAIFeatureNotSupported)
 
 func (d *DB) CreateEpisodicMemory(ctx context.Context, create *store.EpisodicMemory) (*store.EpisodicMemory, error) {
 	return nil, errAIFeatureNotSupported
@@ -25,6 +25,10 @@ func (d *DB) ListEpisodicMemories(ctx context.Context, find *store.FindEpisodicM
 	return nil, errAIFeatureNotSupported
 }
 
+func (d *DB) SearchEpisodicMemories(ctx context.Context, search *store.SearchEpisodicMemory) ([]*store.EpisodicMemoryWithScore, error) {
+	return nil, errAIFeatureNotSupported
+}
+
 func (d *DB) ListActiveUserIDs(ctx context.Context, cutoff time.Time) ([]int32, error) {
 	return nil, errAIFeatureNotSupported
 }
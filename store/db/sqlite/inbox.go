@@ -61,6 +61,12 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 			where, args = append(where, "JSON_EXTRACT(`message`, '$.type') = ?"), append(args, find.MessageType.String())
 		}
 	}
+	if len(find.MessageTypes) > 0 {
+		for _, messageType := range find.MessageTypes {
+			args = append(args, messageType.String())
+		}
+		where = append(where, "JSON_EXTRACT(`message`, '$.type') IN ("+placeholders(len(find.MessageTypes))+")")
+	}
 
 	query := "SELECT `id`, `created_ts`, `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
 	if find.Limit != nil {
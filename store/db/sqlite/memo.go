@@ -47,19 +47,24 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 	); err != nil {
 		return nil, err
 	}
+	if err := d.indexMemo(ctx, create.ID, create.Content, nil); err != nil {
+		return nil, err
+	}
 
 	return create, nil
 }
 
-func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
+// memoConditions returns the WHERE conditions of find. They reference memo and,
+// for excluding comments, the parent_uid column of the joined parent memo.
+func memoConditions(ctx context.Context, find *store.FindMemo) ([]string, []any, error) {
 	where, args := []string{"1 = 1"}, []any{}
 
 	engine, err := filter.DefaultEngine()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if err := filter.AppendConditions(ctx, engine, find.Filters, filter.DialectSQLite, &where, &args); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if v := find.ID; v != nil {
 		where, args = append(where, "`memo`.`id` = ?"), append(args, *v)
@@ -105,6 +110,15 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		where = append(where, "`parent_uid` IS NULL")
 	}
 
+	return where, args, nil
+}
+
+func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
+	where, args, err := memoConditions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
 	order := "DESC"
 	if find.OrderByTimeAsc {
 		order = "ASC"
@@ -230,6 +244,9 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
 		return err
 	}
+	if update.Content != nil {
+		return d.indexMemo(ctx, update.ID, *update.Content, nil)
+	}
 	return nil
 }
 
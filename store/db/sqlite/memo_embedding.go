@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/hrygo/divinesense/plugin/filter"
 	storepb "github.com/hrygo/divinesense/proto/gen/store"
 	"github.com/hrygo/divinesense/store"
 )
@@ -50,22 +51,42 @@ func (d *DB) FindMemosWithoutEmbedding(ctx context.Context, find *store.FindMemo
 // BM25Search performs full-text search using SQLite FTS5 if available.
 // This is a best-effort implementation - for production use, prefer PostgreSQL.
 func (d *DB) BM25Search(ctx context.Context, opts *store.BM25SearchOptions) ([]*store.BM25Result, error) {
-	// Try using FTS5 full-text search
+	match := ftsMatchQuery(opts.Query, opts.Words)
+	if match == "" {
+		return []*store.BM25Result{}, nil
+	}
+
+	where, args := []string{"memo.creator_id = ?", "memo.row_status = 'NORMAL'"}, []any{match, match, opts.UserID}
+	if err := appendMemoFilters(ctx, opts.Filters, &where, &args); err != nil {
+		return nil, err
+	}
+	args = append(args, opts.Limit)
+
+	// Try using FTS5 full-text search; bm25() is lower for better matches.
+	// A memo also matches through the text of its attachments and keeps its best score.
 	query := `
 		SELECT
-			m.id, m.uid, m.creator_id, m.created_ts, m.updated_ts, m.row_status,
-			m.visibility, m.pinned, m.content, m.payload,
-			bm25(memo_fts) AS score
-		FROM memo m
-		LEFT JOIN memo_fts ON m.id = memo_fts.rowid
-		WHERE m.creator_id = $1
-			AND m.row_status = 'NORMAL'
-			AND memo_fts MATCH $2
-		ORDER BY score DESC, m.updated_ts DESC
-		LIMIT $3
+			memo.id, memo.uid, memo.creator_id, memo.created_ts, memo.updated_ts, memo.row_status,
+			memo.visibility, memo.pinned, memo.content, memo.payload,
+			MAX(hit.score) AS score
+		FROM memo
+		JOIN (
+			SELECT memo_fts.rowid AS memo_id, -bm25(memo_fts) AS score
+			FROM memo_fts
+			WHERE memo_fts MATCH ?
+			UNION ALL
+			SELECT attachment.memo_id AS memo_id, -bm25(attachment_fts) AS score
+			FROM attachment_fts
+			JOIN attachment ON attachment.id = attachment_fts.rowid
+			WHERE attachment_fts MATCH ? AND attachment.memo_id IS NOT NULL
+		) AS hit ON hit.memo_id = memo.id
+		WHERE ` + strings.Join(where, " AND ") + `
+		GROUP BY memo.id
+		ORDER BY score DESC, memo.updated_ts DESC
+		LIMIT ?
 	`
 
-	rows, err := d.db.QueryContext(ctx, query, opts.UserID, opts.Query, opts.Limit)
+	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		// FTS5 might not be enabled, fall back to LIKE search
 		return d.bm25SearchFallback(ctx, opts)
@@ -138,26 +159,25 @@ func (d *DB) bm25SearchFallback(ctx context.Context, opts *store.BM25SearchOptio
 	}
 
 	// Build WHERE clause
-	whereClause := strings.Repeat("AND m.content LIKE ? ", len(words))
-	args := make([]any, 0, len(words)+1)
-	args = append(args, opts.UserID)
+	where, args := []string{"memo.creator_id = ?", "memo.row_status = 'NORMAL'"}, []any{opts.UserID}
 	for _, word := range words {
-		args = append(args, word)
+		where, args = append(where, "memo.content LIKE ?"), append(args, word)
+	}
+	if err := appendMemoFilters(ctx, opts.Filters, &where, &args); err != nil {
+		return nil, err
 	}
 	args = append(args, opts.Limit)
 
 	query := `
 		SELECT
-			m.id, m.uid, m.creator_id, m.created_ts, m.updated_ts, m.row_status,
-			m.visibility, m.pinned, m.content, m.payload,
+			memo.id, memo.uid, memo.creator_id, memo.created_ts, memo.updated_ts, memo.row_status,
+			memo.visibility, memo.pinned, memo.content, memo.payload,
 			COUNT(*) AS score
-		FROM memo m
-		WHERE m.creator_id = ?
-			AND m.row_status = 'NORMAL'
-			` + whereClause + `
-		GROUP BY m.id, m.uid, m.creator_id, m.created_ts, m.updated_ts, m.row_status,
-			m.visibility, m.pinned, m.content, m.payload
-		ORDER BY score DESC, m.updated_ts DESC
+		FROM memo
+		WHERE ` + strings.Join(where, " AND ") + `
+		GROUP BY memo.id, memo.uid, memo.creator_id, memo.created_ts, memo.updated_ts, memo.row_status,
+			memo.visibility, memo.pinned, memo.content, memo.payload
+		ORDER BY score DESC, memo.updated_ts DESC
 		LIMIT ?
 	`
 
@@ -213,3 +233,18 @@ func (d *DB) bm25SearchFallback(ctx context.Context, opts *store.BM25SearchOptio
 
 	return results, nil
 }
+
+// appendMemoFilters appends the conditions of CEL memo filters to a query on the memo table.
+func appendMemoFilters(ctx context.Context, filters []string, where *[]string, args *[]any) error {
+	if len(filters) == 0 {
+		return nil
+	}
+	engine, err := filter.DefaultEngine()
+	if err != nil {
+		return err
+	}
+	if err := filter.AppendConditions(ctx, engine, filters, filter.DialectSQLite, where, args); err != nil {
+		return errors.Wrap(err, "invalid memo filter")
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/hrygo/divinesense/store"
+)
+
+func (d *DB) GetMemoFacets(ctx context.Context, find *store.FindMemoFacets) (*store.MemoFacets, error) {
+	where, args, err := memoConditions(ctx, &find.FindMemo)
+	if err != nil {
+		return nil, err
+	}
+	if find.TextQuery != "" {
+		match := ftsMatchQuery(find.TextQuery, find.Words)
+		if match == "" {
+			return &store.MemoFacets{}, nil
+		}
+		where = append(where, "`memo`.`id` IN ("+
+			"SELECT `memo_fts`.`rowid` FROM `memo_fts` WHERE `memo_fts` MATCH ? "+
+			"UNION SELECT `attachment`.`memo_id` FROM `attachment_fts` "+
+			"JOIN `attachment` ON `attachment`.`id` = `attachment_fts`.`rowid` WHERE `attachment_fts` MATCH ?)")
+		args = append(args, match, match)
+	}
+
+	// All facets are counted over the same result set in one round trip.
+	// The month placeholder follows the conditions of the result set.
+	args = append(args, find.TimezoneOffset)
+	query := "WITH `result` AS (" +
+		"SELECT `memo`.`id` AS `id`, `memo`.`creator_id` AS `creator_id`, `memo`.`created_ts` AS `created_ts`, " +
+		"`memo`.`visibility` AS `visibility`, `memo`.`payload` AS `payload`, " +
+		"CASE WHEN `parent_memo`.`uid` IS NOT NULL THEN `parent_memo`.`uid` ELSE NULL END AS `parent_uid` " +
+		"FROM `memo` " +
+		"LEFT JOIN `memo_relation` ON `memo`.`id` = `memo_relation`.`memo_id` AND `memo_relation`.`type` = \"COMMENT\" " +
+		"LEFT JOIN `memo` AS `parent_memo` ON `memo_relation`.`related_memo_id` = `parent_memo`.`id` " +
+		"WHERE " + strings.Join(where, " AND ") +
+		") " +
+		"SELECT 'total', '', COUNT(*) FROM `result` " +
+		"UNION ALL " +
+		"SELECT 'tag', `tag`.`value`, COUNT(DISTINCT `result`.`id`) " +
+		"FROM `result`, json_each(`result`.`payload`, '$.tags') AS `tag` GROUP BY `tag`.`value` " +
+		"UNION ALL " +
+		"SELECT 'creator', CAST(`result`.`creator_id` AS TEXT), COUNT(*) FROM `result` GROUP BY `result`.`creator_id` " +
+		"UNION ALL " +
+		"SELECT 'month', strftime('%Y-%m', `result`.`created_ts` + ?, 'unixepoch'), COUNT(*) FROM `result` GROUP BY 2 " +
+		"UNION ALL " +
+		"SELECT 'visibility', `result`.`visibility`, COUNT(*) FROM `result` GROUP BY `result`.`visibility` " +
+		"UNION ALL " +
+		"SELECT 'has_attachment', '', COUNT(*) FROM `result` " +
+		"WHERE EXISTS (SELECT 1 FROM `attachment` WHERE `attachment`.`memo_id` = `result`.`id`) " +
+		"UNION ALL " +
+		"SELECT 'has_task_list', '', COUNT(*) FROM `result` " +
+		"WHERE JSON_EXTRACT(`result`.`payload`, '$.property.hasTaskList') IS TRUE"
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute memo facets")
+	}
+	defer rows.Close()
+
+	facets := &store.MemoFacets{}
+	for rows.Next() {
+		var facet, value string
+		var count int
+		if err := rows.Scan(&facet, &value, &count); err != nil {
+			return nil, err
+		}
+		facets.AddFacetCount(facet, value, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return facets, nil
+}
@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/store"
+)
+
+func TestGetMemoFacets(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	p := &profile.Profile{Mode: "prod", Driver: "sqlite", Data: dir, DSN: filepath.Join(dir, "test.db"), Version: "0.26.0"}
+	driver, err := NewDB(p)
+	require.NoError(t, err)
+	st := store.New(driver, p)
+	defer st.Close()
+	require.NoError(t, st.Migrate(ctx))
+
+	alice, err := st.CreateUser(ctx, &store.User{Username: "alice", Role: store.RoleUser, PasswordHash: "x"})
+	require.NoError(t, err)
+	bob, err := st.CreateUser(ctx, &store.User{Username: "bob", Role: store.RoleUser, PasswordHash: "x"})
+	require.NoError(t, err)
+
+	createMemo := func(uid string, creatorID int32, visibility store.Visibility, created string, content string, payload *storepb.MemoPayload) *store.Memo {
+		createdTime, err := time.Parse(time.DateOnly, created)
+		require.NoError(t, err)
+		memo, err := st.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  creatorID,
+			Content:    content,
+			Visibility: visibility,
+			Payload:    payload,
+			CreatedTs:  createdTime.Unix(),
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	createMemo("a1", alice.ID, store.Private, "2026-01-05", "开项目周会 #work #周会",
+		&storepb.MemoPayload{Tags: []string{"work", "周会"}, Property: &storepb.MemoPayload_Property{HasTaskList: true}})
+	a2 := createMemo("a2", alice.ID, store.Public, "2026-02-10", "发布计划 #work", &storepb.MemoPayload{Tags: []string{"work"}})
+	createMemo("a3", alice.ID, store.Protected, "2026-02-20", "随手记", nil)
+	createMemo("b1", bob.ID, store.Public, "2026-02-11", "项目周会纪要 #work", &storepb.MemoPayload{Tags: []string{"work"}})
+	createMemo("b2", bob.ID, store.Private, "2026-03-01", "去杭州 #travel", &storepb.MemoPayload{Tags: []string{"travel"}})
+	_, err = st.CreateAttachment(ctx, &store.Attachment{UID: "att1", CreatorID: alice.ID, Filename: "plan.pdf", MemoID: &a2.ID})
+	require.NoError(t, err)
+
+	t.Run("creator", func(t *testing.T) {
+		facets, err := st.GetMemoFacets(ctx, &store.FindMemoFacets{FindMemo: store.FindMemo{CreatorID: &alice.ID}})
+		require.NoError(t, err)
+		assert.Equal(t, 3, facets.Total)
+		assert.Equal(t, []*store.FacetCount{{Value: "work", Count: 2}, {Value: "周会", Count: 1}}, facets.Tags)
+		assert.Equal(t, []*store.CreatorFacetCount{{CreatorID: alice.ID, Count: 3}}, facets.Creators)
+		assert.Equal(t, []*store.FacetCount{{Value: "2026-01", Count: 1}, {Value: "2026-02", Count: 2}}, facets.Months)
+		assert.Equal(t, []*store.FacetCount{{Value: "PRIVATE", Count: 1}, {Value: "PROTECTED", Count: 1}, {Value: "PUBLIC", Count: 1}}, facets.Visibilities)
+		assert.Equal(t, 1, facets.HasAttachment)
+		assert.Equal(t, 1, facets.HasTaskList)
+	})
+
+	t.Run("visibility", func(t *testing.T) {
+		facets, err := st.GetMemoFacets(ctx, &store.FindMemoFacets{FindMemo: store.FindMemo{VisibilityList: []store.Visibility{store.Public}}})
+		require.NoError(t, err)
+		assert.Equal(t, 2, facets.Total)
+		assert.Equal(t, []*store.FacetCount{{Value: "work", Count: 2}}, facets.Tags)
+		assert.Equal(t, []*store.CreatorFacetCount{{CreatorID: alice.ID, Count: 1}, {CreatorID: bob.ID, Count: 1}}, facets.Creators)
+		assert.Equal(t, []*store.FacetCount{{Value: "2026-02", Count: 2}}, facets.Months)
+		assert.Equal(t, []*store.FacetCount{{Value: "PUBLIC", Count: 2}}, facets.Visibilities)
+		assert.Equal(t, 1, facets.HasAttachment)
+		assert.Equal(t, 0, facets.HasTaskList)
+	})
+
+	t.Run("timezone", func(t *testing.T) {
+		// b2 is created at midnight UTC on March 1, which is still February west of UTC
+		facets, err := st.GetMemoFacets(ctx, &store.FindMemoFacets{
+			FindMemo:       store.FindMemo{CreatorID: &bob.ID},
+			TimezoneOffset: -5 * 60 * 60,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*store.FacetCount{{Value: "2026-02", Count: 2}}, facets.Months)
+
+		facets, err = st.GetMemoFacets(ctx, &store.FindMemoFacets{
+			FindMemo:       store.FindMemo{CreatorID: &bob.ID},
+			TimezoneOffset: 8 * 60 * 60,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*store.FacetCount{{Value: "2026-02", Count: 1}, {Value: "2026-03", Count: 1}}, facets.Months)
+	})
+
+	t.Run("text query", func(t *testing.T) {
+		facets, err := st.GetMemoFacets(ctx, &store.FindMemoFacets{TextQuery: "周会"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, facets.Total)
+		assert.Equal(t, []*store.CreatorFacetCount{{CreatorID: alice.ID, Count: 1}, {CreatorID: bob.ID, Count: 1}}, facets.Creators)
+	})
+}
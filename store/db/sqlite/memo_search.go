@@ -0,0 +1,149 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/store"
+)
+
+// FindMemosWithoutSearchIndex finds memos that have no row in memo_fts yet.
+func (d *DB) FindMemosWithoutSearchIndex(ctx context.Context, find *store.FindMemosWithoutSearchIndex) ([]*store.Memo, error) {
+	limit := find.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `
+		SELECT id, uid, creator_id, created_ts, updated_ts, row_status, visibility, pinned, content, payload
+		FROM memo
+		WHERE id NOT IN (SELECT rowid FROM memo_fts)
+		ORDER BY id
+		LIMIT ?`
+
+	rows, err := d.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find memos without search index")
+	}
+	defer rows.Close()
+
+	list := []*store.Memo{}
+	for rows.Next() {
+		var memo store.Memo
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&memo.ID,
+			&memo.UID,
+			&memo.CreatorID,
+			&memo.CreatedTs,
+			&memo.UpdatedTs,
+			&memo.RowStatus,
+			&memo.Visibility,
+			&memo.Pinned,
+			&memo.Content,
+			&payloadBytes,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan memo")
+		}
+
+		payload := &storepb.MemoPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		}
+		memo.Payload = payload
+		list = append(list, &memo)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// UpdateMemoSearchIndex rebuilds the memo_fts row of a memo.
+func (d *DB) UpdateMemoSearchIndex(ctx context.Context, memo *store.Memo, words []string) error {
+	return d.indexMemo(ctx, memo.ID, memo.Content, words)
+}
+
+// indexMemo replaces the memo_fts row of a memo with its content segmented with the words of its creator.
+func (d *DB) indexMemo(ctx context.Context, id int32, content string, words []string) error {
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM `memo_fts` WHERE `rowid` = ?", id); err != nil {
+		return errors.Wrap(err, "failed to delete memo search index")
+	}
+	if _, err := d.db.ExecContext(ctx, "INSERT INTO `memo_fts` (`rowid`, `content`) VALUES (?, ?)", id, store.SearchIndexText(content, words)); err != nil {
+		return errors.Wrap(err, "failed to update memo search index")
+	}
+	return nil
+}
+
+// FindAttachmentsWithoutSearchIndex finds attachments with extracted text that have no row in attachment_fts yet.
+func (d *DB) FindAttachmentsWithoutSearchIndex(ctx context.Context, find *store.FindAttachmentsWithoutSearchIndex) ([]*store.Attachment, error) {
+	limit := find.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `
+		SELECT id, uid, creator_id, memo_id, extracted_text, ocr_text
+		FROM attachment
+		WHERE (extracted_text <> '' OR ocr_text <> '') AND id NOT IN (SELECT rowid FROM attachment_fts)
+		ORDER BY id
+		LIMIT ?`
+
+	rows, err := d.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find attachments without search index")
+	}
+	defer rows.Close()
+
+	list := []*store.Attachment{}
+	for rows.Next() {
+		var attachment store.Attachment
+		var memoID sql.NullInt32
+		if err := rows.Scan(
+			&attachment.ID,
+			&attachment.UID,
+			&attachment.CreatorID,
+			&memoID,
+			&attachment.ExtractedText,
+			&attachment.OCRText,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan attachment")
+		}
+		if memoID.Valid {
+			attachment.MemoID = &memoID.Int32
+		}
+		list = append(list, &attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// UpdateAttachmentSearchIndex replaces the attachment_fts row of an attachment with its segmented text.
+func (d *DB) UpdateAttachmentSearchIndex(ctx context.Context, attachment *store.Attachment, words []string) error {
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM `attachment_fts` WHERE `rowid` = ?", attachment.ID); err != nil {
+		return errors.Wrap(err, "failed to delete attachment search index")
+	}
+	if _, err := d.db.ExecContext(ctx, "INSERT INTO `attachment_fts` (`rowid`, `content`) VALUES (?, ?)", attachment.ID, store.AttachmentSearchIndexText(attachment, words)); err != nil {
+		return errors.Wrap(err, "failed to update attachment search index")
+	}
+	return nil
+}
+
+// ftsMatchQuery returns the FTS5 MATCH expression of a search query, empty if
+// it has no tokens. The query goes through the same segmenter as the indexed
+// content; each token is quoted so that FTS5 matches all of them literally.
+func ftsMatchQuery(query string, words []string) string {
+	tokens := strings.Fields(store.SearchQueryText(query, words))
+	for i, token := range tokens {
+		tokens[i] = `"` + token + `"`
+	}
+	return strings.Join(tokens, " ")
+}
@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	storepb "github.com/hrygo/divinesense/proto/gen/store"
+	"github.com/hrygo/divinesense/store"
+)
+
+func TestSearchIndexTagWords(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	p := &profile.Profile{Mode: "prod", Driver: "sqlite", Data: dir, DSN: filepath.Join(dir, "test.db"), Version: "0.26.0"}
+	driver, err := NewDB(p)
+	require.NoError(t, err)
+	st := store.New(driver, p)
+	defer st.Close()
+	require.NoError(t, st.Migrate(ctx))
+
+	alice, err := st.CreateUser(ctx, &store.User{Username: "alice", Role: store.RoleUser, PasswordHash: "x"})
+	require.NoError(t, err)
+	bob, err := st.CreateUser(ctx, &store.User{Username: "bob", Role: store.RoleUser, PasswordHash: "x"})
+	require.NoError(t, err)
+
+	// indexedWith returns the memos whose index has the whole word as a token
+	indexedWith := func(word string) []int32 {
+		rows, err := driver.(*DB).db.QueryContext(ctx, "SELECT `rowid` FROM `memo_fts` WHERE `memo_fts` MATCH ? ORDER BY `rowid`", `"`+word+`"`)
+		require.NoError(t, err)
+		defer rows.Close()
+		var ids []int32
+		for rows.Next() {
+			var id int32
+			require.NoError(t, rows.Scan(&id))
+			ids = append(ids, id)
+		}
+		require.NoError(t, rows.Err())
+		return ids
+	}
+	search := func(userID int32, query string) []int32 {
+		results, err := st.BM25Search(ctx, &store.BM25SearchOptions{UserID: userID, Query: query})
+		require.NoError(t, err)
+		var ids []int32
+		for _, result := range results {
+			ids = append(ids, result.Memo.ID)
+		}
+		return ids
+	}
+
+	plan, err := st.CreateMemo(ctx, &store.Memo{UID: "plan", CreatorID: alice.ID, Content: "下周启动灵犀号计划", Visibility: store.Private})
+	require.NoError(t, err)
+	other, err := st.CreateMemo(ctx, &store.Memo{UID: "other", CreatorID: bob.ID, Content: "灵犀号计划", Visibility: store.Private})
+	require.NoError(t, err)
+	assert.Empty(t, indexedWith("灵犀号"))
+	assert.Equal(t, []int32{plan.ID}, search(alice.ID, "灵犀号计划"))
+
+	// Tagging a memo with the word reindexes the other memos of the user that contain it
+	tagged, err := st.CreateMemo(ctx, &store.Memo{
+		UID:        "tagged",
+		CreatorID:  alice.ID,
+		Content:    "#灵犀号 周报",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{Tags: []string{"灵犀号"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{plan.ID, tagged.ID}, indexedWith("灵犀号"))
+	assert.Equal(t, []int32{plan.ID}, search(alice.ID, "灵犀号计划"))
+	assert.Equal(t, []int32{other.ID}, search(bob.ID, "灵犀号计划"))
+
+	// Removing the last tag with the word removes it from the index again
+	require.NoError(t, st.UpdateMemo(ctx, &store.UpdateMemo{ID: tagged.ID, Payload: &storepb.MemoPayload{}}))
+	assert.Empty(t, indexedWith("灵犀号"))
+	assert.Equal(t, []int32{plan.ID}, search(alice.ID, "灵犀号计划"))
+}
@@ -172,5 +172,10 @@ func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
 	if _, err := result.RowsAffected(); err != nil {
 		return err
 	}
+	// SQLite runs without foreign key enforcement, so the search history
+	// PostgreSQL cascades away has to be removed by hand.
+	if _, err := d.DeleteSearchLogs(ctx, &store.DeleteSearchLog{UserID: &delete.ID}); err != nil {
+		return err
+	}
 	return nil
 }
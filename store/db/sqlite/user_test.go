@@ -0,0 +1,42 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hrygo/divinesense/internal/profile"
+	"github.com/hrygo/divinesense/store"
+)
+
+func TestDeleteUserDeletesSearchLogs(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	p := &profile.Profile{Mode: "prod", Driver: "sqlite", Data: dir, DSN: filepath.Join(dir, "test.db"), Version: "0.26.0"}
+	driver, err := NewDB(p)
+	require.NoError(t, err)
+	st := store.New(driver, p)
+	defer st.Close()
+	require.NoError(t, st.Migrate(ctx))
+
+	alice, err := st.CreateUser(ctx, &store.User{Username: "alice", Role: store.RoleUser, PasswordHash: "x"})
+	require.NoError(t, err)
+	bob, err := st.CreateUser(ctx, &store.User{Username: "bob", Role: store.RoleUser, PasswordHash: "x"})
+	require.NoError(t, err)
+	for _, userID := range []int32{alice.ID, bob.ID} {
+		_, err := st.CreateSearchLog(ctx, &store.SearchLog{UserID: userID, Query: "周会", Source: store.SearchLogSourceSearch})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, st.DeleteUser(ctx, &store.DeleteUser{ID: alice.ID}))
+
+	logs, err := st.ListSearchLogs(ctx, &store.FindSearchLog{UserID: &alice.ID})
+	require.NoError(t, err)
+	assert.Empty(t, logs)
+	logs, err = st.ListSearchLogs(ctx, &store.FindSearchLog{UserID: &bob.ID})
+	require.NoError(t, err)
+	assert.Len(t, logs, 1)
+}
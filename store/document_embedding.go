@@ -0,0 +1,136 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// Document types stored in document_embedding.
+// Memos and episodic memories keep their embeddings in their own tables.
+const (
+	DocumentTypeSchedule   = "schedule"
+	DocumentTypeAttachment = "attachment"
+	DocumentTypeMessage    = "message"
+)
+
+// DocumentEmbedding represents the vector embedding of a non-memo document.
+type DocumentEmbedding struct {
+	ID           int32
+	DocType      string // One of the DocumentType* constants
+	DocID        int32  // ID of the document in its own table
+	UserID       int32  // Owner of the document
+	Visibility   Visibility
+	Tags         []string
+	Content      string    // The embedded text
+	Embedding    []float32 // 1024-dimensional vector
+	Model        string
+	DocCreatedTs int64 // Creation time of the document, used by time filters
+	CreatedTs    int64
+	UpdatedTs    int64
+}
+
+// FindDocumentEmbedding is the find condition for document embeddings.
+type FindDocumentEmbedding struct {
+	DocType *string
+	DocID   *int32
+	UserID  *int32
+	Model   *string
+}
+
+// DeleteDocumentEmbedding is the delete condition for document embeddings.
+// DocType is required.
+type DeleteDocumentEmbedding struct {
+	DocType string
+	DocID   *int32
+	DocIDs  []int32 // Deletes the embeddings of any of these documents
+	UserID  *int32
+}
+
+// FindDocumentsWithoutEmbedding is the find condition for documents whose embedding
+// is missing or older than the document.
+type FindDocumentsWithoutEmbedding struct {
+	DocType string // One of the DocumentType* constants
+	Model   string // Embedding model to check
+	Limit   int    // Maximum number of documents to return
+}
+
+// DocumentEmbeddingWithScore represents a document vector search result.
+type DocumentEmbeddingWithScore struct {
+	*DocumentEmbedding
+	Score float32 // Similarity score (0-1, higher is more similar)
+}
+
+// SearchDocumentEmbeddingOptions represents the options for document vector search.
+// Empty filters match all documents.
+type SearchDocumentEmbeddingOptions struct {
+	Vector        []float32 // Query vector
+	Model         string
+	UserID        *int32
+	DocTypes      []string
+	CreatedAfter  *int64 // Unix timestamp, compared with DocCreatedTs
+	CreatedBefore *int64
+	Tags          []string // Matches documents with any of the tags
+	Visibilities  []Visibility
+	Limit         int // Number of results to return, default 10
+}
+
+// Validate validates the SearchDocumentEmbeddingOptions.
+func (o *SearchDocumentEmbeddingOptions) Validate() error {
+	if len(o.Vector) == 0 {
+		return fmt.Errorf("vector cannot be empty")
+	}
+	if o.Limit < 0 {
+		return fmt.Errorf("limit cannot be negative: %d", o.Limit)
+	}
+	if o.Limit == 0 {
+		o.Limit = 10 // Default limit
+	}
+	if o.Limit > 1000 {
+		return fmt.Errorf("limit too large (max 1000): %d", o.Limit)
+	}
+	return nil
+}
+
+// UpsertDocumentEmbedding inserts or updates a document embedding.
+func (s *Store) UpsertDocumentEmbedding(ctx context.Context, embedding *DocumentEmbedding) (*DocumentEmbedding, error) {
+	return s.driver.UpsertDocumentEmbedding(ctx, embedding)
+}
+
+// ListDocumentEmbeddings lists document embeddings.
+func (s *Store) ListDocumentEmbeddings(ctx context.Context, find *FindDocumentEmbedding) ([]*DocumentEmbedding, error) {
+	return s.driver.ListDocumentEmbeddings(ctx, find)
+}
+
+// DeleteDocumentEmbeddings deletes document embeddings.
+func (s *Store) DeleteDocumentEmbeddings(ctx context.Context, delete *DeleteDocumentEmbedding) error {
+	if delete.DocType == "" {
+		return fmt.Errorf("doc type is required")
+	}
+	return s.driver.DeleteDocumentEmbeddings(ctx, delete)
+}
+
+// FindDocumentsWithoutEmbedding finds documents to embed. The results carry everything but
+// the embedding; UpdatedTs is the update time of the document, so storing them as returned
+// marks the embedding as current until the document changes again.
+func (s *Store) FindDocumentsWithoutEmbedding(ctx context.Context, find *FindDocumentsWithoutEmbedding) ([]*DocumentEmbedding, error) {
+	return s.driver.FindDocumentsWithoutEmbedding(ctx, find)
+}
+
+// deleteDocumentEmbeddings removes the embeddings of deleted documents.
+func (s *Store) deleteDocumentEmbeddings(ctx context.Context, docType string, docIDs []int32) error {
+	if len(docIDs) == 0 {
+		return nil
+	}
+	if err := s.driver.DeleteDocumentEmbeddings(ctx, &DeleteDocumentEmbedding{DocType: docType, DocIDs: docIDs}); err != nil {
+		return fmt.Errorf("failed to delete %s embeddings: %w", docType, err)
+	}
+	return nil
+}
+
+// SearchDocumentEmbeddings performs vector similarity search over document embeddings.
+func (s *Store) SearchDocumentEmbeddings(ctx context.Context, opts *SearchDocumentEmbeddingOptions) ([]*DocumentEmbeddingWithScore, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return s.driver.SearchDocumentEmbeddings(ctx, opts)
+}
@@ -27,6 +27,7 @@ type Driver interface {
 	// Memo model related methods.
 	CreateMemo(ctx context.Context, create *Memo) (*Memo, error)
 	ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error)
+	GetMemoFacets(ctx context.Context, find *FindMemoFacets) (*MemoFacets, error)
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
 
@@ -83,6 +84,22 @@ type Driver interface {
 	FindMemosWithoutEmbedding(ctx context.Context, find *FindMemosWithoutEmbedding) ([]*Memo, error)
 	VectorSearch(ctx context.Context, opts *VectorSearchOptions) ([]*MemoWithScore, error)
 	BM25Search(ctx context.Context, opts *BM25SearchOptions) ([]*BM25Result, error)
+	FindMemosWithoutSearchIndex(ctx context.Context, find *FindMemosWithoutSearchIndex) ([]*Memo, error)
+	UpdateMemoSearchIndex(ctx context.Context, memo *Memo, words []string) error
+	FindAttachmentsWithoutSearchIndex(ctx context.Context, find *FindAttachmentsWithoutSearchIndex) ([]*Attachment, error)
+	UpdateAttachmentSearchIndex(ctx context.Context, attachment *Attachment, words []string) error
+
+	// DocumentEmbedding model related methods.
+	UpsertDocumentEmbedding(ctx context.Context, embedding *DocumentEmbedding) (*DocumentEmbedding, error)
+	ListDocumentEmbeddings(ctx context.Context, find *FindDocumentEmbedding) ([]*DocumentEmbedding, error)
+	DeleteDocumentEmbeddings(ctx context.Context, delete *DeleteDocumentEmbedding) error
+	FindDocumentsWithoutEmbedding(ctx context.Context, find *FindDocumentsWithoutEmbedding) ([]*DocumentEmbedding, error)
+	SearchDocumentEmbeddings(ctx context.Context, opts *SearchDocumentEmbeddingOptions) ([]*DocumentEmbeddingWithScore, error)
+
+	// AICacheEntry model related methods.
+	UpsertAICacheEntry(ctx context.Context, upsert *AICacheEntry) error
+	ListAICacheEntries(ctx context.Context, find *FindAICacheEntry) ([]*AICacheEntry, error)
+	DeleteAICacheEntries(ctx context.Context, delete *DeleteAICacheEntry) (int64, error)
 
 	// Schedule model related methods.
 	CreateSchedule(ctx context.Context, create *Schedule) (*Schedule, error)
@@ -104,6 +121,7 @@ type Driver interface {
 	// EpisodicMemory model related methods.
 	CreateEpisodicMemory(ctx context.Context, create *EpisodicMemory) (*EpisodicMemory, error)
 	ListEpisodicMemories(ctx context.Context, find *FindEpisodicMemory) ([]*EpisodicMemory, error)
+	SearchEpisodicMemories(ctx context.Context, search *SearchEpisodicMemory) ([]*EpisodicMemoryWithScore, error)
 	ListActiveUserIDs(ctx context.Context, cutoff time.Time) ([]int32, error)
 	DeleteEpisodicMemory(ctx context.Context, delete *DeleteEpisodicMemory) error
 
@@ -111,6 +129,17 @@ type Driver interface {
 	UpsertUserPreferences(ctx context.Context, upsert *UpsertUserPreferences) (*UserPreferences, error)
 	GetUserPreferences(ctx context.Context, find *FindUserPreferences) (*UserPreferences, error)
 
+	// ConversationContext model related methods.
+	UpsertConversationContext(ctx context.Context, upsert *UpsertConversationContext) (*ConversationContext, error)
+	ListConversationContexts(ctx context.Context, find *FindConversationContext) ([]*ConversationContext, error)
+	DeleteConversationContexts(ctx context.Context, delete *DeleteConversationContext) (int64, error)
+
+	// SearchLog model related methods.
+	CreateSearchLog(ctx context.Context, create *SearchLog) (*SearchLog, error)
+	ListSearchLogs(ctx context.Context, find *FindSearchLog) ([]*SearchLog, error)
+	UpdateSearchLog(ctx context.Context, update *UpdateSearchLog) error
+	DeleteSearchLogs(ctx context.Context, delete *DeleteSearchLog) (int64, error)
+
 	// AgentMetrics model related methods.
 	UpsertAgentMetrics(ctx context.Context, upsert *UpsertAgentMetrics) (*AgentMetrics, error)
 	ListAgentMetrics(ctx context.Context, find *FindAgentMetrics) ([]*AgentMetrics, error)
@@ -13,6 +13,10 @@ type EpisodicMemory struct {
 	Summary    string
 	Importance float32 // 0-1
 	CreatedTs  int64
+
+	// Embedding of the user input and summary for semantic recall (optional).
+	Embedding      []float32
+	EmbeddingModel string
 }
 
 // FindEpisodicMemory specifies the conditions for finding episodic memories.
@@ -21,8 +25,24 @@ type FindEpisodicMemory struct {
 	UserID    *int32
 	AgentType *string
 	Query     *string // For text search in user_input and summary
-	Limit     int
-	Offset    int
+	// SummaryPrefix matches summaries starting with the prefix, e.g. "routing_correction:"
+	SummaryPrefix *string
+	Limit         int
+	Offset        int
+}
+
+// SearchEpisodicMemory specifies a vector similarity search over embedded episodes.
+type SearchEpisodicMemory struct {
+	UserID int32
+	Vector []float32
+	Model  string
+	Limit  int
+}
+
+// EpisodicMemoryWithScore is an episodic memory with its similarity to the search vector.
+type EpisodicMemoryWithScore struct {
+	*EpisodicMemory
+	Similarity float32
 }
 
 // DeleteEpisodicMemory specifies the conditions for deleting episodic memories.
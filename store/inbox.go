@@ -44,6 +44,8 @@ type FindInbox struct {
 	ReceiverID  *int32
 	Status      *InboxStatus
 	MessageType *storepb.InboxMessage_Type
+	// MessageTypes matches any of the message types; empty means no filter.
+	MessageTypes []storepb.InboxMessage_Type
 
 	// Pagination
 	Limit  *int
@@ -37,6 +37,8 @@ func (s *Store) UpsertInstanceSetting(ctx context.Context, upsert *storepb.Insta
 		valueBytes, err = protojson.Marshal(upsert.GetStorageSetting())
 	} else if upsert.Key == storepb.InstanceSettingKey_MEMO_RELATED {
 		valueBytes, err = protojson.Marshal(upsert.GetMemoRelatedSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_AI_LIMIT {
+		valueBytes, err = protojson.Marshal(upsert.GetAiLimitSetting())
 	} else {
 		return nil, errors.Errorf("unsupported instance setting key: %v", upsert.Key)
 	}
@@ -206,6 +208,53 @@ func (s *Store) GetInstanceStorageSetting(ctx context.Context) (*storepb.Instanc
 	return instanceStorageSetting, nil
 }
 
+// Default AI limits, applied to unset (zero) fields of the AI limit setting.
+const (
+	DefaultAIUserRequestsPerMinute     = 30
+	DefaultAIUserConcurrentStreams     = 3
+	DefaultAIUserDailyLLMCalls         = 1000
+	DefaultAIInstanceRequestsPerMinute = 600
+	DefaultAIInstanceConcurrentStreams = 50
+	DefaultAIInstanceDailyLLMCalls     = 50000
+)
+
+func (s *Store) GetInstanceAILimitSetting(ctx context.Context) (*storepb.InstanceAILimitSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_AI_LIMIT.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance AI limit setting")
+	}
+
+	instanceAILimitSetting := &storepb.InstanceAILimitSetting{}
+	if instanceSetting != nil {
+		instanceAILimitSetting = instanceSetting.GetAiLimitSetting()
+	}
+	if instanceAILimitSetting.UserRequestsPerMinute == 0 {
+		instanceAILimitSetting.UserRequestsPerMinute = DefaultAIUserRequestsPerMinute
+	}
+	if instanceAILimitSetting.UserConcurrentStreams == 0 {
+		instanceAILimitSetting.UserConcurrentStreams = DefaultAIUserConcurrentStreams
+	}
+	if instanceAILimitSetting.UserDailyLlmCalls == 0 {
+		instanceAILimitSetting.UserDailyLlmCalls = DefaultAIUserDailyLLMCalls
+	}
+	if instanceAILimitSetting.InstanceRequestsPerMinute == 0 {
+		instanceAILimitSetting.InstanceRequestsPerMinute = DefaultAIInstanceRequestsPerMinute
+	}
+	if instanceAILimitSetting.InstanceConcurrentStreams == 0 {
+		instanceAILimitSetting.InstanceConcurrentStreams = DefaultAIInstanceConcurrentStreams
+	}
+	if instanceAILimitSetting.InstanceDailyLlmCalls == 0 {
+		instanceAILimitSetting.InstanceDailyLlmCalls = DefaultAIInstanceDailyLLMCalls
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_AI_LIMIT.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_AI_LIMIT,
+		Value: &storepb.InstanceSetting_AiLimitSetting{AiLimitSetting: instanceAILimitSetting},
+	})
+	return instanceAILimitSetting, nil
+}
+
 func convertInstanceSettingFromRaw(instanceSettingRaw *InstanceSetting) (*storepb.InstanceSetting, error) {
 	instanceSetting := &storepb.InstanceSetting{
 		Key: storepb.InstanceSettingKey(storepb.InstanceSettingKey_value[instanceSettingRaw.Name]),
@@ -235,6 +284,12 @@ func convertInstanceSettingFromRaw(instanceSettingRaw *InstanceSetting) (*storep
 			return nil, err
 		}
 		instanceSetting.Value = &storepb.InstanceSetting_MemoRelatedSetting{MemoRelatedSetting: memoRelatedSetting}
+	case storepb.InstanceSettingKey_AI_LIMIT.String():
+		aiLimitSetting := &storepb.InstanceAILimitSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), aiLimitSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_AiLimitSetting{AiLimitSetting: aiLimitSetting}
 	default:
 		// Skip unsupported instance setting key.
 		return nil, nil
@@ -110,7 +110,18 @@ func (s *Store) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
 	if !base.UIDMatcher.MatchString(create.UID) {
 		return nil, errors.New("invalid uid")
 	}
-	return s.driver.CreateMemo(ctx, create)
+	previous, err := s.SearchWords(ctx, create.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+	memo, err := s.driver.CreateMemo(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.updateSearchWords(ctx, memo.CreatorID, previous, nil, memo); err != nil {
+		return nil, err
+	}
+	return memo, nil
 }
 
 func (s *Store) ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error) {
@@ -134,10 +145,43 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
-	return s.driver.UpdateMemo(ctx, update)
+	if update.Content == nil && update.Payload == nil {
+		return s.driver.UpdateMemo(ctx, update)
+	}
+
+	// Content and tags change the full-text search words and index of the creator
+	old, err := s.GetMemo(ctx, &FindMemo{ID: &update.ID})
+	if err != nil {
+		return err
+	}
+	if old == nil {
+		return s.driver.UpdateMemo(ctx, update)
+	}
+	previous, err := s.SearchWords(ctx, old.CreatorID)
+	if err != nil {
+		return err
+	}
+	if err := s.driver.UpdateMemo(ctx, update); err != nil {
+		return err
+	}
+	memo, err := s.GetMemo(ctx, &FindMemo{ID: &update.ID})
+	if err != nil {
+		return err
+	}
+	return s.updateSearchWords(ctx, old.CreatorID, previous, old, memo)
 }
 
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
+	memo, err := s.GetMemo(ctx, &FindMemo{ID: &delete.ID, ExcludeContent: true})
+	if err != nil {
+		return err
+	}
+	var previous []string
+	if memo != nil {
+		if previous, err = s.SearchWords(ctx, memo.CreatorID); err != nil {
+			return err
+		}
+	}
 	// Clean up memo_relation records where this memo is either the source or target.
 	if err := s.driver.DeleteMemoRelation(ctx, &DeleteMemoRelation{MemoID: &delete.ID}); err != nil {
 		return err
@@ -155,5 +199,11 @@ func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
 			return err
 		}
 	}
-	return s.driver.DeleteMemo(ctx, delete)
+	if err := s.driver.DeleteMemo(ctx, delete); err != nil {
+		return err
+	}
+	if memo == nil {
+		return nil
+	}
+	return s.updateSearchWords(ctx, memo.CreatorID, previous, memo, nil)
 }
@@ -17,14 +17,16 @@ type MemoEmbedding struct {
 
 // FindMemoEmbedding is the find condition for memo embeddings.
 type FindMemoEmbedding struct {
-	MemoID *int32
-	Model  *string
+	MemoID     *int32
+	MemoIDList []int32
+	Model      *string
 }
 
 // FindMemosWithoutEmbedding is the find condition for memos without embeddings.
 type FindMemosWithoutEmbedding struct {
-	Model string // Embedding model to check
-	Limit int    // Maximum number of memos to return
+	Model     string // Embedding model to check
+	CreatorID *int32 // Optional: only memos of this user
+	Limit     int    // Maximum number of memos to return
 }
 
 // MemoWithScore represents a vector search result with similarity score.
@@ -38,6 +40,14 @@ type VectorSearchOptions struct {
 	UserID int32     // Required, only search memos of this user
 	Vector []float32 // Query vector
 	Limit  int       // Number of results to return, default 10
+	Model  string    // Embedding model, default "BAAI/bge-m3"
+
+	// Optional filters
+	CreatedAfter  *int64 // Unix timestamp
+	CreatedBefore *int64
+	Tags          []string // Matches memos with any of the tags
+	Visibilities  []Visibility
+	Filters       []string // CEL memo filters, see plugin/filter
 }
 
 // Validate validates the VectorSearchOptions.
@@ -66,6 +76,8 @@ type BM25SearchOptions struct {
 	Query    string // Search query
 	Limit    int    // Number of results to return, default 10
 	MinScore float32 // Minimum relevance score (default 0)
+	Filters  []string // CEL memo filters, see plugin/filter
+	Words    []string // Search words of the user to segment the query with, set by the store
 }
 
 // Validate validates the BM25SearchOptions.
@@ -150,5 +162,10 @@ func (s *Store) BM25Search(ctx context.Context, opts *BM25SearchOptions) ([]*BM2
 	if err := opts.Validate(); err != nil {
 		return nil, err
 	}
+	words, err := s.SearchWords(ctx, opts.UserID)
+	if err != nil {
+		return nil, err
+	}
+	opts.Words = words
 	return s.driver.BM25Search(ctx, opts)
 }
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"strconv"
+)
+
+// Facet names of the rows returned by drivers for GetMemoFacets.
+const (
+	MemoFacetTotal         = "total"
+	MemoFacetTag           = "tag"
+	MemoFacetCreator       = "creator"
+	MemoFacetMonth         = "month"
+	MemoFacetVisibility    = "visibility"
+	MemoFacetHasAttachment = "has_attachment"
+	MemoFacetHasTaskList   = "has_task_list"
+)
+
+// FindMemoFacets represents a query for the facets of a memo result set.
+// The conditions of FindMemo are applied as in ListMemos; its pagination and
+// ordering are ignored.
+type FindMemoFacets struct {
+	FindMemo
+
+	// TextQuery restricts the result set to memos whose content or attachment
+	// text matches a full-text search query.
+	TextQuery string
+	// TimezoneOffset is the offset of the caller's timezone in seconds east of
+	// UTC, in which creation months are bucketed.
+	TimezoneOffset int
+	// Words are the search words to segment TextQuery with, set by the store
+	// when the memos belong to a single creator.
+	Words []string
+}
+
+// FacetCount is the number of memos with a facet value.
+type FacetCount struct {
+	Value string
+	Count int
+}
+
+// CreatorFacetCount is the number of memos of a creator.
+type CreatorFacetCount struct {
+	CreatorID int32
+	Count     int
+}
+
+// MemoFacets summarizes the memos of a result set.
+type MemoFacets struct {
+	Total         int
+	Tags          []*FacetCount        // Most frequent first
+	Creators      []*CreatorFacetCount // Most frequent first
+	Months        []*FacetCount        // Creation months as YYYY-MM in the caller's timezone, oldest first
+	Visibilities  []*FacetCount        // Most frequent first
+	HasAttachment int
+	HasTaskList   int
+}
+
+// AddFacetCount adds a facet row computed by a driver.
+func (f *MemoFacets) AddFacetCount(facet, value string, count int) {
+	switch facet {
+	case MemoFacetTotal:
+		f.Total = count
+	case MemoFacetTag:
+		f.Tags = append(f.Tags, &FacetCount{Value: value, Count: count})
+	case MemoFacetCreator:
+		if id, err := strconv.ParseInt(value, 10, 32); err == nil {
+			f.Creators = append(f.Creators, &CreatorFacetCount{CreatorID: int32(id), Count: count})
+		}
+	case MemoFacetMonth:
+		f.Months = append(f.Months, &FacetCount{Value: value, Count: count})
+	case MemoFacetVisibility:
+		f.Visibilities = append(f.Visibilities, &FacetCount{Value: value, Count: count})
+	case MemoFacetHasAttachment:
+		f.HasAttachment = count
+	case MemoFacetHasTaskList:
+		f.HasTaskList = count
+	}
+}
+
+// GetMemoFacets computes the tag, creator, month and visibility counts of all memos matching find.
+func (s *Store) GetMemoFacets(ctx context.Context, find *FindMemoFacets) (*MemoFacets, error) {
+	if find.TextQuery != "" && find.CreatorID != nil {
+		words, err := s.SearchWords(ctx, *find.CreatorID)
+		if err != nil {
+			return nil, err
+		}
+		find.Words = words
+	}
+	facets, err := s.driver.GetMemoFacets(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	sortFacetCounts(facets.Tags)
+	sortFacetCounts(facets.Visibilities)
+	sort.Slice(facets.Creators, func(i, j int) bool {
+		if facets.Creators[i].Count != facets.Creators[j].Count {
+			return facets.Creators[i].Count > facets.Creators[j].Count
+		}
+		return facets.Creators[i].CreatorID < facets.Creators[j].CreatorID
+	})
+	sort.Slice(facets.Months, func(i, j int) bool {
+		return facets.Months[i].Value < facets.Months[j].Value
+	})
+	return facets, nil
+}
+
+// sortFacetCounts sorts counts by frequency, then by value.
+func sortFacetCounts(counts []*FacetCount) {
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Value < counts[j].Value
+	})
+}
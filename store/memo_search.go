@@ -0,0 +1,222 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hrygo/divinesense/plugin/segment"
+)
+
+// searchWordsTTL bounds how long another instance may segment queries with
+// outdated tag words of a user. Stale words only make a query less precise:
+// documents are always indexed with the built-in tokens as well.
+const searchWordsTTL = time.Minute
+
+// FindMemosWithoutSearchIndex represents a query for memos whose content is not indexed for full-text search.
+type FindMemosWithoutSearchIndex struct {
+	Limit int // Maximum number of memos to return
+}
+
+// FindAttachmentsWithoutSearchIndex represents a query for attachments whose extracted text is not indexed for full-text search.
+type FindAttachmentsWithoutSearchIndex struct {
+	Limit int // Maximum number of attachments to return
+}
+
+// SearchIndexText returns the segmented text of a memo indexed for full-text search,
+// cut with the search words of its creator (see Store.SearchWords).
+// Tags are part of the content, so tag words are indexed with it.
+func SearchIndexText(content string, words []string) string {
+	return segment.IndexText(content, words)
+}
+
+// AttachmentSearchIndexText returns the segmented extracted and OCR text of an attachment indexed for full-text search.
+func AttachmentSearchIndexText(attachment *Attachment, words []string) string {
+	return segment.IndexText(attachment.ExtractedText+"\n"+attachment.OCRText, words)
+}
+
+// SearchQueryText returns the segmented text of a full-text search query, cut
+// with the search words of the user whose memos are searched, or nil words.
+func SearchQueryText(query string, words []string) string {
+	return segment.QueryText(query, words)
+}
+
+// SearchWords returns the user dictionary of a user for full-text search: the
+// Chinese words of the tags of their memos.
+func (s *Store) SearchWords(ctx context.Context, userID int32) ([]string, error) {
+	if cached, ok := s.searchWordsCache.Get(ctx, strconv.Itoa(int(userID))); ok {
+		if words, ok := cached.([]string); ok {
+			return words, nil
+		}
+	}
+	return s.loadSearchWords(ctx, userID)
+}
+
+// loadSearchWords reads the search words of a user from their memo tags and caches them.
+func (s *Store) loadSearchWords(ctx context.Context, userID int32) ([]string, error) {
+	var tags []string
+	limit, offset := 500, 0
+	for {
+		memos, err := s.ListMemos(ctx, &FindMemo{
+			CreatorID:      &userID,
+			ExcludeContent: true,
+			Limit:          &limit,
+			Offset:         &offset,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memo tags")
+		}
+		for _, memo := range memos {
+			tags = append(tags, memoTags(memo)...)
+		}
+		if len(memos) < limit {
+			break
+		}
+		offset += len(memos)
+	}
+
+	words := segment.TagWords(tags)
+	s.searchWordsCache.SetWithTTL(ctx, strconv.Itoa(int(userID)), words, searchWordsTTL)
+	return words, nil
+}
+
+// updateSearchWords updates the search words of a user after one of their
+// memos changed from old to memo, either of which is nil when the memo was
+// created or deleted. previous are the search words before the change.
+// Documents containing an added or removed word are reindexed, and so is the
+// changed memo, which the driver indexes without the words.
+func (s *Store) updateSearchWords(ctx context.Context, userID int32, previous []string, old, memo *Memo) error {
+	newWords := segment.TagWords(memoTags(memo))
+	words := previous
+	var changed []string
+	for _, word := range newWords {
+		if !slices.Contains(previous, word) {
+			changed = append(changed, word)
+		}
+	}
+	if len(changed) > 0 {
+		words = segment.TagWords(append(slices.Clone(previous), changed...))
+		s.searchWordsCache.SetWithTTL(ctx, strconv.Itoa(int(userID)), words, searchWordsTTL)
+	}
+
+	// A word is only removed when no other memo of the user is tagged with it
+	var dropped bool
+	for _, word := range segment.TagWords(memoTags(old)) {
+		if !slices.Contains(newWords, word) {
+			dropped = true
+			break
+		}
+	}
+	if dropped {
+		loaded, err := s.loadSearchWords(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, word := range previous {
+			if !slices.Contains(loaded, word) {
+				changed = append(changed, word)
+			}
+		}
+		words = loaded
+	}
+
+	if len(changed) > 0 {
+		if err := s.reindexSearchWords(ctx, userID, words, changed); err != nil {
+			return err
+		}
+	}
+	if memo != nil && containsAny(memo.Content, words) && !containsAny(memo.Content, changed) {
+		return s.driver.UpdateMemoSearchIndex(ctx, memo, words)
+	}
+	return nil
+}
+
+// reindexSearchWords reindexes the memos and attachments of a user whose text contains a changed word.
+func (s *Store) reindexSearchWords(ctx context.Context, userID int32, words, changed []string) error {
+	limit, offset := 500, 0
+	for {
+		memos, err := s.ListMemos(ctx, &FindMemo{CreatorID: &userID, Limit: &limit, Offset: &offset})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memos to reindex")
+		}
+		for _, memo := range memos {
+			if containsAny(memo.Content, changed) {
+				if err := s.driver.UpdateMemoSearchIndex(ctx, memo, words); err != nil {
+					return err
+				}
+			}
+		}
+		if len(memos) < limit {
+			break
+		}
+		offset += len(memos)
+	}
+
+	attachments, err := s.ListAttachments(ctx, &FindAttachment{CreatorID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments to reindex")
+	}
+	for _, attachment := range attachments {
+		if containsAny(attachment.ExtractedText+"\n"+attachment.OCRText, changed) {
+			if err := s.driver.UpdateAttachmentSearchIndex(ctx, attachment, words); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func memoTags(memo *Memo) []string {
+	if memo == nil || memo.Payload == nil {
+		return nil
+	}
+	return memo.Payload.Tags
+}
+
+func containsAny(text string, words []string) bool {
+	for _, word := range words {
+		if strings.Contains(text, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindMemosWithoutSearchIndex finds memos that were saved before full-text indexing was added.
+func (s *Store) FindMemosWithoutSearchIndex(ctx context.Context, find *FindMemosWithoutSearchIndex) ([]*Memo, error) {
+	if find.Limit <= 0 {
+		find.Limit = 100
+	}
+	return s.driver.FindMemosWithoutSearchIndex(ctx, find)
+}
+
+// UpdateMemoSearchIndex rebuilds the full-text search index of a memo from its content and tags.
+func (s *Store) UpdateMemoSearchIndex(ctx context.Context, memo *Memo) error {
+	words, err := s.SearchWords(ctx, memo.CreatorID)
+	if err != nil {
+		return err
+	}
+	return s.driver.UpdateMemoSearchIndex(ctx, memo, words)
+}
+
+// FindAttachmentsWithoutSearchIndex finds attachments with extracted or OCR text that is not indexed yet.
+func (s *Store) FindAttachmentsWithoutSearchIndex(ctx context.Context, find *FindAttachmentsWithoutSearchIndex) ([]*Attachment, error) {
+	if find.Limit <= 0 {
+		find.Limit = 100
+	}
+	return s.driver.FindAttachmentsWithoutSearchIndex(ctx, find)
+}
+
+// UpdateAttachmentSearchIndex rebuilds the full-text search index of an attachment from its extracted and OCR text.
+// Matches are attributed to the memo the attachment belongs to.
+func (s *Store) UpdateAttachmentSearchIndex(ctx context.Context, attachment *Attachment) error {
+	words, err := s.SearchWords(ctx, attachment.CreatorID)
+	if err != nil {
+		return err
+	}
+	return s.driver.UpdateAttachmentSearchIndex(ctx, attachment, words)
+}
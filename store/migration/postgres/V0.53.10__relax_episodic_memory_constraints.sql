@@ -0,0 +1,8 @@
+-- Allow suggestion feedback episodes and routing decisions without a known agent
+ALTER TABLE episodic_memory DROP CONSTRAINT IF EXISTS chk_episodic_memory_outcome;
+ALTER TABLE episodic_memory ADD CONSTRAINT chk_episodic_memory_outcome
+  CHECK (outcome IN ('success', 'failure', 'dismissed'));
+
+ALTER TABLE episodic_memory DROP CONSTRAINT IF EXISTS chk_episodic_memory_agent_type;
+ALTER TABLE episodic_memory ADD CONSTRAINT chk_episodic_memory_agent_type
+  CHECK (agent_type IN ('memo', 'schedule', 'amazing', 'assistant', 'suggestion', 'unknown'));
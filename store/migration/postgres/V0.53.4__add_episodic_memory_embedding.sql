@@ -0,0 +1,13 @@
+-- Add embeddings to episodic_memory for semantic recall of past conversations
+-- Episodes are embedded at creation time; recall ranks by similarity, recency and importance
+
+ALTER TABLE episodic_memory ADD COLUMN embedding vector(1024);
+ALTER TABLE episodic_memory ADD COLUMN embedding_model VARCHAR(100);
+
+-- HNSW index for fast vector similarity search over embedded episodes
+CREATE INDEX idx_episodic_memory_embedding_hnsw
+ON episodic_memory USING hnsw (embedding vector_cosine_ops)
+WHERE embedding IS NOT NULL;
+
+COMMENT ON COLUMN episodic_memory.embedding IS 'Embedding of user input and summary, used for semantic recall';
+COMMENT ON COLUMN episodic_memory.embedding_model IS 'Model that produced the embedding';
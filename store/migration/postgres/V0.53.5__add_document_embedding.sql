@@ -0,0 +1,36 @@
+-- Add document_embedding for vector search over schedules, attachments and conversation messages
+-- Memos keep their embeddings in memo_embedding, episodes in episodic_memory
+
+CREATE TABLE document_embedding (
+  id SERIAL PRIMARY KEY,
+  doc_type VARCHAR(32) NOT NULL,
+  doc_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  visibility VARCHAR(32) NOT NULL DEFAULT 'PRIVATE',
+  tags JSONB NOT NULL DEFAULT '[]'::jsonb,
+  content TEXT NOT NULL DEFAULT '',
+  embedding vector(1024) NOT NULL,
+  model VARCHAR(100) NOT NULL DEFAULT 'BAAI/bge-m3',
+  doc_created_ts BIGINT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())::BIGINT,
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())::BIGINT,
+  CONSTRAINT fk_document_embedding_user
+    FOREIGN KEY (user_id)
+    REFERENCES "user"(id)
+    ON DELETE CASCADE,
+  CONSTRAINT uq_document_embedding_doc_model
+    UNIQUE (doc_type, doc_id, model),
+  CONSTRAINT chk_document_embedding_doc_type
+    CHECK (doc_type IN ('schedule', 'attachment', 'message'))
+);
+
+CREATE INDEX idx_document_embedding_hnsw
+ON document_embedding USING hnsw (embedding vector_cosine_ops)
+WITH (m = 16, ef_construction = 64);
+
+CREATE INDEX idx_document_embedding_user_type
+ON document_embedding (user_id, doc_type);
+
+COMMENT ON TABLE document_embedding IS 'Vector embeddings of non-memo documents for unified semantic search';
+COMMENT ON COLUMN document_embedding.doc_id IS 'ID of the document in the table of its doc_type';
+COMMENT ON COLUMN document_embedding.doc_created_ts IS 'Creation time of the document, used by time filters';
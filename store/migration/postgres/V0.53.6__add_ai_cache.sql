@@ -0,0 +1,18 @@
+-- Add ai_cache: persistent embedding and LLM response cache shared by all instances
+
+CREATE TABLE ai_cache (
+  key VARCHAR(256) PRIMARY KEY,
+  namespace VARCHAR(128) NOT NULL,
+  value BYTEA NOT NULL,
+  embedding BYTEA,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())::BIGINT,
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_ai_cache_namespace_created ON ai_cache (namespace, created_ts DESC);
+
+CREATE INDEX idx_ai_cache_expires ON ai_cache (expires_ts) WHERE expires_ts > 0;
+
+COMMENT ON TABLE ai_cache IS 'Cached embeddings and LLM responses, keyed by model or task and content hash';
+COMMENT ON COLUMN ai_cache.embedding IS 'Prompt embedding (little-endian float32) for semantic response lookups';
+COMMENT ON COLUMN ai_cache.expires_ts IS 'Unix timestamp after which the entry is stale, 0 for no expiry';
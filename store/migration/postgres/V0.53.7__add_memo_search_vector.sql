@@ -0,0 +1,6 @@
+-- memo.search_vector: precomputed full-text index of segmented memo content
+-- The application segments Chinese text into words before indexing, so the 'simple'
+-- configuration only lowercases tokens. Existing memos are indexed by the search index runner.
+ALTER TABLE memo ADD COLUMN IF NOT EXISTS search_vector TSVECTOR;
+
+CREATE INDEX IF NOT EXISTS idx_memo_search_vector ON memo USING GIN (search_vector);
@@ -0,0 +1,6 @@
+-- attachment.search_vector: precomputed full-text index of segmented extracted and OCR text
+-- Matches are attributed to the parent memo by BM25 search. Existing attachments are
+-- indexed by the search index runner.
+ALTER TABLE attachment ADD COLUMN IF NOT EXISTS search_vector TSVECTOR;
+
+CREATE INDEX IF NOT EXISTS idx_attachment_search_vector ON attachment USING GIN (search_vector);
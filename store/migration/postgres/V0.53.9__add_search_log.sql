@@ -0,0 +1,21 @@
+-- search_log: memo searches of each user for search insights
+-- Users can disable logging in their AI preferences; logs past the retention period are deleted periodically.
+CREATE TABLE search_log (
+  id SERIAL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  query TEXT NOT NULL,
+  source VARCHAR(20) NOT NULL,
+  strategy VARCHAR(64) NOT NULL DEFAULT '',
+  result_count INTEGER NOT NULL DEFAULT 0,
+  latency_ms INTEGER NOT NULL DEFAULT 0,
+  clicked_memo_id INTEGER,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  CONSTRAINT fk_search_log_user
+    FOREIGN KEY (user_id)
+    REFERENCES "user"(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_search_log_user_created ON search_log (user_id, created_ts DESC);
+
+CREATE INDEX idx_search_log_created ON search_log (created_ts);
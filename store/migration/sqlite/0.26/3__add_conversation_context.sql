@@ -0,0 +1,14 @@
+-- conversation_context: persisted multi-turn agent state (session recovery across restarts and instances)
+CREATE TABLE conversation_context (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  session_id TEXT NOT NULL UNIQUE,
+  user_id INTEGER NOT NULL,
+  agent_type TEXT NOT NULL CHECK (agent_type IN ('memo', 'schedule', 'amazing', 'assistant')),
+  context_data TEXT NOT NULL DEFAULT '{}',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_conversation_context_user ON conversation_context (user_id);
+
+CREATE INDEX idx_conversation_context_updated ON conversation_context (updated_ts);
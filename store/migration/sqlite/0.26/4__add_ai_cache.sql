@@ -0,0 +1,13 @@
+-- ai_cache: persistent embedding and LLM response cache shared by all instances
+CREATE TABLE ai_cache (
+  key TEXT NOT NULL PRIMARY KEY,
+  namespace TEXT NOT NULL,
+  value BLOB NOT NULL,
+  embedding BLOB,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_ai_cache_namespace_created ON ai_cache (namespace, created_ts);
+
+CREATE INDEX idx_ai_cache_expires ON ai_cache (expires_ts);
@@ -0,0 +1,9 @@
+-- memo_fts: full-text index of segmented memo content (rowid = memo.id)
+-- The application segments Chinese text into space-separated words before indexing.
+-- Existing memos are indexed by the search index runner.
+CREATE VIRTUAL TABLE memo_fts USING fts5(content, tokenize = 'unicode61');
+
+CREATE TRIGGER memo_fts_delete AFTER DELETE ON memo
+BEGIN
+  DELETE FROM memo_fts WHERE rowid = OLD.id;
+END;
@@ -0,0 +1,12 @@
+-- attachment text extracted by the OCR runner, as in the PostgreSQL schema
+ALTER TABLE attachment ADD COLUMN extracted_text TEXT NOT NULL DEFAULT '';
+ALTER TABLE attachment ADD COLUMN ocr_text TEXT NOT NULL DEFAULT '';
+
+-- attachment_fts: full-text index of segmented attachment text (rowid = attachment.id)
+-- Matches are attributed to the parent memo by BM25 search.
+CREATE VIRTUAL TABLE attachment_fts USING fts5(content, tokenize = 'unicode61');
+
+CREATE TRIGGER attachment_fts_delete AFTER DELETE ON attachment
+BEGIN
+  DELETE FROM attachment_fts WHERE rowid = OLD.id;
+END;
@@ -0,0 +1,16 @@
+-- search_log: memo searches of each user for search insights, as in the PostgreSQL schema
+CREATE TABLE search_log (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  query TEXT NOT NULL,
+  source TEXT NOT NULL,
+  strategy TEXT NOT NULL DEFAULT '',
+  result_count INTEGER NOT NULL DEFAULT 0,
+  latency_ms INTEGER NOT NULL DEFAULT 0,
+  clicked_memo_id INTEGER,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_search_log_user_created ON search_log (user_id, created_ts);
+
+CREATE INDEX idx_search_log_created ON search_log (created_ts);
@@ -0,0 +1,2 @@
+-- Rollback conversation_context table for SQLite
+DROP TABLE IF EXISTS conversation_context;
@@ -0,0 +1,2 @@
+-- Rollback ai_cache table for SQLite
+DROP TABLE IF EXISTS ai_cache;
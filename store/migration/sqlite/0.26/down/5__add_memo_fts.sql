@@ -0,0 +1,3 @@
+-- Rollback memo_fts full-text index for SQLite
+DROP TRIGGER IF EXISTS memo_fts_delete;
+DROP TABLE IF EXISTS memo_fts;
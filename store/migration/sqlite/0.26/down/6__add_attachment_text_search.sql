@@ -0,0 +1,5 @@
+-- Rollback attachment text search for SQLite
+DROP TRIGGER IF EXISTS attachment_fts_delete;
+DROP TABLE IF EXISTS attachment_fts;
+ALTER TABLE attachment DROP COLUMN ocr_text;
+ALTER TABLE attachment DROP COLUMN extracted_text;
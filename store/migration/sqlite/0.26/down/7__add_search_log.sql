@@ -0,0 +1,4 @@
+-- Rollback search log for SQLite
+DROP INDEX IF EXISTS idx_search_log_created;
+DROP INDEX IF EXISTS idx_search_log_user_created;
+DROP TABLE IF EXISTS search_log;
@@ -65,7 +65,9 @@ CREATE TABLE attachment (
   memo_id INTEGER,
   storage_type TEXT NOT NULL DEFAULT '',
   reference TEXT NOT NULL DEFAULT '',
-  payload TEXT NOT NULL DEFAULT '{}'
+  payload TEXT NOT NULL DEFAULT '{}',
+  extracted_text TEXT NOT NULL DEFAULT '',
+  ocr_text TEXT NOT NULL DEFAULT ''
 );
 
 -- activity
@@ -132,3 +134,64 @@ CREATE TABLE ai_message (
   created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
 );
 
+-- conversation_context
+CREATE TABLE conversation_context (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  session_id TEXT NOT NULL UNIQUE,
+  user_id INTEGER NOT NULL,
+  agent_type TEXT NOT NULL CHECK (agent_type IN ('memo', 'schedule', 'amazing', 'assistant')),
+  context_data TEXT NOT NULL DEFAULT '{}',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_conversation_context_user ON conversation_context (user_id);
+
+CREATE INDEX idx_conversation_context_updated ON conversation_context (updated_ts);
+
+-- ai_cache
+CREATE TABLE ai_cache (
+  key TEXT NOT NULL PRIMARY KEY,
+  namespace TEXT NOT NULL,
+  value BLOB NOT NULL,
+  embedding BLOB,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_ai_cache_namespace_created ON ai_cache (namespace, created_ts);
+
+CREATE INDEX idx_ai_cache_expires ON ai_cache (expires_ts);
+
+-- memo_fts
+CREATE VIRTUAL TABLE memo_fts USING fts5(content, tokenize = 'unicode61');
+
+CREATE TRIGGER memo_fts_delete AFTER DELETE ON memo
+BEGIN
+  DELETE FROM memo_fts WHERE rowid = OLD.id;
+END;
+
+-- attachment_fts
+CREATE VIRTUAL TABLE attachment_fts USING fts5(content, tokenize = 'unicode61');
+
+CREATE TRIGGER attachment_fts_delete AFTER DELETE ON attachment
+BEGIN
+  DELETE FROM attachment_fts WHERE rowid = OLD.id;
+END;
+
+-- search_log
+CREATE TABLE search_log (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  query TEXT NOT NULL,
+  source TEXT NOT NULL,
+  strategy TEXT NOT NULL DEFAULT '',
+  result_count INTEGER NOT NULL DEFAULT 0,
+  latency_ms INTEGER NOT NULL DEFAULT 0,
+  clicked_memo_id INTEGER,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_search_log_user_created ON search_log (user_id, created_ts);
+
+CREATE INDEX idx_search_log_created ON search_log (created_ts);
@@ -102,9 +102,12 @@ func (s *Store) UpdateSchedule(ctx context.Context, update *UpdateSchedule) erro
 	return s.driver.UpdateSchedule(ctx, update)
 }
 
-// DeleteSchedule deletes a schedule.
+// DeleteSchedule deletes a schedule and its embedding.
 func (s *Store) DeleteSchedule(ctx context.Context, delete *DeleteSchedule) error {
-	return s.driver.DeleteSchedule(ctx, delete)
+	if err := s.driver.DeleteSchedule(ctx, delete); err != nil {
+		return err
+	}
+	return s.deleteDocumentEmbeddings(ctx, DocumentTypeSchedule, []int32{delete.ID})
 }
 
 // ScheduleService is the interface for schedule-related operations.
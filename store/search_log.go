@@ -0,0 +1,76 @@
+package store
+
+import "context"
+
+// SearchLog is a memo search of a user, kept for search insights.
+type SearchLog struct {
+	ID            int64
+	UserID        int32
+	Query         string // Query as entered, including operators
+	Source        string // Entry point, e.g. "search" or "agent"
+	Strategy      string // Retrieval strategy
+	ResultCount   int32
+	LatencyMs     int32
+	ClickedMemoID *int32 // Last result opened from the search; nil if none
+	CreatedTs     int64
+}
+
+// Search log sources.
+const (
+	SearchLogSourceSearch = "search" // Memo search with highlighting
+	SearchLogSourceAgent  = "agent"  // Memo search tool of the AI agents
+)
+
+// FindSearchLog specifies the conditions for finding search logs.
+type FindSearchLog struct {
+	ID             *int64
+	UserID         *int32
+	CreatedTsAfter *int64 // Unix timestamp, inclusive
+	Limit          int    // 0 means no limit
+}
+
+// UpdateSearchLog specifies the data for updating a search log.
+type UpdateSearchLog struct {
+	ID            int64
+	ClickedMemoID *int32
+}
+
+// DeleteSearchLog specifies the conditions for deleting search logs.
+// At least one condition is required.
+type DeleteSearchLog struct {
+	UserID          *int32
+	CreatedTsBefore *int64 // Unix timestamp; deletes logs created before
+}
+
+// CreateSearchLog saves a search log.
+func (s *Store) CreateSearchLog(ctx context.Context, create *SearchLog) (*SearchLog, error) {
+	return s.driver.CreateSearchLog(ctx, create)
+}
+
+// ListSearchLogs lists search logs, newest first.
+func (s *Store) ListSearchLogs(ctx context.Context, find *FindSearchLog) ([]*SearchLog, error) {
+	return s.driver.ListSearchLogs(ctx, find)
+}
+
+// GetSearchLog returns the search log matching the conditions, or nil if none.
+func (s *Store) GetSearchLog(ctx context.Context, find *FindSearchLog) (*SearchLog, error) {
+	find.Limit = 1
+	list, err := s.driver.ListSearchLogs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// UpdateSearchLog updates a search log.
+func (s *Store) UpdateSearchLog(ctx context.Context, update *UpdateSearchLog) error {
+	return s.driver.UpdateSearchLog(ctx, update)
+}
+
+// DeleteSearchLogs deletes search logs and returns the number of deleted logs.
+func (s *Store) DeleteSearchLogs(ctx context.Context, delete *DeleteSearchLog) (int64, error) {
+	return s.driver.DeleteSearchLogs(ctx, delete)
+}
@@ -20,6 +20,7 @@ type Store struct {
 	instanceSettingCache *cache.Cache // cache for instance settings
 	userCache            *cache.Cache // cache for users
 	userSettingCache     *cache.Cache // cache for user settings
+	searchWordsCache     *cache.Cache // cache for full-text search words of users
 }
 
 // New creates a new instance of Store.
@@ -39,6 +40,7 @@ func New(driver Driver, profile *profile.Profile) *Store {
 		instanceSettingCache: cache.New(cacheConfig),
 		userCache:            cache.New(cacheConfig),
 		userSettingCache:     cache.New(cacheConfig),
+		searchWordsCache:     cache.New(cacheConfig),
 	}
 
 	return store
@@ -53,6 +55,7 @@ func (s *Store) Close() error {
 	s.instanceSettingCache.Close()
 	s.userCache.Close()
 	s.userSettingCache.Close()
+	s.searchWordsCache.Close()
 
 	return s.driver.Close()
 }
@@ -70,7 +73,15 @@ func (s *Store) UpdateAIConversation(ctx context.Context, update *UpdateAIConver
 }
 
 func (s *Store) DeleteAIConversation(ctx context.Context, delete *DeleteAIConversation) error {
-	return s.driver.DeleteAIConversation(ctx, delete)
+	// Messages are deleted with the conversation, so collect them for their embeddings first
+	messageIDs, err := s.listAIMessageIDs(ctx, &FindAIMessage{ConversationID: &delete.ID})
+	if err != nil {
+		return err
+	}
+	if err := s.driver.DeleteAIConversation(ctx, delete); err != nil {
+		return err
+	}
+	return s.deleteDocumentEmbeddings(ctx, DocumentTypeMessage, messageIDs)
 }
 
 func (s *Store) CreateAIMessage(ctx context.Context, create *AIMessage) (*AIMessage, error) {
@@ -82,7 +93,30 @@ func (s *Store) ListAIMessages(ctx context.Context, find *FindAIMessage) ([]*AIM
 }
 
 func (s *Store) DeleteAIMessage(ctx context.Context, delete *DeleteAIMessage) error {
-	return s.driver.DeleteAIMessage(ctx, delete)
+	if delete.ID == nil && delete.ConversationID == nil {
+		return s.driver.DeleteAIMessage(ctx, delete) // Rejected by the driver
+	}
+	messageIDs, err := s.listAIMessageIDs(ctx, &FindAIMessage{ID: delete.ID, ConversationID: delete.ConversationID})
+	if err != nil {
+		return err
+	}
+	if err := s.driver.DeleteAIMessage(ctx, delete); err != nil {
+		return err
+	}
+	return s.deleteDocumentEmbeddings(ctx, DocumentTypeMessage, messageIDs)
+}
+
+// listAIMessageIDs returns the IDs of the matching messages.
+func (s *Store) listAIMessageIDs(ctx context.Context, find *FindAIMessage) ([]int32, error) {
+	messages, err := s.driver.ListAIMessages(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int32, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+	return ids, nil
 }
 
 func (s *Store) CreateEpisodicMemory(ctx context.Context, create *EpisodicMemory) (*EpisodicMemory, error) {
@@ -97,6 +131,10 @@ func (s *Store) ListActiveUserIDs(ctx context.Context, cutoff time.Time) ([]int3
 	return s.driver.ListActiveUserIDs(ctx, cutoff)
 }
 
+func (s *Store) SearchEpisodicMemories(ctx context.Context, search *SearchEpisodicMemory) ([]*EpisodicMemoryWithScore, error) {
+	return s.driver.SearchEpisodicMemories(ctx, search)
+}
+
 func (s *Store) DeleteEpisodicMemory(ctx context.Context, delete *DeleteEpisodicMemory) error {
 	return s.driver.DeleteEpisodicMemory(ctx, delete)
 }
@@ -109,6 +147,29 @@ func (s *Store) GetUserPreferences(ctx context.Context, find *FindUserPreference
 	return s.driver.GetUserPreferences(ctx, find)
 }
 
+func (s *Store) UpsertConversationContext(ctx context.Context, upsert *UpsertConversationContext) (*ConversationContext, error) {
+	return s.driver.UpsertConversationContext(ctx, upsert)
+}
+
+func (s *Store) ListConversationContexts(ctx context.Context, find *FindConversationContext) ([]*ConversationContext, error) {
+	return s.driver.ListConversationContexts(ctx, find)
+}
+
+func (s *Store) GetConversationContext(ctx context.Context, find *FindConversationContext) (*ConversationContext, error) {
+	list, err := s.driver.ListConversationContexts(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteConversationContexts(ctx context.Context, delete *DeleteConversationContext) (int64, error) {
+	return s.driver.DeleteConversationContexts(ctx, delete)
+}
+
 func (s *Store) UpsertAgentMetrics(ctx context.Context, upsert *UpsertAgentMetrics) (*AgentMetrics, error) {
 	return s.driver.UpsertAgentMetrics(ctx, upsert)
 }
@@ -1,5 +1,11 @@
 package store
 
+import "errors"
+
+// ErrAIFeatureNotSupported is returned by database drivers that can't store
+// episodic memories and user preferences.
+var ErrAIFeatureNotSupported = errors.New("AI features (episodic memory, user preferences) are not supported")
+
 // UserPreferences represents user preferences for AI personalization.
 type UserPreferences struct {
 	UserID      int32
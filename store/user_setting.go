@@ -394,6 +394,96 @@ func (s *Store) UpdateUserWebhook(ctx context.Context, userID int32, webhook *st
 	return err
 }
 
+// GetUserDigests returns the scheduled AI digests of the user.
+func (s *Store) GetUserDigests(ctx context.Context, userID int32) ([]*storepb.DigestsUserSetting_Digest, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_DIGESTS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.DigestsUserSetting_Digest{}, nil
+	}
+
+	return userSetting.GetDigests().Digests, nil
+}
+
+// SetUserDigests replaces the scheduled AI digests of the user.
+func (s *Store) SetUserDigests(ctx context.Context, userID int32, digests []*storepb.DigestsUserSetting_Digest) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_DIGESTS,
+		Value: &storepb.UserSetting_Digests{
+			Digests: &storepb.DigestsUserSetting{
+				Digests: digests,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserSearchSynonyms returns the search synonym groups of the user.
+func (s *Store) GetUserSearchSynonyms(ctx context.Context, userID int32) ([]*storepb.SearchSynonymsUserSetting_Group, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_SEARCH_SYNONYMS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.SearchSynonymsUserSetting_Group{}, nil
+	}
+
+	return userSetting.GetSearchSynonyms().Groups, nil
+}
+
+// SetUserSearchSynonyms replaces the search synonym groups of the user.
+func (s *Store) SetUserSearchSynonyms(ctx context.Context, userID int32, groups []*storepb.SearchSynonymsUserSetting_Group) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_SEARCH_SYNONYMS,
+		Value: &storepb.UserSetting_SearchSynonyms{
+			SearchSynonyms: &storepb.SearchSynonymsUserSetting{
+				Groups: groups,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserShortcuts returns the shortcuts of the user.
+func (s *Store) GetUserShortcuts(ctx context.Context, userID int32) ([]*storepb.ShortcutsUserSetting_Shortcut, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_SHORTCUTS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.ShortcutsUserSetting_Shortcut{}, nil
+	}
+
+	return userSetting.GetShortcuts().Shortcuts, nil
+}
+
+// SetUserShortcuts replaces the shortcuts of the user.
+func (s *Store) SetUserShortcuts(ctx context.Context, userID int32, shortcuts []*storepb.ShortcutsUserSetting_Shortcut) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_SHORTCUTS,
+		Value: &storepb.UserSetting_Shortcuts{
+			Shortcuts: &storepb.ShortcutsUserSetting{
+				Shortcuts: shortcuts,
+			},
+		},
+	})
+	return err
+}
+
 func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 	userSetting := &storepb.UserSetting{
 		UserId: raw.UserID,
@@ -437,6 +527,18 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_ReviewStates{ReviewStates: reviewStatesUserSetting}
+	case storepb.UserSetting_DIGESTS:
+		digestsUserSetting := &storepb.DigestsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), digestsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Digests{Digests: digestsUserSetting}
+	case storepb.UserSetting_SEARCH_SYNONYMS:
+		searchSynonymsUserSetting := &storepb.SearchSynonymsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), searchSynonymsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_SearchSynonyms{SearchSynonyms: searchSynonymsUserSetting}
 	default:
 		return nil, nil
 	}
@@ -492,6 +594,20 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSetting_DIGESTS:
+		digestsUserSetting := userSetting.GetDigests()
+		value, err := protojson.Marshal(digestsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_SEARCH_SYNONYMS:
+		searchSynonymsUserSetting := userSetting.GetSearchSynonyms()
+		value, err := protojson.Marshal(searchSynonymsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}